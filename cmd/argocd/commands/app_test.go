@@ -2208,7 +2208,7 @@ func (c *fakeAppServiceClient) WatchResourceTree(_ context.Context, _ *applicati
 	return nil, nil
 }
 
-func (c *fakeAppServiceClient) Rollback(_ context.Context, _ *applicationpkg.ApplicationRollbackRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+func (c *fakeAppServiceClient) Rollback(_ context.Context, _ *applicationpkg.ApplicationRollbackRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationRollbackResponse, error) {
 	return nil, nil
 }
 
@@ -2257,6 +2257,254 @@ func (c *fakeAppServiceClient) ServerSideDiff(_ context.Context, _ *applicationp
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) RestartAppWorkloads(_ context.Context, _ *applicationpkg.RestartAppWorkloadsRequest, _ ...grpc.CallOption) (*applicationpkg.RestartAppWorkloadsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListProjectSyncWindows(_ context.Context, _ *applicationpkg.ProjectSyncWindowsQuery, _ ...grpc.CallOption) (*applicationpkg.ProjectSyncWindowsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetLastAppliedConfig(_ context.Context, _ *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*applicationpkg.LastAppliedConfigResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetRBACName(_ context.Context, _ *applicationpkg.ApplicationRBACNameQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationRBACNameResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) UpdateApplicationsMetadata(_ context.Context, _ *applicationpkg.ApplicationsMetadataUpdateRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationsMetadataUpdateResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetRevisionsDiff(_ context.Context, _ *applicationpkg.ApplicationRevisionsDiffQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationRevisionsDiffResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ValidateResourceAction(_ context.Context, _ *applicationpkg.ResourceActionRunRequestV2, _ ...grpc.CallOption) (*applicationpkg.ResourceActionValidationResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) StreamManagedResources(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (applicationpkg.ApplicationService_StreamManagedResourcesClient, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListAppsBlockedBySyncWindow(_ context.Context, _ *applicationpkg.ApplicationQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationsBlockedBySyncWindowResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) PreviewProjectChange(_ context.Context, _ *applicationpkg.ApplicationProjectChangePreviewRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationProjectChangePreviewResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetSyncWaves(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationSyncWavesResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetDeployedRevisionAuthor(_ context.Context, _ *applicationpkg.DeployedRevisionAuthorQuery, _ ...grpc.CallOption) (*applicationpkg.DeployedRevisionAuthorResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetStableHealth(_ context.Context, _ *applicationpkg.ApplicationStableHealthQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationStableHealthResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) PatchResources(_ context.Context, _ *applicationpkg.ApplicationResourcesPatchRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourcesPatchResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetIgnoreDifferencesMatches(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationIgnoreDifferencesMatchesResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetResourceKindCounts(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceKindCountsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ValidateSync(_ context.Context, _ *applicationpkg.ApplicationSyncRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationSyncValidationResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetDestinationInfo(_ context.Context, _ *applicationpkg.ApplicationDestinationInfoQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationDestinationInfoResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetAppResourceRequests(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceRequestsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetPerSourceSyncStatus(_ context.Context, _ *applicationpkg.PerSourceSyncStatusQuery, _ ...grpc.CallOption) (*applicationpkg.PerSourceSyncStatusResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ArchiveLogs(_ context.Context, _ *applicationpkg.ApplicationPodLogsQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationLogsArchiveResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) DiffFromGeneratedTemplate(_ context.Context, _ *applicationpkg.ApplicationTemplateDiffQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationTemplateDiffResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetResourceTargetManifest(_ context.Context, _ *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetEffectiveIgnoreDifferences(_ context.Context, _ *applicationpkg.ApplicationEffectiveIgnoreDifferencesQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationEffectiveIgnoreDifferencesResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) RefreshResource(_ context.Context, _ *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*v1alpha1.ResourceNode, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) PreviewUpdate(_ context.Context, _ *applicationpkg.ApplicationUpdateRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationUpdatePreviewResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetPodLogsSnapshot(_ context.Context, _ *applicationpkg.ApplicationPodLogsQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationPodLogsSnapshotResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ValidatePatch(_ context.Context, _ *applicationpkg.ApplicationPatchRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationSchemaValidationResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetSyncDurations(_ context.Context, _ *applicationpkg.ApplicationSyncDurationsQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationSyncDurationsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetTTL(_ context.Context, _ *applicationpkg.ApplicationTTLQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationTTLResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListExcludedResources(_ context.Context, _ *applicationpkg.ApplicationExcludedResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationExcludedResourcesResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) PreviewSyncOptionImpact(_ context.Context, _ *applicationpkg.ApplicationSyncOptionImpactRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationSyncOptionImpactResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) BatchGetWithTrees(_ context.Context, _ *applicationpkg.ApplicationsWithTreesQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationsWithTreesResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListProjectAppConditions(_ context.Context, _ *applicationpkg.ProjectAppConditionsQuery, _ ...grpc.CallOption) (*applicationpkg.ProjectAppConditionsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetDeployedImageDigests(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationImageDigestsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) VerifyDeployedRevisionSignature(_ context.Context, _ *applicationpkg.DeployedRevisionSignatureQuery, _ ...grpc.CallOption) (*applicationpkg.DeployedRevisionSignatureResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetResourceDestinations(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceDestinationsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetResolvedSourceParameters(_ context.Context, _ *applicationpkg.ApplicationResolvedSourceParametersQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationResolvedSourceParametersResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListManualEdits(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationManualEditsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetHookResults(_ context.Context, _ *applicationpkg.ApplicationHookResultsQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationHookResultsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetPrunePreview(_ context.Context, _ *applicationpkg.ApplicationPrunePreviewQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationPrunePreviewResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) WatchApplicationEvents(_ context.Context, _ *applicationpkg.ApplicationObjectEventsQuery, _ ...grpc.CallOption) (applicationpkg.ApplicationService_WatchApplicationEventsClient, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListStaleApplications(_ context.Context, _ *applicationpkg.ApplicationQuery, _ ...grpc.CallOption) (*applicationpkg.StaleApplicationsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) UploadLocalManifests(_ context.Context, _ ...grpc.CallOption) (applicationpkg.ApplicationService_UploadLocalManifestsClient, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetFieldManager(_ context.Context, _ *applicationpkg.ApplicationFieldManagerQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationFieldManagerResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetKustomizeDetails(_ context.Context, _ *applicationpkg.ApplicationKustomizeDetailsQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationKustomizeDetailsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListSourceRevisionHistory(_ context.Context, _ *applicationpkg.ApplicationSourceRevisionHistoryQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationSourceRevisionHistoryResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetSyncBlastRadius(_ context.Context, _ *applicationpkg.ApplicationSyncBlastRadiusQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationSyncBlastRadiusResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetStatusTransitions(_ context.Context, _ *applicationpkg.ApplicationStatusTransitionsQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationStatusTransitionsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListNotificationSubscriptions(_ context.Context, _ *applicationpkg.ApplicationNotificationSubscriptionsQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationNotificationSubscriptionsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) UpdateSyncPolicy(_ context.Context, _ *applicationpkg.ApplicationSyncPolicyUpdateRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationSyncPolicyResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetRevisionPinningStatus(_ context.Context, _ *applicationpkg.ApplicationRevisionPinningStatusQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationRevisionPinningStatusResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ValidateAgainstCluster(_ context.Context, _ *applicationpkg.ApplicationClusterValidationRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationClusterValidationResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) DryRunPatch(_ context.Context, _ *applicationpkg.ApplicationPatchRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationDryRunPatchResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListAppsByDestinationNamespace(_ context.Context, _ *applicationpkg.ApplicationDestinationNamespaceQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationList, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetProgressingReason(_ context.Context, _ *applicationpkg.ApplicationProgressingReasonQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationProgressingReasonResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListGroupedByProject(_ context.Context, _ *applicationpkg.ApplicationQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationsByProjectResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetLastOperationError(_ context.Context, _ *applicationpkg.ApplicationLastOperationErrorQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationLastOperationErrorResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetResolvedSyncOptions(_ context.Context, _ *applicationpkg.ApplicationResolvedSyncOptionsQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationResolvedSyncOptionsResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetNextReconcileTime(_ context.Context, _ *applicationpkg.ApplicationNextReconcileTimeQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationNextReconcileTimeResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) CheckSourcesPermitted(_ context.Context, _ *applicationpkg.ApplicationSourcesPermissionQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationSourcesPermissionResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) GetResourceSubtreeHealthCounts(_ context.Context, _ *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*applicationpkg.ResourceSubtreeHealthCountsResponse, error) {
+	return nil, nil
+}
+
 type fakeAcdClient struct {
 	simulateTimeout uint
 }
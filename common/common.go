@@ -184,6 +184,10 @@ const (
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelKeyClusterKubernetesVersion contains the kubernetes version of the cluster secret if it has been enabled
 	LabelKeyClusterKubernetesVersion = "argocd.argoproj.io/kubernetes-version"
+	// LabelKeyCorrelationID is the event label, and the operation info name, holding the correlation ID a sync was requested with
+	LabelKeyCorrelationID = "argocd.argoproj.io/correlation-id"
+	// LabelKeyOperationID is the event label, and the operation info name, holding the unique ID of an operation started by the API server
+	LabelKeyOperationID = "argocd.argoproj.io/operation-id"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
 	// LabelValueSecretTypeRepository indicates a secret type of repository
@@ -227,6 +231,9 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+	// AnnotationKeyAppTTL is the time, as a duration such as "72h", after which an Application is deleted, counted from
+	// its creation. The API server only validates it, the deletion is left to a separate controller.
+	AnnotationKeyAppTTL = "argocd.argoproj.io/ttl"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -257,6 +264,12 @@ const (
 	EnvGnuPGHome = "ARGOCD_GNUPGHOME"
 	// EnvWatchAPIBufferSize is the buffer size used to transfer K8S watch events to watch API consumer
 	EnvWatchAPIBufferSize = "ARGOCD_WATCH_API_BUFFER_SIZE"
+	// EnvExternalEventsListTimeout is the maximum time the API server waits for the events of a resource deployed to an external cluster
+	EnvExternalEventsListTimeout = "ARGOCD_SERVER_EXTERNAL_EVENTS_LIST_TIMEOUT"
+	// EnvHardRefreshAppDetailsTimeout is the maximum time the API server waits for the application details to be regenerated during a hard refresh
+	EnvHardRefreshAppDetailsTimeout = "ARGOCD_SERVER_HARD_REFRESH_APP_DETAILS_TIMEOUT"
+	// EnvManifestGenerationParallelism is the maximum number of sources of an application the API server generates manifests for concurrently
+	EnvManifestGenerationParallelism = "ARGOCD_SERVER_MANIFEST_GENERATION_PARALLELISM"
 	// EnvPauseGenerationAfterFailedAttempts will pause manifest generation after the specified number of failed generation attempts
 	EnvPauseGenerationAfterFailedAttempts = "ARGOCD_PAUSE_GEN_AFTER_FAILED_ATTEMPTS"
 	// EnvPauseGenerationMinutes pauses manifest generation for the specified number of minutes, after sufficient manifest generation failures
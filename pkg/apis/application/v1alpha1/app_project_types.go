@@ -422,10 +422,19 @@ func globMatch(pattern string, val string, allowNegation bool, separators ...run
 
 // IsSourcePermitted validates if the provided application's source is a one of the allowed sources for the project.
 func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
+	permitted, _ := proj.MatchSourceRepos(src)
+	return permitted
+}
+
+// MatchSourceRepos returns whether the provided application's source is permitted by the source repositories of the
+// project, along with the pattern which decided it: the deny pattern excluding the source, or else the first pattern
+// permitting it. The pattern is empty if no source repository matched.
+func (proj AppProject) MatchSourceRepos(src ApplicationSource) (bool, string) {
 	srcNormalized := git.NormalizeGitURL(src.RepoURL)
 
 	var normalized string
-	anySourceMatched := false
+	var matchedPattern string
+	var matchedAllowPattern string
 
 	for _, repoURL := range proj.Spec.SourceRepos {
 		if isDenyPattern(repoURL) {
@@ -436,13 +445,21 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 
 		matched := globMatch(normalized, srcNormalized, true, '/')
 		if matched {
-			anySourceMatched = true
+			if matchedPattern == "" {
+				matchedPattern = repoURL
+			}
+			if matchedAllowPattern == "" && !isDenyPattern(repoURL) {
+				matchedAllowPattern = repoURL
+			}
 		} else if !matched && isDenyPattern(normalized) {
-			return false
+			return false, repoURL
 		}
 	}
 
-	return anySourceMatched
+	if matchedAllowPattern != "" {
+		return true, matchedAllowPattern
+	}
+	return matchedPattern != "", matchedPattern
 }
 
 // IsDestinationPermitted validates if the provided application's destination is one of the allowed destinations for the project
@@ -4914,780 +4914,776 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 12358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x70, 0x24, 0x59,
-	0x56, 0x18, 0xbc, 0x59, 0xa5, 0x92, 0xaa, 0x8e, 0x5e, 0xad, 0xdb, 0xdd, 0x33, 0xea, 0x9e, 0x87,
-	0x9a, 0x1c, 0x98, 0x5d, 0xbe, 0x9d, 0x91, 0x98, 0x9e, 0x99, 0x65, 0x3e, 0x06, 0x16, 0xf4, 0xe8,
-	0x87, 0xba, 0xa5, 0x96, 0xe6, 0x96, 0xba, 0x9b, 0x9d, 0x65, 0x76, 0x36, 0x95, 0x75, 0x25, 0x65,
-	0x2b, 0x2b, 0xb3, 0x26, 0x33, 0x4b, 0xad, 0x1a, 0x86, 0x65, 0x97, 0xdd, 0x85, 0x85, 0x7d, 0x8d,
-	0xc1, 0x61, 0x06, 0xdb, 0xe0, 0xc5, 0xe0, 0x47, 0x84, 0x63, 0x03, 0x6c, 0x7e, 0x98, 0x08, 0x20,
-	0x08, 0x1e, 0x41, 0x80, 0x5f, 0x60, 0x02, 0x03, 0x36, 0x20, 0xef, 0xb6, 0xed, 0x80, 0x70, 0x84,
-	0x89, 0x00, 0xfb, 0x87, 0xa3, 0xed, 0x20, 0x1c, 0xf7, 0x7d, 0x33, 0x2b, 0x4b, 0x2a, 0xb5, 0x52,
-	0xdd, 0xbd, 0x30, 0xff, 0xaa, 0xee, 0x39, 0x79, 0xce, 0xcd, 0x9b, 0xf7, 0x9e, 0x73, 0xee, 0xb9,
-	0xe7, 0x9c, 0x0b, 0x4b, 0x9b, 0x5e, 0xb2, 0xd5, 0x5e, 0x9f, 0x76, 0xc3, 0xe6, 0x8c, 0x13, 0x6d,
-	0x86, 0xad, 0x28, 0xbc, 0xc5, 0x7e, 0x3c, 0xeb, 0x36, 0x66, 0x76, 0x9e, 0x9f, 0x69, 0x6d, 0x6f,
-	0xce, 0x38, 0x2d, 0x2f, 0x9e, 0x71, 0x5a, 0x2d, 0xdf, 0x73, 0x9d, 0xc4, 0x0b, 0x83, 0x99, 0x9d,
-	0xe7, 0x1c, 0xbf, 0xb5, 0xe5, 0x3c, 0x37, 0xb3, 0x49, 0x02, 0x12, 0x39, 0x09, 0x69, 0x4c, 0xb7,
-	0xa2, 0x30, 0x09, 0xd1, 0xb7, 0x6a, 0x6a, 0xd3, 0x92, 0x1a, 0xfb, 0xf1, 0xba, 0xdb, 0x98, 0xde,
-	0x79, 0x7e, 0xba, 0xb5, 0xbd, 0x39, 0x4d, 0xa9, 0x4d, 0x1b, 0xd4, 0xa6, 0x25, 0xb5, 0xb3, 0xcf,
-	0x1a, 0x7d, 0xd9, 0x0c, 0x37, 0xc3, 0x19, 0x46, 0x74, 0xbd, 0xbd, 0xc1, 0xfe, 0xb1, 0x3f, 0xec,
-	0x17, 0x67, 0x76, 0xd6, 0xde, 0x7e, 0x29, 0x9e, 0xf6, 0x42, 0xda, 0xbd, 0x19, 0x37, 0x8c, 0xc8,
-	0xcc, 0x4e, 0x57, 0x87, 0xce, 0x5e, 0xd6, 0x38, 0x64, 0x37, 0x21, 0x41, 0xec, 0x85, 0x41, 0xfc,
-	0x2c, 0xed, 0x02, 0x89, 0x76, 0x48, 0x64, 0xbe, 0x9e, 0x81, 0x90, 0x47, 0xe9, 0x05, 0x4d, 0xa9,
-	0xe9, 0xb8, 0x5b, 0x5e, 0x40, 0xa2, 0x8e, 0x7e, 0xbc, 0x49, 0x12, 0x27, 0xef, 0xa9, 0x99, 0x5e,
-	0x4f, 0x45, 0xed, 0x20, 0xf1, 0x9a, 0xa4, 0xeb, 0x81, 0x0f, 0x1c, 0xf4, 0x40, 0xec, 0x6e, 0x91,
-	0xa6, 0xd3, 0xf5, 0xdc, 0xf3, 0xbd, 0x9e, 0x6b, 0x27, 0x9e, 0x3f, 0xe3, 0x05, 0x49, 0x9c, 0x44,
-	0xd9, 0x87, 0xec, 0xbf, 0x6f, 0xc1, 0xe8, 0xec, 0xcd, 0xfa, 0x6c, 0x3b, 0xd9, 0x9a, 0x0f, 0x83,
-	0x0d, 0x6f, 0x13, 0xbd, 0x08, 0xc3, 0xae, 0xdf, 0x8e, 0x13, 0x12, 0x5d, 0x73, 0x9a, 0x64, 0xd2,
-	0x3a, 0x67, 0xbd, 0xaf, 0x36, 0x77, 0xf2, 0x37, 0xf7, 0xa6, 0xde, 0x73, 0x67, 0x6f, 0x6a, 0x78,
-	0x5e, 0x83, 0xb0, 0x89, 0x87, 0xbe, 0x11, 0x86, 0xa2, 0xd0, 0x27, 0xb3, 0xf8, 0xda, 0x64, 0x89,
-	0x3d, 0x32, 0x2e, 0x1e, 0x19, 0xc2, 0xbc, 0x19, 0x4b, 0x38, 0x45, 0x6d, 0x45, 0xe1, 0x86, 0xe7,
-	0x93, 0xc9, 0x72, 0x1a, 0x75, 0x95, 0x37, 0x63, 0x09, 0xb7, 0x7f, 0xac, 0x04, 0xe3, 0xb3, 0xad,
-	0xd6, 0x65, 0xe2, 0xf8, 0xc9, 0x56, 0x3d, 0x71, 0x92, 0x76, 0x8c, 0x36, 0x61, 0x30, 0x66, 0xbf,
-	0x44, 0xdf, 0x56, 0xc4, 0xd3, 0x83, 0x1c, 0x7e, 0x77, 0x6f, 0xea, 0xdb, 0xf2, 0x66, 0xf4, 0xa6,
-	0x97, 0x84, 0xad, 0xf8, 0x59, 0x12, 0x6c, 0x7a, 0x01, 0x61, 0xe3, 0xb2, 0xc5, 0xa8, 0x4e, 0x9b,
-	0xc4, 0xe7, 0xc3, 0x06, 0xc1, 0x82, 0x3c, 0xed, 0x67, 0x93, 0xc4, 0xb1, 0xb3, 0x49, 0xb2, 0xaf,
-	0xb4, 0xcc, 0x9b, 0xb1, 0x84, 0xa3, 0x08, 0x90, 0xef, 0xc4, 0xc9, 0x5a, 0xe4, 0x04, 0xb1, 0x47,
-	0xa7, 0xf4, 0x9a, 0xd7, 0xe4, 0x6f, 0x37, 0x7c, 0xfe, 0xff, 0x9b, 0xe6, 0x1f, 0x66, 0xda, 0xfc,
-	0x30, 0x7a, 0x1d, 0xd0, 0x79, 0x33, 0xbd, 0xf3, 0xdc, 0x34, 0x7d, 0x62, 0xee, 0x91, 0x3b, 0x7b,
-	0x53, 0x68, 0xa9, 0x8b, 0x12, 0xce, 0xa1, 0x6e, 0xff, 0x7e, 0x09, 0x60, 0xb6, 0xd5, 0x5a, 0x8d,
-	0xc2, 0x5b, 0xc4, 0x4d, 0xd0, 0x47, 0xa1, 0x4a, 0x49, 0x35, 0x9c, 0xc4, 0x61, 0x03, 0x33, 0x7c,
-	0xfe, 0x9b, 0xfa, 0x63, 0xbc, 0xb2, 0x4e, 0x9f, 0x5f, 0x26, 0x89, 0x33, 0x87, 0xc4, 0x0b, 0x82,
-	0x6e, 0xc3, 0x8a, 0x2a, 0x0a, 0x60, 0x20, 0x6e, 0x11, 0x97, 0x0d, 0xc6, 0xf0, 0xf9, 0xa5, 0xe9,
-	0xa3, 0xac, 0xf4, 0x69, 0xdd, 0xf3, 0x7a, 0x8b, 0xb8, 0x73, 0x23, 0x82, 0xf3, 0x00, 0xfd, 0x87,
-	0x19, 0x1f, 0xb4, 0xa3, 0x3e, 0x34, 0x1f, 0xc8, 0x6b, 0x85, 0x71, 0x64, 0x54, 0xe7, 0xc6, 0xd2,
-	0x13, 0x47, 0x7e, 0x77, 0xfb, 0x4f, 0x2c, 0x18, 0xd3, 0xc8, 0x4b, 0x5e, 0x9c, 0xa0, 0xef, 0xea,
-	0x1a, 0xdc, 0xe9, 0xfe, 0x06, 0x97, 0x3e, 0xcd, 0x86, 0xf6, 0x84, 0x60, 0x56, 0x95, 0x2d, 0xc6,
-	0xc0, 0x36, 0xa1, 0xe2, 0x25, 0xa4, 0x19, 0x4f, 0x96, 0xce, 0x95, 0xdf, 0x37, 0x7c, 0xfe, 0x72,
-	0x51, 0xef, 0x39, 0x37, 0x2a, 0x98, 0x56, 0x16, 0x29, 0x79, 0xcc, 0xb9, 0xd8, 0x7f, 0x39, 0x6a,
-	0xbe, 0x1f, 0x1d, 0x70, 0xf4, 0x1c, 0x0c, 0xc7, 0x61, 0x3b, 0x72, 0x09, 0x26, 0xad, 0x90, 0x2e,
-	0xac, 0x32, 0x9d, 0xee, 0x74, 0xc1, 0xd7, 0x75, 0x33, 0x36, 0x71, 0xd0, 0x17, 0x2c, 0x18, 0x69,
-	0x90, 0x38, 0xf1, 0x02, 0xc6, 0x5f, 0x76, 0x7e, 0xed, 0xc8, 0x9d, 0x97, 0x8d, 0x0b, 0x9a, 0xf8,
-	0xdc, 0x29, 0xf1, 0x22, 0x23, 0x46, 0x63, 0x8c, 0x53, 0xfc, 0xa9, 0xe0, 0x6a, 0x90, 0xd8, 0x8d,
-	0xbc, 0x16, 0xfd, 0x2f, 0x44, 0x8b, 0x12, 0x5c, 0x0b, 0x1a, 0x84, 0x4d, 0x3c, 0x14, 0x40, 0x85,
-	0x0a, 0xa6, 0x78, 0x72, 0x80, 0xf5, 0x7f, 0xf1, 0x68, 0xfd, 0x17, 0x83, 0x4a, 0x65, 0x9e, 0x1e,
-	0x7d, 0xfa, 0x2f, 0xc6, 0x9c, 0x0d, 0xfa, 0xbc, 0x05, 0x93, 0x42, 0x70, 0x62, 0xc2, 0x07, 0xf4,
-	0xe6, 0x96, 0x97, 0x10, 0xdf, 0x8b, 0x93, 0xc9, 0x0a, 0xeb, 0xc3, 0x4c, 0x7f, 0x73, 0xeb, 0x52,
-	0x14, 0xb6, 0x5b, 0x57, 0xbd, 0xa0, 0x31, 0x77, 0x4e, 0x70, 0x9a, 0x9c, 0xef, 0x41, 0x18, 0xf7,
-	0x64, 0x89, 0x7e, 0xc4, 0x82, 0xb3, 0x81, 0xd3, 0x24, 0x71, 0xcb, 0xa1, 0x9f, 0x96, 0x83, 0xe7,
-	0x7c, 0xc7, 0xdd, 0x66, 0x3d, 0x1a, 0xbc, 0xb7, 0x1e, 0xd9, 0xa2, 0x47, 0x67, 0xaf, 0xf5, 0x24,
-	0x8d, 0xf7, 0x61, 0x8b, 0x7e, 0xca, 0x82, 0x89, 0x30, 0x6a, 0x6d, 0x39, 0x01, 0x69, 0x48, 0x68,
-	0x3c, 0x39, 0xc4, 0x96, 0xde, 0x47, 0x8e, 0xf6, 0x89, 0x56, 0xb2, 0x64, 0x97, 0xc3, 0xc0, 0x4b,
-	0xc2, 0xa8, 0x4e, 0x92, 0xc4, 0x0b, 0x36, 0xe3, 0xb9, 0xd3, 0x77, 0xf6, 0xa6, 0x26, 0xba, 0xb0,
-	0x70, 0x77, 0x7f, 0xd0, 0x77, 0xc3, 0x70, 0xdc, 0x09, 0xdc, 0x9b, 0x5e, 0xd0, 0x08, 0x6f, 0xc7,
-	0x93, 0xd5, 0x22, 0x96, 0x6f, 0x5d, 0x11, 0x14, 0x0b, 0x50, 0x33, 0xc0, 0x26, 0xb7, 0xfc, 0x0f,
-	0xa7, 0xa7, 0x52, 0xad, 0xe8, 0x0f, 0xa7, 0x27, 0xd3, 0x3e, 0x6c, 0xd1, 0x0f, 0x58, 0x30, 0x1a,
-	0x7b, 0x9b, 0x81, 0x93, 0xb4, 0x23, 0x72, 0x95, 0x74, 0xe2, 0x49, 0x60, 0x1d, 0xb9, 0x72, 0xc4,
-	0x51, 0x31, 0x48, 0xce, 0x9d, 0x16, 0x7d, 0x1c, 0x35, 0x5b, 0x63, 0x9c, 0xe6, 0x9b, 0xb7, 0xd0,
-	0xf4, 0xb4, 0x1e, 0x2e, 0x76, 0xa1, 0xe9, 0x49, 0xdd, 0x93, 0x25, 0xfa, 0x0e, 0x38, 0xc1, 0x9b,
-	0xd4, 0xc8, 0xc6, 0x93, 0x23, 0x4c, 0xd0, 0x9e, 0xba, 0xb3, 0x37, 0x75, 0xa2, 0x9e, 0x81, 0xe1,
-	0x2e, 0x6c, 0xf4, 0x06, 0x4c, 0xb5, 0x48, 0xd4, 0xf4, 0x92, 0x95, 0xc0, 0xef, 0x48, 0xf1, 0xed,
-	0x86, 0x2d, 0xd2, 0x10, 0xdd, 0x89, 0x27, 0x47, 0xcf, 0x59, 0xef, 0xab, 0xce, 0xbd, 0x57, 0x74,
-	0x73, 0x6a, 0x75, 0x7f, 0x74, 0x7c, 0x10, 0x3d, 0xf4, 0x1b, 0x16, 0x9c, 0x35, 0xa4, 0x6c, 0x9d,
-	0x44, 0x3b, 0x9e, 0x4b, 0x66, 0x5d, 0x37, 0x6c, 0x07, 0x49, 0x3c, 0x39, 0xc6, 0x86, 0x71, 0xfd,
-	0x38, 0x64, 0x7e, 0x9a, 0x95, 0x9e, 0x97, 0x3d, 0x51, 0x62, 0xbc, 0x4f, 0x4f, 0xed, 0xdf, 0x2a,
-	0xc1, 0x89, 0xac, 0x05, 0x80, 0xfe, 0xb1, 0x05, 0xe3, 0xb7, 0x6e, 0x27, 0x6b, 0xe1, 0x36, 0x09,
-	0xe2, 0xb9, 0x0e, 0x95, 0xd3, 0x4c, 0xf7, 0x0d, 0x9f, 0x77, 0x8b, 0xb5, 0x35, 0xa6, 0xaf, 0xa4,
-	0xb9, 0x5c, 0x08, 0x92, 0xa8, 0x33, 0xf7, 0xa8, 0x78, 0xa7, 0xf1, 0x2b, 0x37, 0xd7, 0x4c, 0x28,
-	0xce, 0x76, 0xea, 0xec, 0x67, 0x2d, 0x38, 0x95, 0x47, 0x02, 0x9d, 0x80, 0xf2, 0x36, 0xe9, 0x70,
-	0x4b, 0x18, 0xd3, 0x9f, 0xe8, 0x35, 0xa8, 0xec, 0x38, 0x7e, 0x9b, 0x08, 0x33, 0xed, 0xd2, 0xd1,
-	0x5e, 0x44, 0xf5, 0x0c, 0x73, 0xaa, 0xdf, 0x52, 0x7a, 0xc9, 0xb2, 0x7f, 0xbb, 0x0c, 0xc3, 0xc6,
-	0x47, 0xbb, 0x0f, 0xa6, 0x67, 0x98, 0x32, 0x3d, 0x97, 0x0b, 0x9b, 0x6f, 0x3d, 0x6d, 0xcf, 0xdb,
-	0x19, 0xdb, 0x73, 0xa5, 0x38, 0x96, 0xfb, 0x1a, 0x9f, 0x28, 0x81, 0x5a, 0xd8, 0xa2, 0x5b, 0x34,
-	0x6a, 0xc3, 0x0c, 0x14, 0xf1, 0x09, 0x57, 0x24, 0xb9, 0xb9, 0xd1, 0x3b, 0x7b, 0x53, 0x35, 0xf5,
-	0x17, 0x6b, 0x46, 0xf6, 0x1f, 0x58, 0x70, 0xca, 0xe8, 0xe3, 0x7c, 0x18, 0x34, 0xd8, 0x46, 0x03,
-	0x9d, 0x83, 0x81, 0xa4, 0xd3, 0x92, 0xdb, 0x40, 0x35, 0x52, 0x6b, 0x9d, 0x16, 0xc1, 0x0c, 0xf2,
-	0xb0, 0xef, 0x92, 0x7e, 0xc4, 0x82, 0x47, 0xf2, 0x05, 0x0c, 0x7a, 0x1a, 0x06, 0xb9, 0x0f, 0x40,
-	0xbc, 0x9d, 0xfe, 0x24, 0xac, 0x15, 0x0b, 0x28, 0x9a, 0x81, 0x9a, 0x52, 0x78, 0xe2, 0x1d, 0x27,
-	0x04, 0x6a, 0x4d, 0x6b, 0x49, 0x8d, 0x43, 0x07, 0x8d, 0xfe, 0x11, 0x26, 0xa8, 0x1a, 0x34, 0xb6,
-	0x69, 0x66, 0x10, 0xfb, 0xf7, 0x2c, 0xf8, 0xfa, 0x7e, 0xc4, 0xde, 0xf1, 0xf5, 0xb1, 0x0e, 0xa7,
-	0x1b, 0x64, 0xc3, 0x69, 0xfb, 0x49, 0x9a, 0xa3, 0xe8, 0xf4, 0x13, 0xe2, 0xe1, 0xd3, 0x0b, 0x79,
-	0x48, 0x38, 0xff, 0x59, 0xfb, 0x3f, 0x5b, 0x6c, 0xbb, 0x2e, 0x5f, 0xeb, 0x3e, 0x6c, 0x9d, 0x82,
-	0xf4, 0xd6, 0x69, 0xb1, 0xb0, 0x65, 0xda, 0x63, 0xef, 0xf4, 0x79, 0x0b, 0xce, 0x1a, 0x58, 0xcb,
-	0x4e, 0xe2, 0x6e, 0x5d, 0xd8, 0x6d, 0x45, 0x24, 0x8e, 0xe9, 0x94, 0x7a, 0xc2, 0x10, 0xc7, 0x73,
-	0xc3, 0x82, 0x42, 0xf9, 0x2a, 0xe9, 0x70, 0xd9, 0xfc, 0x0c, 0x54, 0xf9, 0x9a, 0x0b, 0x23, 0xf1,
-	0x91, 0xd4, 0xbb, 0xad, 0x88, 0x76, 0xac, 0x30, 0x90, 0x0d, 0x83, 0x4c, 0xe6, 0x52, 0x19, 0x44,
-	0xcd, 0x04, 0xa0, 0xdf, 0xfd, 0x06, 0x6b, 0xc1, 0x02, 0x62, 0xc7, 0xa9, 0xee, 0xac, 0x46, 0x84,
-	0xcd, 0x87, 0xc6, 0x45, 0x8f, 0xf8, 0x8d, 0x98, 0x6e, 0xeb, 0x9c, 0x20, 0x08, 0x13, 0xb1, 0x43,
-	0x33, 0xb6, 0x75, 0xb3, 0xba, 0x19, 0x9b, 0x38, 0x94, 0xa9, 0xef, 0xac, 0x13, 0x9f, 0x8f, 0xa8,
-	0x60, 0xba, 0xc4, 0x5a, 0xb0, 0x80, 0xd8, 0x77, 0x4a, 0x6c, 0x03, 0xa9, 0x24, 0x1a, 0xb9, 0x1f,
-	0xde, 0x87, 0x28, 0xa5, 0x02, 0x56, 0x8b, 0x93, 0xc7, 0xa4, 0xb7, 0x07, 0xe2, 0xcd, 0x8c, 0x16,
-	0xc0, 0x85, 0x72, 0xdd, 0xdf, 0x0b, 0xf1, 0xf1, 0x32, 0x4c, 0xa5, 0x1f, 0xe8, 0x52, 0x22, 0x74,
-	0xcb, 0x6b, 0x30, 0xca, 0xfa, 0xea, 0x0c, 0x7c, 0x6c, 0xe2, 0xf5, 0x90, 0xc3, 0xa5, 0xe3, 0x94,
-	0xc3, 0xa6, 0x9a, 0x28, 0x1f, 0xa0, 0x26, 0x9e, 0x56, 0xa3, 0x3e, 0x90, 0x91, 0x79, 0x69, 0x55,
-	0x79, 0x0e, 0x06, 0xe2, 0x84, 0xb4, 0x26, 0x2b, 0x69, 0x31, 0x5b, 0x4f, 0x48, 0x0b, 0x33, 0x08,
-	0xfa, 0x36, 0x18, 0x4f, 0x9c, 0x68, 0x93, 0x24, 0x11, 0xd9, 0xf1, 0x98, 0x5f, 0x97, 0xed, 0x67,
-	0x6b, 0x73, 0x27, 0xa9, 0xd5, 0xb5, 0xc6, 0x40, 0x58, 0x82, 0x70, 0x16, 0xd7, 0xfe, 0xef, 0x25,
-	0x78, 0x34, 0xfd, 0x09, 0xb4, 0x62, 0xfc, 0xf6, 0x94, 0x62, 0x7c, 0xbf, 0xa9, 0x18, 0xef, 0xee,
-	0x4d, 0x3d, 0xd6, 0xe3, 0xb1, 0xaf, 0x19, 0xbd, 0x89, 0x2e, 0x65, 0x3e, 0xc2, 0x4c, 0x97, 0x97,
-	0xf5, 0x89, 0x1e, 0xef, 0x98, 0xf9, 0x4a, 0x4f, 0xc3, 0x60, 0x44, 0x9c, 0x38, 0x0c, 0xc4, 0x77,
-	0x52, 0x5f, 0x13, 0xb3, 0x56, 0x2c, 0xa0, 0xf6, 0xef, 0xd6, 0xb2, 0x83, 0x7d, 0x89, 0xfb, 0xaa,
-	0xc3, 0x08, 0x79, 0x30, 0xc0, 0x76, 0x6d, 0x5c, 0xb2, 0x5c, 0x3d, 0xda, 0x2a, 0xa4, 0x5a, 0x44,
-	0x91, 0x9e, 0xab, 0xd2, 0xaf, 0x46, 0x9b, 0x30, 0x63, 0x81, 0x76, 0xa1, 0xea, 0xca, 0xcd, 0x54,
-	0xa9, 0x08, 0xb7, 0xa3, 0xd8, 0x4a, 0x69, 0x8e, 0x23, 0x54, 0xdc, 0xab, 0x1d, 0x98, 0xe2, 0x86,
-	0x08, 0x94, 0x37, 0xbd, 0x44, 0x7c, 0xd6, 0x23, 0x6e, 0x97, 0x2f, 0x79, 0xc6, 0x2b, 0x0e, 0x51,
-	0x1d, 0x74, 0xc9, 0x4b, 0x30, 0xa5, 0x8f, 0x3e, 0x6d, 0xc1, 0x70, 0xec, 0x36, 0x57, 0xa3, 0x70,
-	0xc7, 0x6b, 0x90, 0x48, 0xd8, 0x98, 0x47, 0x94, 0x6c, 0xf5, 0xf9, 0x65, 0x49, 0x50, 0xf3, 0xe5,
-	0xee, 0x0b, 0x0d, 0xc1, 0x26, 0x5f, 0xba, 0xf7, 0x7a, 0x54, 0xbc, 0xfb, 0x02, 0x71, 0xd9, 0x8a,
-	0x93, 0x7b, 0x66, 0x36, 0x53, 0x8e, 0x6c, 0x73, 0x2f, 0xb4, 0xdd, 0x6d, 0xba, 0xde, 0x74, 0x87,
-	0x1e, 0xbb, 0xb3, 0x37, 0xf5, 0xe8, 0x7c, 0x3e, 0x4f, 0xdc, 0xab, 0x33, 0x6c, 0xc0, 0x5a, 0x6d,
-	0xdf, 0xc7, 0xe4, 0x8d, 0x36, 0x61, 0x1e, 0xb1, 0x02, 0x06, 0x6c, 0x55, 0x13, 0xcc, 0x0c, 0x98,
-	0x01, 0xc1, 0x26, 0x5f, 0xf4, 0x06, 0x0c, 0x36, 0x9d, 0x24, 0xf2, 0x76, 0x85, 0x1b, 0xec, 0x88,
-	0xbb, 0xa0, 0x65, 0x46, 0x4b, 0x33, 0x67, 0x8a, 0x9e, 0x37, 0x62, 0xc1, 0x08, 0x35, 0xa1, 0xd2,
-	0x24, 0xd1, 0x26, 0x99, 0xac, 0x16, 0xe1, 0xf2, 0x5f, 0xa6, 0xa4, 0x34, 0xc3, 0x1a, 0x35, 0xae,
-	0x58, 0x1b, 0xe6, 0x5c, 0xd0, 0x6b, 0x50, 0x8d, 0x89, 0x4f, 0x5c, 0x6a, 0x1e, 0xd5, 0x18, 0xc7,
-	0xe7, 0xfb, 0x34, 0x15, 0xa9, 0x5d, 0x52, 0x17, 0x8f, 0xf2, 0x05, 0x26, 0xff, 0x61, 0x45, 0x92,
-	0x0e, 0x60, 0xcb, 0x6f, 0x6f, 0x7a, 0xc1, 0x24, 0x14, 0x31, 0x80, 0xab, 0x8c, 0x56, 0x66, 0x00,
-	0x79, 0x23, 0x16, 0x8c, 0xec, 0xff, 0x66, 0x01, 0x4a, 0x0b, 0xb5, 0xfb, 0x60, 0x13, 0xbf, 0x91,
-	0xb6, 0x89, 0x97, 0x8a, 0x34, 0x5a, 0x7a, 0x98, 0xc5, 0xbf, 0x50, 0x83, 0x8c, 0x3a, 0xb8, 0x46,
-	0xe2, 0x84, 0x34, 0xde, 0x15, 0xe1, 0xef, 0x8a, 0xf0, 0x77, 0x45, 0xb8, 0x12, 0xe1, 0xeb, 0x19,
-	0x11, 0xfe, 0x41, 0x63, 0xd5, 0xeb, 0xd8, 0x83, 0xd7, 0x55, 0x70, 0x82, 0xd9, 0x03, 0x03, 0x81,
-	0x4a, 0x82, 0x2b, 0xf5, 0x95, 0x6b, 0xb9, 0x32, 0xfb, 0xf5, 0xb4, 0xcc, 0x3e, 0x2a, 0x8b, 0xbf,
-	0x09, 0x52, 0xfa, 0x37, 0x2c, 0x78, 0x6f, 0x5a, 0x7a, 0xc9, 0x99, 0xb3, 0xb8, 0x19, 0x84, 0x11,
-	0x59, 0xf0, 0x36, 0x36, 0x48, 0x44, 0x02, 0x97, 0xc4, 0xca, 0xb7, 0x63, 0xf5, 0xf2, 0xed, 0xa0,
-	0x17, 0x60, 0xe4, 0x56, 0x1c, 0x06, 0xab, 0xa1, 0x17, 0x08, 0x11, 0x44, 0x77, 0x1c, 0x27, 0xee,
-	0xec, 0x4d, 0x8d, 0xd0, 0x11, 0x95, 0xed, 0x38, 0x85, 0x85, 0xe6, 0x61, 0xe2, 0xd6, 0x1b, 0xab,
-	0x4e, 0x62, 0x78, 0x13, 0xe4, 0xbe, 0x9f, 0x9d, 0x47, 0x5d, 0x79, 0x25, 0x03, 0xc4, 0xdd, 0xf8,
-	0xf6, 0xdf, 0x2b, 0xc1, 0x99, 0xcc, 0x8b, 0x84, 0xbe, 0x1f, 0xb6, 0x13, 0xba, 0x27, 0x42, 0x3f,
-	0x61, 0xc1, 0x89, 0x66, 0xda, 0x61, 0x11, 0x0b, 0x77, 0xf7, 0x77, 0x16, 0xa6, 0x23, 0x32, 0x1e,
-	0x91, 0xb9, 0x49, 0x31, 0x42, 0x27, 0x32, 0x80, 0x18, 0x77, 0xf5, 0x05, 0xbd, 0x06, 0xb5, 0xa6,
-	0xb3, 0x7b, 0xbd, 0xd5, 0x70, 0x12, 0xb9, 0x1d, 0xed, 0xed, 0x45, 0x68, 0x27, 0x9e, 0x3f, 0xcd,
-	0xa3, 0x5a, 0xa6, 0x17, 0x83, 0x64, 0x25, 0xaa, 0x27, 0x91, 0x17, 0x6c, 0x72, 0x27, 0xe7, 0xb2,
-	0x24, 0x83, 0x35, 0x45, 0xfb, 0xc7, 0xad, 0xac, 0x92, 0x52, 0xa3, 0x13, 0x39, 0x09, 0xd9, 0xec,
-	0xa0, 0xb7, 0xa0, 0x42, 0xf7, 0x8d, 0x72, 0x54, 0x6e, 0x16, 0xa9, 0x39, 0x8d, 0x2f, 0xa1, 0x95,
-	0x28, 0xfd, 0x17, 0x63, 0xce, 0xd4, 0xfe, 0x89, 0x5a, 0xd6, 0x58, 0x60, 0x67, 0xf3, 0xe7, 0x01,
-	0x36, 0xc3, 0x35, 0xd2, 0x6c, 0xf9, 0x74, 0x58, 0x2c, 0x76, 0xc0, 0xa3, 0x5c, 0x25, 0x97, 0x14,
-	0x04, 0x1b, 0x58, 0xe8, 0x07, 0x2d, 0x80, 0x4d, 0x39, 0xe7, 0xa5, 0x21, 0x70, 0xbd, 0xc8, 0xd7,
-	0xd1, 0x2b, 0x4a, 0xf7, 0x45, 0x31, 0xc4, 0x06, 0x73, 0xf4, 0x7d, 0x16, 0x54, 0x13, 0xd9, 0x7d,
-	0xae, 0x1a, 0xd7, 0x8a, 0xec, 0x89, 0x7c, 0x69, 0x6d, 0x13, 0xa9, 0x21, 0x51, 0x7c, 0xd1, 0xf7,
-	0x5b, 0x00, 0x71, 0x27, 0x70, 0x57, 0x43, 0xdf, 0x73, 0x3b, 0x42, 0x63, 0xde, 0x28, 0xd4, 0x9d,
-	0xa3, 0xa8, 0xcf, 0x8d, 0xd1, 0xd1, 0xd0, 0xff, 0xb1, 0xc1, 0x19, 0x7d, 0x0c, 0xaa, 0xb1, 0x98,
-	0x6e, 0x42, 0x47, 0xae, 0x15, 0xeb, 0x54, 0xe2, 0xb4, 0x85, 0x78, 0x15, 0xff, 0xb0, 0xe2, 0x89,
-	0x7e, 0xd4, 0x82, 0xf1, 0x56, 0xda, 0x4d, 0x28, 0xd4, 0x61, 0x71, 0x32, 0x20, 0xe3, 0x86, 0xe4,
-	0xde, 0x96, 0x4c, 0x23, 0xce, 0xf6, 0x82, 0x4a, 0x40, 0x3d, 0x83, 0x57, 0x5a, 0xdc, 0x65, 0x39,
-	0xa4, 0x25, 0xe0, 0xa5, 0x2c, 0x10, 0x77, 0xe3, 0xa3, 0x55, 0x38, 0x45, 0x7b, 0xd7, 0xe1, 0xe6,
-	0xa7, 0x54, 0x2f, 0x31, 0x53, 0x86, 0xd5, 0xb9, 0xc7, 0xc5, 0x0c, 0x61, 0x67, 0x1d, 0x59, 0x1c,
-	0x9c, 0xfb, 0x24, 0xfa, 0x6d, 0x0b, 0x1e, 0xf7, 0x98, 0x1a, 0x30, 0x1d, 0xf6, 0x5a, 0x23, 0x88,
-	0x83, 0x76, 0x52, 0xa8, 0xac, 0xe8, 0xa5, 0x7e, 0xe6, 0xbe, 0x5e, 0xbc, 0xc1, 0xe3, 0x8b, 0xfb,
-	0x74, 0x09, 0xef, 0xdb, 0x61, 0xf4, 0xcd, 0x30, 0x2a, 0xd7, 0xc5, 0x2a, 0x15, 0xc1, 0x4c, 0xd1,
-	0xd6, 0xe6, 0x26, 0xee, 0xec, 0x4d, 0x8d, 0xae, 0x99, 0x00, 0x9c, 0xc6, 0xb3, 0xff, 0x55, 0x39,
-	0x75, 0x4a, 0xa4, 0x7c, 0x98, 0x4c, 0xdc, 0xb8, 0xd2, 0xff, 0x23, 0xa5, 0x67, 0xa1, 0xe2, 0x46,
-	0x79, 0x97, 0xb4, 0xb8, 0x51, 0x4d, 0x31, 0x36, 0x98, 0x53, 0xa3, 0x74, 0xc2, 0xc9, 0x7a, 0x4a,
-	0x85, 0x04, 0x7c, 0xad, 0xc8, 0x2e, 0x75, 0x9f, 0xe9, 0x9d, 0x11, 0x5d, 0x9b, 0xe8, 0x02, 0xe1,
-	0xee, 0x2e, 0xa1, 0xef, 0x81, 0x5a, 0xa4, 0x22, 0x5b, 0xca, 0x45, 0x6c, 0xd5, 0xe4, 0xb4, 0x11,
-	0xdd, 0x51, 0x07, 0x40, 0x3a, 0x86, 0x45, 0x73, 0xb4, 0x3f, 0x53, 0x4a, 0x1d, 0x8c, 0x19, 0xb2,
-	0xa3, 0x8f, 0x43, 0xbf, 0x2f, 0x58, 0x30, 0x1c, 0x85, 0xbe, 0xef, 0x05, 0x9b, 0x54, 0xce, 0x09,
-	0x65, 0xfd, 0xe1, 0x63, 0xd1, 0x97, 0x42, 0xa0, 0x31, 0xcb, 0x1a, 0x6b, 0x9e, 0xd8, 0xec, 0x00,
-	0x7a, 0x19, 0x46, 0x1b, 0xc4, 0x27, 0xf4, 0xd9, 0x95, 0x88, 0xee, 0x89, 0xb8, 0x93, 0x59, 0x45,
-	0x8a, 0x2c, 0x98, 0x40, 0x9c, 0xc6, 0xb5, 0xff, 0xc4, 0x82, 0xc9, 0x5e, 0xc2, 0x1c, 0x11, 0x78,
-	0x4c, 0x4a, 0x2a, 0x35, 0x8e, 0x2b, 0x81, 0xa4, 0x27, 0xf4, 0xf1, 0x53, 0x82, 0xcf, 0x63, 0xab,
-	0xbd, 0x51, 0xf1, 0x7e, 0x74, 0xd0, 0xab, 0x70, 0xc2, 0x18, 0x94, 0x58, 0x8d, 0x6a, 0x6d, 0x6e,
-	0x9a, 0x5a, 0x4f, 0xb3, 0x19, 0xd8, 0xdd, 0xbd, 0xa9, 0x47, 0xb2, 0x6d, 0x42, 0xdb, 0x74, 0xd1,
-	0xb1, 0x7f, 0xba, 0xeb, 0x53, 0x2b, 0x43, 0xe1, 0x1d, 0xab, 0xcb, 0x15, 0xf1, 0x9d, 0xc7, 0xa1,
-	0x9c, 0x99, 0xd3, 0x42, 0xc5, 0x70, 0xf4, 0xc6, 0x79, 0x80, 0x67, 0xfe, 0xf6, 0xbf, 0x19, 0x80,
-	0x7d, 0x7a, 0xd6, 0x87, 0xe5, 0x7f, 0xe8, 0x43, 0xd8, 0xcf, 0x59, 0xea, 0xb4, 0x8d, 0x0b, 0x80,
-	0xc6, 0x71, 0x8d, 0x3d, 0xdf, 0x7c, 0xc5, 0x3c, 0xee, 0x44, 0xb9, 0xe0, 0xd3, 0xe7, 0x7a, 0xe8,
-	0x4b, 0x56, 0xfa, 0xbc, 0x90, 0x47, 0x44, 0x7a, 0xc7, 0xd6, 0x27, 0xe3, 0x10, 0x92, 0x77, 0x4c,
-	0x1f, 0x5d, 0xf5, 0x3a, 0x9e, 0x9c, 0x06, 0xd8, 0xf0, 0x02, 0xc7, 0xf7, 0xde, 0xa4, 0x5b, 0xab,
-	0x0a, 0xb3, 0x0e, 0x98, 0xb9, 0x75, 0x51, 0xb5, 0x62, 0x03, 0xe3, 0xec, 0xff, 0x0f, 0xc3, 0xc6,
-	0x9b, 0xe7, 0x84, 0xcb, 0x9c, 0x32, 0xc3, 0x65, 0x6a, 0x46, 0x94, 0xcb, 0xd9, 0x0f, 0xc2, 0x89,
-	0x6c, 0x07, 0x0f, 0xf3, 0xbc, 0xfd, 0xbf, 0x87, 0xb2, 0x07, 0x78, 0x6b, 0x24, 0x6a, 0xd2, 0xae,
-	0xbd, 0xeb, 0x15, 0x7b, 0xd7, 0x2b, 0xf6, 0xae, 0x57, 0xcc, 0x3c, 0xd8, 0x10, 0x1e, 0x9f, 0xa1,
-	0xfb, 0xe4, 0xf1, 0x49, 0xf9, 0xb0, 0xaa, 0x85, 0xfb, 0xb0, 0xec, 0x4f, 0x77, 0xb9, 0xfd, 0xd7,
-	0x22, 0x42, 0x50, 0x08, 0x95, 0x20, 0x6c, 0x10, 0x69, 0x20, 0x5f, 0x29, 0xc6, 0xda, 0xbb, 0x16,
-	0x36, 0x8c, 0x58, 0x73, 0xfa, 0x2f, 0xc6, 0x9c, 0x8f, 0xfd, 0xa9, 0x41, 0x48, 0xd9, 0xa2, 0xfc,
-	0xbb, 0x7f, 0x23, 0x0c, 0x45, 0xa4, 0x15, 0x5e, 0xc7, 0x4b, 0x42, 0x97, 0xe9, 0x54, 0x1d, 0xde,
-	0x8c, 0x25, 0x9c, 0xea, 0xbc, 0x96, 0x93, 0x6c, 0x09, 0x65, 0xa6, 0x74, 0xde, 0xaa, 0x93, 0x6c,
-	0x61, 0x06, 0x41, 0x1f, 0x84, 0xb1, 0x24, 0x75, 0x8e, 0x2e, 0xce, 0x8b, 0x1f, 0x11, 0xb8, 0x63,
-	0xe9, 0x53, 0x76, 0x9c, 0xc1, 0x46, 0x6f, 0xc0, 0xc0, 0x16, 0xf1, 0x9b, 0xe2, 0xd3, 0xd7, 0x8b,
-	0xd3, 0x35, 0xec, 0x5d, 0x2f, 0x13, 0xbf, 0xc9, 0x25, 0x21, 0xfd, 0x85, 0x19, 0x2b, 0x3a, 0xef,
-	0x6b, 0xdb, 0xed, 0x38, 0x09, 0x9b, 0xde, 0x9b, 0xd2, 0x4d, 0xfa, 0x9d, 0x05, 0x33, 0xbe, 0x2a,
-	0xe9, 0x73, 0x7f, 0x94, 0xfa, 0x8b, 0x35, 0x67, 0xd6, 0x8f, 0x86, 0x17, 0xb1, 0x29, 0xd3, 0x11,
-	0xde, 0xce, 0xa2, 0xfb, 0xb1, 0x20, 0xe9, 0xf3, 0x7e, 0xa8, 0xbf, 0x58, 0x73, 0x46, 0x1d, 0xb5,
-	0xfe, 0x86, 0x59, 0x1f, 0xae, 0x17, 0xdc, 0x07, 0xbe, 0xf6, 0x72, 0xd7, 0xe1, 0x53, 0x50, 0x71,
-	0xb7, 0x9c, 0x28, 0x99, 0x1c, 0x61, 0x93, 0x46, 0xcd, 0xe2, 0x79, 0xda, 0x88, 0x39, 0x0c, 0x3d,
-	0x01, 0xe5, 0x88, 0x6c, 0xb0, 0xd0, 0x66, 0x23, 0xa8, 0x0a, 0x93, 0x0d, 0x4c, 0xdb, 0x95, 0x5d,
-	0x36, 0xd6, 0x33, 0xda, 0xee, 0x27, 0x4b, 0x69, 0xc3, 0x2e, 0x3d, 0x32, 0x7c, 0x3d, 0xb8, 0xed,
-	0x28, 0x96, 0xde, 0x35, 0x63, 0x3d, 0xb0, 0x66, 0x2c, 0xe1, 0xe8, 0x13, 0x16, 0x0c, 0xdd, 0x8a,
-	0xc3, 0x20, 0x20, 0x89, 0x50, 0xa2, 0x37, 0x0a, 0x1e, 0xac, 0x2b, 0x9c, 0xba, 0xee, 0x83, 0x68,
-	0xc0, 0x92, 0x2f, 0xed, 0x2e, 0xd9, 0x75, 0xfd, 0x76, 0xa3, 0x2b, 0x92, 0xe6, 0x02, 0x6f, 0xc6,
-	0x12, 0x4e, 0x51, 0xbd, 0x80, 0xa3, 0x0e, 0xa4, 0x51, 0x17, 0x03, 0x81, 0x2a, 0xe0, 0xf6, 0xcf,
-	0x55, 0xe1, 0x74, 0xee, 0xf2, 0xa1, 0x26, 0x17, 0x33, 0x6a, 0x2e, 0x7a, 0x3e, 0x91, 0x31, 0x64,
-	0xcc, 0xe4, 0xba, 0xa1, 0x5a, 0xb1, 0x81, 0x81, 0xbe, 0x17, 0xa0, 0xe5, 0x44, 0x4e, 0x93, 0x28,
-	0xef, 0xf7, 0x91, 0x2d, 0x1b, 0xda, 0x8f, 0x55, 0x49, 0x53, 0x7b, 0x00, 0x54, 0x53, 0x8c, 0x0d,
-	0x96, 0xe8, 0x45, 0x18, 0x8e, 0x88, 0x4f, 0x9c, 0x98, 0xc5, 0xce, 0x67, 0x13, 0x81, 0xb0, 0x06,
-	0x61, 0x13, 0x0f, 0x3d, 0xad, 0xc2, 0xed, 0x32, 0x61, 0x47, 0xe9, 0x90, 0x3b, 0xf4, 0x45, 0x0b,
-	0xc6, 0x36, 0x3c, 0x9f, 0x68, 0xee, 0x22, 0x6d, 0x67, 0xe5, 0xe8, 0x2f, 0x79, 0xd1, 0xa4, 0xab,
-	0x65, 0x68, 0xaa, 0x39, 0xc6, 0x19, 0xf6, 0xf4, 0x33, 0xef, 0x90, 0x88, 0x09, 0xdf, 0xc1, 0xf4,
-	0x67, 0xbe, 0xc1, 0x9b, 0xb1, 0x84, 0xa3, 0x59, 0x18, 0x6f, 0x39, 0x71, 0x3c, 0x1f, 0x91, 0x06,
-	0x09, 0x12, 0xcf, 0xf1, 0x79, 0x52, 0x4d, 0x55, 0xc7, 0xa2, 0xaf, 0xa6, 0xc1, 0x38, 0x8b, 0x8f,
-	0x3e, 0x04, 0x8f, 0x72, 0xf7, 0xd2, 0xb2, 0x17, 0xc7, 0x5e, 0xb0, 0xa9, 0xa7, 0x81, 0xf0, 0xb2,
-	0x4d, 0x09, 0x52, 0x8f, 0x2e, 0xe6, 0xa3, 0xe1, 0x5e, 0xcf, 0xa3, 0x67, 0xa0, 0x1a, 0x6f, 0x7b,
-	0xad, 0xf9, 0xa8, 0x11, 0xb3, 0xa3, 0xa5, 0xaa, 0xf6, 0xe9, 0xd6, 0x45, 0x3b, 0x56, 0x18, 0xc8,
-	0x85, 0x11, 0xfe, 0x49, 0x78, 0xbc, 0xa0, 0x90, 0xa0, 0xcf, 0xf6, 0x54, 0xe4, 0x22, 0x7f, 0x76,
-	0x1a, 0x3b, 0xb7, 0x2f, 0xc8, 0x83, 0x2e, 0x7e, 0x2e, 0x73, 0xc3, 0x20, 0x83, 0x53, 0x44, 0xd3,
-	0x7b, 0xba, 0xe1, 0x3e, 0xf6, 0x74, 0x2f, 0xc2, 0xf0, 0x76, 0x7b, 0x9d, 0x88, 0x91, 0x17, 0x82,
-	0x4d, 0xcd, 0xbe, 0xab, 0x1a, 0x84, 0x4d, 0x3c, 0x16, 0xaa, 0xd9, 0xf2, 0xc4, 0xbf, 0x78, 0x72,
-	0xd4, 0x08, 0xd5, 0x5c, 0x5d, 0x94, 0xcd, 0xd8, 0xc4, 0xa1, 0x5d, 0xa3, 0x63, 0xb1, 0x46, 0x62,
-	0x96, 0x89, 0x41, 0x87, 0x4b, 0x75, 0xad, 0x2e, 0x01, 0x58, 0xe3, 0xa0, 0x55, 0x38, 0x45, 0xff,
-	0xd4, 0x59, 0xfe, 0xf0, 0x0d, 0xc7, 0xf7, 0x1a, 0x3c, 0x6e, 0x70, 0x3c, 0xed, 0x1c, 0xad, 0xe7,
-	0xe0, 0xe0, 0xdc, 0x27, 0xed, 0x1f, 0x2b, 0xa5, 0x3d, 0x27, 0xa6, 0x08, 0x43, 0x31, 0x15, 0x54,
-	0xc9, 0x0d, 0x27, 0x92, 0x06, 0xcf, 0x11, 0x33, 0xa3, 0x04, 0xdd, 0x1b, 0x4e, 0x64, 0x8a, 0x3c,
-	0xc6, 0x00, 0x4b, 0x4e, 0xe8, 0x16, 0x0c, 0x24, 0xbe, 0x53, 0x50, 0x2a, 0xa5, 0xc1, 0x51, 0x7b,
-	0xc1, 0x96, 0x66, 0x63, 0xcc, 0x78, 0xa0, 0xc7, 0xe9, 0xee, 0x6d, 0x5d, 0x1e, 0xd3, 0x89, 0x0d,
-	0xd7, 0x7a, 0x8c, 0x59, 0xab, 0xfd, 0xb7, 0x47, 0x73, 0xb4, 0x8e, 0x32, 0x04, 0xd0, 0x79, 0x00,
-	0x3a, 0x69, 0x56, 0x23, 0xb2, 0xe1, 0xed, 0x0a, 0x43, 0x4c, 0x49, 0xb6, 0x6b, 0x0a, 0x82, 0x0d,
-	0x2c, 0xf9, 0x4c, 0xbd, 0xbd, 0x41, 0x9f, 0x29, 0x75, 0x3f, 0xc3, 0x21, 0xd8, 0xc0, 0x42, 0x2f,
-	0xc0, 0xa0, 0xd7, 0x74, 0x36, 0x55, 0x14, 0xf1, 0xe3, 0x54, 0xa4, 0x2d, 0xb2, 0x96, 0xbb, 0x7b,
-	0x53, 0x63, 0xaa, 0x43, 0xac, 0x09, 0x0b, 0x5c, 0xf4, 0xd3, 0x16, 0x8c, 0xb8, 0x61, 0xb3, 0x19,
-	0x06, 0x7c, 0xfb, 0x2c, 0x7c, 0x01, 0xb7, 0x8e, 0xcb, 0x4c, 0x9a, 0x9e, 0x37, 0x98, 0x71, 0x67,
-	0x80, 0xca, 0xf9, 0x34, 0x41, 0x38, 0xd5, 0x2b, 0x53, 0xf2, 0x55, 0x0e, 0x90, 0x7c, 0x3f, 0x6f,
-	0xc1, 0x04, 0x7f, 0xd6, 0xd8, 0xd5, 0x8b, 0xf4, 0xc6, 0xf0, 0x98, 0x5f, 0xab, 0xcb, 0xd1, 0xa1,
-	0x3c, 0xc5, 0x5d, 0x70, 0xdc, 0xdd, 0x49, 0x74, 0x09, 0x26, 0x36, 0xc2, 0xc8, 0x25, 0xe6, 0x40,
-	0x08, 0xb1, 0xad, 0x08, 0x5d, 0xcc, 0x22, 0xe0, 0xee, 0x67, 0xd0, 0x0d, 0x78, 0xc4, 0x68, 0x34,
-	0xc7, 0x81, 0x4b, 0xee, 0x27, 0x05, 0xb5, 0x47, 0x2e, 0xe6, 0x62, 0xe1, 0x1e, 0x4f, 0xa7, 0x85,
-	0x64, 0xad, 0x0f, 0x21, 0xf9, 0x3a, 0x9c, 0x71, 0xbb, 0x47, 0x66, 0x27, 0x6e, 0xaf, 0xc7, 0x5c,
-	0x8e, 0x57, 0xe7, 0xbe, 0x4e, 0x10, 0x38, 0x33, 0xdf, 0x0b, 0x11, 0xf7, 0xa6, 0x81, 0xde, 0x82,
-	0x6a, 0x44, 0xd8, 0x57, 0x89, 0x45, 0xae, 0xdf, 0x11, 0xbd, 0x1d, 0xda, 0x82, 0xe7, 0x64, 0xb5,
-	0x66, 0x12, 0x0d, 0x31, 0x56, 0x1c, 0xd1, 0x6d, 0x18, 0x6a, 0x39, 0x89, 0xbb, 0x25, 0x32, 0xfc,
-	0x8e, 0xec, 0xd8, 0x57, 0xcc, 0xd9, 0x39, 0x8c, 0x51, 0x2f, 0x81, 0x33, 0xc1, 0x92, 0x1b, 0xb5,
-	0xd5, 0xdc, 0xb0, 0xd9, 0x0a, 0x03, 0x12, 0x24, 0x52, 0x89, 0x8c, 0xf1, 0xc3, 0x12, 0xd9, 0x8a,
-	0x0d, 0x8c, 0x2e, 0x5d, 0xae, 0xd1, 0x26, 0x27, 0xf6, 0xd1, 0xe5, 0x06, 0xb5, 0x5e, 0xcf, 0x53,
-	0x65, 0xc3, 0xdc, 0x8a, 0x37, 0xbd, 0x64, 0x2b, 0x6c, 0x27, 0x72, 0x97, 0x2c, 0x14, 0x95, 0x52,
-	0x36, 0x4b, 0x39, 0x38, 0x38, 0xf7, 0xc9, 0xac, 0x66, 0x1d, 0xbf, 0x37, 0xcd, 0x7a, 0xa2, 0x0f,
-	0xcd, 0x5a, 0x87, 0xd3, 0xac, 0x07, 0xc2, 0x4a, 0x96, 0x4e, 0xcb, 0x78, 0x12, 0xb1, 0xce, 0xab,
-	0xe4, 0x98, 0xa5, 0x3c, 0x24, 0x9c, 0xff, 0xec, 0xd9, 0x6f, 0x87, 0x89, 0x2e, 0x21, 0x77, 0x28,
-	0x87, 0xe4, 0x02, 0x3c, 0x92, 0x2f, 0x4e, 0x0e, 0xe5, 0x96, 0xfc, 0xb9, 0x4c, 0x50, 0xbb, 0xb1,
-	0x45, 0xeb, 0xc3, 0xc5, 0xed, 0x40, 0x99, 0x04, 0x3b, 0x42, 0xbb, 0x5e, 0x3c, 0xda, 0xac, 0xbe,
-	0x10, 0xec, 0x70, 0x69, 0xc8, 0xfc, 0x78, 0x17, 0x82, 0x1d, 0x4c, 0x69, 0xa3, 0x1f, 0xb6, 0x52,
-	0x1b, 0x08, 0xee, 0x18, 0xff, 0xc8, 0xb1, 0xec, 0x49, 0xfb, 0xde, 0x53, 0xd8, 0xff, 0xb6, 0x04,
-	0xe7, 0x0e, 0x22, 0xd2, 0xc7, 0xf0, 0x3d, 0x05, 0x83, 0x31, 0x0b, 0x53, 0x11, 0xea, 0x6a, 0x98,
-	0xae, 0x62, 0x1e, 0xb8, 0xf2, 0x3a, 0x16, 0x20, 0xe4, 0x43, 0xb9, 0xe9, 0xb4, 0x84, 0xbf, 0x74,
-	0xf1, 0xa8, 0xc9, 0x7f, 0xf4, 0xbf, 0xe3, 0x2f, 0x3b, 0x2d, 0x3e, 0xe7, 0x8d, 0x06, 0x4c, 0xd9,
-	0xa0, 0x04, 0x2a, 0x4e, 0x14, 0x39, 0x32, 0x26, 0xe2, 0x6a, 0x31, 0xfc, 0x66, 0x29, 0x49, 0x7e,
-	0xa4, 0x9c, 0x6a, 0xc2, 0x9c, 0x99, 0xfd, 0xa3, 0xd5, 0x54, 0xa6, 0x18, 0x0b, 0x74, 0x89, 0x61,
-	0x50, 0xb8, 0x49, 0xad, 0xa2, 0x73, 0x2e, 0x79, 0x2a, 0x36, 0xf3, 0x40, 0x88, 0x82, 0x16, 0x82,
-	0x15, 0xfa, 0xac, 0xc5, 0xca, 0x46, 0xc8, 0xf4, 0x3b, 0xb1, 0xab, 0x3f, 0x9e, 0x2a, 0x16, 0x66,
-	0x31, 0x0a, 0xd9, 0x88, 0x4d, 0xee, 0xa2, 0x34, 0x0e, 0xdb, 0xcd, 0x74, 0x97, 0xc6, 0x61, 0xbb,
-	0x13, 0x09, 0x47, 0xbb, 0x39, 0x01, 0x2d, 0x05, 0x94, 0x1e, 0xe8, 0x23, 0x84, 0xe5, 0x4b, 0x16,
-	0x4c, 0x78, 0xd9, 0xc8, 0x04, 0xb1, 0x07, 0xbe, 0x59, 0x8c, 0x4f, 0xb3, 0x3b, 0xf0, 0x41, 0x19,
-	0x3a, 0x5d, 0x20, 0xdc, 0xdd, 0x19, 0xd4, 0x80, 0x01, 0x2f, 0xd8, 0x08, 0x85, 0x79, 0x37, 0x77,
-	0xb4, 0x4e, 0x2d, 0x06, 0x1b, 0xa1, 0x5e, 0xcd, 0xf4, 0x1f, 0x66, 0xd4, 0xd1, 0x12, 0x9c, 0x92,
-	0xc9, 0x42, 0x97, 0xbd, 0x38, 0x09, 0xa3, 0xce, 0x92, 0xd7, 0xf4, 0x12, 0x66, 0x9a, 0x95, 0xe7,
-	0x26, 0xa9, 0x7a, 0xc3, 0x39, 0x70, 0x9c, 0xfb, 0x14, 0x7a, 0x13, 0x86, 0x64, 0x34, 0x40, 0xb5,
-	0x08, 0x7f, 0x42, 0xf7, 0xfc, 0x57, 0x93, 0xa9, 0x2e, 0xc2, 0x01, 0x24, 0x43, 0xf4, 0x19, 0x0b,
-	0xc6, 0xf8, 0xef, 0xcb, 0x9d, 0x06, 0xcf, 0x4f, 0xac, 0x15, 0x11, 0xf2, 0x5f, 0x4f, 0xd1, 0x9c,
-	0x43, 0x77, 0xf6, 0xa6, 0xc6, 0xd2, 0x6d, 0x38, 0xc3, 0xd7, 0xfe, 0x27, 0x23, 0xd0, 0x1d, 0x3f,
-	0x91, 0x0e, 0x96, 0xb0, 0xee, 0x77, 0xb0, 0x04, 0xdd, 0x55, 0xc6, 0x3a, 0xce, 0xa1, 0x80, 0x65,
-	0x26, 0xb8, 0xea, 0x63, 0xe8, 0x4e, 0xe0, 0x62, 0xc6, 0x03, 0xb5, 0x61, 0x90, 0x57, 0xa6, 0x12,
-	0x1a, 0xe0, 0xe8, 0x27, 0xdf, 0x66, 0x85, 0x2b, 0xed, 0xd6, 0xe2, 0xad, 0x58, 0x30, 0x43, 0xbb,
-	0x30, 0xb4, 0xc5, 0xa7, 0xa3, 0xd8, 0xeb, 0x2d, 0x1f, 0x75, 0x7c, 0x53, 0x73, 0x5c, 0x4f, 0x3e,
-	0xd1, 0x80, 0x25, 0x3b, 0x16, 0x9b, 0x67, 0x44, 0x0f, 0x71, 0x41, 0x52, 0x5c, 0xaa, 0x65, 0xff,
-	0xa1, 0x43, 0x1f, 0x85, 0x91, 0x88, 0xb8, 0x61, 0xe0, 0x7a, 0x3e, 0x69, 0xcc, 0xca, 0x03, 0xb1,
-	0xc3, 0x64, 0xd8, 0x31, 0x6f, 0x12, 0x36, 0x68, 0xe0, 0x14, 0x45, 0xb6, 0xce, 0x54, 0xd6, 0x3d,
-	0xfd, 0x20, 0x44, 0x1c, 0x7c, 0x2c, 0x15, 0x94, 0xe3, 0xcf, 0x68, 0xf2, 0x75, 0x96, 0x6e, 0xc3,
-	0x19, 0xbe, 0xe8, 0x55, 0x80, 0x70, 0x9d, 0x07, 0xe0, 0xcd, 0x26, 0xe2, 0x14, 0xe4, 0x30, 0xaf,
-	0x3a, 0xc6, 0x33, 0x75, 0x25, 0x05, 0x6c, 0x50, 0x43, 0x57, 0x01, 0xf8, 0xca, 0x59, 0xeb, 0xb4,
-	0xe4, 0x86, 0x50, 0xa6, 0x48, 0x42, 0x5d, 0x41, 0xee, 0xee, 0x4d, 0x75, 0xfb, 0x9c, 0x59, 0x94,
-	0x91, 0xf1, 0x38, 0xfa, 0x6e, 0x18, 0x8a, 0xdb, 0xcd, 0xa6, 0xa3, 0xce, 0x48, 0x0a, 0xcc, 0xfd,
-	0xe5, 0x74, 0x0d, 0xc1, 0xc8, 0x1b, 0xb0, 0xe4, 0x88, 0x6e, 0x51, 0x11, 0x2f, 0x24, 0x14, 0x5f,
-	0x45, 0xdc, 0x42, 0xe1, 0x9e, 0xc0, 0x0f, 0xc8, 0x5d, 0x0c, 0xce, 0xc1, 0xb9, 0xbb, 0x37, 0xf5,
-	0x48, 0xba, 0x7d, 0x29, 0x14, 0xd9, 0xb8, 0xb9, 0x34, 0xd1, 0x15, 0x59, 0x84, 0x8b, 0xbe, 0xb6,
-	0xac, 0x0d, 0xf3, 0x3e, 0x5d, 0x84, 0x8b, 0x35, 0xf7, 0x1e, 0x33, 0xf3, 0x61, 0xb4, 0x0c, 0x27,
-	0xdd, 0x30, 0x48, 0xa2, 0xd0, 0xf7, 0x79, 0x81, 0x3e, 0xbe, 0x37, 0xe7, 0x67, 0x28, 0x8f, 0x89,
-	0x6e, 0x9f, 0x9c, 0xef, 0x46, 0xc1, 0x79, 0xcf, 0x51, 0x9b, 0x3c, 0xab, 0x1f, 0xc6, 0x0a, 0x39,
-	0x5e, 0x4f, 0xd1, 0x14, 0x12, 0x4a, 0xb9, 0xbd, 0x0f, 0xd0, 0x14, 0x41, 0xfa, 0x90, 0x55, 0x7c,
-	0xb1, 0x17, 0x60, 0x84, 0xec, 0x26, 0x24, 0x0a, 0x1c, 0xff, 0x3a, 0x5e, 0x92, 0x07, 0x16, 0x6c,
-	0x61, 0x5e, 0x30, 0xda, 0x71, 0x0a, 0x0b, 0xd9, 0xca, 0x4b, 0x66, 0xa4, 0xbd, 0x73, 0x2f, 0x99,
-	0xf4, 0x89, 0xd9, 0x3f, 0x5b, 0x4e, 0xd9, 0xac, 0x0f, 0xe4, 0x48, 0x97, 0xd5, 0x57, 0x92, 0x85,
-	0xa8, 0x18, 0x40, 0xec, 0xc5, 0x8a, 0xe4, 0xac, 0xa2, 0xe6, 0x56, 0x4c, 0x46, 0x38, 0xcd, 0x17,
-	0x6d, 0x43, 0x65, 0x2b, 0x8c, 0x13, 0xb9, 0x43, 0x3b, 0xe2, 0x66, 0xf0, 0x72, 0x18, 0x27, 0xcc,
-	0xd0, 0x52, 0xaf, 0x4d, 0x5b, 0x62, 0xcc, 0x79, 0xd0, 0xbd, 0x7f, 0xbc, 0xe5, 0x44, 0x8d, 0x78,
-	0x9e, 0x15, 0xa9, 0x18, 0x60, 0x16, 0x96, 0xb2, 0xa7, 0xeb, 0x1a, 0x84, 0x4d, 0x3c, 0xfb, 0x4f,
-	0xad, 0xd4, 0xa9, 0xd6, 0x4d, 0x96, 0x71, 0xb0, 0x43, 0x02, 0x2a, 0xa2, 0xcc, 0x18, 0xc7, 0x6f,
-	0xce, 0xe4, 0x6f, 0xbf, 0xb7, 0x57, 0x2d, 0xcd, 0xdb, 0x94, 0xc2, 0x34, 0x23, 0x61, 0x84, 0x43,
-	0x7e, 0xdc, 0x4a, 0x27, 0xe2, 0x97, 0x8a, 0xd8, 0xba, 0x99, 0xc5, 0x28, 0x0e, 0xcc, 0xe9, 0xb7,
-	0x7f, 0xd8, 0x82, 0xa1, 0x39, 0xc7, 0xdd, 0x0e, 0x37, 0x36, 0xd0, 0x33, 0x50, 0x6d, 0xb4, 0x23,
-	0xb3, 0x26, 0x80, 0x72, 0x56, 0x2d, 0x88, 0x76, 0xac, 0x30, 0xe8, 0xd4, 0xdf, 0x70, 0x5c, 0x59,
-	0x92, 0xa2, 0xcc, 0xa7, 0xfe, 0x45, 0xd6, 0x82, 0x05, 0x84, 0x0e, 0x7f, 0xd3, 0xd9, 0x95, 0x0f,
-	0x67, 0x8f, 0xd4, 0x96, 0x35, 0x08, 0x9b, 0x78, 0xf6, 0xaf, 0x5b, 0x30, 0x39, 0xe7, 0xc4, 0x9e,
-	0x3b, 0xdb, 0x4e, 0xb6, 0xe6, 0xbc, 0x64, 0xbd, 0xed, 0x6e, 0x93, 0x84, 0x97, 0x2e, 0xa1, 0xbd,
-	0x6c, 0xc7, 0x74, 0x05, 0xaa, 0x1d, 0xb3, 0xea, 0xe5, 0x75, 0xd1, 0x8e, 0x15, 0x06, 0x7a, 0x13,
-	0x86, 0x5b, 0x4e, 0x1c, 0xdf, 0x0e, 0xa3, 0x06, 0x26, 0x1b, 0xc5, 0x14, 0x37, 0xaa, 0x13, 0x37,
-	0x22, 0x09, 0x26, 0x1b, 0x22, 0x40, 0x45, 0xd3, 0xc7, 0x26, 0x33, 0xfb, 0x07, 0x2d, 0x38, 0x35,
-	0x47, 0x9c, 0x88, 0x44, 0xac, 0x16, 0x92, 0x7a, 0x11, 0xf4, 0x06, 0x54, 0x13, 0xda, 0x42, 0x7b,
-	0x64, 0x15, 0xdb, 0x23, 0x16, 0x5a, 0xb2, 0x26, 0x88, 0x63, 0xc5, 0xc6, 0xfe, 0x82, 0x05, 0x67,
-	0xf2, 0xfa, 0x32, 0xef, 0x87, 0xed, 0xc6, 0x83, 0xe8, 0xd0, 0xdf, 0xb5, 0x60, 0x84, 0x1d, 0xd7,
-	0x2f, 0x90, 0xc4, 0xf1, 0xfc, 0xae, 0x3a, 0x8c, 0x56, 0x9f, 0x75, 0x18, 0xcf, 0xc1, 0xc0, 0x56,
-	0xd8, 0x24, 0xd9, 0x50, 0x93, 0xcb, 0x61, 0x93, 0x60, 0x06, 0x41, 0xcf, 0xd1, 0x49, 0xe8, 0x05,
-	0x89, 0x43, 0x97, 0xa3, 0x3c, 0xce, 0x18, 0xe7, 0x13, 0x50, 0x35, 0x63, 0x13, 0xc7, 0xfe, 0x95,
-	0x1a, 0x0c, 0x89, 0xb8, 0xa8, 0xbe, 0x4b, 0xe9, 0x48, 0x2f, 0x4e, 0xa9, 0xa7, 0x17, 0x27, 0x86,
-	0x41, 0x97, 0x15, 0xcb, 0x15, 0x16, 0xfa, 0xd5, 0x42, 0x02, 0xe9, 0x78, 0xfd, 0x5d, 0xdd, 0x2d,
-	0xfe, 0x1f, 0x0b, 0x56, 0xe8, 0x6d, 0x0b, 0xc6, 0xdd, 0x30, 0x08, 0x88, 0xab, 0x6d, 0xc7, 0x81,
-	0x22, 0x36, 0x08, 0xf3, 0x69, 0xa2, 0xfa, 0x24, 0x38, 0x03, 0xc0, 0x59, 0xf6, 0xe8, 0x65, 0x18,
-	0xe5, 0x63, 0x76, 0x23, 0x75, 0x06, 0xa3, 0xcb, 0xf3, 0x99, 0x40, 0x9c, 0xc6, 0x45, 0xd3, 0xfc,
-	0x2c, 0x4b, 0x14, 0xc2, 0x1b, 0xd4, 0xae, 0x6a, 0xa3, 0x04, 0x9e, 0x81, 0x81, 0x22, 0x40, 0x11,
-	0xd9, 0x88, 0x48, 0xbc, 0x25, 0xe2, 0xc6, 0x98, 0xdd, 0x3a, 0x74, 0x6f, 0x45, 0x30, 0x70, 0x17,
-	0x25, 0x9c, 0x43, 0x1d, 0x6d, 0x0b, 0x37, 0x42, 0xb5, 0x08, 0x79, 0x2e, 0x3e, 0x73, 0x4f, 0x6f,
-	0xc2, 0x14, 0x54, 0x98, 0xea, 0x62, 0xf6, 0x72, 0x99, 0x27, 0x5e, 0x32, 0xc5, 0x86, 0x79, 0x3b,
-	0x5a, 0x80, 0x13, 0x99, 0xe2, 0x82, 0xb1, 0x38, 0x2b, 0x51, 0x49, 0x76, 0x99, 0xb2, 0x84, 0x31,
-	0xee, 0x7a, 0xc2, 0x74, 0x31, 0x0d, 0x1f, 0xe0, 0x62, 0xea, 0xa8, 0xe8, 0x64, 0x7e, 0x8a, 0xf1,
-	0x4a, 0x21, 0x03, 0xd0, 0x57, 0x28, 0xf2, 0xe7, 0x33, 0xa1, 0xc8, 0xa3, 0xac, 0x03, 0x37, 0x8a,
-	0xe9, 0xc0, 0xe1, 0xe3, 0x8e, 0x1f, 0x64, 0x1c, 0xf1, 0xff, 0xb2, 0x40, 0x7e, 0xd7, 0x79, 0xc7,
-	0xdd, 0x22, 0x74, 0xca, 0xa0, 0x0f, 0xc2, 0x98, 0xf2, 0x4e, 0x70, 0x93, 0xc8, 0x62, 0xb3, 0x46,
-	0xd9, 0xce, 0x38, 0x05, 0xc5, 0x19, 0x6c, 0x34, 0x03, 0x35, 0x3a, 0x4e, 0xfc, 0x51, 0xae, 0xf7,
-	0x95, 0x07, 0x64, 0x76, 0x75, 0x51, 0x3c, 0xa5, 0x71, 0x50, 0x08, 0x13, 0xbe, 0x13, 0x27, 0xac,
-	0x07, 0xf5, 0x4e, 0xe0, 0xde, 0x63, 0x09, 0x1a, 0x96, 0xc9, 0xb5, 0x94, 0x25, 0x84, 0xbb, 0x69,
-	0xdb, 0xff, 0xbe, 0x02, 0xa3, 0x29, 0xc9, 0x78, 0x48, 0x83, 0xe1, 0x19, 0xa8, 0x4a, 0x1d, 0x9e,
-	0xad, 0xb5, 0xa5, 0x14, 0xbd, 0xc2, 0xa0, 0x4a, 0x6b, 0x5d, 0x6b, 0xd5, 0xac, 0x81, 0x63, 0x28,
-	0x5c, 0x6c, 0xe2, 0x31, 0xa1, 0x9c, 0xf8, 0xf1, 0xbc, 0xef, 0x91, 0x20, 0xe1, 0xdd, 0x2c, 0x46,
-	0x28, 0xaf, 0x2d, 0xd5, 0x4d, 0xa2, 0x5a, 0x28, 0x67, 0x00, 0x38, 0xcb, 0x1e, 0x7d, 0xca, 0x82,
-	0x51, 0xe7, 0x76, 0xac, 0x2b, 0xba, 0x8b, 0xa0, 0xe3, 0x23, 0x2a, 0xa9, 0x54, 0x91, 0x78, 0xee,
-	0xd8, 0x4f, 0x35, 0xe1, 0x34, 0x53, 0xf4, 0x8e, 0x05, 0x88, 0xec, 0x12, 0x57, 0x86, 0x45, 0x8b,
-	0xbe, 0x0c, 0x16, 0xb1, 0x83, 0xbf, 0xd0, 0x45, 0x97, 0x4b, 0xf5, 0xee, 0x76, 0x9c, 0xd3, 0x07,
-	0x74, 0x05, 0x50, 0xc3, 0x8b, 0x9d, 0x75, 0x9f, 0xcc, 0x87, 0x4d, 0x99, 0x7d, 0x2c, 0xce, 0xd3,
-	0xcf, 0x8a, 0x71, 0x46, 0x0b, 0x5d, 0x18, 0x38, 0xe7, 0x29, 0x36, 0xcb, 0xa2, 0x70, 0xb7, 0x73,
-	0x3d, 0xf2, 0x99, 0x96, 0x30, 0x67, 0x99, 0x68, 0xc7, 0x0a, 0xc3, 0xfe, 0xb3, 0xb2, 0x5a, 0xca,
-	0x3a, 0x07, 0xc0, 0x31, 0x62, 0x91, 0xad, 0x7b, 0x8f, 0x45, 0xd6, 0x91, 0x52, 0xdd, 0x39, 0xf5,
-	0xa9, 0x14, 0xdc, 0xd2, 0x03, 0x4a, 0xc1, 0xfd, 0x3e, 0x2b, 0x55, 0xcf, 0x6e, 0xf8, 0xfc, 0xab,
-	0xc5, 0xe6, 0x1f, 0x4c, 0xf3, 0x28, 0xae, 0x8c, 0x5e, 0xc9, 0x04, 0xef, 0x3d, 0x03, 0xd5, 0x0d,
-	0xdf, 0x61, 0x55, 0x58, 0xd8, 0x42, 0x35, 0x22, 0xcc, 0x2e, 0x8a, 0x76, 0xac, 0x30, 0xa8, 0xd4,
-	0x37, 0x88, 0x1e, 0x4a, 0x6a, 0xff, 0xa7, 0x32, 0x0c, 0x1b, 0x1a, 0x3f, 0xd7, 0x7c, 0xb3, 0x1e,
-	0x32, 0xf3, 0xad, 0x74, 0x08, 0xf3, 0xed, 0x7b, 0xa1, 0xe6, 0x4a, 0x6d, 0x54, 0x4c, 0x7d, 0xfe,
-	0xac, 0x8e, 0xd3, 0x0a, 0x49, 0x35, 0x61, 0xcd, 0x13, 0x5d, 0x4a, 0xa5, 0x79, 0xa6, 0xfc, 0x02,
-	0x79, 0x79, 0x98, 0x42, 0xa3, 0x75, 0x3f, 0x93, 0x8d, 0x0f, 0xa8, 0x1c, 0x1c, 0x1f, 0x60, 0xff,
-	0x81, 0xa5, 0x3e, 0xee, 0x7d, 0xa8, 0xe7, 0x73, 0x2b, 0x5d, 0xcf, 0xe7, 0x42, 0x21, 0xc3, 0xdc,
-	0xa3, 0x90, 0xcf, 0x35, 0x18, 0x9a, 0x0f, 0x9b, 0x4d, 0x27, 0x68, 0xa0, 0x6f, 0x80, 0x21, 0x97,
-	0xff, 0x14, 0x3e, 0x34, 0x76, 0x58, 0x2d, 0xa0, 0x58, 0xc2, 0xd0, 0xe3, 0x30, 0xe0, 0x44, 0x9b,
-	0xd2, 0x6f, 0xc6, 0x82, 0xe0, 0x66, 0xa3, 0xcd, 0x18, 0xb3, 0x56, 0xfb, 0x2f, 0x2c, 0x18, 0xa3,
-	0x8f, 0x78, 0xec, 0xa5, 0xd8, 0xeb, 0x3c, 0x0d, 0x83, 0x4e, 0x3b, 0xd9, 0x0a, 0xbb, 0xf6, 0x61,
-	0xb3, 0xac, 0x15, 0x0b, 0x28, 0xdd, 0x87, 0xa9, 0x42, 0x10, 0xc6, 0x3e, 0x6c, 0x81, 0xce, 0x65,
-	0x06, 0xa1, 0xa6, 0x6c, 0xdc, 0x5e, 0xcf, 0x3b, 0x2d, 0xad, 0xf3, 0x66, 0x2c, 0xe1, 0x94, 0xd8,
-	0x7a, 0xd8, 0xe8, 0x88, 0xd0, 0x5e, 0x45, 0x6c, 0x2e, 0x6c, 0x74, 0x30, 0x83, 0xa0, 0x27, 0xa0,
-	0x1c, 0x6f, 0x39, 0xf2, 0x5c, 0x5e, 0x46, 0x99, 0xd7, 0x2f, 0xcf, 0x62, 0xda, 0xae, 0x92, 0x26,
-	0x22, 0x3f, 0x1b, 0x63, 0x9b, 0x4e, 0x9a, 0x88, 0x7c, 0xfb, 0x5f, 0x0c, 0x00, 0x8b, 0xb7, 0x71,
-	0x22, 0xd2, 0x58, 0x0b, 0x59, 0x29, 0xe1, 0x63, 0x3d, 0xd6, 0xd6, 0x1b, 0xd9, 0x87, 0xf9, 0x68,
-	0xdb, 0x38, 0xde, 0x2c, 0xdf, 0xef, 0xe3, 0xcd, 0xfc, 0x13, 0xeb, 0x81, 0x87, 0xe8, 0xc4, 0xda,
-	0xfe, 0x9c, 0x05, 0x48, 0x45, 0x4f, 0xe9, 0x90, 0x92, 0x19, 0xa8, 0xa9, 0x70, 0x2d, 0xb1, 0x5e,
-	0xb4, 0x58, 0x94, 0x00, 0xac, 0x71, 0xfa, 0xf0, 0x5e, 0x3c, 0x25, 0x75, 0x56, 0x39, 0x9d, 0x73,
-	0xc1, 0x34, 0x9d, 0x50, 0x61, 0xf6, 0xaf, 0x96, 0xe0, 0x11, 0x6e, 0x2e, 0x2d, 0x3b, 0x81, 0xb3,
-	0x49, 0x9a, 0xb4, 0x57, 0xfd, 0x06, 0x09, 0xb9, 0x74, 0xdb, 0xec, 0xc9, 0x0c, 0x89, 0xa3, 0xca,
-	0x2b, 0x2e, 0x67, 0xb8, 0x64, 0x59, 0x0c, 0xbc, 0x04, 0x33, 0xe2, 0x28, 0x86, 0xaa, 0xbc, 0xcc,
-	0x48, 0xe8, 0x9f, 0x82, 0x18, 0x29, 0x51, 0x2c, 0x2c, 0x0b, 0x82, 0x15, 0x23, 0x6a, 0x3e, 0xf8,
-	0xa1, 0xbb, 0x4d, 0x97, 0x7c, 0xd6, 0x7c, 0x58, 0x12, 0xed, 0x58, 0x61, 0xd8, 0x4d, 0x18, 0x97,
-	0x63, 0xd8, 0xba, 0x4a, 0x3a, 0x98, 0x6c, 0x50, 0x9d, 0xeb, 0xca, 0x26, 0xe3, 0x7e, 0x25, 0xa5,
-	0x73, 0xe7, 0x4d, 0x20, 0x4e, 0xe3, 0xca, 0xea, 0xc2, 0xa5, 0xfc, 0xea, 0xc2, 0xf6, 0xaf, 0x5a,
-	0x90, 0x55, 0xfa, 0x46, 0x2d, 0x55, 0x6b, 0xdf, 0x5a, 0xaa, 0x87, 0xa8, 0x46, 0xfa, 0x5d, 0x30,
-	0xec, 0x24, 0xd4, 0xaa, 0xe3, 0x1e, 0x98, 0xf2, 0xbd, 0x9d, 0x1c, 0x2e, 0x87, 0x0d, 0x6f, 0xc3,
-	0x63, 0x9e, 0x17, 0x93, 0x9c, 0xfd, 0x8e, 0x05, 0xb5, 0x85, 0xa8, 0x73, 0xf8, 0x54, 0xb5, 0xee,
-	0x44, 0xb4, 0xd2, 0xa1, 0x12, 0xd1, 0x64, 0xaa, 0x5b, 0xb9, 0x57, 0xaa, 0x9b, 0xfd, 0x97, 0x03,
-	0x30, 0xd1, 0x95, 0x7b, 0x89, 0x5e, 0x82, 0x11, 0xf5, 0x95, 0xa4, 0xdb, 0xb5, 0x66, 0x06, 0x2f,
-	0x6b, 0x18, 0x4e, 0x61, 0xf6, 0xb1, 0x54, 0x17, 0xe1, 0x64, 0x44, 0xde, 0x68, 0x93, 0x36, 0x99,
-	0xdd, 0x48, 0x48, 0x54, 0x27, 0x6e, 0x18, 0x34, 0x78, 0x31, 0xe2, 0xf2, 0xdc, 0xa3, 0x77, 0xf6,
-	0xa6, 0x4e, 0xe2, 0x6e, 0x30, 0xce, 0x7b, 0x06, 0xb5, 0x60, 0xd4, 0x37, 0xf7, 0x0b, 0x62, 0x9b,
-	0x7a, 0x4f, 0x5b, 0x0d, 0x35, 0x5b, 0x53, 0xcd, 0x38, 0xcd, 0x20, 0xbd, 0xe9, 0xa8, 0x3c, 0xa0,
-	0x4d, 0xc7, 0x27, 0xf5, 0xa6, 0x83, 0xc7, 0x02, 0x7d, 0xb8, 0xe0, 0xdc, 0xdb, 0x7e, 0x76, 0x1d,
-	0x47, 0xd9, 0x47, 0xbc, 0x02, 0x55, 0x19, 0x27, 0xd9, 0x57, 0x7c, 0xa1, 0x49, 0xa7, 0x87, 0x6c,
-	0xbf, 0x5b, 0x82, 0x9c, 0xad, 0x32, 0x5d, 0x6b, 0xda, 0xde, 0x4b, 0xad, 0xb5, 0xc3, 0xd9, 0x7c,
-	0x68, 0x97, 0xc7, 0x88, 0x72, 0x2d, 0xff, 0xa1, 0xa2, 0xb7, 0xfa, 0x3a, 0x6c, 0x54, 0x49, 0x40,
-	0x15, 0x3a, 0x7a, 0x1e, 0x40, 0x9b, 0xe9, 0xc2, 0xd6, 0x53, 0x41, 0x1f, 0xda, 0x9a, 0xc7, 0x06,
-	0x16, 0x7a, 0x11, 0x86, 0xbd, 0x20, 0x4e, 0x1c, 0xdf, 0xbf, 0xec, 0x05, 0x89, 0xb0, 0xff, 0x94,
-	0x39, 0xb3, 0xa8, 0x41, 0xd8, 0xc4, 0x3b, 0xfb, 0x01, 0xe3, 0xbb, 0x1c, 0xe6, 0x7b, 0x6e, 0xc1,
-	0x99, 0x4b, 0x5e, 0xa2, 0x92, 0x0f, 0xd5, 0x3c, 0xa2, 0x56, 0xb8, 0x92, 0x41, 0x56, 0xcf, 0x74,
-	0x5b, 0x23, 0xf9, 0xaf, 0x94, 0xce, 0x55, 0xcc, 0x26, 0xff, 0xd9, 0x2e, 0x9c, 0xba, 0xe4, 0x25,
-	0x17, 0x3d, 0x9f, 0x1c, 0x23, 0x93, 0x5f, 0x1e, 0x84, 0x11, 0x33, 0x27, 0xff, 0x30, 0x12, 0xfb,
-	0x0b, 0xd4, 0x3e, 0x15, 0x03, 0xe1, 0xa9, 0x83, 0xec, 0x9b, 0x47, 0x2e, 0x10, 0x90, 0x3f, 0xb8,
-	0x86, 0x89, 0xaa, 0x79, 0x62, 0xb3, 0x03, 0xe8, 0x36, 0x54, 0x36, 0x58, 0x1e, 0x5b, 0xb9, 0x88,
-	0x10, 0xa4, 0xbc, 0xc1, 0xd7, 0x2b, 0x92, 0x67, 0xc2, 0x71, 0x7e, 0xd4, 0xac, 0x88, 0xd2, 0xe9,
-	0xd3, 0x46, 0x76, 0x81, 0xd0, 0x57, 0x0a, 0xa3, 0x97, 0x56, 0xa8, 0xdc, 0x83, 0x56, 0x48, 0xc9,
-	0xe8, 0xc1, 0x07, 0x24, 0xa3, 0x59, 0x4e, 0x62, 0xb2, 0xc5, 0x8c, 0x5e, 0x91, 0x0e, 0x35, 0xc4,
-	0x06, 0xc1, 0xc8, 0x49, 0x4c, 0x81, 0x71, 0x16, 0x1f, 0x7d, 0x4c, 0x49, 0xf9, 0x6a, 0x11, 0x07,
-	0x05, 0xe6, 0x8c, 0x3e, 0x6e, 0x01, 0xff, 0xb9, 0x12, 0x8c, 0x5d, 0x0a, 0xda, 0xab, 0x97, 0x56,
-	0xdb, 0xeb, 0xbe, 0xe7, 0x5e, 0x25, 0x1d, 0x2a, 0xc5, 0xb7, 0x49, 0x67, 0x71, 0x41, 0xac, 0x20,
-	0x35, 0x67, 0xae, 0xd2, 0x46, 0xcc, 0x61, 0x54, 0x6e, 0x6d, 0x78, 0xc1, 0x26, 0x89, 0x5a, 0x91,
-	0x27, 0x7c, 0xf8, 0x86, 0xdc, 0xba, 0xa8, 0x41, 0xd8, 0xc4, 0xa3, 0xb4, 0xc3, 0xdb, 0x81, 0x2a,
-	0x90, 0xa4, 0x68, 0xaf, 0xd0, 0x46, 0xcc, 0x61, 0x14, 0x29, 0x89, 0xda, 0xc2, 0x45, 0x66, 0x20,
-	0xad, 0xd1, 0x46, 0xcc, 0x61, 0x62, 0xf7, 0xcd, 0x22, 0xbc, 0x2a, 0x5d, 0xbb, 0x6f, 0x16, 0x1c,
-	0x21, 0xe1, 0x14, 0x75, 0x9b, 0x74, 0x16, 0x9c, 0xc4, 0xc9, 0x6e, 0x9e, 0xaf, 0xf2, 0x66, 0x2c,
-	0xe1, 0xac, 0x62, 0x72, 0x7a, 0x38, 0xbe, 0xe6, 0x2a, 0x26, 0xa7, 0xbb, 0xdf, 0xc3, 0xd1, 0xf2,
-	0x77, 0x4a, 0x30, 0xf2, 0xee, 0xb5, 0xa6, 0x39, 0x17, 0xf6, 0xdc, 0x84, 0x89, 0xae, 0x4c, 0xe8,
-	0x3e, 0x2c, 0x9f, 0x03, 0x2b, 0x55, 0xd8, 0x18, 0x86, 0x29, 0x61, 0x59, 0x29, 0x70, 0x1e, 0x26,
-	0xf8, 0xe2, 0xa5, 0x9c, 0x58, 0x62, 0xab, 0xca, 0x6e, 0x67, 0x87, 0x54, 0x37, 0xb2, 0x40, 0xdc,
-	0x8d, 0x6f, 0x7f, 0xde, 0x82, 0xd1, 0x54, 0x72, 0x7a, 0x41, 0x36, 0x1a, 0x5b, 0xdd, 0x21, 0x8b,
-	0x4e, 0x66, 0xd9, 0x22, 0x65, 0xa6, 0x86, 0xf5, 0xea, 0xd6, 0x20, 0x6c, 0xe2, 0xd9, 0xbf, 0x55,
-	0x86, 0xaa, 0x8c, 0xa4, 0xea, 0xa3, 0x2b, 0x9f, 0xb5, 0x60, 0x54, 0x1d, 0x0c, 0x32, 0x4f, 0x6e,
-	0xa9, 0x88, 0x5c, 0x39, 0xda, 0x03, 0xe5, 0x17, 0x09, 0x36, 0x42, 0xbd, 0x61, 0xc0, 0x26, 0x33,
-	0x9c, 0xe6, 0x8d, 0x6e, 0x00, 0xc4, 0x9d, 0x38, 0x21, 0x4d, 0xc3, 0xa7, 0x6c, 0x1b, 0xb3, 0x6c,
-	0xda, 0x0d, 0x23, 0x42, 0xe7, 0xd4, 0xb5, 0xb0, 0x41, 0xea, 0x0a, 0x53, 0x5b, 0x78, 0xba, 0x0d,
-	0x1b, 0x94, 0xd0, 0x9b, 0xea, 0x18, 0x7b, 0xa0, 0x08, 0xbd, 0x2e, 0xc7, 0xb7, 0x9f, 0x73, 0xec,
-	0x23, 0x9c, 0x1b, 0xdb, 0x3f, 0x53, 0x82, 0x13, 0xd9, 0x91, 0x44, 0x1f, 0x86, 0x11, 0x39, 0x68,
-	0x86, 0xfb, 0x40, 0x86, 0xaf, 0x8d, 0x60, 0x03, 0x76, 0x77, 0x6f, 0x6a, 0xaa, 0xfb, 0x7e, 0xec,
-	0x69, 0x13, 0x05, 0xa7, 0x88, 0xf1, 0x43, 0x65, 0x11, 0xfd, 0x30, 0xd7, 0x99, 0x6d, 0xb5, 0xc4,
-	0xc9, 0xb0, 0x71, 0xa8, 0x6c, 0x42, 0x71, 0x06, 0x1b, 0xad, 0xc2, 0x29, 0xa3, 0xe5, 0x1a, 0xf1,
-	0x36, 0xb7, 0xd6, 0xc3, 0x48, 0xee, 0x57, 0x1f, 0xd7, 0xc1, 0xb2, 0xdd, 0x38, 0x38, 0xf7, 0x49,
-	0x6a, 0x18, 0xb9, 0x4e, 0xcb, 0x71, 0xbd, 0xa4, 0x23, 0x7c, 0xfb, 0x4a, 0x8c, 0xcf, 0x8b, 0x76,
-	0xac, 0x30, 0xec, 0x7f, 0x38, 0x00, 0x27, 0x78, 0x74, 0x28, 0x51, 0xc1, 0xcf, 0xe8, 0xc3, 0x50,
-	0x8b, 0x13, 0x27, 0xe2, 0xce, 0x0a, 0xeb, 0xd0, 0xa2, 0x4b, 0x67, 0xd4, 0x4b, 0x22, 0x58, 0xd3,
-	0x43, 0xaf, 0xb2, 0x72, 0x64, 0x5e, 0xbc, 0xc5, 0xa8, 0x97, 0xee, 0xcd, 0x15, 0x72, 0x51, 0x51,
-	0xc0, 0x06, 0x35, 0xf4, 0xad, 0x50, 0x69, 0x6d, 0x39, 0xb1, 0xf4, 0xd3, 0x3d, 0x2d, 0xe5, 0xc4,
-	0x2a, 0x6d, 0xbc, 0xbb, 0x37, 0x75, 0x3a, 0xfb, 0xaa, 0x0c, 0x80, 0xf9, 0x43, 0xa6, 0x94, 0x1f,
-	0x38, 0xf8, 0xbe, 0x9d, 0x46, 0xd4, 0xa9, 0x5f, 0x9e, 0xcd, 0xde, 0xd0, 0xb2, 0xc0, 0x5a, 0xb1,
-	0x80, 0x52, 0x99, 0xb4, 0xc5, 0x59, 0x36, 0x28, 0xf2, 0x60, 0xda, 0xe2, 0xb8, 0xac, 0x41, 0xd8,
-	0xc4, 0x43, 0x9f, 0xeb, 0x8e, 0x1d, 0x1e, 0x3a, 0x86, 0xdc, 0x92, 0x7e, 0xa3, 0x86, 0x2f, 0x40,
-	0x4d, 0x74, 0x75, 0x2d, 0x44, 0x2f, 0xc1, 0x08, 0x77, 0x03, 0xcd, 0x45, 0x4e, 0xe0, 0x6e, 0x65,
-	0x9d, 0x37, 0x6b, 0x06, 0x0c, 0xa7, 0x30, 0xed, 0x65, 0x18, 0xe8, 0x53, 0xc8, 0xf6, 0xb5, 0x27,
-	0x7f, 0x05, 0xaa, 0x94, 0x9c, 0xdc, 0xa0, 0x15, 0x41, 0x32, 0x84, 0xaa, 0xbc, 0xbd, 0x11, 0xd9,
-	0x50, 0xf6, 0x1c, 0x19, 0x23, 0xa2, 0x96, 0xd0, 0x62, 0x1c, 0xb7, 0xd9, 0xb4, 0xa3, 0x40, 0xf4,
-	0x14, 0x94, 0xc9, 0x6e, 0x2b, 0x1b, 0x0c, 0x72, 0x61, 0xb7, 0xe5, 0x45, 0x24, 0xa6, 0x48, 0x64,
-	0xb7, 0x85, 0xce, 0x42, 0xc9, 0x6b, 0x88, 0x19, 0x09, 0x02, 0xa7, 0xb4, 0xb8, 0x80, 0x4b, 0x5e,
-	0xc3, 0xde, 0x85, 0x9a, 0xba, 0x2e, 0x12, 0x6d, 0x4b, 0x93, 0xca, 0x2a, 0x22, 0x3a, 0x58, 0xd2,
-	0xed, 0x61, 0x4c, 0xb5, 0x01, 0x74, 0xa9, 0x86, 0xa2, 0x54, 0xf0, 0x39, 0x18, 0x70, 0x43, 0x51,
-	0x64, 0xa7, 0xaa, 0xc9, 0x30, 0x5b, 0x8a, 0x41, 0xec, 0x9b, 0x30, 0x76, 0x35, 0x08, 0x6f, 0xb3,
-	0x5b, 0x9d, 0x58, 0x11, 0x63, 0x4a, 0x78, 0x83, 0xfe, 0xc8, 0x5a, 0xee, 0x0c, 0x8a, 0x39, 0x4c,
-	0x95, 0x57, 0x2d, 0xf5, 0x2a, 0xaf, 0x6a, 0x7f, 0xdc, 0x82, 0x11, 0x95, 0xf3, 0x7d, 0x69, 0x67,
-	0x9b, 0xd2, 0xdd, 0x8c, 0xc2, 0x76, 0x2b, 0x4b, 0x97, 0xdd, 0x4c, 0x8b, 0x39, 0xcc, 0x2c, 0x86,
-	0x50, 0x3a, 0xa0, 0x18, 0xc2, 0x39, 0x18, 0xd8, 0xf6, 0x82, 0x46, 0xd6, 0xd9, 0x79, 0xd5, 0x0b,
-	0x1a, 0x98, 0x41, 0xec, 0xbf, 0xb2, 0xe0, 0x84, 0xea, 0x82, 0xb4, 0x99, 0x5e, 0x82, 0x91, 0xf5,
-	0xb6, 0xe7, 0x37, 0x64, 0x75, 0xe6, 0xcc, 0x72, 0x99, 0x33, 0x60, 0x38, 0x85, 0x89, 0xce, 0x03,
-	0xac, 0x7b, 0x81, 0x13, 0x75, 0x56, 0xb5, 0x91, 0xa6, 0xf4, 0xf6, 0x9c, 0x82, 0x60, 0x03, 0x0b,
-	0xbd, 0x05, 0xd5, 0x1d, 0x79, 0x2a, 0x5b, 0x2e, 0x34, 0x87, 0x5f, 0x8c, 0x87, 0x5e, 0x09, 0xea,
-	0x98, 0x57, 0x71, 0xb4, 0xbf, 0x58, 0x86, 0xb1, 0x74, 0xde, 0x7d, 0x1f, 0x9e, 0x93, 0xa7, 0xa0,
-	0xc2, 0x52, 0xf1, 0xb3, 0x13, 0x8b, 0x97, 0x53, 0xe6, 0x30, 0x14, 0xc3, 0x20, 0x17, 0x25, 0xc5,
-	0xdc, 0x2d, 0xaa, 0x3a, 0xa9, 0xfc, 0xb3, 0x2c, 0x82, 0x5b, 0xb8, 0xbb, 0x05, 0x2b, 0xf4, 0x29,
-	0x0b, 0x86, 0xc2, 0x96, 0x59, 0xd7, 0xf3, 0x43, 0x45, 0xd6, 0x24, 0x10, 0x89, 0xbf, 0xc2, 0x1a,
-	0x52, 0x13, 0x4f, 0x4e, 0x06, 0xc9, 0xfa, 0xec, 0xb7, 0xc0, 0x88, 0x89, 0x79, 0x90, 0x41, 0x54,
-	0x35, 0x0d, 0xa2, 0xcf, 0x9a, 0x53, 0x52, 0x54, 0x5d, 0xe8, 0x63, 0xb1, 0x5f, 0x87, 0x8a, 0xab,
-	0xc2, 0xdc, 0xee, 0xe9, 0x46, 0x01, 0x55, 0x95, 0x8c, 0x85, 0x10, 0x70, 0x6a, 0xf6, 0x1f, 0x58,
-	0xc6, 0xfc, 0xc0, 0x24, 0x5e, 0x6c, 0xa0, 0x08, 0xca, 0x9b, 0x3b, 0xdb, 0xc2, 0xc8, 0xb8, 0x52,
-	0xd0, 0xf0, 0x5e, 0xda, 0xd9, 0xd6, 0x2b, 0xcc, 0x6c, 0xc5, 0x94, 0x59, 0x1f, 0x87, 0x08, 0xa9,
-	0xe2, 0x1c, 0xe5, 0x83, 0x8b, 0x73, 0xd8, 0xef, 0x94, 0x60, 0xa2, 0x6b, 0x52, 0xa1, 0x37, 0xa1,
-	0x12, 0xd1, 0xb7, 0x14, 0xaf, 0xb7, 0x54, 0x58, 0x39, 0x8d, 0x78, 0xb1, 0xa1, 0x95, 0x77, 0xba,
-	0x1d, 0x73, 0x96, 0xe8, 0x0a, 0x20, 0x1d, 0x8c, 0xa9, 0x4e, 0x30, 0xf8, 0x2b, 0xab, 0x88, 0xad,
-	0xd9, 0x2e, 0x0c, 0x9c, 0xf3, 0x14, 0x7a, 0x39, 0x7b, 0x10, 0x92, 0xa9, 0x14, 0xbd, 0xdf, 0x99,
-	0x86, 0xfd, 0xb6, 0x39, 0x05, 0x6f, 0x68, 0x61, 0x7a, 0xd4, 0xcd, 0x69, 0x97, 0x64, 0x2d, 0xf7,
-	0x2b, 0x59, 0xed, 0x5f, 0x2c, 0xc1, 0x68, 0xaa, 0xf2, 0x2b, 0xf2, 0xa1, 0x4a, 0x7c, 0x76, 0x62,
-	0x2b, 0xb5, 0xef, 0x51, 0x2f, 0x81, 0x51, 0x72, 0xf2, 0x82, 0xa0, 0x8b, 0x15, 0x87, 0x87, 0x23,
-	0xb6, 0xec, 0x25, 0x18, 0x91, 0x1d, 0xfa, 0x90, 0xd3, 0xf4, 0xb3, 0xc3, 0x77, 0xc1, 0x80, 0xe1,
-	0x14, 0xa6, 0xfd, 0x6b, 0x65, 0x98, 0xe4, 0x47, 0xdc, 0x0d, 0xb5, 0x18, 0x54, 0xa8, 0xca, 0x0f,
-	0xe9, 0xfa, 0xcc, 0x56, 0x11, 0x37, 0x9d, 0xf7, 0x62, 0xd4, 0x57, 0x48, 0xf4, 0x4f, 0x64, 0x42,
-	0xa2, 0xf9, 0x56, 0x7d, 0xf3, 0x98, 0x7a, 0xf4, 0xb5, 0x15, 0x23, 0xfd, 0x4f, 0x4b, 0x30, 0x9e,
-	0xb9, 0xd0, 0x0e, 0x7d, 0x31, 0x7d, 0x07, 0x8a, 0x55, 0xc4, 0xf1, 0xdf, 0xbe, 0x77, 0x9c, 0x1d,
-	0xee, 0x26, 0x94, 0x07, 0xb4, 0x54, 0xec, 0xdf, 0x2b, 0xc1, 0x58, 0xfa, 0x26, 0xbe, 0x87, 0x70,
-	0xa4, 0xde, 0x0f, 0x35, 0x76, 0xd9, 0xd4, 0x55, 0xd2, 0x91, 0xa7, 0x8c, 0xfc, 0x5e, 0x1f, 0xd9,
-	0x88, 0x35, 0xfc, 0xa1, 0xb8, 0x60, 0xc6, 0xfe, 0x67, 0x16, 0x9c, 0xe6, 0x6f, 0x99, 0x9d, 0x87,
-	0x7f, 0x2b, 0x6f, 0x74, 0x5f, 0x2b, 0xb6, 0x83, 0x99, 0xba, 0xe2, 0x07, 0x8d, 0x2f, 0xbb, 0xef,
-	0x5d, 0xf4, 0x36, 0x3d, 0x15, 0x1e, 0xc2, 0xce, 0x1e, 0x6a, 0x32, 0xd8, 0xff, 0xa1, 0x04, 0xc3,
-	0x2b, 0xf3, 0x8b, 0x4a, 0x84, 0xcf, 0x40, 0xcd, 0x8d, 0x88, 0xa3, 0xdd, 0x3f, 0x66, 0x00, 0x95,
-	0x04, 0x60, 0x8d, 0x43, 0x77, 0x51, 0x3c, 0x00, 0x31, 0xce, 0xee, 0xa2, 0x78, 0x7c, 0x62, 0x8c,
-	0x25, 0x1c, 0x3d, 0x03, 0x55, 0x96, 0x1a, 0x7c, 0x3d, 0x92, 0x1a, 0x47, 0x6f, 0xad, 0x59, 0x3b,
-	0x5e, 0xc2, 0x0a, 0x83, 0x12, 0x6e, 0x84, 0x6e, 0x4c, 0x91, 0x33, 0x1e, 0x99, 0x05, 0xda, 0x8c,
-	0x97, 0xb0, 0x84, 0xb3, 0xca, 0x8e, 0xcc, 0x6b, 0x41, 0x91, 0x2b, 0xe9, 0x4e, 0x73, 0xf7, 0x06,
-	0x45, 0xd7, 0x38, 0x87, 0xa9, 0x00, 0x9a, 0x49, 0xcf, 0x1b, 0xea, 0x2f, 0x3d, 0xcf, 0xfe, 0xbd,
-	0x32, 0xd4, 0xb4, 0x53, 0xcd, 0x13, 0xf5, 0x30, 0x0a, 0xa9, 0x5b, 0x5f, 0xef, 0x04, 0xae, 0x22,
-	0xcd, 0xa3, 0x09, 0x8c, 0x72, 0x18, 0x3f, 0x60, 0xc1, 0xb0, 0x17, 0x78, 0x89, 0xe7, 0x30, 0xdf,
-	0x60, 0x31, 0xf7, 0x7f, 0x2b, 0x76, 0x8b, 0x9c, 0x72, 0x18, 0x99, 0x47, 0xfe, 0x8a, 0x19, 0x36,
-	0x39, 0xa3, 0x8f, 0x8a, 0x6c, 0xb0, 0x72, 0x61, 0x45, 0x65, 0xaa, 0x99, 0x14, 0xb0, 0x16, 0xb5,
-	0xb1, 0x93, 0xa8, 0xa0, 0x5a, 0x4c, 0x98, 0x92, 0x52, 0xf7, 0xa7, 0xa8, 0x5d, 0x0c, 0x6b, 0xc6,
-	0x9c, 0x91, 0x1d, 0x03, 0xea, 0x1e, 0x8b, 0x43, 0x66, 0xda, 0xcc, 0x40, 0xcd, 0x69, 0x27, 0x61,
-	0x93, 0x0e, 0x93, 0x08, 0x18, 0xd0, 0xb9, 0x44, 0x12, 0x80, 0x35, 0x8e, 0xfd, 0xc5, 0x0a, 0x64,
-	0xaa, 0x53, 0xa0, 0x5d, 0xa8, 0xa9, 0xfa, 0x14, 0xc5, 0x64, 0xae, 0xea, 0x19, 0xa5, 0x3a, 0xa3,
-	0x9a, 0xb0, 0x66, 0x86, 0x36, 0xa5, 0x9b, 0x95, 0xaf, 0xf6, 0x57, 0xb2, 0x6e, 0xd6, 0xef, 0xe8,
-	0xef, 0xd4, 0x8d, 0xce, 0xd5, 0x19, 0x5e, 0x8f, 0x70, 0xfa, 0x40, 0x8f, 0xec, 0x41, 0x37, 0xa0,
-	0x7f, 0x42, 0xdc, 0x56, 0x86, 0x49, 0xdc, 0xf6, 0x13, 0x31, 0x1b, 0x5e, 0x29, 0x70, 0x95, 0x71,
-	0xc2, 0xba, 0xca, 0x13, 0xff, 0x8f, 0x0d, 0xa6, 0x69, 0xbf, 0xf9, 0xe0, 0xb1, 0xfa, 0xcd, 0x87,
-	0x0a, 0xf5, 0x9b, 0x9f, 0x07, 0x60, 0x73, 0x9b, 0x67, 0x04, 0x54, 0x99, 0x3b, 0x53, 0xa9, 0x18,
-	0xac, 0x20, 0xd8, 0xc0, 0xb2, 0xbf, 0x09, 0xd2, 0x65, 0xca, 0xd0, 0x94, 0xac, 0x8a, 0xc6, 0x4f,
-	0x04, 0x59, 0x32, 0x66, 0xaa, 0x80, 0xd9, 0xcf, 0x5b, 0x60, 0xd6, 0x52, 0x43, 0x6f, 0xf0, 0xa2,
-	0x6d, 0x56, 0x11, 0x27, 0x4c, 0x06, 0xdd, 0xe9, 0x65, 0xa7, 0x95, 0x89, 0x76, 0x92, 0x95, 0xdb,
-	0xce, 0x7e, 0x00, 0xaa, 0x12, 0x7a, 0x28, 0x63, 0xf9, 0x63, 0x70, 0x52, 0x16, 0x76, 0x90, 0x87,
-	0x41, 0x22, 0xea, 0xe0, 0x60, 0x1f, 0xa3, 0x74, 0x1c, 0x96, 0x7a, 0x39, 0x0e, 0xd5, 0x6e, 0xb8,
-	0xdc, 0xb3, 0x1c, 0xfb, 0x2f, 0x58, 0x70, 0x2e, 0xdb, 0x81, 0x78, 0x39, 0x0c, 0xbc, 0x24, 0x8c,
-	0xea, 0x24, 0x49, 0xbc, 0x60, 0x93, 0xd5, 0xd6, 0xbd, 0xed, 0x44, 0xf2, 0x7e, 0x25, 0x26, 0x28,
-	0x6f, 0x3a, 0x51, 0x80, 0x59, 0x2b, 0xea, 0xc0, 0x20, 0x0f, 0xa1, 0x16, 0xbb, 0xa0, 0x23, 0xae,
-	0x8d, 0x9c, 0xe1, 0xd0, 0xdb, 0x30, 0x1e, 0xbe, 0x8d, 0x05, 0x43, 0xfb, 0x2b, 0x16, 0xa0, 0x95,
-	0x1d, 0x12, 0x45, 0x5e, 0xc3, 0x08, 0xfa, 0x66, 0xb7, 0x7e, 0x1a, 0xb7, 0x7b, 0x9a, 0x65, 0x47,
-	0x32, 0xb7, 0x7e, 0x1a, 0xff, 0xf2, 0x6f, 0xfd, 0x2c, 0x1d, 0xee, 0xd6, 0x4f, 0xb4, 0x02, 0xa7,
-	0x9b, 0x7c, 0x1b, 0xc7, 0x6f, 0xd2, 0xe3, 0x7b, 0x3a, 0x95, 0x21, 0x7f, 0xe6, 0xce, 0xde, 0xd4,
-	0xe9, 0xe5, 0x3c, 0x04, 0x9c, 0xff, 0x9c, 0xfd, 0x01, 0x40, 0x3c, 0xd6, 0x7b, 0x3e, 0x2f, 0x5c,
-	0xb5, 0xa7, 0x9b, 0xc3, 0xfe, 0xf1, 0x0a, 0x8c, 0x67, 0x6e, 0xdf, 0xa0, 0x5b, 0xe8, 0xee, 0xf8,
-	0xd8, 0x23, 0xeb, 0xef, 0xee, 0xee, 0xf5, 0x15, 0x71, 0x1b, 0x40, 0xc5, 0x0b, 0x5a, 0xed, 0xa4,
-	0x98, 0x02, 0x1d, 0xbc, 0x13, 0x8b, 0x94, 0xa0, 0x71, 0x2e, 0x41, 0xff, 0x62, 0xce, 0xa6, 0xc8,
-	0xf8, 0xdd, 0xd4, 0x26, 0x67, 0xe0, 0x01, 0xb9, 0x59, 0x3e, 0xa1, 0xa3, 0x69, 0x2b, 0x45, 0xf8,
-	0x90, 0x33, 0x93, 0xe5, 0xb8, 0x43, 0xad, 0x7e, 0xb6, 0x04, 0xc3, 0xc6, 0x47, 0x43, 0x3f, 0x99,
-	0xae, 0x34, 0x6a, 0x15, 0xf7, 0x4a, 0x8c, 0xfe, 0xb4, 0xae, 0x25, 0xca, 0x5f, 0xe9, 0xe9, 0xee,
-	0x22, 0xa3, 0x77, 0xf7, 0xa6, 0x4e, 0x64, 0xca, 0x88, 0xa6, 0x0a, 0x8f, 0x9e, 0xfd, 0x1e, 0x18,
-	0xcf, 0x90, 0xc9, 0x79, 0xe5, 0x35, 0xf3, 0x95, 0x8f, 0xec, 0xee, 0x33, 0x87, 0xec, 0xcb, 0x74,
-	0xc8, 0x44, 0x5d, 0x80, 0xd0, 0x27, 0x7d, 0xf8, 0x3a, 0x33, 0xfb, 0x8b, 0x52, 0x9f, 0xe5, 0x3f,
-	0xde, 0x07, 0xd5, 0x56, 0xe8, 0x7b, 0xae, 0xa7, 0x0a, 0x95, 0xb3, 0x82, 0x23, 0xab, 0xa2, 0x0d,
-	0x2b, 0x28, 0xba, 0x0d, 0xb5, 0x5b, 0xb7, 0x13, 0x7e, 0xcc, 0x28, 0x8e, 0x32, 0x8a, 0x3a, 0x5d,
-	0x54, 0x46, 0x8b, 0x3a, 0xc7, 0xc4, 0x9a, 0x17, 0xb2, 0x61, 0x90, 0x29, 0x41, 0x99, 0x23, 0xc8,
-	0x8e, 0x59, 0x98, 0x76, 0x8c, 0xb1, 0x80, 0xd8, 0xff, 0x6e, 0x18, 0x4e, 0xe5, 0x5d, 0x81, 0x84,
-	0xde, 0x82, 0x41, 0xde, 0xc7, 0x62, 0x6e, 0xd9, 0xcb, 0xe3, 0x71, 0x89, 0x11, 0x14, 0xdd, 0x62,
-	0xbf, 0xb1, 0xe0, 0x29, 0xb8, 0xfb, 0xce, 0xba, 0x98, 0x21, 0xc7, 0xc3, 0x7d, 0xc9, 0xd1, 0xdc,
-	0x97, 0x1c, 0xce, 0xdd, 0x77, 0xd6, 0xd1, 0x2e, 0x54, 0x36, 0xbd, 0x84, 0x38, 0xc2, 0x39, 0x73,
-	0xf3, 0x58, 0x98, 0x13, 0x87, 0x5b, 0x69, 0xec, 0x27, 0xe6, 0x0c, 0xd1, 0x97, 0x2c, 0x18, 0x5f,
-	0x4f, 0xd7, 0x1d, 0x12, 0xc2, 0xd3, 0x39, 0x86, 0x6b, 0xae, 0xd2, 0x8c, 0xf8, 0xb5, 0xb7, 0x99,
-	0x46, 0x9c, 0xed, 0x0e, 0xfa, 0xa4, 0x05, 0x43, 0x1b, 0x9e, 0x6f, 0xdc, 0x23, 0x72, 0x0c, 0x1f,
-	0xe7, 0x22, 0x63, 0xa0, 0x77, 0x1c, 0xfc, 0x7f, 0x8c, 0x25, 0xe7, 0x5e, 0x9a, 0x6a, 0xf0, 0xa8,
-	0x9a, 0x6a, 0xe8, 0x01, 0x69, 0xaa, 0xcf, 0x58, 0x50, 0x53, 0x23, 0x2d, 0xea, 0xb7, 0x7c, 0xf8,
-	0x18, 0x3f, 0x39, 0xf7, 0x48, 0xa9, 0xbf, 0x58, 0x33, 0x47, 0x6f, 0x5b, 0x30, 0xec, 0xbc, 0xd9,
-	0x8e, 0x48, 0x83, 0xec, 0x84, 0xad, 0x58, 0x14, 0x56, 0x7d, 0xad, 0xf8, 0xce, 0xcc, 0x52, 0x26,
-	0x0b, 0x64, 0x67, 0xa5, 0x15, 0x8b, 0xfc, 0x65, 0xdd, 0x80, 0xcd, 0x2e, 0xa0, 0xef, 0xd7, 0x7a,
-	0x1c, 0x8a, 0x28, 0xaf, 0x9d, 0xd7, 0x9b, 0xbe, 0xd2, 0xf1, 0x09, 0x3c, 0xe6, 0x86, 0x41, 0xe2,
-	0x05, 0x6d, 0xb2, 0x12, 0x60, 0xd2, 0x0a, 0xaf, 0x85, 0xc9, 0xc5, 0xb0, 0x1d, 0x34, 0x2e, 0x44,
-	0x51, 0x18, 0xb1, 0x02, 0x35, 0xc6, 0xe5, 0xaa, 0xf3, 0xbd, 0x51, 0xf1, 0x7e, 0x74, 0x8e, 0x62,
-	0x33, 0xec, 0x95, 0x60, 0xea, 0x80, 0xc1, 0x46, 0x2f, 0xc1, 0x48, 0x18, 0x6d, 0x3a, 0x81, 0xf7,
-	0xa6, 0x59, 0x73, 0x4d, 0x19, 0xa4, 0x2b, 0x06, 0x0c, 0xa7, 0x30, 0xcd, 0x62, 0x3c, 0xa5, 0x03,
-	0x8a, 0xf1, 0x9c, 0x83, 0x81, 0x88, 0xb4, 0xc2, 0xec, 0xbe, 0x8a, 0xa5, 0x1c, 0x32, 0x08, 0x7a,
-	0x02, 0xca, 0x4e, 0xcb, 0x13, 0xce, 0x45, 0xb5, 0x5d, 0x9c, 0x5d, 0x5d, 0xc4, 0xb4, 0x3d, 0x55,
-	0x1b, 0xac, 0x72, 0x5f, 0x6a, 0x83, 0x51, 0x8d, 0x29, 0x8e, 0xcf, 0x06, 0xb5, 0xc6, 0x4c, 0x1f,
-	0x6b, 0xd9, 0xef, 0x94, 0xe1, 0x89, 0x7d, 0x97, 0x96, 0x0e, 0x59, 0xb7, 0xf6, 0x09, 0x59, 0x97,
-	0xc3, 0x53, 0x3a, 0x68, 0x78, 0xca, 0x3d, 0x86, 0xe7, 0x93, 0x54, 0x62, 0xc8, 0x5a, 0x75, 0xc5,
-	0x5c, 0x10, 0xdf, 0xab, 0xf4, 0x9d, 0x10, 0x16, 0x12, 0x8a, 0x35, 0x5f, 0xba, 0x5d, 0x4a, 0x15,
-	0xa2, 0xa9, 0x14, 0xa1, 0x31, 0x7b, 0xd6, 0x8b, 0xe3, 0x62, 0xa2, 0x57, 0x75, 0x1b, 0xfb, 0x97,
-	0x06, 0xe0, 0xa9, 0x3e, 0x14, 0x9d, 0x39, 0x8b, 0xad, 0x3e, 0x67, 0xf1, 0xd7, 0xf8, 0x67, 0xfa,
-	0x74, 0xee, 0x67, 0xc2, 0xc5, 0x7f, 0xa6, 0xfd, 0xbf, 0x10, 0x3b, 0x81, 0x08, 0x62, 0xe2, 0xb6,
-	0x23, 0x9e, 0xbe, 0x63, 0xe4, 0x23, 0x2f, 0x8a, 0x76, 0xac, 0x30, 0xe8, 0xf6, 0xd7, 0x75, 0xe8,
-	0xf2, 0x1f, 0x2a, 0xa8, 0xf0, 0x88, 0x99, 0xda, 0xcc, 0xad, 0xaf, 0xf9, 0x59, 0x2a, 0x01, 0x38,
-	0x1b, 0xfb, 0xd7, 0x2d, 0x38, 0xdb, 0xdb, 0x1a, 0x41, 0xcf, 0xc1, 0xf0, 0x3a, 0x0b, 0xa6, 0x5c,
-	0x66, 0x21, 0x53, 0x62, 0xea, 0xb0, 0xf7, 0xd5, 0xcd, 0xd8, 0xc4, 0x41, 0xf3, 0x30, 0x61, 0x46,
-	0x61, 0x2e, 0x1b, 0xb1, 0x56, 0xcc, 0x5f, 0xb2, 0x96, 0x05, 0xe2, 0x6e, 0x7c, 0x34, 0x0d, 0x90,
-	0x78, 0x89, 0x4f, 0xf8, 0xd3, 0x7c, 0xa2, 0x31, 0x87, 0xe2, 0x9a, 0x6a, 0xc5, 0x06, 0x86, 0xfd,
-	0xd5, 0x72, 0xfe, 0x6b, 0x70, 0x2b, 0xf7, 0x30, 0xb3, 0x5f, 0xcc, 0xed, 0x52, 0x1f, 0x12, 0xba,
-	0x7c, 0xbf, 0x25, 0xf4, 0x40, 0x2f, 0x09, 0x8d, 0x16, 0xe0, 0x84, 0x71, 0x5d, 0x2b, 0x2f, 0x5d,
-	0xc3, 0x0f, 0xa5, 0x54, 0xdd, 0xb9, 0xd5, 0x0c, 0x1c, 0x77, 0x3d, 0xf1, 0x90, 0x4f, 0xd5, 0xdf,
-	0x28, 0xc1, 0x99, 0x9e, 0x1b, 0x8b, 0xfb, 0xa4, 0x81, 0xcc, 0xcf, 0x3f, 0x70, 0x7f, 0x3e, 0xbf,
-	0xf9, 0x51, 0x2a, 0x07, 0x7e, 0x94, 0x7e, 0xd4, 0xf9, 0xef, 0x97, 0x7a, 0x2e, 0x16, 0xba, 0x11,
-	0xfd, 0x6b, 0x3b, 0x92, 0x2f, 0xc3, 0xa8, 0xd3, 0x6a, 0x71, 0x3c, 0x96, 0x99, 0x91, 0xa9, 0x85,
-	0x39, 0x6b, 0x02, 0x71, 0x1a, 0xb7, 0xaf, 0x81, 0xfd, 0x63, 0x0b, 0x6a, 0x98, 0x6c, 0x70, 0x09,
-	0x87, 0x6e, 0x89, 0x21, 0xb2, 0x8a, 0xb8, 0x90, 0x80, 0x0e, 0x6c, 0xec, 0xb1, 0x2a, 0xfd, 0x79,
-	0x83, 0x7d, 0xd4, 0xca, 0x0a, 0xea, 0x92, 0xd7, 0x72, 0xef, 0x4b, 0x5e, 0xed, 0x5f, 0xae, 0xd1,
-	0xd7, 0x6b, 0x85, 0xf3, 0x11, 0x69, 0xc4, 0xf4, 0xfb, 0xb6, 0x23, 0x5f, 0x4c, 0x12, 0xf5, 0x7d,
-	0xaf, 0xe3, 0x25, 0x4c, 0xdb, 0x53, 0xe7, 0x93, 0xa5, 0x43, 0x55, 0x02, 0x2c, 0x1f, 0x58, 0x09,
-	0xf0, 0x65, 0x18, 0x8d, 0xe3, 0xad, 0xd5, 0xc8, 0xdb, 0x71, 0x12, 0x72, 0x95, 0xc8, 0x92, 0x41,
-	0xba, 0x2a, 0x56, 0xfd, 0xb2, 0x06, 0xe2, 0x34, 0x2e, 0xba, 0x04, 0x13, 0xba, 0x1e, 0x1f, 0x89,
-	0x12, 0x96, 0xf2, 0xc8, 0x67, 0x82, 0x2a, 0x07, 0xa3, 0x2b, 0xf8, 0x09, 0x04, 0xdc, 0xfd, 0x0c,
-	0x95, 0xb9, 0xa9, 0x46, 0xda, 0x91, 0xc1, 0xb4, 0xcc, 0x4d, 0xd1, 0xa1, 0x7d, 0xe9, 0x7a, 0x02,
-	0x2d, 0xc3, 0x49, 0x3e, 0x31, 0x66, 0x5b, 0x2d, 0xe3, 0x8d, 0x86, 0xd2, 0x55, 0xe0, 0x2f, 0x75,
-	0xa3, 0xe0, 0xbc, 0xe7, 0xd0, 0x8b, 0x30, 0xac, 0x9a, 0x17, 0x17, 0xc4, 0xd1, 0x9a, 0x72, 0xed,
-	0x29, 0x32, 0x8b, 0x0d, 0x6c, 0xe2, 0xa1, 0x0f, 0xc1, 0xa3, 0xfa, 0x2f, 0x4f, 0xa1, 0xe7, 0xe7,
-	0xcd, 0x0b, 0xa2, 0xd4, 0xa9, 0xba, 0x64, 0xec, 0x52, 0x2e, 0x5a, 0x03, 0xf7, 0x7a, 0x1e, 0xad,
-	0xc3, 0x59, 0x05, 0xba, 0x10, 0x24, 0x2c, 0xc9, 0x35, 0x26, 0x73, 0x4e, 0xcc, 0x22, 0x27, 0x80,
-	0xbd, 0xa7, 0x2d, 0xa8, 0x9f, 0xbd, 0xe4, 0x25, 0x97, 0xf3, 0x30, 0xf1, 0x12, 0xde, 0x87, 0x0a,
-	0x9a, 0x81, 0x1a, 0x09, 0x9c, 0x75, 0x9f, 0xac, 0xcc, 0x2f, 0x8a, 0x1d, 0xa9, 0xce, 0x8e, 0x90,
-	0x00, 0xac, 0x71, 0x54, 0x7c, 0xff, 0x48, 0xaf, 0xf8, 0x7e, 0xb4, 0x0a, 0xa7, 0x36, 0xdd, 0x16,
-	0xb5, 0x32, 0x3d, 0x97, 0xcc, 0xba, 0x2c, 0xa0, 0x98, 0x7e, 0x18, 0x5e, 0x9e, 0x5f, 0x25, 0x4a,
-	0x5d, 0x9a, 0x5f, 0xed, 0xc2, 0xc1, 0xb9, 0x4f, 0xb2, 0xc0, 0xf3, 0x28, 0xdc, 0xed, 0x4c, 0x9e,
-	0xcc, 0x04, 0x9e, 0xd3, 0x46, 0xcc, 0x61, 0xe8, 0x0a, 0x20, 0x96, 0x2c, 0x78, 0x39, 0x49, 0x5a,
-	0xca, 0xac, 0x9d, 0x3c, 0x95, 0x2e, 0x7c, 0x78, 0xb1, 0x0b, 0x03, 0xe7, 0x3c, 0x45, 0xad, 0x9e,
-	0x20, 0x64, 0xd4, 0x27, 0x1f, 0x4d, 0x5b, 0x3d, 0xd7, 0x78, 0x33, 0x96, 0x70, 0xf4, 0x5d, 0x30,
-	0xd9, 0x8e, 0x09, 0xdb, 0x30, 0xdf, 0x0c, 0xa3, 0x6d, 0x3f, 0x74, 0x1a, 0x8b, 0xec, 0x36, 0xd9,
-	0xa4, 0x33, 0x39, 0xc9, 0x98, 0x9f, 0x13, 0xcf, 0x4e, 0x5e, 0xef, 0x81, 0x87, 0x7b, 0x52, 0xc8,
-	0x56, 0xee, 0x3c, 0xd3, 0x67, 0xe5, 0xce, 0x55, 0x38, 0x25, 0xf5, 0xda, 0xca, 0xfc, 0xa2, 0x7a,
-	0xe9, 0xc9, 0xb3, 0xe9, 0xeb, 0xe9, 0x16, 0x73, 0x70, 0x70, 0xee, 0x93, 0xf6, 0x1f, 0x59, 0x30,
-	0xaa, 0x24, 0xd8, 0x7d, 0x48, 0x5a, 0xf6, 0xd3, 0x49, 0xcb, 0x97, 0x8e, 0xae, 0x03, 0x58, 0xcf,
-	0x7b, 0xa4, 0xd8, 0xfc, 0xe8, 0x28, 0x80, 0xd6, 0x13, 0x4a, 0x45, 0x5b, 0x3d, 0x55, 0xf4, 0x43,
-	0x2b, 0xa3, 0xf3, 0x2a, 0x31, 0x56, 0x1e, 0x6c, 0x25, 0xc6, 0x3a, 0x9c, 0x96, 0x53, 0x8a, 0x1f,
-	0x29, 0x5f, 0x0e, 0x63, 0x25, 0xf2, 0x8d, 0xfb, 0x06, 0x17, 0xf3, 0x90, 0x70, 0xfe, 0xb3, 0x29,
-	0xdb, 0x6e, 0xe8, 0x40, 0xdb, 0x4e, 0x49, 0xb9, 0xa5, 0x0d, 0x79, 0x1b, 0x68, 0x46, 0xca, 0x2d,
-	0x5d, 0xac, 0x63, 0x8d, 0x93, 0xaf, 0xea, 0x6a, 0x05, 0xa9, 0x3a, 0x38, 0xb4, 0xaa, 0x93, 0x42,
-	0x77, 0xb8, 0xa7, 0xd0, 0x95, 0x47, 0x57, 0x23, 0x3d, 0x8f, 0xae, 0x3e, 0x08, 0x63, 0x5e, 0xb0,
-	0x45, 0x22, 0x2f, 0x21, 0x0d, 0xb6, 0x16, 0x98, 0x40, 0xae, 0x6a, 0x43, 0x67, 0x31, 0x05, 0xc5,
-	0x19, 0xec, 0xb4, 0xa6, 0x18, 0xeb, 0x43, 0x53, 0xf4, 0xd0, 0xcf, 0xe3, 0xc5, 0xe8, 0xe7, 0x13,
-	0x47, 0xd7, 0xcf, 0x13, 0xc7, 0xaa, 0x9f, 0x51, 0x21, 0xfa, 0xb9, 0x2f, 0xd5, 0x67, 0x6c, 0xd2,
-	0x4f, 0x1d, 0xb0, 0x49, 0xef, 0xa5, 0x9c, 0x4f, 0xdf, 0xb3, 0x72, 0xce, 0xd7, 0xbb, 0x8f, 0xbc,
-	0xab, 0x77, 0x0b, 0xd1, 0xbb, 0x9f, 0x29, 0xc1, 0x69, 0xad, 0x99, 0xa8, 0x3c, 0xf0, 0x36, 0xa8,
-	0x6c, 0x66, 0x57, 0x6c, 0xf3, 0x03, 0x6f, 0x23, 0x55, 0x5e, 0x17, 0x0b, 0x50, 0x10, 0x6c, 0x60,
-	0xb1, 0x8c, 0x73, 0x12, 0xb1, 0xcb, 0x5d, 0xb2, 0x6a, 0x6b, 0x5e, 0xb4, 0x63, 0x85, 0x41, 0x07,
-	0x81, 0xfe, 0x16, 0x05, 0x4f, 0xb2, 0x65, 0xc3, 0xe7, 0x35, 0x08, 0x9b, 0x78, 0xe8, 0x7d, 0x9c,
-	0x09, 0x13, 0x99, 0x54, 0x75, 0x8d, 0xf0, 0x6d, 0xa5, 0x92, 0x92, 0x0a, 0x2a, 0xbb, 0xc3, 0x2a,
-	0x22, 0x54, 0xba, 0xbb, 0xc3, 0x62, 0x47, 0x15, 0x86, 0xfd, 0x3f, 0x2d, 0x38, 0x93, 0x3b, 0x14,
-	0xf7, 0xc1, 0x1c, 0xd9, 0x4d, 0x9b, 0x23, 0xf5, 0xa2, 0xb6, 0xa4, 0xc6, 0x5b, 0xf4, 0x30, 0x4d,
-	0xfe, 0xa3, 0x05, 0x63, 0x1a, 0xff, 0x3e, 0xbc, 0xaa, 0x97, 0x7e, 0xd5, 0xe2, 0x76, 0xdf, 0xb5,
-	0xae, 0x77, 0xfb, 0xb5, 0x12, 0xa8, 0x52, 0xfe, 0xb3, 0x6e, 0xd2, 0x5f, 0xba, 0x59, 0x07, 0x06,
-	0x59, 0x04, 0x49, 0x5c, 0x4c, 0x74, 0x5c, 0x9a, 0x3f, 0x8b, 0x46, 0xd1, 0x07, 0x7a, 0xec, 0x6f,
-	0x8c, 0x05, 0x43, 0x76, 0xf5, 0x10, 0xaf, 0x92, 0xde, 0x10, 0x89, 0xd3, 0xfa, 0xea, 0x21, 0xd1,
-	0x8e, 0x15, 0x06, 0x55, 0x98, 0x9e, 0x1b, 0x06, 0xf3, 0xbe, 0x13, 0xc7, 0xc2, 0x86, 0x53, 0x0a,
-	0x73, 0x51, 0x02, 0xb0, 0xc6, 0x61, 0xc1, 0x25, 0x5e, 0xdc, 0xf2, 0x9d, 0x8e, 0xe1, 0x63, 0x31,
-	0x0a, 0x7b, 0x29, 0x10, 0x36, 0xf1, 0xec, 0x26, 0x4c, 0xa6, 0x5f, 0x62, 0x81, 0x6c, 0xb0, 0xc8,
-	0xee, 0xbe, 0x86, 0x73, 0x06, 0x6a, 0x0e, 0x7b, 0x6a, 0xa9, 0xed, 0x08, 0x99, 0xa0, 0xe3, 0x9b,
-	0x25, 0x00, 0x6b, 0x1c, 0xfb, 0x9b, 0xe1, 0x64, 0xce, 0x98, 0xf5, 0x11, 0x40, 0xf7, 0x8b, 0x25,
-	0x18, 0x4f, 0x3f, 0x19, 0xb3, 0xdc, 0x47, 0xde, 0x67, 0x2f, 0x76, 0xc3, 0x1d, 0x12, 0x75, 0x68,
-	0x37, 0xac, 0x4c, 0xee, 0x63, 0x17, 0x06, 0xce, 0x79, 0x8a, 0xdd, 0xaa, 0xd1, 0x50, 0xaf, 0x2e,
-	0xa7, 0xc7, 0x8d, 0x22, 0xa7, 0x87, 0x1e, 0x59, 0x33, 0xe8, 0x47, 0xb1, 0xc4, 0x26, 0x7f, 0x6a,
-	0xff, 0xb0, 0xcc, 0x8d, 0xb9, 0xb6, 0xe7, 0x27, 0x5e, 0x20, 0x5e, 0x59, 0x4c, 0x1c, 0x65, 0xff,
-	0x2c, 0x77, 0xa3, 0xe0, 0xbc, 0xe7, 0xec, 0xaf, 0x0c, 0x80, 0xaa, 0x80, 0xc2, 0x82, 0x32, 0x0b,
-	0x0a, 0x69, 0x3d, 0x6c, 0x06, 0xad, 0xfa, 0xd2, 0x03, 0xfb, 0x45, 0x49, 0x71, 0x2f, 0x99, 0xe9,
-	0x4e, 0x57, 0x03, 0xb6, 0xa6, 0x41, 0xd8, 0xc4, 0xa3, 0x3d, 0xf1, 0xbd, 0x1d, 0xc2, 0x1f, 0x1a,
-	0x4c, 0xf7, 0x64, 0x49, 0x02, 0xb0, 0xc6, 0x61, 0x45, 0xb4, 0xbd, 0x8d, 0x0d, 0xe1, 0xf2, 0xd1,
-	0x45, 0xb4, 0xbd, 0x8d, 0x0d, 0xcc, 0x20, 0xfc, 0xde, 0xa5, 0x70, 0x5b, 0xd8, 0xfc, 0xc6, 0xbd,
-	0x4b, 0xe1, 0x36, 0x66, 0x10, 0xfa, 0x95, 0x82, 0x30, 0x6a, 0x3a, 0xbe, 0xf7, 0x26, 0x69, 0x28,
-	0x2e, 0xc2, 0xd6, 0x57, 0x5f, 0xe9, 0x5a, 0x37, 0x0a, 0xce, 0x7b, 0x8e, 0x4e, 0xe8, 0x56, 0x44,
-	0x1a, 0x9e, 0x9b, 0x98, 0xd4, 0x20, 0x3d, 0xa1, 0x57, 0xbb, 0x30, 0x70, 0xce, 0x53, 0x68, 0x16,
-	0xc6, 0x65, 0x05, 0x1b, 0x59, 0xf5, 0x71, 0x38, 0x5d, 0x3a, 0x0e, 0xa7, 0xc1, 0x38, 0x8b, 0x4f,
-	0x25, 0x56, 0x53, 0xd4, 0xa2, 0x65, 0x5b, 0x03, 0x43, 0x62, 0xc9, 0x1a, 0xb5, 0x58, 0x61, 0xd8,
-	0x9f, 0x28, 0x53, 0x0d, 0xdb, 0xa3, 0xe4, 0xf3, 0x7d, 0x0b, 0xa1, 0x4e, 0xcf, 0xc8, 0x81, 0x3e,
-	0x66, 0xe4, 0x0b, 0x30, 0x72, 0x2b, 0x0e, 0x03, 0x15, 0x9e, 0x5c, 0xe9, 0x19, 0x9e, 0x6c, 0x60,
-	0xe5, 0x87, 0x27, 0x0f, 0x16, 0x15, 0x9e, 0x3c, 0x74, 0x8f, 0xe1, 0xc9, 0xff, 0xba, 0x02, 0xea,
-	0x62, 0xcd, 0x6b, 0x24, 0xb9, 0x1d, 0x46, 0xdb, 0x5e, 0xb0, 0xc9, 0xaa, 0xb1, 0x7c, 0xc9, 0x92,
-	0x05, 0x5d, 0x96, 0xcc, 0xb4, 0xdd, 0x8d, 0x82, 0x2e, 0x47, 0x4c, 0x31, 0x9b, 0x5e, 0x33, 0x18,
-	0xf1, 0x30, 0x97, 0x4c, 0xe1, 0x18, 0xe1, 0xc1, 0x4f, 0xf5, 0x08, 0x7d, 0x0f, 0x80, 0xf4, 0x8f,
-	0x6f, 0x48, 0x09, 0xbc, 0x58, 0x4c, 0xff, 0x30, 0xd9, 0xd0, 0xf6, 0xed, 0x9a, 0x62, 0x82, 0x0d,
-	0x86, 0xe8, 0x33, 0x3a, 0xa5, 0x99, 0xe7, 0x31, 0x7d, 0xf4, 0x58, 0xc6, 0xa6, 0x9f, 0x84, 0x66,
-	0x0c, 0x43, 0x5e, 0xb0, 0x49, 0xe7, 0x89, 0x08, 0xe3, 0x7c, 0x6f, 0x5e, 0xb1, 0xaf, 0xa5, 0xd0,
-	0x69, 0xcc, 0x39, 0xbe, 0x13, 0xb8, 0x24, 0x5a, 0xe4, 0xe8, 0x7a, 0xcf, 0x23, 0x1a, 0xb0, 0x24,
-	0xd4, 0x75, 0xfb, 0x67, 0xa5, 0x9f, 0xdb, 0x3f, 0xcf, 0x7e, 0x3b, 0x4c, 0x74, 0x7d, 0xcc, 0x43,
-	0xe5, 0x2f, 0x1f, 0xa1, 0xcc, 0xd7, 0x2f, 0x0d, 0x6a, 0xa5, 0x75, 0x2d, 0x6c, 0xf0, 0xcb, 0x24,
-	0x23, 0xfd, 0x45, 0x85, 0xfd, 0x5a, 0xe0, 0x14, 0x51, 0x6a, 0xc6, 0x68, 0xc4, 0x26, 0x4b, 0x3a,
-	0x47, 0x5b, 0x4e, 0x44, 0x82, 0xe3, 0x9e, 0xa3, 0xab, 0x8a, 0x09, 0x36, 0x18, 0xa2, 0xad, 0x54,
-	0xa2, 0xdd, 0xc5, 0xa3, 0x27, 0xda, 0xb1, 0xd2, 0xab, 0x79, 0x77, 0xae, 0xbd, 0x6d, 0xc1, 0x58,
-	0x90, 0x9a, 0xb9, 0xc5, 0xc4, 0xd6, 0xe7, 0xaf, 0x0a, 0x7e, 0x2f, 0x73, 0xba, 0x0d, 0x67, 0xf8,
-	0xe7, 0xa9, 0xb4, 0xca, 0x21, 0x55, 0x9a, 0xbe, 0xcc, 0x76, 0xb0, 0xd7, 0x65, 0xb6, 0x28, 0x50,
-	0xb7, 0x8c, 0x0f, 0x15, 0x51, 0xae, 0x24, 0x75, 0xc5, 0x38, 0xe4, 0x5c, 0x2f, 0x7e, 0xd3, 0xcc,
-	0xc3, 0x3d, 0xfc, 0x6d, 0xd3, 0xa3, 0xbd, 0xf2, 0x75, 0xed, 0xff, 0x33, 0x00, 0x27, 0xe4, 0x88,
-	0xc8, 0xbc, 0x1c, 0xaa, 0x1f, 0x39, 0x5f, 0x6d, 0x2b, 0x2b, 0xfd, 0x78, 0x59, 0x02, 0xb0, 0xc6,
-	0xa1, 0xf6, 0x58, 0x3b, 0x26, 0x2b, 0x2d, 0x12, 0x2c, 0x79, 0xeb, 0xb1, 0x38, 0x0b, 0x57, 0x0b,
-	0xe5, 0xba, 0x06, 0x61, 0x13, 0x8f, 0x25, 0x0b, 0xbb, 0x66, 0xc5, 0x0e, 0x9d, 0x2c, 0x2c, 0x0c,
-	0x55, 0x09, 0x47, 0x3f, 0x96, 0x7b, 0x07, 0x45, 0x31, 0xd9, 0xac, 0x5d, 0xe9, 0x48, 0x87, 0xbb,
-	0x7c, 0x02, 0xfd, 0x23, 0x0b, 0x4e, 0xf3, 0x56, 0x39, 0x92, 0xd7, 0x5b, 0x0d, 0x27, 0x21, 0x71,
-	0x31, 0xf7, 0x75, 0xe5, 0xf4, 0x4f, 0xbb, 0xb4, 0xf3, 0xd8, 0xe2, 0xfc, 0xde, 0xa0, 0x2f, 0x5a,
-	0x30, 0xbe, 0x9d, 0xaa, 0xb8, 0x25, 0x55, 0xc7, 0x51, 0xcb, 0xd1, 0xa4, 0x88, 0xea, 0xa5, 0x96,
-	0x6e, 0x8f, 0x71, 0x96, 0xbb, 0xfd, 0x17, 0x16, 0x98, 0x62, 0xf4, 0xfe, 0x17, 0xea, 0x3a, 0xbc,
-	0x29, 0x28, 0xad, 0xcb, 0x4a, 0x4f, 0xeb, 0xf2, 0x09, 0x28, 0xb7, 0xbd, 0x86, 0xd8, 0x5f, 0xe8,
-	0xd3, 0xf7, 0xc5, 0x05, 0x4c, 0xdb, 0xed, 0x3f, 0xa9, 0x68, 0x9f, 0x84, 0x48, 0x16, 0xfd, 0x6b,
-	0xf1, 0xda, 0x1b, 0xaa, 0x02, 0x2f, 0x7f, 0xf3, 0x6b, 0x5d, 0x15, 0x78, 0xbf, 0xf5, 0xf0, 0xb9,
-	0xc0, 0x7c, 0x80, 0x7a, 0x15, 0xe0, 0x1d, 0x3a, 0x20, 0x11, 0xf8, 0x16, 0x54, 0xe9, 0x16, 0x8c,
-	0x39, 0x17, 0xab, 0xa9, 0x4e, 0x55, 0x2f, 0x8b, 0xf6, 0xbb, 0x7b, 0x53, 0xdf, 0x72, 0xf8, 0x6e,
-	0xc9, 0xa7, 0xb1, 0xa2, 0x8f, 0x62, 0xa8, 0xd1, 0xdf, 0x2c, 0x67, 0x59, 0x6c, 0xee, 0xae, 0x2b,
-	0x99, 0x29, 0x01, 0x85, 0x24, 0x44, 0x6b, 0x3e, 0x28, 0x80, 0x1a, 0x45, 0xe4, 0x4c, 0xf9, 0x1e,
-	0x70, 0x55, 0x65, 0x0e, 0x4b, 0xc0, 0xdd, 0xbd, 0xa9, 0x97, 0x0f, 0xcf, 0x54, 0x3d, 0x8e, 0x35,
-	0x0b, 0x43, 0x35, 0x0e, 0xf7, 0xbc, 0xe7, 0xfd, 0xff, 0x0e, 0xe8, 0xf9, 0x2d, 0x8a, 0x33, 0xff,
-	0xb5, 0x98, 0xdf, 0x2f, 0x65, 0xe6, 0xf7, 0xb9, 0xae, 0xf9, 0x3d, 0x46, 0xc7, 0x2c, 0xa7, 0x64,
-	0xf4, 0xfd, 0x36, 0x16, 0x0e, 0xf6, 0x49, 0x30, 0x2b, 0xe9, 0x8d, 0xb6, 0x17, 0x91, 0x78, 0x35,
-	0x6a, 0x07, 0x5e, 0xb0, 0xc9, 0xa6, 0x6c, 0xd5, 0xb4, 0x92, 0x52, 0x60, 0x9c, 0xc5, 0xa7, 0x1b,
-	0x7f, 0x3a, 0x2f, 0x6e, 0x3a, 0x3b, 0x7c, 0xe6, 0x19, 0x85, 0x31, 0xeb, 0xa2, 0x1d, 0x2b, 0x0c,
-	0xb4, 0x05, 0x8f, 0x4b, 0x02, 0x0b, 0xc4, 0x27, 0xf4, 0x85, 0x58, 0x54, 0x61, 0xd4, 0xe4, 0x31,
-	0xff, 0x3c, 0x30, 0xe4, 0xeb, 0x05, 0x85, 0xc7, 0xf1, 0x3e, 0xb8, 0x78, 0x5f, 0x4a, 0xf6, 0x97,
-	0x59, 0x1c, 0x81, 0x51, 0xba, 0x81, 0xce, 0x3e, 0xdf, 0x6b, 0x7a, 0xb2, 0x7e, 0xa7, 0x9a, 0x7d,
-	0x4b, 0xb4, 0x11, 0x73, 0x18, 0xba, 0x0d, 0x43, 0xeb, 0xfc, 0xfe, 0xf7, 0x62, 0xee, 0x5d, 0x12,
-	0x97, 0xc9, 0xb3, 0xda, 0xdd, 0xf2, 0x66, 0xf9, 0xbb, 0xfa, 0x27, 0x96, 0xdc, 0xec, 0xdf, 0xad,
-	0xc0, 0xb8, 0x8c, 0xf5, 0xba, 0xec, 0xc5, 0x2c, 0x3c, 0xc0, 0xbc, 0xd0, 0xa0, 0x74, 0xe0, 0x85,
-	0x06, 0x1f, 0x01, 0x68, 0x90, 0x96, 0x1f, 0x76, 0x98, 0x71, 0x38, 0x70, 0x68, 0xe3, 0x50, 0xed,
-	0x27, 0x16, 0x14, 0x15, 0x6c, 0x50, 0x14, 0x45, 0x4b, 0xf9, 0xfd, 0x08, 0x99, 0xa2, 0xa5, 0xc6,
-	0xed, 0x6c, 0x83, 0xf7, 0xf7, 0x76, 0x36, 0x0f, 0xc6, 0x79, 0x17, 0x55, 0x81, 0x84, 0x7b, 0xa8,
-	0x83, 0xc0, 0x52, 0xcc, 0x16, 0xd2, 0x64, 0x70, 0x96, 0xae, 0x79, 0xf5, 0x5a, 0xf5, 0x7e, 0x5f,
-	0xbd, 0xf6, 0x7e, 0xa8, 0xc9, 0xef, 0x1c, 0x4f, 0xd6, 0x74, 0xf1, 0x1e, 0x39, 0x0d, 0x62, 0xac,
-	0xe1, 0x5d, 0xb5, 0x5e, 0xe0, 0x41, 0xd5, 0x7a, 0xb1, 0xdf, 0x2e, 0xd3, 0x5d, 0x05, 0xef, 0xd7,
-	0xa1, 0x6f, 0x2e, 0xbc, 0x6c, 0xdc, 0x5c, 0x78, 0xb8, 0xef, 0x59, 0xcd, 0xdc, 0x70, 0xf8, 0x38,
-	0x0c, 0x24, 0xce, 0xa6, 0xcc, 0x88, 0x65, 0xd0, 0x35, 0x67, 0x33, 0xc6, 0xac, 0xf5, 0x30, 0x35,
-	0x9e, 0x5f, 0x86, 0xd1, 0xd8, 0xdb, 0x0c, 0x9c, 0xa4, 0x1d, 0x11, 0xe3, 0x30, 0x51, 0x47, 0xcc,
-	0x98, 0x40, 0x9c, 0xc6, 0x45, 0x9f, 0xb4, 0x00, 0x22, 0xa2, 0xf6, 0x2c, 0x83, 0x45, 0xcc, 0x21,
-	0x25, 0x06, 0x24, 0x5d, 0xb3, 0x46, 0x87, 0xda, 0xab, 0x18, 0x6c, 0xed, 0x4f, 0x5b, 0x30, 0xd1,
-	0xf5, 0x14, 0x6a, 0xc1, 0xa0, 0xcb, 0xee, 0x97, 0x2c, 0xa6, 0x2e, 0x65, 0xfa, 0xae, 0x4a, 0xae,
-	0x9c, 0x78, 0x1b, 0x16, 0x7c, 0xec, 0x5f, 0x1e, 0x81, 0x53, 0xf5, 0xf9, 0x65, 0x79, 0x2b, 0xd1,
-	0xb1, 0xa5, 0xf8, 0xe6, 0xf1, 0xb8, 0x7f, 0x29, 0xbe, 0x3d, 0xb8, 0xfb, 0x46, 0x8a, 0xaf, 0x6f,
-	0xa4, 0xf8, 0xa6, 0xf3, 0x2d, 0xcb, 0x45, 0xe4, 0x5b, 0xe6, 0xf5, 0xa0, 0x9f, 0x7c, 0xcb, 0x63,
-	0xcb, 0xf9, 0xdd, 0xb7, 0x43, 0x87, 0xca, 0xf9, 0x55, 0x09, 0xd1, 0x85, 0xa4, 0x77, 0xf5, 0xf8,
-	0x54, 0xb9, 0x09, 0xd1, 0x2a, 0x19, 0x95, 0xa7, 0x2e, 0x0a, 0xa5, 0xf7, 0x5a, 0xf1, 0x1d, 0xe8,
-	0x23, 0x19, 0x55, 0x64, 0x4f, 0x9a, 0x09, 0xd0, 0x43, 0x45, 0x24, 0x40, 0xe7, 0x75, 0xe7, 0xc0,
-	0x04, 0xe8, 0x97, 0x61, 0xd4, 0xf5, 0xc3, 0x80, 0xac, 0x46, 0x61, 0x12, 0xba, 0xa1, 0xbc, 0xcd,
-	0x5b, 0x5f, 0xcc, 0x68, 0x02, 0x71, 0x1a, 0xb7, 0x57, 0xf6, 0x74, 0xed, 0xa8, 0xd9, 0xd3, 0xf0,
-	0x80, 0xb2, 0xa7, 0x8d, 0xfc, 0xe0, 0xe1, 0x22, 0xf2, 0x83, 0xf3, 0xbe, 0x48, 0x5f, 0xf9, 0xc1,
-	0xef, 0xf0, 0xcb, 0xec, 0xe9, 0x66, 0x84, 0x4b, 0x61, 0x76, 0x44, 0x37, 0x7c, 0xfe, 0xf5, 0x63,
-	0x98, 0xb0, 0x37, 0xeb, 0x9a, 0x8d, 0xba, 0xe0, 0x5e, 0x37, 0xe1, 0x74, 0x47, 0x8e, 0x92, 0x53,
-	0xfc, 0xe3, 0x25, 0xf8, 0xba, 0x03, 0xbb, 0x80, 0x6e, 0x03, 0x24, 0xce, 0xa6, 0x98, 0xa8, 0xe2,
-	0x20, 0xeb, 0x88, 0x41, 0xbe, 0x6b, 0x92, 0x9e, 0xc8, 0x77, 0x53, 0xe4, 0xb1, 0xc1, 0x8a, 0xc5,
-	0xf6, 0x86, 0x7e, 0x57, 0x49, 0x69, 0x1c, 0xfa, 0x04, 0x33, 0x08, 0x35, 0x84, 0x22, 0xb2, 0x49,
-	0x8d, 0xfb, 0x72, 0xda, 0x10, 0xc2, 0xac, 0x15, 0x0b, 0x28, 0x7a, 0x11, 0x86, 0x1d, 0xdf, 0xe7,
-	0xb9, 0x77, 0x24, 0x16, 0x37, 0xa6, 0xea, 0x42, 0xb2, 0x1a, 0x84, 0x4d, 0x3c, 0xfb, 0xcf, 0x4b,
-	0x30, 0x75, 0x80, 0x4c, 0xe9, 0xca, 0xb9, 0xae, 0xf4, 0x9d, 0x73, 0x2d, 0x72, 0x87, 0x06, 0x7b,
-	0xe4, 0x0e, 0xbd, 0x08, 0xc3, 0x09, 0x71, 0x9a, 0x22, 0x2c, 0x30, 0x5b, 0x1f, 0x71, 0x4d, 0x83,
-	0xb0, 0x89, 0x47, 0xa5, 0xd8, 0x98, 0xe3, 0xba, 0x24, 0x8e, 0x65, 0x72, 0x90, 0xf0, 0x72, 0x17,
-	0x96, 0x79, 0xc4, 0x0e, 0x0f, 0x66, 0x53, 0x2c, 0x70, 0x86, 0x65, 0x76, 0xc0, 0x6b, 0x7d, 0x0e,
-	0xf8, 0x4f, 0x95, 0xe0, 0x89, 0x7d, 0xb5, 0x5b, 0xdf, 0x79, 0x5b, 0xed, 0x98, 0x44, 0xd9, 0x89,
-	0x73, 0x3d, 0x26, 0x11, 0x66, 0x10, 0x3e, 0x4a, 0xad, 0x96, 0x0a, 0xe9, 0x2e, 0x3e, 0xd1, 0x91,
-	0x8f, 0x52, 0x8a, 0x05, 0xce, 0xb0, 0xbc, 0xd7, 0x69, 0xf9, 0xbb, 0x03, 0xf0, 0x54, 0x1f, 0x36,
-	0x40, 0x81, 0x09, 0xa1, 0xe9, 0x64, 0xe7, 0xf2, 0x03, 0x4a, 0x76, 0xbe, 0xb7, 0xe1, 0x7a, 0x37,
-	0x47, 0xba, 0xaf, 0xc4, 0xd3, 0x2f, 0x97, 0xe0, 0x6c, 0x6f, 0x83, 0x05, 0x7d, 0x1b, 0x8c, 0x47,
-	0x2a, 0x3e, 0xd0, 0xcc, 0x93, 0x3e, 0xc9, 0x7d, 0x5c, 0x29, 0x10, 0xce, 0xe2, 0xa2, 0x69, 0x80,
-	0x96, 0x93, 0x6c, 0xc5, 0x17, 0x76, 0xbd, 0x38, 0x11, 0x85, 0xe5, 0xc6, 0xf8, 0xc9, 0xab, 0x6c,
-	0xc5, 0x06, 0x06, 0x65, 0xc7, 0xfe, 0x2d, 0x84, 0xd7, 0xc2, 0x84, 0x3f, 0xc4, 0xb7, 0x9e, 0x27,
-	0xe5, 0x35, 0x8c, 0x06, 0x08, 0x67, 0x71, 0x29, 0x3b, 0x76, 0xb6, 0xcf, 0x3b, 0x3a, 0xa0, 0x33,
-	0xab, 0x97, 0x54, 0x2b, 0x36, 0x30, 0xb2, 0x19, 0xe0, 0x95, 0x83, 0x33, 0xc0, 0xed, 0x7f, 0x59,
-	0x82, 0x33, 0x3d, 0x0d, 0xde, 0xfe, 0xc4, 0xd4, 0xc3, 0x97, 0x85, 0x7d, 0x8f, 0x2b, 0xec, 0x50,
-	0xd9, 0xbb, 0xf6, 0x1f, 0xf7, 0x98, 0x69, 0x22, 0x33, 0xf7, 0xde, 0x8b, 0x98, 0x3c, 0x7c, 0xe3,
-	0xd9, 0x95, 0x8c, 0x3b, 0x70, 0x88, 0x64, 0xdc, 0xcc, 0xc7, 0xa8, 0xf4, 0xa9, 0x1d, 0xfe, 0xeb,
-	0x40, 0xcf, 0xe1, 0xa5, 0x1b, 0xe4, 0xbe, 0x4e, 0x10, 0x16, 0xe0, 0x84, 0x17, 0xb0, 0x8b, 0x75,
-	0xeb, 0xed, 0x75, 0x51, 0x6b, 0x8c, 0x17, 0xd4, 0x55, 0xa9, 0x30, 0x8b, 0x19, 0x38, 0xee, 0x7a,
-	0xe2, 0x21, 0x4c, 0x8e, 0xbe, 0xb7, 0x21, 0x3d, 0xa4, 0xe4, 0x5e, 0x81, 0xd3, 0x72, 0x28, 0xb6,
-	0x9c, 0x88, 0x34, 0x84, 0xb2, 0x8d, 0x45, 0xf2, 0xd3, 0x19, 0x9e, 0x40, 0x95, 0x83, 0x80, 0xf3,
-	0x9f, 0x63, 0xb7, 0xa0, 0x86, 0x2d, 0xcf, 0x15, 0x5b, 0x41, 0x7d, 0x0b, 0x2a, 0x6d, 0xc4, 0x1c,
-	0xa6, 0xf5, 0x45, 0xed, 0xfe, 0xe8, 0x8b, 0x8f, 0x40, 0x4d, 0x8d, 0x37, 0x4f, 0x70, 0x50, 0x93,
-	0xbc, 0x2b, 0xc1, 0x41, 0xcd, 0x70, 0x03, 0x8b, 0xce, 0x0e, 0xba, 0x51, 0xc9, 0xac, 0x56, 0xca,
-	0x8f, 0xb6, 0xdb, 0xcf, 0xc3, 0x88, 0xf2, 0x05, 0xf6, 0x7b, 0x17, 0xad, 0xfd, 0x57, 0x25, 0xc8,
-	0x5c, 0xbb, 0x86, 0x76, 0xa1, 0xd6, 0x90, 0xd7, 0xf8, 0x17, 0x53, 0xd0, 0x79, 0x41, 0x92, 0xd3,
-	0x07, 0x61, 0xaa, 0x09, 0x6b, 0x66, 0xe8, 0x2d, 0x5e, 0x3b, 0x59, 0xb0, 0x2e, 0x15, 0x91, 0x20,
-	0x5f, 0x57, 0xf4, 0xcc, 0xcb, 0x26, 0x65, 0x1b, 0x36, 0xf8, 0xa1, 0x04, 0x6a, 0x5b, 0xf2, 0x7a,
-	0xb9, 0x62, 0xc4, 0x9d, 0xba, 0xad, 0x8e, 0x9b, 0x68, 0xea, 0x2f, 0xd6, 0x8c, 0xec, 0x3f, 0x2a,
-	0xc1, 0xa9, 0xf4, 0x07, 0x10, 0x07, 0x97, 0x3f, 0x63, 0xc1, 0xa3, 0xbe, 0x13, 0x27, 0xf5, 0x36,
-	0xdb, 0x28, 0x6c, 0xb4, 0xfd, 0x95, 0x4c, 0x99, 0xed, 0xa3, 0x3a, 0x5b, 0x14, 0xe1, 0xec, 0x75,
-	0x84, 0x73, 0x8f, 0xdd, 0xd9, 0x9b, 0x7a, 0x74, 0x29, 0x9f, 0x39, 0xee, 0xd5, 0x2b, 0xf4, 0xb6,
-	0x05, 0x27, 0xdc, 0x76, 0x14, 0x91, 0x20, 0xd1, 0x5d, 0xe5, 0x5f, 0xf1, 0x5a, 0x21, 0x03, 0xa9,
-	0x3b, 0x78, 0x8a, 0x0a, 0xd4, 0xf9, 0x0c, 0x2f, 0xdc, 0xc5, 0xdd, 0xfe, 0x21, 0xaa, 0x39, 0x7b,
-	0xbe, 0xe7, 0xdf, 0xb0, 0xfb, 0x13, 0xff, 0x74, 0x10, 0x46, 0x53, 0xb5, 0xc4, 0x53, 0x87, 0x7d,
-	0xd6, 0x81, 0x87, 0x7d, 0x2c, 0x5d, 0xaf, 0x1d, 0xc8, 0xab, 0xe5, 0x8d, 0x74, 0xbd, 0x76, 0x40,
-	0x30, 0x87, 0x89, 0x21, 0xc5, 0xed, 0x40, 0xe4, 0x02, 0x98, 0x43, 0x8a, 0xdb, 0x01, 0x16, 0x50,
-	0xf4, 0x71, 0x0b, 0x46, 0xd8, 0xe2, 0x13, 0x47, 0xa5, 0x42, 0xa1, 0x5d, 0x29, 0x60, 0xb9, 0xcb,
-	0xba, 0xf9, 0x2c, 0x76, 0xd4, 0x6c, 0xc1, 0x29, 0x8e, 0xe8, 0x53, 0x16, 0xd4, 0xd4, 0x3d, 0xb6,
-	0xe2, 0x6c, 0xa4, 0x5e, 0x6c, 0xa9, 0xf6, 0x8c, 0xd4, 0x53, 0x35, 0xb3, 0xb1, 0x66, 0x8c, 0x62,
-	0x75, 0x8e, 0x39, 0x74, 0x3c, 0xe7, 0x98, 0x90, 0x73, 0x86, 0xf9, 0x7e, 0xa8, 0x35, 0x9d, 0xc0,
-	0xdb, 0x20, 0x71, 0xc2, 0x8f, 0x16, 0xe5, 0xcd, 0x1c, 0xb2, 0x11, 0x6b, 0x38, 0x35, 0xf6, 0x63,
-	0xf6, 0x62, 0x89, 0x71, 0x16, 0xc8, 0x8c, 0xfd, 0xba, 0x6e, 0xc6, 0x26, 0x8e, 0x79, 0x70, 0x09,
-	0x0f, 0xf4, 0xe0, 0x72, 0xf8, 0x80, 0x83, 0xcb, 0x3a, 0x9c, 0x76, 0xda, 0x49, 0x78, 0x99, 0x38,
-	0xfe, 0x6c, 0x92, 0x90, 0x66, 0x2b, 0x89, 0x79, 0xf9, 0xf9, 0x11, 0xe6, 0x02, 0x56, 0xd1, 0x6e,
-	0x75, 0xe2, 0x6f, 0x74, 0x21, 0xe1, 0xfc, 0x67, 0xed, 0x7f, 0x6e, 0xc1, 0xe9, 0xdc, 0xa9, 0xf0,
-	0xf0, 0xe6, 0x19, 0xd8, 0x3f, 0x52, 0x81, 0x93, 0x39, 0x37, 0x0d, 0xa0, 0x8e, 0xb9, 0x48, 0xac,
-	0x22, 0x42, 0xf6, 0xd2, 0x11, 0x68, 0xf2, 0xdb, 0xe4, 0xac, 0x8c, 0xc3, 0xc5, 0x22, 0xe8, 0x78,
-	0x80, 0xf2, 0xfd, 0x8d, 0x07, 0x30, 0xe6, 0xfa, 0xc0, 0x03, 0x9d, 0xeb, 0x95, 0x03, 0xe6, 0xfa,
-	0xcf, 0x5a, 0x30, 0xd9, 0xec, 0x71, 0x6d, 0x98, 0x38, 0x4f, 0xba, 0x71, 0x3c, 0x97, 0x92, 0xcd,
-	0x3d, 0x7e, 0x67, 0x6f, 0xaa, 0xe7, 0x6d, 0x6d, 0xb8, 0x67, 0xaf, 0xec, 0xaf, 0x94, 0x81, 0xd9,
-	0x6b, 0xac, 0x9a, 0x74, 0x07, 0x7d, 0xcc, 0xbc, 0xb0, 0xc4, 0x2a, 0xea, 0x72, 0x0d, 0x4e, 0x5c,
-	0x5d, 0x78, 0xc2, 0x47, 0x30, 0xef, 0xfe, 0x93, 0xac, 0x24, 0x2c, 0xf5, 0x21, 0x09, 0x7d, 0x79,
-	0x33, 0x4c, 0xb9, 0xf8, 0x9b, 0x61, 0x6a, 0xd9, 0x5b, 0x61, 0xf6, 0xff, 0xc4, 0x03, 0x0f, 0xe5,
-	0x27, 0xfe, 0x15, 0x8b, 0x0b, 0x9e, 0xcc, 0x57, 0xd0, 0xe6, 0x86, 0xb5, 0x8f, 0xb9, 0xf1, 0x0c,
-	0x54, 0x63, 0x21, 0x99, 0x85, 0x59, 0xa2, 0x43, 0xc1, 0x44, 0x3b, 0x56, 0x18, 0x74, 0xd7, 0xe5,
-	0xf8, 0x7e, 0x78, 0xfb, 0x42, 0xb3, 0x95, 0x74, 0x84, 0x81, 0xa2, 0xb6, 0x05, 0xb3, 0x0a, 0x82,
-	0x0d, 0x2c, 0xf4, 0x14, 0x0c, 0xf2, 0xb2, 0x0f, 0xc2, 0xb9, 0x33, 0x4c, 0xd7, 0x21, 0xaf, 0x09,
-	0xd1, 0xc0, 0x02, 0x64, 0x6f, 0x81, 0xb1, 0xab, 0xb8, 0xf7, 0xbb, 0xa9, 0x0f, 0xbe, 0x6e, 0xd2,
-	0xfe, 0x07, 0x25, 0xc1, 0x8a, 0xef, 0x12, 0x74, 0x64, 0xa0, 0x75, 0xc8, 0xc8, 0xc0, 0xb7, 0x00,
-	0xdc, 0xb0, 0xd9, 0xa2, 0xfb, 0xe6, 0xb5, 0xb0, 0x98, 0xcd, 0xd6, 0xbc, 0xa2, 0xa7, 0x47, 0x55,
-	0xb7, 0x61, 0x83, 0x5f, 0x4a, 0xb4, 0x97, 0x0f, 0x14, 0xed, 0x29, 0x29, 0x37, 0xb0, 0xbf, 0x94,
-	0xb3, 0xff, 0xdc, 0x82, 0x94, 0xd5, 0x87, 0x5a, 0x50, 0xa1, 0xdd, 0xed, 0x08, 0x81, 0xb1, 0x52,
-	0x9c, 0x89, 0x49, 0x25, 0xb5, 0x58, 0x85, 0xec, 0x27, 0xe6, 0x8c, 0x90, 0x2f, 0xa2, 0x20, 0x0b,
-	0xd9, 0xfc, 0x98, 0x0c, 0x2f, 0x87, 0xe1, 0x36, 0x0f, 0x26, 0xd2, 0x11, 0x95, 0xf6, 0x4b, 0x30,
-	0xd1, 0xd5, 0x29, 0x76, 0x9f, 0x75, 0x28, 0x77, 0xf0, 0xc6, 0xea, 0x61, 0xd5, 0x17, 0x30, 0x87,
-	0xd9, 0x5f, 0xb6, 0xe0, 0x44, 0x96, 0x3c, 0x7a, 0xc7, 0x82, 0x89, 0x38, 0x4b, 0xef, 0xb8, 0xc6,
-	0x4e, 0x65, 0x3b, 0x74, 0x81, 0x70, 0x77, 0x27, 0xec, 0xff, 0x21, 0xb4, 0xc1, 0x4d, 0x2f, 0x68,
-	0x84, 0xb7, 0x95, 0x9d, 0x64, 0xf5, 0xb4, 0x93, 0xa8, 0x78, 0x70, 0xb7, 0x48, 0xa3, 0xed, 0x77,
-	0xd5, 0x84, 0xa8, 0x8b, 0x76, 0xac, 0x30, 0x58, 0x0a, 0x7c, 0x5b, 0xec, 0x5b, 0x33, 0x93, 0x72,
-	0x41, 0xb4, 0x63, 0x85, 0x81, 0x5e, 0x80, 0x11, 0xe3, 0x25, 0xe5, 0xbc, 0x64, 0x9b, 0x0e, 0x43,
-	0x83, 0xc7, 0x38, 0x85, 0x85, 0xa6, 0x01, 0x94, 0xcd, 0x25, 0x35, 0x36, 0x73, 0xb4, 0x2b, 0xc1,
-	0x18, 0x63, 0x03, 0x83, 0x15, 0x9c, 0xf0, 0xdb, 0x31, 0x3b, 0x49, 0x1e, 0xd4, 0xb7, 0x2b, 0xcc,
-	0x8b, 0x36, 0xac, 0xa0, 0x54, 0xb8, 0x35, 0x9d, 0xa0, 0xed, 0xf8, 0x74, 0x84, 0x84, 0xeb, 0x4c,
-	0x2d, 0xc3, 0x65, 0x05, 0xc1, 0x06, 0x16, 0x7d, 0xe3, 0xc4, 0x6b, 0x92, 0x57, 0xc3, 0x40, 0x46,
-	0xa9, 0xeb, 0xe0, 0x02, 0xd1, 0x8e, 0x15, 0x06, 0x7a, 0x09, 0x86, 0x9d, 0xa0, 0xc1, 0x0d, 0xc4,
-	0x30, 0x12, 0x67, 0x94, 0x6a, 0xf7, 0x79, 0x3d, 0x26, 0xb3, 0x1a, 0x8a, 0x4d, 0xd4, 0xec, 0xd5,
-	0x12, 0xd0, 0xe7, 0xd5, 0x75, 0x7f, 0x66, 0xc1, 0xb8, 0xae, 0x20, 0xc4, 0x3c, 0x6c, 0x29, 0xd7,
-	0xa2, 0x75, 0xa0, 0x6b, 0x31, 0x5d, 0x48, 0xa4, 0xd4, 0x57, 0x21, 0x11, 0xb3, 0xc6, 0x47, 0x79,
-	0xdf, 0x1a, 0x1f, 0xdf, 0x00, 0x43, 0xdb, 0xa4, 0x63, 0x14, 0x03, 0x61, 0xca, 0xe1, 0x2a, 0x6f,
-	0xc2, 0x12, 0x86, 0x6c, 0x18, 0x74, 0x1d, 0x55, 0x50, 0x70, 0x44, 0xc4, 0xa6, 0xcd, 0x32, 0x24,
-	0x01, 0xb1, 0x57, 0xa0, 0xa6, 0x0e, 0xf5, 0xa5, 0xa7, 0xcf, 0xca, 0xf7, 0xf4, 0xf5, 0x75, 0x09,
-	0xfe, 0xdc, 0xfa, 0x6f, 0x7e, 0xf5, 0xc9, 0xf7, 0xfc, 0xce, 0x57, 0x9f, 0x7c, 0xcf, 0x1f, 0x7e,
-	0xf5, 0xc9, 0xf7, 0x7c, 0xfc, 0xce, 0x93, 0xd6, 0x6f, 0xde, 0x79, 0xd2, 0xfa, 0x9d, 0x3b, 0x4f,
-	0x5a, 0x7f, 0x78, 0xe7, 0x49, 0xeb, 0x2b, 0x77, 0x9e, 0xb4, 0xde, 0xfe, 0x2f, 0x4f, 0xbe, 0xe7,
-	0xd5, 0xdc, 0xbc, 0x08, 0xfa, 0xe3, 0x59, 0xb7, 0x31, 0xb3, 0xf3, 0x3c, 0x0b, 0xcd, 0xa7, 0xeb,
-	0x79, 0xc6, 0x98, 0xc4, 0x33, 0x72, 0x3d, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0x6f,
-	0xd4, 0x86, 0x81, 0x00, 0x01, 0x00,
+	// 12298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x7d, 0x5b, 0x70, 0x1c, 0xd9,
+	0x75, 0x98, 0x7a, 0x06, 0x03, 0x60, 0x2e, 0x40, 0x90, 0xec, 0x25, 0x77, 0x41, 0xee, 0x4a, 0x5c,
+	0xf7, 0xca, 0x92, 0x12, 0x65, 0x41, 0x6b, 0x57, 0x91, 0x14, 0x3d, 0x8d, 0x07, 0x1f, 0x20, 0x01,
+	0x02, 0x3a, 0x03, 0x92, 0x7a, 0xaf, 0x1a, 0x33, 0x0d, 0xa0, 0x97, 0x83, 0xe9, 0xd9, 0xee, 0x19,
+	0x92, 0x58, 0x4b, 0xb2, 0x64, 0x59, 0xb1, 0x2c, 0xc9, 0x92, 0x62, 0xbb, 0x22, 0x39, 0x8e, 0x14,
+	0x39, 0x76, 0x1e, 0x55, 0x29, 0x95, 0x9d, 0xf8, 0x23, 0xae, 0xb2, 0x5d, 0x2a, 0xdb, 0x29, 0x95,
+	0x9c, 0x97, 0x1d, 0x95, 0xe2, 0x38, 0xb1, 0xbd, 0x91, 0x95, 0xa4, 0xec, 0x4a, 0x55, 0x5c, 0x15,
+	0x27, 0x1f, 0xa9, 0x4d, 0xca, 0x95, 0x7b, 0xee, 0xfb, 0xf6, 0x03, 0x98, 0xe1, 0x34, 0x40, 0x4a,
+	0xde, 0x0f, 0xee, 0x62, 0xee, 0x3d, 0x7d, 0xce, 0xed, 0xdb, 0xf7, 0x9e, 0xd7, 0x3d, 0xe7, 0x5c,
+	0xb2, 0xb2, 0x1d, 0xf6, 0x76, 0xfa, 0x9b, 0x73, 0xcd, 0x68, 0xf7, 0xbc, 0x1f, 0x6f, 0x47, 0xdd,
+	0x38, 0x7a, 0x96, 0xfd, 0xf1, 0x64, 0xb3, 0x75, 0xfe, 0xf6, 0xd3, 0xe7, 0xbb, 0xb7, 0xb6, 0xcf,
+	0xfb, 0xdd, 0x30, 0xa1, 0xff, 0xe9, 0xb6, 0xc3, 0xa6, 0xdf, 0x0b, 0xa3, 0xce, 0xf9, 0xdb, 0xaf,
+	0xf3, 0xdb, 0xdd, 0x1d, 0xff, 0x75, 0xe7, 0xb7, 0x83, 0x4e, 0x10, 0xfb, 0xbd, 0xa0, 0x35, 0x47,
+	0x9f, 0xeb, 0x45, 0xee, 0x5b, 0x35, 0xb6, 0x39, 0x89, 0x8d, 0xfd, 0xf1, 0x4c, 0xb3, 0x35, 0x77,
+	0xfb, 0xe9, 0x39, 0x8a, 0x6d, 0x0e, 0xb1, 0xcd, 0x19, 0xd8, 0xe6, 0x24, 0xb6, 0xb3, 0x4f, 0x1a,
+	0x63, 0xd9, 0x8e, 0xb6, 0xa3, 0xf3, 0x0c, 0xe9, 0x66, 0x7f, 0x8b, 0xfd, 0x62, 0x3f, 0xd8, 0x5f,
+	0x9c, 0xd8, 0x59, 0xef, 0xd6, 0x9b, 0x92, 0xb9, 0x30, 0xc2, 0xe1, 0x9d, 0x6f, 0x46, 0x71, 0x40,
+	0x87, 0x95, 0x1e, 0xd0, 0xd9, 0xcb, 0x1a, 0x26, 0xb8, 0xdb, 0x0b, 0x3a, 0x09, 0x25, 0x98, 0x3c,
+	0x89, 0x43, 0x08, 0xe2, 0xdb, 0x41, 0x6c, 0xbe, 0x9e, 0x01, 0x90, 0x87, 0xe9, 0xf5, 0x1a, 0xd3,
+	0xae, 0xdf, 0xdc, 0x09, 0x69, 0xef, 0x9e, 0x7e, 0x7c, 0x37, 0xe8, 0xf9, 0x79, 0x4f, 0x9d, 0x2f,
+	0x7a, 0x2a, 0xee, 0x77, 0x7a, 0xe1, 0x6e, 0x90, 0x79, 0xe0, 0x0d, 0x07, 0x3d, 0x90, 0x34, 0x77,
+	0x82, 0x5d, 0x3f, 0xf3, 0xdc, 0xd3, 0x45, 0xcf, 0xf5, 0x7b, 0x61, 0xfb, 0x7c, 0xd8, 0xe9, 0x25,
+	0xbd, 0x38, 0xfd, 0x90, 0xf7, 0x77, 0x1d, 0x72, 0x6c, 0xfe, 0x66, 0x63, 0xbe, 0xdf, 0xdb, 0x59,
+	0x8c, 0x3a, 0x5b, 0xe1, 0xb6, 0xfb, 0xd7, 0xc9, 0x54, 0xb3, 0xdd, 0x4f, 0x7a, 0x41, 0x7c, 0xcd,
+	0xdf, 0x0d, 0x66, 0x9d, 0xc7, 0x9d, 0xd7, 0xd4, 0x17, 0x1e, 0xfa, 0xc6, 0x0b, 0xe7, 0x5e, 0xf6,
+	0x9d, 0x17, 0xce, 0x4d, 0x2d, 0xea, 0x2e, 0x30, 0xe1, 0xdc, 0xbf, 0x42, 0x26, 0xe2, 0xa8, 0x1d,
+	0xcc, 0xc3, 0xb5, 0xd9, 0x0a, 0x7b, 0xe4, 0xb8, 0x78, 0x64, 0x02, 0x78, 0x33, 0xc8, 0x7e, 0x04,
+	0xa5, 0xc4, 0xb7, 0xc2, 0x76, 0x30, 0x5b, 0xb5, 0x41, 0xd7, 0x79, 0x33, 0xc8, 0x7e, 0xef, 0x67,
+	0x2a, 0xe4, 0xf8, 0x7c, 0xb7, 0x7b, 0x39, 0xf0, 0xdb, 0xbd, 0x9d, 0x46, 0xcf, 0xef, 0xf5, 0x13,
+	0x77, 0x9b, 0x8c, 0x27, 0xec, 0x2f, 0x31, 0xb6, 0x35, 0xf1, 0xf4, 0x38, 0xef, 0x7f, 0xf1, 0x85,
+	0x73, 0x6f, 0xcb, 0x5b, 0xd1, 0xb4, 0x2d, 0xea, 0x26, 0x4f, 0x06, 0x9d, 0x6d, 0x3a, 0x33, 0x6c,
+	0x5e, 0x76, 0x18, 0xd6, 0x39, 0x13, 0xf9, 0x62, 0xd4, 0x0a, 0x40, 0xa0, 0xc7, 0x71, 0xee, 0x06,
+	0x49, 0xe2, 0x6f, 0x07, 0xe9, 0x57, 0x5a, 0xe5, 0xcd, 0x20, 0xfb, 0xdd, 0x98, 0xb8, 0x6d, 0x3f,
+	0xe9, 0x6d, 0xc4, 0x3e, 0x5d, 0x3e, 0xb8, 0xa4, 0x37, 0xe8, 0x87, 0x62, 0x6f, 0x37, 0xf5, 0xd4,
+	0x5f, 0x9d, 0xe3, 0x1f, 0x66, 0xce, 0xfc, 0x30, 0x7a, 0x1f, 0xe0, 0xba, 0xa1, 0x1b, 0x60, 0x0e,
+	0x9f, 0x58, 0x78, 0x98, 0x62, 0x77, 0x57, 0x32, 0x98, 0x20, 0x07, 0xbb, 0xf7, 0x7b, 0x15, 0x42,
+	0xe8, 0xdc, 0xd0, 0x39, 0x7b, 0x36, 0x68, 0xf6, 0xdc, 0x0f, 0x92, 0x49, 0x44, 0xd5, 0xf2, 0x7b,
+	0x3e, 0x9b, 0x98, 0xa9, 0xa7, 0x7e, 0x60, 0x30, 0xc2, 0x6b, 0x9b, 0xf8, 0xfc, 0x2a, 0xfd, 0xb5,
+	0xe0, 0x8a, 0x17, 0x24, 0xba, 0x0d, 0x14, 0x56, 0xb7, 0x43, 0xc6, 0x92, 0x6e, 0xd0, 0x64, 0x93,
+	0x31, 0xf5, 0xd4, 0xca, 0xdc, 0x28, 0x3b, 0x7d, 0x4e, 0x8f, 0xbc, 0x41, 0x71, 0x2e, 0x4c, 0x0b,
+	0xca, 0x63, 0xf8, 0x0b, 0x18, 0x1d, 0xf7, 0xb6, 0xfa, 0xd0, 0x7c, 0x22, 0xaf, 0x95, 0x46, 0x91,
+	0x61, 0x5d, 0x98, 0xb1, 0x17, 0x8e, 0xfc, 0xee, 0xde, 0x1f, 0x39, 0x64, 0x46, 0x03, 0xaf, 0x84,
+	0x49, 0xcf, 0x7d, 0x5f, 0x66, 0x72, 0xe7, 0x06, 0x9b, 0x5c, 0x7c, 0x9a, 0x4d, 0xed, 0x09, 0x41,
+	0x6c, 0x52, 0xb6, 0x18, 0x13, 0xbb, 0x4b, 0x6a, 0x61, 0x2f, 0xd8, 0x4d, 0xe8, 0xcc, 0x56, 0x29,
+	0xea, 0xcb, 0x65, 0xbd, 0xe7, 0xc2, 0x31, 0x41, 0xb4, 0xb6, 0x8c, 0xe8, 0x81, 0x53, 0xf1, 0xfe,
+	0xfc, 0x98, 0xf9, 0x7e, 0x38, 0xe1, 0xee, 0xeb, 0xc8, 0x54, 0x12, 0xf5, 0xe3, 0x66, 0x00, 0x41,
+	0x37, 0xc2, 0x8d, 0x55, 0xc5, 0xe5, 0x8e, 0x1b, 0xbe, 0xa1, 0x9b, 0xc1, 0x84, 0x71, 0x3f, 0xeb,
+	0x90, 0xe9, 0x56, 0x90, 0xf4, 0xc2, 0x0e, 0xa3, 0x2f, 0x07, 0xbf, 0x31, 0xf2, 0xe0, 0x65, 0xe3,
+	0x92, 0x46, 0xbe, 0x70, 0x4a, 0xbc, 0xc8, 0xb4, 0xd1, 0x98, 0x80, 0x45, 0x1f, 0x19, 0x17, 0xfd,
+	0xdd, 0x8c, 0xc3, 0x2e, 0xfe, 0x16, 0xac, 0x45, 0x31, 0xae, 0x25, 0xdd, 0x05, 0x26, 0x1c, 0x5d,
+	0xd5, 0x35, 0x64, 0x4c, 0xc9, 0xec, 0x18, 0x1b, 0xff, 0xf2, 0x68, 0xe3, 0x17, 0x93, 0x8a, 0x3c,
+	0x4f, 0xcf, 0x3e, 0xfe, 0xa2, 0xb3, 0xcf, 0xc8, 0xb8, 0x3f, 0xe1, 0x90, 0x59, 0xc1, 0x38, 0x21,
+	0xe0, 0x13, 0x7a, 0x73, 0x87, 0x7e, 0x98, 0x36, 0x5d, 0x17, 0xb3, 0x35, 0x36, 0x86, 0xf3, 0x83,
+	0xad, 0xad, 0x4b, 0x71, 0xd4, 0xef, 0x5e, 0x0d, 0x3b, 0xad, 0x85, 0xc7, 0x05, 0xa5, 0xd9, 0xc5,
+	0x02, 0xc4, 0x50, 0x48, 0xd2, 0xfd, 0x29, 0x87, 0x9c, 0xed, 0x50, 0x0e, 0x9e, 0x74, 0x7d, 0xfc,
+	0xb4, 0xbc, 0x7b, 0xa1, 0xed, 0x37, 0x6f, 0xb1, 0x11, 0x8d, 0xdf, 0xdb, 0x88, 0x3c, 0x31, 0xa2,
+	0xb3, 0xd7, 0x0a, 0x51, 0xc3, 0x3e, 0x64, 0xdd, 0x9f, 0x77, 0xc8, 0xc9, 0x28, 0xa6, 0x53, 0xda,
+	0x09, 0x5a, 0xb2, 0x37, 0x99, 0x9d, 0x60, 0x5b, 0xef, 0x03, 0xa3, 0x7d, 0xa2, 0xb5, 0x34, 0xda,
+	0xd5, 0xa8, 0x43, 0x65, 0x43, 0xdc, 0x08, 0x7a, 0x74, 0x31, 0x6d, 0x27, 0x0b, 0xa7, 0xe9, 0xb8,
+	0x4f, 0x66, 0xa0, 0x20, 0x3b, 0x1e, 0xf7, 0x87, 0xe8, 0xb6, 0xd9, 0xeb, 0x34, 0x6f, 0xd2, 0x37,
+	0x8e, 0xee, 0x24, 0xb3, 0x93, 0x65, 0x6c, 0xdf, 0x86, 0x42, 0x28, 0x36, 0xa0, 0x26, 0x00, 0x26,
+	0xb5, 0xfc, 0x0f, 0xa7, 0x97, 0x52, 0xbd, 0xec, 0x0f, 0xa7, 0x17, 0xd3, 0x3e, 0x64, 0xdd, 0x1f,
+	0xa3, 0x0a, 0x45, 0x12, 0x6e, 0xd3, 0x4d, 0xd9, 0x8f, 0x83, 0xab, 0xc1, 0x5e, 0x32, 0x4b, 0xd8,
+	0x40, 0xae, 0x8c, 0x38, 0x2b, 0x06, 0xca, 0x85, 0xd3, 0x62, 0x8c, 0xc7, 0xcc, 0xd6, 0x04, 0x6c,
+	0xba, 0x79, 0x1b, 0x4d, 0x2f, 0xeb, 0xa9, 0x72, 0x37, 0x9a, 0x5e, 0xd4, 0x85, 0x24, 0xdd, 0x1f,
+	0x24, 0x27, 0x78, 0x93, 0x9a, 0xd9, 0x64, 0x76, 0x9a, 0x31, 0xda, 0x53, 0x14, 0xe3, 0x89, 0x46,
+	0xaa, 0x0f, 0x32, 0xd0, 0xee, 0x73, 0xe4, 0x5c, 0x37, 0x88, 0x77, 0xc3, 0xde, 0x5a, 0xa7, 0xbd,
+	0x27, 0xd9, 0x77, 0x33, 0xea, 0x06, 0x2d, 0x31, 0x9c, 0x64, 0xf6, 0x18, 0xdd, 0x21, 0x93, 0x0b,
+	0xaf, 0x16, 0xc3, 0x3c, 0xb7, 0xbe, 0x3f, 0x38, 0x1c, 0x84, 0xcf, 0xfd, 0x3a, 0x5d, 0x64, 0x06,
+	0x97, 0x6d, 0x50, 0x9d, 0x39, 0x6c, 0x06, 0xf3, 0xcd, 0x66, 0x44, 0x95, 0xd1, 0x64, 0x76, 0x86,
+	0x4d, 0xe3, 0xe6, 0x61, 0xf0, 0x7c, 0x9b, 0x94, 0x5e, 0x97, 0x85, 0x20, 0x09, 0xec, 0x33, 0x52,
+	0xef, 0xb7, 0x2b, 0xe4, 0x44, 0x5a, 0x03, 0x70, 0xff, 0xa1, 0x43, 0x8e, 0x3f, 0x7b, 0xa7, 0xb7,
+	0x11, 0xdd, 0xa2, 0x6a, 0xff, 0xc2, 0x1e, 0xf2, 0x69, 0x26, 0xfb, 0xa6, 0x9e, 0x6a, 0x96, 0xab,
+	0x6b, 0xcc, 0x5d, 0xb1, 0xa9, 0x5c, 0xe8, 0xf4, 0xe2, 0xbd, 0x85, 0x47, 0xc4, 0x3b, 0x1d, 0xbf,
+	0x72, 0x73, 0xc3, 0xec, 0x85, 0xf4, 0xa0, 0xce, 0x7e, 0xda, 0x21, 0xa7, 0xf2, 0x50, 0xb8, 0x27,
+	0x48, 0xf5, 0x56, 0xb0, 0xc7, 0x35, 0x61, 0xc0, 0x3f, 0xdd, 0xf7, 0x93, 0xda, 0x6d, 0xbf, 0xdd,
+	0x0f, 0x84, 0x9a, 0x76, 0x69, 0xb4, 0x17, 0x51, 0x23, 0x03, 0x8e, 0xf5, 0xcd, 0x95, 0x37, 0x39,
+	0xde, 0xef, 0x54, 0xc9, 0x94, 0xf1, 0xd1, 0x8e, 0x40, 0xf5, 0x8c, 0x2c, 0xd5, 0x73, 0xb5, 0xb4,
+	0xf5, 0x56, 0xa8, 0x7b, 0xde, 0x49, 0xe9, 0x9e, 0x6b, 0xe5, 0x91, 0xdc, 0x57, 0xf9, 0x74, 0x7b,
+	0xa4, 0x4e, 0x37, 0x60, 0xcc, 0x40, 0xa9, 0x4a, 0x52, 0xc2, 0x27, 0x5c, 0x93, 0xe8, 0x16, 0x8e,
+	0x51, 0x7a, 0x75, 0xf5, 0x13, 0x34, 0x21, 0xef, 0x3f, 0xd0, 0xf5, 0x65, 0x8c, 0x91, 0x9a, 0x82,
+	0x2d, 0x66, 0x68, 0xb8, 0x8f, 0x93, 0xb1, 0xde, 0x5e, 0x57, 0x9a, 0x81, 0x6a, 0xa6, 0x36, 0x68,
+	0x1b, 0xb0, 0x9e, 0x07, 0xdd, 0x4a, 0xa2, 0x52, 0xf2, 0xe1, 0x7c, 0x06, 0xe3, 0xbe, 0x8a, 0x7e,
+	0x63, 0xe6, 0x03, 0x10, 0x6f, 0xa7, 0x3f, 0x09, 0x6b, 0x05, 0xd1, 0xeb, 0x9e, 0x27, 0x75, 0x25,
+	0xf0, 0xc4, 0x3b, 0x9e, 0x14, 0xa0, 0x75, 0x2d, 0x25, 0x35, 0x0c, 0x4e, 0x1a, 0xfe, 0x10, 0x2a,
+	0xa8, 0x9a, 0x34, 0x66, 0x34, 0xb3, 0x1e, 0xef, 0x5b, 0x0e, 0x79, 0xe5, 0x20, 0x6c, 0xef, 0xf0,
+	0xc6, 0xd8, 0x20, 0xa7, 0x5b, 0xc1, 0x96, 0xdf, 0x6f, 0xf7, 0x6c, 0x8a, 0x62, 0xd0, 0x2f, 0x17,
+	0x0f, 0x9f, 0x5e, 0xca, 0x03, 0x82, 0xfc, 0x67, 0xbd, 0xff, 0xec, 0x30, 0x73, 0x5d, 0xbe, 0xd6,
+	0x11, 0x98, 0x4e, 0x1d, 0xdb, 0x74, 0x5a, 0x2e, 0x6d, 0x9b, 0x16, 0xd8, 0x4e, 0x54, 0xa9, 0x38,
+	0x6b, 0x40, 0xad, 0xfa, 0xbd, 0xe6, 0xce, 0x85, 0xbb, 0xdd, 0x98, 0xae, 0x70, 0x5c, 0x52, 0x2f,
+	0x37, 0xd8, 0xf1, 0xc2, 0x94, 0xc0, 0x50, 0xa5, 0xea, 0x08, 0xe7, 0xcd, 0x7f, 0x8d, 0x4c, 0xf2,
+	0x3d, 0x17, 0xc5, 0xe2, 0x23, 0xa9, 0x77, 0x5b, 0x13, 0xed, 0xa0, 0x20, 0x5c, 0x8f, 0x8c, 0x33,
+	0x9e, 0x8b, 0x3c, 0x08, 0xd5, 0x04, 0x82, 0xdf, 0xfd, 0x06, 0x6b, 0x01, 0xd1, 0xe3, 0x25, 0xd6,
+	0x70, 0xd6, 0xe9, 0x38, 0x70, 0x3d, 0xb4, 0x2e, 0x86, 0x41, 0xbb, 0x95, 0xa0, 0x59, 0xe7, 0x77,
+	0x3a, 0x51, 0x4f, 0x58, 0x68, 0x86, 0x59, 0x37, 0xaf, 0x9b, 0xc1, 0x84, 0x41, 0xa2, 0x6d, 0x7f,
+	0x33, 0x68, 0xf3, 0x19, 0x15, 0x44, 0x57, 0x58, 0x0b, 0x88, 0x1e, 0xef, 0x3b, 0x15, 0x66, 0x40,
+	0x2a, 0x8e, 0x16, 0x1c, 0x85, 0xf7, 0x21, 0xb6, 0x44, 0xc0, 0x7a, 0x79, 0xfc, 0x38, 0x28, 0xf6,
+	0x40, 0x3c, 0x9f, 0x92, 0x02, 0x50, 0x2a, 0xd5, 0xfd, 0xbd, 0x10, 0x1f, 0xad, 0x92, 0x73, 0xf6,
+	0x03, 0x19, 0x21, 0x82, 0x26, 0xaf, 0x41, 0x28, 0xed, 0xab, 0x33, 0xe0, 0xc1, 0x84, 0x2b, 0xe0,
+	0xc3, 0x95, 0xc3, 0xe4, 0xc3, 0xa6, 0x98, 0xa8, 0x1e, 0x20, 0x26, 0x5e, 0xa5, 0x66, 0x7d, 0x2c,
+	0xc5, 0xf3, 0x6c, 0x51, 0x49, 0xd9, 0x2c, 0x55, 0x52, 0xbb, 0xd4, 0x68, 0xb6, 0xd8, 0x6c, 0x83,
+	0xb6, 0x01, 0xeb, 0x71, 0xdf, 0x46, 0x8e, 0xf7, 0xe8, 0xd7, 0x09, 0x7a, 0x71, 0x70, 0x3b, 0x64,
+	0x7e, 0x5d, 0x66, 0xcf, 0xd2, 0x39, 0x42, 0xad, 0x6b, 0x83, 0x75, 0x81, 0xec, 0x82, 0x34, 0xac,
+	0xf7, 0xdf, 0x2b, 0xe4, 0x11, 0xfb, 0x13, 0x68, 0xc1, 0xf8, 0x0e, 0x4b, 0x30, 0xbe, 0xd6, 0x14,
+	0x8c, 0x2f, 0xbe, 0x70, 0xee, 0xd1, 0x82, 0xc7, 0xbe, 0x6b, 0xe4, 0xa6, 0x7b, 0x29, 0xf5, 0x11,
+	0xce, 0x67, 0xbc, 0xac, 0x2f, 0x2f, 0x78, 0xc7, 0xd4, 0x57, 0xa2, 0x5f, 0x33, 0x0e, 0xfc, 0x84,
+	0x2e, 0xcf, 0x9a, 0xfd, 0x35, 0x81, 0xb5, 0x82, 0xe8, 0xf5, 0xbe, 0x59, 0x4f, 0x4f, 0xf6, 0x25,
+	0xee, 0xab, 0xa6, 0x9c, 0x30, 0x24, 0x63, 0xcc, 0x6a, 0xe3, 0x9c, 0xe5, 0xea, 0x68, 0xbb, 0x10,
+	0xa5, 0x88, 0x42, 0xbd, 0x30, 0x89, 0x5f, 0x0d, 0x9b, 0x80, 0x91, 0x70, 0xef, 0x92, 0xc9, 0xa6,
+	0x34, 0xa6, 0x2a, 0x65, 0xb8, 0x1d, 0x85, 0x29, 0xa5, 0x29, 0x4e, 0x23, 0xbb, 0x57, 0x16, 0x98,
+	0xa2, 0xe6, 0x06, 0xa4, 0x4a, 0x09, 0x89, 0xcf, 0x3a, 0xa2, 0xb9, 0x7c, 0x29, 0x34, 0x5e, 0x71,
+	0x02, 0x65, 0x10, 0x6d, 0x01, 0xc4, 0xef, 0x7e, 0xc2, 0x21, 0x53, 0x49, 0x73, 0x97, 0x9a, 0x27,
+	0xb7, 0xc3, 0x16, 0xd5, 0x2b, 0xc6, 0xca, 0xe0, 0x6c, 0x8d, 0xc5, 0x55, 0x89, 0x50, 0xd3, 0xe5,
+	0xee, 0x0b, 0xdd, 0x03, 0x26, 0x5d, 0xb4, 0xbd, 0x1e, 0x11, 0xef, 0xbe, 0x14, 0x34, 0xd9, 0x8e,
+	0x93, 0x36, 0x33, 0x5b, 0x29, 0x23, 0xeb, 0xdc, 0x4b, 0xfd, 0xe6, 0x2d, 0xdc, 0x6f, 0x7a, 0x40,
+	0x8f, 0xd2, 0x01, 0x3d, 0xb2, 0x98, 0x4f, 0x13, 0x8a, 0x06, 0xc3, 0x26, 0xac, 0xdb, 0x6f, 0xb7,
+	0x21, 0x78, 0x8e, 0x4a, 0x5c, 0xf4, 0x88, 0x95, 0x30, 0x61, 0xeb, 0x1a, 0x61, 0x6a, 0xc2, 0x8c,
+	0x1e, 0x30, 0xe9, 0x52, 0xeb, 0x7f, 0x7c, 0xd7, 0xef, 0xc5, 0xe1, 0x5d, 0xe1, 0x06, 0x1b, 0xd1,
+	0x0a, 0x5a, 0x65, 0xb8, 0x34, 0x71, 0x26, 0xe8, 0x79, 0x23, 0x08, 0x42, 0xe8, 0x98, 0xde, 0x0d,
+	0x28, 0x4f, 0x9c, 0x9d, 0x2c, 0xc3, 0xe5, 0xbf, 0x8a, 0xa8, 0x34, 0xc1, 0x3a, 0x2a, 0x57, 0xac,
+	0x0d, 0x38, 0x15, 0x6a, 0xba, 0x4e, 0x26, 0x41, 0x9b, 0x8a, 0x7e, 0xaa, 0x1e, 0xd5, 0x19, 0xc5,
+	0xa7, 0x07, 0x54, 0x15, 0x51, 0x2f, 0x69, 0x88, 0x47, 0xf9, 0x06, 0x93, 0xbf, 0x40, 0xa1, 0xc4,
+	0x09, 0xec, 0xb6, 0xfb, 0xdb, 0x61, 0x67, 0x96, 0x94, 0x31, 0x81, 0xeb, 0x0c, 0x57, 0x6a, 0x02,
+	0x79, 0x23, 0x08, 0x42, 0xde, 0x7f, 0x73, 0x88, 0x6b, 0x33, 0xb5, 0x23, 0xd0, 0x89, 0x9f, 0xb3,
+	0x75, 0xe2, 0x95, 0x32, 0x95, 0x96, 0x02, 0xb5, 0xf8, 0x57, 0xeb, 0x24, 0x25, 0x0e, 0xae, 0xd1,
+	0x25, 0x1b, 0xb4, 0x5e, 0x62, 0xe1, 0x2f, 0xb1, 0xf0, 0x97, 0x58, 0xb8, 0x62, 0xe1, 0x9b, 0x29,
+	0x16, 0xfe, 0x76, 0x63, 0xd7, 0xeb, 0xd8, 0x83, 0x67, 0x54, 0x70, 0x82, 0x39, 0x02, 0x03, 0x00,
+	0x39, 0xc1, 0x95, 0xc6, 0xda, 0xb5, 0x5c, 0x9e, 0xfd, 0x8c, 0xcd, 0xb3, 0x47, 0x25, 0xf1, 0x97,
+	0x81, 0x4b, 0x7f, 0xdd, 0x21, 0xaf, 0xb6, 0xb9, 0x97, 0x5c, 0x39, 0xcb, 0xdb, 0x9d, 0x28, 0x0e,
+	0x96, 0xc2, 0xad, 0xad, 0x20, 0x0e, 0x3a, 0xe8, 0x83, 0x97, 0xbe, 0x1d, 0xa7, 0xc8, 0xb7, 0xe3,
+	0xbe, 0x9e, 0x4c, 0x3f, 0x4b, 0x15, 0xda, 0xf5, 0x28, 0xec, 0x08, 0x16, 0x84, 0x16, 0xc7, 0x09,
+	0x3c, 0xbd, 0xc4, 0x19, 0x95, 0xed, 0x60, 0x41, 0xb9, 0x8b, 0xe4, 0xe4, 0xb3, 0xcf, 0xad, 0xfb,
+	0x3d, 0xc3, 0x9b, 0x20, 0xed, 0x7e, 0x76, 0x1e, 0x75, 0xe5, 0x9d, 0xa9, 0x4e, 0xc8, 0xc2, 0x7b,
+	0x3f, 0x5b, 0x21, 0x67, 0x52, 0x2f, 0x12, 0xb5, 0xdb, 0x51, 0xbf, 0x87, 0x36, 0x91, 0xfb, 0x65,
+	0x87, 0x9c, 0xd8, 0xb5, 0x1d, 0x16, 0x89, 0x70, 0x77, 0xbf, 0xab, 0x34, 0x19, 0x91, 0xf2, 0x88,
+	0x2c, 0xcc, 0x8a, 0x19, 0x3a, 0x91, 0xea, 0x48, 0x20, 0x33, 0x16, 0xba, 0xb2, 0xea, 0xbb, 0xfe,
+	0xdd, 0xeb, 0x5d, 0x2a, 0xc5, 0xa4, 0x39, 0x5a, 0xec, 0x45, 0xc0, 0xa8, 0x96, 0x39, 0x1e, 0xd5,
+	0x32, 0xb7, 0xdc, 0xe9, 0xad, 0xc5, 0x0d, 0xba, 0xfc, 0x3b, 0xdb, 0xdc, 0xc9, 0xb9, 0x2a, 0xd1,
+	0x80, 0xc6, 0xe8, 0x7d, 0xc9, 0x49, 0x0b, 0x29, 0x35, 0x3b, 0x18, 0x12, 0xb3, 0xbd, 0xe7, 0x7e,
+	0x88, 0xd4, 0xd0, 0x6e, 0x94, 0xb3, 0x72, 0xb3, 0x4c, 0xc9, 0x69, 0x7c, 0x09, 0x2d, 0x44, 0xf1,
+	0x17, 0x15, 0xa2, 0x8c, 0xa8, 0xf7, 0xe5, 0x7a, 0x5a, 0x59, 0x60, 0x67, 0xf3, 0x4f, 0x11, 0xb2,
+	0x1d, 0x6d, 0x04, 0xbb, 0xdd, 0x36, 0x4e, 0x8b, 0xc3, 0x0e, 0x78, 0x94, 0xab, 0xe4, 0x92, 0xea,
+	0x01, 0x03, 0xca, 0xfd, 0x71, 0x87, 0x3e, 0x24, 0xd7, 0xbc, 0x54, 0x04, 0xae, 0x97, 0xf9, 0x3a,
+	0x7a, 0x47, 0xe9, 0xb1, 0x28, 0x82, 0x60, 0x10, 0x77, 0x7f, 0xc4, 0x21, 0x93, 0x3d, 0x39, 0x7c,
+	0x2e, 0x1a, 0x37, 0xca, 0x1c, 0x89, 0x7c, 0x69, 0xad, 0x13, 0xa9, 0x29, 0x51, 0x74, 0xdd, 0xbf,
+	0x49, 0x27, 0x04, 0x0f, 0x4f, 0xd7, 0x23, 0xfa, 0xe4, 0x9e, 0x90, 0x98, 0x37, 0x4a, 0x75, 0xe7,
+	0x28, 0xec, 0x0b, 0x33, 0x38, 0x1b, 0xfa, 0x37, 0x18, 0x94, 0xdd, 0x8f, 0x50, 0xee, 0x29, 0x96,
+	0x9b, 0x90, 0x91, 0x1b, 0xe5, 0x3a, 0x95, 0x38, 0x6e, 0xc1, 0x5e, 0xc5, 0x2f, 0x50, 0x34, 0xdd,
+	0x2f, 0x38, 0xe4, 0x78, 0xd7, 0x76, 0x13, 0x0a, 0x71, 0x58, 0x1e, 0x0f, 0x48, 0xb9, 0x21, 0xb9,
+	0xb7, 0x25, 0xd5, 0x08, 0xe9, 0x51, 0x20, 0x07, 0xd4, 0x2b, 0x78, 0xad, 0xcb, 0x5d, 0x96, 0x13,
+	0x9a, 0x03, 0x5e, 0x4a, 0x77, 0x42, 0x16, 0xde, 0x5d, 0x27, 0xa7, 0x70, 0x74, 0x7b, 0x5c, 0xfd,
+	0x94, 0xe2, 0x25, 0x61, 0xc2, 0x70, 0x72, 0xe1, 0x31, 0xb1, 0x42, 0xd8, 0x59, 0x47, 0x1a, 0x06,
+	0x72, 0x9f, 0x74, 0x7f, 0xc7, 0x21, 0x8f, 0x85, 0x4c, 0x0c, 0x98, 0x0e, 0x7b, 0x2d, 0x11, 0xc4,
+	0x41, 0x7b, 0x50, 0x2a, 0xaf, 0x28, 0x12, 0x3f, 0x0b, 0xaf, 0x14, 0x6f, 0xf0, 0xd8, 0xf2, 0x3e,
+	0x43, 0x82, 0x7d, 0x07, 0xec, 0xbe, 0x91, 0x1c, 0x93, 0xfb, 0x62, 0x1d, 0x59, 0x30, 0x13, 0xb4,
+	0xf5, 0x85, 0x93, 0x78, 0xa2, 0xbe, 0x61, 0x76, 0x80, 0x0d, 0xe7, 0xfd, 0xcb, 0xaa, 0x75, 0x4a,
+	0xa4, 0x7c, 0x98, 0x8c, 0xdd, 0x34, 0xa5, 0xff, 0x47, 0x72, 0xcf, 0x52, 0xd9, 0x8d, 0xf2, 0x2e,
+	0x69, 0x76, 0xa3, 0x9a, 0x28, 0xbb, 0xd1, 0xc4, 0x51, 0x29, 0x3d, 0xe9, 0xa7, 0x3d, 0xa5, 0x82,
+	0x03, 0xbe, 0xbf, 0xcc, 0x21, 0x65, 0xcf, 0xf4, 0xce, 0x88, 0xa1, 0x9d, 0xcc, 0x74, 0x41, 0x76,
+	0x48, 0xee, 0x87, 0x49, 0x3d, 0x56, 0x91, 0x2d, 0xd5, 0x32, 0x4c, 0x35, 0xb9, 0x6c, 0xc4, 0x70,
+	0xd4, 0x01, 0x90, 0x8e, 0x61, 0xd1, 0x14, 0xbd, 0x4f, 0x56, 0xac, 0x83, 0x31, 0x83, 0x77, 0x0c,
+	0x70, 0xe8, 0xf7, 0x59, 0xaa, 0x50, 0xc7, 0x54, 0xa0, 0x51, 0x81, 0x8b, 0x7c, 0x4e, 0x08, 0xeb,
+	0xf7, 0x1e, 0x8a, 0xbc, 0x14, 0x0c, 0x8d, 0x69, 0xd6, 0xa0, 0x69, 0x82, 0x39, 0x00, 0xf7, 0x2d,
+	0xe4, 0x58, 0x8b, 0x6e, 0x59, 0x7c, 0x76, 0x2d, 0x46, 0x9b, 0x88, 0x3b, 0x99, 0x55, 0xa4, 0xc8,
+	0x92, 0xd9, 0x09, 0x36, 0x2c, 0x06, 0xfc, 0xcd, 0x16, 0x31, 0x73, 0x6a, 0xd3, 0x3d, 0x2a, 0x39,
+	0x95, 0x9a, 0xc7, 0xb5, 0x8e, 0xc4, 0x27, 0xe4, 0xf1, 0x13, 0x82, 0xce, 0xa3, 0xeb, 0xc5, 0xa0,
+	0xb0, 0x1f, 0x1e, 0xf7, 0x3d, 0xe4, 0x84, 0x31, 0x29, 0x89, 0x9a, 0xd5, 0xfa, 0xc2, 0x1c, 0x6a,
+	0x4f, 0xf3, 0xa9, 0xbe, 0x17, 0x5f, 0x38, 0xf7, 0x70, 0xba, 0x4d, 0x48, 0x9b, 0x0c, 0x1e, 0xef,
+	0x17, 0x32, 0x9f, 0x5a, 0x29, 0x0a, 0x5f, 0x74, 0x32, 0xae, 0x88, 0x77, 0x1d, 0x86, 0x70, 0x66,
+	0x4e, 0x0b, 0x15, 0xc3, 0x51, 0x0c, 0x73, 0x1f, 0xcf, 0xfc, 0xbd, 0x7f, 0x3d, 0x46, 0xf6, 0x19,
+	0xd9, 0x00, 0x9a, 0xff, 0xd0, 0x87, 0xb0, 0x9f, 0x71, 0xd4, 0x69, 0x1b, 0x67, 0x00, 0xad, 0xc3,
+	0x9a, 0x7b, 0x6e, 0x7c, 0x25, 0x3c, 0xee, 0x44, 0xb9, 0xe0, 0xed, 0x73, 0x3d, 0xf7, 0x2b, 0x8e,
+	0x7d, 0x5e, 0xc8, 0x23, 0x22, 0xc3, 0x43, 0x1b, 0x93, 0x71, 0x08, 0xc9, 0x07, 0xa6, 0x8f, 0xae,
+	0x8a, 0x8e, 0x27, 0xe7, 0x08, 0xd9, 0x0a, 0x3b, 0x7e, 0x3b, 0x7c, 0x1e, 0x4d, 0xab, 0x1a, 0xd3,
+	0x0e, 0x98, 0xba, 0x75, 0x51, 0xb5, 0x82, 0x01, 0x71, 0xf6, 0x6f, 0x90, 0x29, 0xe3, 0xcd, 0x73,
+	0xc2, 0x65, 0x4e, 0x99, 0xe1, 0x32, 0x75, 0x23, 0xca, 0xe5, 0xec, 0xdb, 0xc9, 0x89, 0xf4, 0x00,
+	0x87, 0x79, 0xde, 0xfb, 0x3f, 0x13, 0xe9, 0x03, 0xbc, 0x0d, 0x0c, 0xb6, 0xa2, 0x43, 0x7b, 0xc9,
+	0x2b, 0xf6, 0x92, 0x57, 0xec, 0x25, 0xaf, 0x98, 0x79, 0xb0, 0x21, 0x3c, 0x3e, 0x13, 0x47, 0xe4,
+	0xf1, 0xb1, 0x7c, 0x58, 0x93, 0xa5, 0xfb, 0xb0, 0xbc, 0x4f, 0x64, 0xdc, 0xfe, 0x1b, 0x71, 0x10,
+	0x50, 0x89, 0x56, 0xeb, 0x44, 0xad, 0x40, 0x2a, 0xc8, 0x57, 0xca, 0xd1, 0xf6, 0xae, 0x51, 0x94,
+	0xda, 0xa3, 0x80, 0xbf, 0x12, 0xe0, 0x74, 0xbc, 0x1f, 0x1d, 0x27, 0x96, 0x2e, 0xca, 0xbf, 0x3b,
+	0xa6, 0xea, 0x04, 0xdd, 0xe8, 0x3a, 0xac, 0x08, 0x59, 0xa6, 0x53, 0x75, 0x78, 0x33, 0xc8, 0x7e,
+	0x94, 0x79, 0x5d, 0xbf, 0xb7, 0x23, 0x84, 0x99, 0x92, 0x79, 0xe8, 0x77, 0x02, 0xd6, 0xe3, 0xbe,
+	0x9d, 0xcc, 0xf4, 0xac, 0x73, 0x74, 0x71, 0x5e, 0xfc, 0xb0, 0x80, 0x9d, 0xb1, 0x4f, 0xd9, 0x21,
+	0x05, 0x4d, 0x3f, 0xfe, 0xd8, 0x4e, 0xd0, 0xde, 0x15, 0x9f, 0xbe, 0x51, 0x9e, 0xac, 0x61, 0xef,
+	0x7a, 0x99, 0xa2, 0xe6, 0x9c, 0x10, 0xff, 0x02, 0x46, 0x0a, 0xd7, 0x7d, 0xfd, 0x16, 0xdd, 0x12,
+	0xd1, 0x2e, 0x95, 0x11, 0xe2, 0xf3, 0xbf, 0xab, 0x64, 0xc2, 0x57, 0x25, 0x7e, 0xee, 0x8f, 0x52,
+	0x3f, 0x41, 0x53, 0x66, 0xe3, 0x68, 0x85, 0x31, 0x5b, 0x32, 0x7b, 0xc2, 0xdb, 0x59, 0xf6, 0x38,
+	0x96, 0x24, 0x7e, 0x3e, 0x0e, 0xf5, 0x13, 0x34, 0x65, 0x77, 0x4f, 0xed, 0xbf, 0x29, 0x36, 0x86,
+	0xeb, 0x25, 0x8f, 0x81, 0xef, 0xbd, 0xdc, 0x7d, 0xf8, 0x04, 0xa9, 0x35, 0x77, 0xfc, 0xb8, 0x37,
+	0x3b, 0xcd, 0x16, 0x8d, 0x5a, 0xc5, 0x8b, 0xd8, 0x08, 0xbc, 0x0f, 0x83, 0xaa, 0xe2, 0x60, 0x8b,
+	0x85, 0x36, 0x1b, 0x41, 0x55, 0x10, 0x6c, 0x01, 0xb6, 0x2b, 0xbd, 0x6c, 0xa6, 0x30, 0xda, 0xee,
+	0xe7, 0x2a, 0xb6, 0x62, 0x67, 0xcf, 0x0c, 0xdf, 0x0f, 0xcd, 0x7e, 0x9c, 0x48, 0xef, 0x9a, 0xb1,
+	0x1f, 0x58, 0x33, 0xc8, 0x7e, 0xf7, 0x63, 0x0e, 0x99, 0x40, 0xb7, 0x6d, 0x27, 0xe8, 0x09, 0x21,
+	0x7a, 0xa3, 0xe4, 0xc9, 0xba, 0xc2, 0xb1, 0xeb, 0x31, 0x88, 0x06, 0x90, 0x74, 0x71, 0xb8, 0xc1,
+	0x5d, 0xca, 0xd3, 0x5b, 0x99, 0x48, 0x9a, 0x0b, 0xbc, 0x19, 0x64, 0x3f, 0x82, 0x86, 0x1d, 0x0e,
+	0x3a, 0x66, 0x83, 0x2e, 0x77, 0x04, 0xa8, 0xe8, 0xf7, 0x7e, 0x79, 0x92, 0x9c, 0xce, 0xdd, 0x3e,
+	0xa8, 0x72, 0x31, 0xa5, 0xe6, 0x62, 0xd8, 0x0e, 0x64, 0x0c, 0x19, 0x53, 0xb9, 0x6e, 0xa8, 0x56,
+	0x30, 0x20, 0xdc, 0x1f, 0x26, 0xa4, 0xeb, 0xc7, 0x74, 0xde, 0x95, 0xf7, 0x7b, 0x64, 0xcd, 0x06,
+	0xc7, 0xb1, 0x2e, 0x71, 0x6a, 0x0f, 0x80, 0x6a, 0xa2, 0x03, 0xd0, 0x24, 0x31, 0x2a, 0x2a, 0xa6,
+	0x9c, 0xd8, 0x4f, 0x58, 0xec, 0x7c, 0x3a, 0x11, 0x08, 0x74, 0x17, 0x98, 0x70, 0x18, 0xa8, 0x22,
+	0xc2, 0xed, 0x52, 0x61, 0x47, 0x76, 0xc8, 0x9d, 0xfb, 0x39, 0x87, 0xcc, 0x60, 0x72, 0xa2, 0xa6,
+	0x2e, 0xd2, 0x76, 0xd6, 0x46, 0x7f, 0xc9, 0x8b, 0x26, 0x5e, 0xcd, 0x43, 0xad, 0xe6, 0x04, 0x52,
+	0xe4, 0xf1, 0x33, 0xdf, 0xa6, 0xff, 0x47, 0xe6, 0x3b, 0x6e, 0x7f, 0xe6, 0x1b, 0xbc, 0x19, 0x64,
+	0xbf, 0x3b, 0x4f, 0x8e, 0x77, 0xfd, 0x24, 0x59, 0x8c, 0x83, 0x56, 0xd0, 0xe9, 0x85, 0x7e, 0x9b,
+	0x27, 0xd5, 0x4c, 0xea, 0x58, 0xf4, 0x75, 0xbb, 0x1b, 0xd2, 0xf0, 0xee, 0xbb, 0xc9, 0x23, 0xdc,
+	0xbd, 0xb4, 0x1a, 0x26, 0x09, 0xb5, 0xbf, 0xf5, 0x32, 0x10, 0x5e, 0xb6, 0x73, 0x02, 0xd5, 0x23,
+	0xcb, 0xf9, 0x60, 0x50, 0xf4, 0x3c, 0xc6, 0x47, 0x26, 0xb7, 0xc2, 0xee, 0x62, 0xdc, 0x4a, 0xd8,
+	0xd1, 0xd2, 0xa4, 0xf6, 0xe9, 0x36, 0x44, 0x3b, 0x28, 0x08, 0xb7, 0x49, 0xa6, 0xf9, 0x27, 0xe1,
+	0xf1, 0x82, 0x82, 0x83, 0x3e, 0x59, 0x28, 0xc8, 0x45, 0xfe, 0xec, 0x1c, 0xf8, 0x77, 0x2e, 0xc8,
+	0x83, 0x2e, 0x7e, 0x2e, 0x73, 0xc3, 0x40, 0x03, 0x16, 0x52, 0xdb, 0xa6, 0x9b, 0x1a, 0xc0, 0xa6,
+	0xa3, 0xab, 0xef, 0x56, 0x7f, 0x33, 0x10, 0x33, 0x2f, 0x18, 0x9b, 0x5a, 0x7d, 0x57, 0x75, 0x17,
+	0x98, 0x70, 0x2c, 0x54, 0xb3, 0x1b, 0x8a, 0x5f, 0x98, 0xc7, 0xa1, 0x43, 0x35, 0xd7, 0x97, 0x65,
+	0x33, 0x98, 0x30, 0x38, 0x34, 0x9c, 0x8b, 0x0d, 0xaa, 0x43, 0x25, 0x8c, 0xfb, 0x4d, 0xea, 0xa1,
+	0x35, 0x64, 0x07, 0x68, 0x18, 0x74, 0x8e, 0xe2, 0x8f, 0x06, 0xcb, 0x1f, 0xa6, 0xef, 0x1c, 0xb6,
+	0x78, 0xdc, 0xe0, 0x71, 0xdb, 0x39, 0xda, 0xc8, 0x81, 0x81, 0xdc, 0x27, 0x31, 0x3f, 0x77, 0xb6,
+	0x88, 0x85, 0xb9, 0x09, 0x32, 0xaa, 0xde, 0x0d, 0x3f, 0x96, 0x0a, 0xcf, 0x88, 0x99, 0x51, 0x02,
+	0x2f, 0x45, 0x68, 0xb2, 0x3c, 0x46, 0x00, 0x24, 0x25, 0xf7, 0x59, 0x32, 0xd6, 0x6b, 0xfb, 0x25,
+	0xa5, 0x52, 0x1a, 0x14, 0xb5, 0x17, 0x6c, 0x65, 0x3e, 0x01, 0x46, 0xc3, 0x7d, 0x0c, 0xad, 0xb7,
+	0x4d, 0x79, 0x4c, 0x27, 0x0c, 0xae, 0xcd, 0x04, 0x58, 0xab, 0xf7, 0xd3, 0xc7, 0x72, 0xa4, 0x8e,
+	0x52, 0x04, 0xf0, 0x58, 0x07, 0x17, 0xcd, 0x3a, 0x15, 0x61, 0xe1, 0x5d, 0xa1, 0x88, 0x29, 0xce,
+	0x76, 0x4d, 0xf5, 0x80, 0x01, 0x25, 0x9f, 0x69, 0xf4, 0xb7, 0xf0, 0x99, 0x4a, 0xf6, 0x19, 0xde,
+	0x03, 0x06, 0x94, 0xfb, 0x7a, 0x32, 0x4e, 0xf7, 0xc1, 0xb6, 0x8a, 0x22, 0x7e, 0x0c, 0x59, 0xda,
+	0x32, 0x6b, 0x79, 0x91, 0xb2, 0x16, 0x35, 0x20, 0xd6, 0x04, 0x02, 0xd6, 0xfd, 0x05, 0x87, 0x4c,
+	0xd3, 0x39, 0xdb, 0x8d, 0x3a, 0xdc, 0x7c, 0x16, 0xbe, 0x80, 0x67, 0x0f, 0x4b, 0x4d, 0x9a, 0x5b,
+	0x34, 0x88, 0x71, 0x67, 0x80, 0xca, 0xf9, 0x34, 0xbb, 0xc0, 0x1a, 0x95, 0xc9, 0xf9, 0x6a, 0x07,
+	0x70, 0xbe, 0x5f, 0x71, 0xc8, 0x49, 0xfe, 0xac, 0x61, 0xd5, 0x8b, 0xf4, 0xc6, 0xe8, 0x90, 0x5f,
+	0x2b, 0xe3, 0xe8, 0x50, 0x9e, 0xe2, 0x4c, 0x3f, 0x64, 0x07, 0xe9, 0x5e, 0x22, 0x27, 0xb7, 0x22,
+	0x8a, 0xd6, 0x9c, 0x08, 0xc1, 0xb6, 0x15, 0xa2, 0x8b, 0x69, 0x00, 0xc8, 0x3e, 0xe3, 0xde, 0x20,
+	0x0f, 0x1b, 0x8d, 0xe6, 0x3c, 0x70, 0xce, 0xfd, 0x0a, 0x81, 0xed, 0xe1, 0x8b, 0xb9, 0x50, 0x50,
+	0xf0, 0xb4, 0xcd, 0x24, 0xeb, 0x03, 0x30, 0xc9, 0x67, 0xc8, 0x99, 0x66, 0x76, 0x66, 0x6e, 0x27,
+	0xfd, 0xcd, 0x84, 0xf3, 0xf1, 0xc9, 0x85, 0xef, 0x13, 0x08, 0xce, 0x2c, 0x16, 0x01, 0x42, 0x31,
+	0x0e, 0xf7, 0x43, 0x64, 0x92, 0xda, 0x30, 0xf8, 0x55, 0x12, 0x91, 0xeb, 0x37, 0xa2, 0xb7, 0x43,
+	0x6b, 0xf0, 0x1c, 0xad, 0x96, 0x4c, 0xa2, 0x81, 0x4a, 0x26, 0x49, 0xd1, 0xbd, 0x43, 0x26, 0xba,
+	0x78, 0x62, 0x22, 0x32, 0xfc, 0x46, 0x76, 0xec, 0x2b, 0xe2, 0xec, 0x1c, 0xc6, 0xa8, 0x97, 0xc0,
+	0x89, 0x80, 0xa4, 0x86, 0xba, 0x1a, 0xa5, 0xd0, 0x8d, 0x3a, 0x01, 0x66, 0xe7, 0x1d, 0xd3, 0xba,
+	0xda, 0xa2, 0x6a, 0x05, 0x03, 0x22, 0x23, 0xcb, 0x35, 0xd8, 0xec, 0xc9, 0x7d, 0x64, 0xb9, 0x81,
+	0xad, 0xe8, 0x79, 0x14, 0x36, 0xcc, 0xad, 0x78, 0x93, 0xbe, 0x38, 0xfa, 0xf1, 0xa5, 0xb9, 0x3d,
+	0x63, 0x0b, 0x9b, 0x95, 0x1c, 0x18, 0xc8, 0x7d, 0x32, 0x2d, 0x59, 0x8f, 0xdf, 0x9b, 0x64, 0x3d,
+	0x31, 0x80, 0x64, 0x6d, 0x90, 0xd3, 0x6c, 0x04, 0x42, 0x4b, 0x96, 0x4e, 0xcb, 0x64, 0xd6, 0x65,
+	0x83, 0x57, 0xc9, 0x31, 0x2b, 0x79, 0x40, 0x90, 0xff, 0xec, 0xd9, 0x77, 0x90, 0x93, 0x19, 0x26,
+	0x37, 0x94, 0x43, 0x72, 0x89, 0x3c, 0x9c, 0xcf, 0x4e, 0x86, 0x72, 0x4b, 0xfe, 0x72, 0x2a, 0xa8,
+	0xdd, 0x30, 0xd1, 0x06, 0x70, 0x71, 0xfb, 0xa4, 0x1a, 0x74, 0x6e, 0x0b, 0xe9, 0x7a, 0x71, 0xb4,
+	0x55, 0x4d, 0x37, 0x2b, 0xe7, 0x86, 0xcc, 0x8f, 0x47, 0x7f, 0x01, 0xe2, 0x76, 0x7f, 0xd2, 0xb1,
+	0x0c, 0x08, 0xee, 0x18, 0xff, 0xc0, 0xa1, 0xd8, 0xa4, 0x03, 0xdb, 0x14, 0xde, 0xbf, 0xa9, 0x90,
+	0xc7, 0x0f, 0x42, 0x32, 0xc0, 0xf4, 0x3d, 0x81, 0x51, 0xf5, 0x18, 0xa6, 0x22, 0xc4, 0xd5, 0x14,
+	0xee, 0x62, 0x1e, 0xb8, 0xf2, 0x0c, 0x88, 0x2e, 0xb7, 0x4d, 0xaa, 0xbb, 0x7e, 0x57, 0xf8, 0x4b,
+	0x97, 0x47, 0x4d, 0xfe, 0xc3, 0xdf, 0x7e, 0x7b, 0xd5, 0xef, 0xf2, 0x35, 0x6f, 0x34, 0x00, 0x92,
+	0x71, 0x7b, 0xa4, 0xe6, 0xc7, 0xb1, 0x2f, 0x63, 0x22, 0xae, 0x96, 0x43, 0x6f, 0x1e, 0x51, 0xf2,
+	0x23, 0x65, 0xab, 0x09, 0x38, 0x31, 0xef, 0x0b, 0x93, 0x56, 0xa6, 0x18, 0x0b, 0x74, 0x49, 0xe8,
+	0xe4, 0x70, 0x37, 0xa9, 0x53, 0x76, 0xce, 0x25, 0x4f, 0xc5, 0x66, 0x1e, 0x08, 0x51, 0xd0, 0x42,
+	0x90, 0x72, 0x3f, 0xed, 0xb0, 0xb2, 0x11, 0x32, 0xfd, 0x4e, 0x58, 0xf5, 0x87, 0x53, 0xc5, 0xc2,
+	0x2c, 0x46, 0x21, 0x1b, 0xc1, 0xa4, 0x2e, 0x4a, 0xe3, 0x30, 0x6b, 0x26, 0x5b, 0x1a, 0x87, 0x59,
+	0x27, 0xb2, 0xdf, 0xbd, 0x9b, 0x13, 0xd0, 0x52, 0x42, 0xe9, 0x81, 0x01, 0x42, 0x58, 0xbe, 0x42,
+	0x35, 0xa9, 0x30, 0x1d, 0x99, 0x20, 0x6c, 0xe0, 0x9b, 0xe5, 0xf8, 0x34, 0xb3, 0x81, 0x0f, 0x4a,
+	0xd1, 0xc9, 0x74, 0x41, 0x76, 0x30, 0x6e, 0x8b, 0x8c, 0x85, 0x9d, 0xad, 0x48, 0xa8, 0x77, 0x0b,
+	0xa3, 0x0d, 0x6a, 0x99, 0x62, 0xd2, 0xbb, 0x19, 0x7f, 0x01, 0xc3, 0xee, 0xae, 0x90, 0x53, 0x32,
+	0x59, 0xe8, 0x72, 0x98, 0xa0, 0x2f, 0x69, 0x25, 0xdc, 0x0d, 0x7b, 0x4c, 0x35, 0xab, 0x2e, 0xcc,
+	0xa2, 0x78, 0x83, 0x9c, 0x7e, 0xc8, 0x7d, 0xca, 0x7d, 0x9e, 0x4c, 0xc8, 0x68, 0x80, 0xc9, 0x32,
+	0xfc, 0x09, 0xd9, 0xf5, 0xaf, 0x16, 0x53, 0x43, 0x84, 0x03, 0x48, 0x82, 0xee, 0x27, 0x1d, 0x32,
+	0xc3, 0xff, 0xbe, 0xbc, 0xd7, 0xe2, 0xf9, 0x89, 0xf5, 0x32, 0x42, 0xfe, 0x1b, 0x16, 0xce, 0x05,
+	0x17, 0x9d, 0x19, 0x76, 0x1b, 0xa4, 0xe8, 0x7a, 0xff, 0x68, 0x9a, 0x64, 0xe3, 0x27, 0xec, 0x60,
+	0x09, 0xe7, 0xa8, 0x83, 0x25, 0xd0, 0xaa, 0x4c, 0x74, 0x9c, 0x43, 0x09, 0xdb, 0x4c, 0x50, 0xd5,
+	0xc7, 0xd0, 0x18, 0xd1, 0xc0, 0x68, 0xb8, 0x7d, 0x32, 0xce, 0x2b, 0x53, 0x09, 0x09, 0x30, 0xfa,
+	0xc9, 0xb7, 0x59, 0xe1, 0x4a, 0xbb, 0xb5, 0x78, 0x2b, 0x08, 0x62, 0x94, 0x9f, 0x4c, 0xec, 0xf0,
+	0xe5, 0x28, 0x6c, 0xbd, 0xd5, 0x51, 0xe7, 0xd7, 0x5a, 0xe3, 0x7a, 0xf1, 0x89, 0x06, 0x90, 0xe4,
+	0x58, 0x6c, 0x9e, 0x11, 0x3d, 0xc4, 0x19, 0x49, 0x79, 0xa9, 0x96, 0x83, 0x87, 0x0e, 0x7d, 0x90,
+	0x4c, 0xc7, 0x01, 0xfd, 0xdd, 0x0c, 0xdb, 0x41, 0x6b, 0x5e, 0x1e, 0x88, 0x0d, 0x93, 0x61, 0xc7,
+	0xbc, 0x49, 0x60, 0xe0, 0x00, 0x0b, 0x23, 0xdb, 0x67, 0x2a, 0xeb, 0x1e, 0x3f, 0x48, 0x20, 0x0e,
+	0x3e, 0x56, 0x4a, 0xca, 0xf1, 0x67, 0x38, 0xf9, 0x3e, 0xb3, 0xdb, 0x20, 0x45, 0xd7, 0x7d, 0x0f,
+	0x21, 0xd1, 0x26, 0x0f, 0xc0, 0xa3, 0xaf, 0x3a, 0x39, 0xf4, 0xab, 0xce, 0xf0, 0x4c, 0x5d, 0x89,
+	0x01, 0x0c, 0x6c, 0xee, 0x55, 0x2a, 0x9b, 0xd8, 0xce, 0xc1, 0x63, 0x4a, 0x61, 0x10, 0xca, 0x14,
+	0x49, 0xd2, 0x50, 0x3d, 0x2f, 0x52, 0x15, 0x3a, 0xc3, 0xa5, 0x58, 0x94, 0x91, 0xf1, 0xb8, 0xfb,
+	0x43, 0x94, 0x2f, 0xf6, 0x77, 0x77, 0x7d, 0x75, 0x46, 0x52, 0x62, 0xee, 0x2f, 0xc7, 0x6b, 0x30,
+	0x46, 0xde, 0x00, 0x92, 0x22, 0xdd, 0xf8, 0xa7, 0x24, 0x17, 0x10, 0xbb, 0x88, 0x6b, 0x28, 0xdc,
+	0x13, 0xf8, 0x06, 0x69, 0xc5, 0x40, 0x0e, 0x0c, 0x86, 0xe8, 0xd8, 0xed, 0x2b, 0x91, 0xc8, 0xc6,
+	0xcd, 0xc5, 0xe9, 0x5e, 0x91, 0x45, 0xb8, 0xf0, 0xb5, 0x65, 0x6d, 0x98, 0xd7, 0xe8, 0x22, 0x5c,
+	0xac, 0xb9, 0x78, 0xce, 0xcc, 0x87, 0xdd, 0x55, 0xf2, 0x10, 0x5d, 0x76, 0x3d, 0x0c, 0x91, 0xe2,
+	0x05, 0xfa, 0xb8, 0x6d, 0xce, 0xcf, 0x50, 0x1e, 0x15, 0xc3, 0x7e, 0x68, 0x31, 0x0b, 0x02, 0x79,
+	0xcf, 0xa1, 0x4e, 0x9e, 0x96, 0x0f, 0x33, 0xa5, 0x1c, 0xaf, 0x5b, 0x38, 0x05, 0x87, 0x52, 0x6e,
+	0xef, 0x03, 0x24, 0x45, 0xc7, 0x3e, 0x64, 0x15, 0x5f, 0xec, 0xf5, 0x64, 0x1a, 0xd3, 0x18, 0x62,
+	0xaa, 0x71, 0x5e, 0x87, 0x15, 0x79, 0x60, 0xc1, 0x36, 0xe6, 0x05, 0xa3, 0x1d, 0x2c, 0x28, 0x4c,
+	0x7b, 0x17, 0x5e, 0x32, 0x23, 0xed, 0x9d, 0x7b, 0xc9, 0xa4, 0x4f, 0xcc, 0xfb, 0xd4, 0xb8, 0xa5,
+	0xb3, 0xde, 0x97, 0x23, 0x5d, 0x56, 0x5f, 0x49, 0x16, 0xa2, 0x62, 0x1d, 0xc2, 0x16, 0x2b, 0x93,
+	0xb2, 0x8a, 0x9a, 0x5b, 0x33, 0x09, 0x81, 0x4d, 0xd7, 0xbd, 0x45, 0x6a, 0x3b, 0x11, 0xba, 0x9e,
+	0xab, 0x65, 0x18, 0x83, 0x97, 0x29, 0x2a, 0xa6, 0x68, 0xa9, 0xd7, 0xc6, 0x16, 0xfa, 0xda, 0x8c,
+	0x06, 0xda, 0xfe, 0xc9, 0x8e, 0x1f, 0xb7, 0x92, 0x45, 0x56, 0xa4, 0x62, 0x8c, 0x69, 0x58, 0x4a,
+	0x9f, 0x6e, 0xe8, 0x2e, 0x30, 0xe1, 0xdc, 0x8f, 0x3b, 0xc8, 0xd2, 0x77, 0xa3, 0xdb, 0x72, 0xb2,
+	0x6a, 0x65, 0x94, 0x89, 0x50, 0xa1, 0x15, 0xc1, 0x96, 0xf6, 0x52, 0x82, 0x41, 0x06, 0x2c, 0xa2,
+	0xee, 0xcf, 0x3a, 0xc8, 0x45, 0x58, 0x83, 0x35, 0xa3, 0x42, 0x3d, 0x2d, 0x71, 0x34, 0x8f, 0x69,
+	0x86, 0x94, 0x25, 0x07, 0xb9, 0x83, 0xc0, 0x0d, 0x23, 0xda, 0xd9, 0x8c, 0x8b, 0x90, 0xeb, 0x13,
+	0xc6, 0x3b, 0xf1, 0x2f, 0x61, 0x41, 0x79, 0x7f, 0xe2, 0x58, 0xe7, 0x85, 0x37, 0x59, 0x2e, 0xc7,
+	0xed, 0xa0, 0x83, 0xcc, 0xdf, 0x8c, 0x1e, 0x7d, 0x63, 0x2a, 0x33, 0xfe, 0xd5, 0x45, 0x55, 0x4a,
+	0xef, 0x20, 0x86, 0x39, 0x86, 0xc2, 0x08, 0x34, 0xfd, 0xa8, 0x63, 0x97, 0x38, 0xa8, 0x94, 0x61,
+	0x14, 0x9b, 0x65, 0x3e, 0x0e, 0xac, 0x96, 0xe0, 0x51, 0xde, 0x37, 0xb1, 0xe0, 0x37, 0x6f, 0x45,
+	0x5b, 0x5b, 0x78, 0x40, 0xd5, 0xea, 0xc7, 0x66, 0xb5, 0x05, 0xe5, 0x06, 0x5c, 0x12, 0xed, 0xa0,
+	0x20, 0x90, 0xa9, 0x6c, 0xf9, 0x4d, 0x59, 0xec, 0xa3, 0xca, 0x99, 0xca, 0x45, 0xd6, 0x02, 0xa2,
+	0x07, 0x17, 0xf6, 0xae, 0x7f, 0x57, 0x3e, 0x9c, 0x3e, 0xac, 0x5c, 0xd5, 0x5d, 0x60, 0xc2, 0x79,
+	0xff, 0xc2, 0x21, 0xb3, 0x0b, 0x7e, 0x12, 0x36, 0xb1, 0x72, 0xeb, 0x42, 0xd8, 0xdb, 0xec, 0x37,
+	0x6f, 0x05, 0x3d, 0x5e, 0x14, 0x06, 0x47, 0xd9, 0x4f, 0x90, 0xb7, 0x29, 0x5f, 0x84, 0x1a, 0xe5,
+	0x75, 0xd1, 0x0e, 0x0a, 0x82, 0xda, 0x1d, 0x53, 0x78, 0xc4, 0x77, 0x27, 0x8a, 0x5b, 0x74, 0x09,
+	0x95, 0x53, 0x36, 0xaa, 0x11, 0x34, 0x63, 0x0c, 0xf2, 0xd8, 0x12, 0xa1, 0x3f, 0x1a, 0x3f, 0x98,
+	0xc4, 0xbc, 0x1f, 0xa7, 0x3b, 0x63, 0x21, 0xf0, 0xe3, 0x20, 0x66, 0x55, 0xa6, 0xd4, 0x8b, 0xb8,
+	0xcf, 0x91, 0xc9, 0x1e, 0xb6, 0xe0, 0x88, 0x9c, 0x72, 0x47, 0xc4, 0x82, 0x76, 0x36, 0x04, 0x72,
+	0x50, 0x64, 0xbc, 0xcf, 0x3a, 0xe4, 0x4c, 0xde, 0x58, 0x16, 0xdb, 0x51, 0xbf, 0x75, 0x3f, 0x06,
+	0xf4, 0x77, 0x28, 0xf3, 0x62, 0x81, 0x10, 0x4b, 0x54, 0xef, 0x0a, 0xdb, 0x99, 0x0a, 0x97, 0xce,
+	0x80, 0x15, 0x2e, 0x1f, 0x27, 0x63, 0x3b, 0xd1, 0x6e, 0x90, 0x0e, 0xe2, 0xb9, 0x1c, 0xa1, 0x5b,
+	0x0a, 0x7b, 0xd0, 0x45, 0xba, 0xeb, 0x87, 0x1d, 0x4a, 0xa5, 0x23, 0x5d, 0x6e, 0xc2, 0x45, 0xba,
+	0xaa, 0x9b, 0xc1, 0x84, 0xf1, 0x7e, 0xa3, 0x4e, 0x26, 0x44, 0xc4, 0xd9, 0xc0, 0x45, 0x8a, 0xa4,
+	0x7f, 0xac, 0x52, 0xe8, 0x1f, 0x4b, 0xc8, 0x78, 0x93, 0x95, 0x21, 0x16, 0xb6, 0xcf, 0xd5, 0x52,
+	0x42, 0x14, 0x79, 0x65, 0x63, 0x3d, 0x2c, 0xfe, 0x1b, 0x04, 0x29, 0xf7, 0xf3, 0x0e, 0x39, 0xde,
+	0xc4, 0x83, 0xbe, 0xa6, 0xd6, 0xca, 0xc7, 0xca, 0x30, 0xbd, 0x16, 0x6d, 0xa4, 0xfa, 0x8c, 0x3d,
+	0xd5, 0x01, 0x69, 0xf2, 0x18, 0xce, 0xce, 0xe7, 0xec, 0x86, 0x75, 0xba, 0xa5, 0x0b, 0x1f, 0x9a,
+	0x9d, 0x60, 0xc3, 0xe2, 0x21, 0x40, 0x47, 0x97, 0x18, 0x1c, 0xd7, 0x87, 0x00, 0x46, 0x71, 0x41,
+	0x03, 0x02, 0xcb, 0x8b, 0xc4, 0xc1, 0x16, 0x55, 0x49, 0x77, 0x44, 0x44, 0x1e, 0xb3, 0x08, 0x26,
+	0xee, 0xad, 0xbc, 0x08, 0x64, 0x30, 0x41, 0x0e, 0x76, 0xaa, 0x3c, 0x70, 0x07, 0xcd, 0x64, 0x19,
+	0xfc, 0x5c, 0x7c, 0xe6, 0x42, 0x3f, 0xcd, 0x39, 0x52, 0x63, 0x4a, 0x01, 0xb3, 0x44, 0xaa, 0x3c,
+	0xa5, 0x95, 0xa9, 0x0c, 0xc0, 0xdb, 0xdd, 0x25, 0x72, 0x22, 0x55, 0xb6, 0x31, 0x11, 0xa7, 0x50,
+	0x2a, 0x7d, 0x31, 0x55, 0xf0, 0x31, 0x81, 0xcc, 0x13, 0xa6, 0xf3, 0x6e, 0xea, 0x00, 0xe7, 0xdd,
+	0x9e, 0x8a, 0xfb, 0xe6, 0xe7, 0x43, 0xef, 0x2c, 0x65, 0x02, 0x06, 0x0a, 0xf2, 0xfe, 0x89, 0x54,
+	0x90, 0xf7, 0x31, 0x36, 0x80, 0x1b, 0xe5, 0x0c, 0x60, 0xf8, 0x88, 0xee, 0xfb, 0x19, 0xa1, 0xfd,
+	0xbf, 0x1d, 0x22, 0xbf, 0xeb, 0x22, 0x5d, 0xdb, 0x01, 0x2e, 0x19, 0x0c, 0x68, 0x54, 0x7e, 0x1f,
+	0xae, 0x6c, 0x3a, 0x6c, 0xd5, 0x28, 0xab, 0x04, 0xac, 0x5e, 0x48, 0x41, 0xe3, 0x59, 0x28, 0xce,
+	0x13, 0x7f, 0x94, 0xcb, 0x7d, 0xe5, 0x5b, 0x9a, 0x5f, 0x5f, 0x16, 0x4f, 0x69, 0x18, 0x6a, 0x42,
+	0x9c, 0xc4, 0xfa, 0x3b, 0x6c, 0x04, 0xe8, 0x06, 0xba, 0xc7, 0xe2, 0x3e, 0x2c, 0x47, 0x6e, 0x25,
+	0x8d, 0x08, 0xb2, 0xb8, 0xbd, 0x7f, 0x57, 0x23, 0xc7, 0x2c, 0xce, 0x38, 0xa4, 0xc2, 0x40, 0xa1,
+	0xa5, 0x0c, 0x4f, 0x57, 0x31, 0x53, 0x82, 0x5e, 0x41, 0xa0, 0xd0, 0xda, 0xd4, 0x52, 0x35, 0xad,
+	0xe0, 0x18, 0x02, 0x17, 0x4c, 0x38, 0xc6, 0x94, 0x7b, 0xed, 0x64, 0xb1, 0x1d, 0x52, 0x85, 0x90,
+	0x0f, 0xb3, 0x1c, 0xa6, 0xbc, 0xb1, 0xd2, 0x30, 0x91, 0x6a, 0xa6, 0x9c, 0xea, 0x80, 0x34, 0x79,
+	0xf7, 0x47, 0xa9, 0xe9, 0xe5, 0xdf, 0x49, 0x74, 0xad, 0x7c, 0x11, 0xce, 0x3d, 0xa2, 0x90, 0xb2,
+	0xca, 0xef, 0xf3, 0x23, 0x13, 0xab, 0x09, 0x6c, 0xa2, 0x98, 0xb2, 0xe3, 0x06, 0x77, 0x83, 0xa6,
+	0x0c, 0x38, 0x17, 0x63, 0x19, 0x2f, 0xc3, 0x37, 0x72, 0x21, 0x83, 0x97, 0x73, 0xf5, 0x6c, 0x3b,
+	0xe4, 0x8c, 0xc1, 0xbd, 0x42, 0xdc, 0x56, 0x98, 0xf8, 0x9b, 0x6d, 0x8c, 0x11, 0x90, 0x79, 0xdd,
+	0x22, 0x52, 0xe1, 0xac, 0x98, 0x67, 0x77, 0x29, 0x03, 0x01, 0x39, 0x4f, 0xb1, 0x55, 0x16, 0x47,
+	0x77, 0xf7, 0xae, 0xc7, 0x6d, 0x26, 0x25, 0xcc, 0x55, 0x26, 0xda, 0x41, 0x41, 0x78, 0x7f, 0x5a,
+	0x55, 0x5b, 0x59, 0x67, 0x57, 0xf8, 0x46, 0x94, 0xb7, 0x73, 0xef, 0x51, 0xde, 0x3a, 0x06, 0x2d,
+	0x5b, 0xad, 0xc0, 0x4a, 0x6e, 0xae, 0xdc, 0xa7, 0xe4, 0x66, 0x3a, 0x08, 0xb3, 0x52, 0xe0, 0xd4,
+	0x53, 0xef, 0x29, 0x37, 0xb3, 0x63, 0x8e, 0xc7, 0xc7, 0xa5, 0xe4, 0x4a, 0x2a, 0x2c, 0x92, 0x7e,
+	0xaf, 0x2d, 0x3a, 0x1a, 0xcc, 0x38, 0x61, 0x1b, 0xd5, 0x88, 0xdd, 0xbb, 0x28, 0xda, 0x41, 0x41,
+	0x20, 0xd7, 0x37, 0x90, 0x0e, 0xc5, 0xb5, 0xff, 0x53, 0x95, 0x4c, 0x19, 0x12, 0x3f, 0x57, 0x7d,
+	0x73, 0x1e, 0x30, 0xf5, 0xad, 0x32, 0x84, 0xfa, 0xf6, 0xc3, 0xa4, 0xde, 0x94, 0xd2, 0xa8, 0x9c,
+	0x9b, 0x0f, 0xd2, 0x32, 0x4e, 0x0b, 0x24, 0xd5, 0x04, 0x9a, 0x26, 0x86, 0x1b, 0x99, 0x29, 0x84,
+	0xa6, 0xc7, 0x25, 0x2f, 0xc3, 0x55, 0x48, 0xb4, 0xec, 0x33, 0xe9, 0xc8, 0x8b, 0xda, 0xc1, 0x91,
+	0x17, 0x58, 0x88, 0x56, 0x7e, 0xdc, 0x23, 0xa8, 0x94, 0xf4, 0xac, 0x5d, 0x29, 0xe9, 0x42, 0x29,
+	0xd3, 0x5c, 0x50, 0x22, 0xe9, 0x1a, 0xb5, 0x97, 0xa2, 0xdd, 0x5d, 0xbf, 0xd3, 0x72, 0xbf, 0x9f,
+	0x4c, 0x34, 0xf9, 0x9f, 0xc2, 0x3b, 0xc9, 0xc2, 0x00, 0x44, 0x2f, 0xc8, 0x3e, 0x0c, 0x2f, 0xa4,
+	0xb4, 0xa5, 0x47, 0x92, 0x85, 0x17, 0xce, 0xd3, 0xdf, 0xc0, 0x5a, 0xbd, 0xff, 0xe9, 0x90, 0x19,
+	0x7c, 0x24, 0x64, 0x2f, 0xc5, 0x5e, 0x87, 0xda, 0x61, 0x3e, 0x95, 0x13, 0x51, 0xc6, 0x0e, 0x9b,
+	0x67, 0xad, 0x20, 0x7a, 0xd1, 0x0e, 0x53, 0x25, 0x36, 0x0c, 0x3b, 0x6c, 0x09, 0xd7, 0x32, 0xeb,
+	0x41, 0x55, 0x36, 0xe9, 0x6f, 0xe6, 0x9d, 0x43, 0x37, 0x78, 0x33, 0xc8, 0x7e, 0x44, 0xb6, 0x19,
+	0xb5, 0xf6, 0x44, 0xd0, 0xb4, 0x42, 0xb6, 0x40, 0xdb, 0x80, 0xf5, 0x60, 0xfc, 0x3e, 0x55, 0xb3,
+	0x65, 0xc4, 0x83, 0x8c, 0xdf, 0x6f, 0x5c, 0x9e, 0x07, 0x6c, 0x57, 0xe9, 0x28, 0x94, 0xcf, 0x8f,
+	0xef, 0x97, 0x8e, 0x42, 0xb9, 0xfc, 0x3f, 0x1b, 0x23, 0x2c, 0x92, 0x89, 0xaa, 0x09, 0xad, 0x8d,
+	0x88, 0x15, 0x69, 0x3e, 0xd4, 0x80, 0x01, 0x6d, 0xc8, 0x3e, 0xc8, 0x41, 0x03, 0xc6, 0xc1, 0x71,
+	0xf5, 0xa8, 0x0f, 0x8e, 0xf3, 0x63, 0x01, 0xc6, 0x1e, 0xa0, 0x58, 0x00, 0xef, 0x33, 0x54, 0x5f,
+	0x52, 0x71, 0x69, 0x3a, 0x58, 0x87, 0xea, 0xe9, 0x2a, 0x10, 0x4e, 0xec, 0x17, 0xcd, 0x16, 0x65,
+	0x07, 0x68, 0x98, 0x01, 0xbc, 0x17, 0x4f, 0x48, 0x99, 0x55, 0xb5, 0xb3, 0x59, 0x98, 0xa4, 0x13,
+	0x22, 0xcc, 0xfb, 0xcd, 0x0a, 0x86, 0x71, 0xa1, 0xba, 0xb4, 0xea, 0x77, 0xfc, 0xed, 0x60, 0x17,
+	0x47, 0x35, 0x68, 0xf8, 0x55, 0x13, 0xcd, 0xe6, 0x50, 0xe6, 0x9e, 0x8c, 0xca, 0xaf, 0x38, 0x9f,
+	0xe1, 0x9c, 0x65, 0x99, 0xa2, 0x05, 0x86, 0xdc, 0x4d, 0xc8, 0xa4, 0xbc, 0x26, 0x4a, 0xc8, 0x9f,
+	0x92, 0x08, 0x29, 0x56, 0x2c, 0x34, 0x0b, 0xaa, 0xc3, 0x48, 0x42, 0xa8, 0x3e, 0xb4, 0xa3, 0xe6,
+	0x2d, 0xdc, 0xf2, 0x69, 0xf5, 0x61, 0x45, 0xb4, 0x83, 0x82, 0xf0, 0x76, 0xc9, 0x71, 0x39, 0x87,
+	0x5d, 0xac, 0xae, 0x1c, 0x6c, 0xa1, 0xcc, 0x6d, 0xca, 0x26, 0xe3, 0xe6, 0x2a, 0x25, 0x73, 0x17,
+	0xcd, 0x4e, 0xb0, 0x61, 0x65, 0xdd, 0xe6, 0x4a, 0x7e, 0xdd, 0x66, 0xef, 0x37, 0x1d, 0x92, 0x16,
+	0xfa, 0x46, 0x95, 0x5a, 0x67, 0xdf, 0x2a, 0xb5, 0x43, 0xd4, 0x79, 0x7d, 0x1f, 0x95, 0x97, 0x3d,
+	0xd4, 0xea, 0xb8, 0x07, 0xa6, 0x7a, 0x6f, 0x67, 0xb2, 0xab, 0x51, 0x2b, 0xdc, 0x0a, 0x99, 0xe7,
+	0xc5, 0x44, 0xe7, 0x51, 0xbb, 0xa1, 0xbe, 0x14, 0xef, 0x0d, 0x9f, 0x04, 0x98, 0x4d, 0xf1, 0xab,
+	0x0c, 0x95, 0xe2, 0x27, 0x93, 0x08, 0xab, 0x45, 0x49, 0x84, 0xde, 0x9f, 0x8f, 0x91, 0x93, 0x99,
+	0xac, 0x56, 0xf7, 0x4d, 0x18, 0x82, 0x2e, 0xbe, 0x92, 0x74, 0xbb, 0xd6, 0xcd, 0xb0, 0x70, 0xdd,
+	0x07, 0x16, 0xe4, 0x00, 0x5b, 0x75, 0x99, 0x3c, 0x14, 0xa3, 0x3b, 0xaa, 0x1f, 0xcc, 0x6f, 0x51,
+	0x6e, 0xd0, 0xc0, 0x43, 0xfa, 0x16, 0x2f, 0xf3, 0x5c, 0x5d, 0x78, 0x04, 0xcf, 0x46, 0x21, 0xdb,
+	0x0d, 0x79, 0xcf, 0xb8, 0x5d, 0x72, 0xac, 0x6d, 0xda, 0x0b, 0xc2, 0x4c, 0xbd, 0x27, 0x53, 0x43,
+	0xad, 0x56, 0xab, 0x19, 0x6c, 0x02, 0xb6, 0xd1, 0x51, 0xbb, 0x4f, 0x46, 0xc7, 0xc7, 0xb5, 0xd1,
+	0xc1, 0x8f, 0xb1, 0xde, 0x5b, 0x72, 0x56, 0xf3, 0x20, 0x56, 0xc7, 0x28, 0x76, 0xc4, 0x3b, 0xc9,
+	0xa4, 0x8c, 0x40, 0x1d, 0x28, 0x72, 0xd3, 0xc4, 0x53, 0xc0, 0xdb, 0x5f, 0xac, 0x90, 0x1c, 0x53,
+	0x19, 0xf7, 0x9a, 0xd6, 0xf7, 0xac, 0xbd, 0x36, 0x9c, 0xce, 0xe7, 0xde, 0xe5, 0xd1, 0xb7, 0x5c,
+	0xca, 0xbf, 0xbb, 0x6c, 0x53, 0x5f, 0x07, 0xe4, 0x2a, 0x0e, 0xa8, 0x82, 0x72, 0x9f, 0x22, 0x44,
+	0xab, 0xe9, 0x42, 0xd7, 0x53, 0xe1, 0x34, 0x5a, 0x9b, 0x07, 0x03, 0x0a, 0x3d, 0x3f, 0x61, 0x87,
+	0x72, 0xc1, 0x76, 0xfb, 0x72, 0xd8, 0xe9, 0x09, 0xfd, 0x4f, 0xa9, 0x33, 0xcb, 0xba, 0x0b, 0x4c,
+	0xb8, 0xb3, 0x6f, 0x30, 0xbe, 0xcb, 0x30, 0xdf, 0x73, 0x87, 0x9c, 0xb9, 0x14, 0xf6, 0x54, 0x5a,
+	0xa7, 0x5a, 0x47, 0xa8, 0x85, 0x2b, 0x1e, 0xe4, 0x14, 0x26, 0x32, 0x1b, 0x69, 0x95, 0x15, 0x3b,
+	0x0b, 0x34, 0x9d, 0x56, 0xe9, 0x35, 0xc9, 0x29, 0x4a, 0x09, 0x53, 0xd6, 0x0e, 0x91, 0xc8, 0xd7,
+	0xc6, 0xc9, 0xb4, 0x59, 0xed, 0x60, 0x18, 0x8e, 0x8d, 0xe5, 0x79, 0x64, 0x7e, 0x6f, 0xa8, 0x42,
+	0x04, 0x6e, 0x8e, 0x5c, 0x7a, 0x21, 0x7f, 0x72, 0x0d, 0x15, 0x55, 0xd3, 0x04, 0x73, 0x00, 0x54,
+	0x53, 0xaf, 0x6d, 0xb1, 0x0c, 0xc1, 0x6a, 0x19, 0xc1, 0x5d, 0x79, 0x93, 0xaf, 0x77, 0x24, 0xcf,
+	0x31, 0xe4, 0xf4, 0x50, 0xad, 0x88, 0xed, 0xc4, 0x74, 0x23, 0x6f, 0x43, 0xc8, 0x2b, 0x05, 0x51,
+	0x24, 0x15, 0x6a, 0xf7, 0x20, 0x15, 0x2c, 0x1e, 0x3d, 0x7e, 0x9f, 0x78, 0x34, 0xcb, 0xf6, 0xec,
+	0xed, 0x30, 0xa5, 0x57, 0x24, 0x9a, 0x4d, 0xb0, 0x49, 0x30, 0xb2, 0x3d, 0xad, 0x6e, 0x48, 0xc3,
+	0xbb, 0x1f, 0x51, 0x5c, 0x7e, 0xb2, 0x8c, 0x83, 0x02, 0x73, 0x45, 0x1f, 0x36, 0x83, 0xff, 0x4c,
+	0x85, 0xcc, 0x5c, 0xea, 0xf4, 0xd7, 0x2f, 0xad, 0xf7, 0x37, 0xe9, 0x48, 0xa8, 0x36, 0x87, 0x5c,
+	0x9c, 0x3e, 0xb3, 0xbc, 0x24, 0x76, 0x90, 0x5a, 0x33, 0x57, 0xb1, 0x11, 0x78, 0x1f, 0xf2, 0xad,
+	0xad, 0xb0, 0xb3, 0x1d, 0xc4, 0xdd, 0x38, 0x14, 0x3e, 0x7c, 0x83, 0x6f, 0x5d, 0xd4, 0x5d, 0x60,
+	0xc2, 0x21, 0xee, 0xe8, 0x4e, 0x47, 0x95, 0x9e, 0x52, 0xb8, 0xd7, 0xb0, 0x11, 0x78, 0x1f, 0x02,
+	0xf5, 0xe2, 0xbe, 0x70, 0x91, 0x19, 0x40, 0x1b, 0xd8, 0x08, 0xbc, 0x4f, 0x58, 0xdf, 0x2c, 0x76,
+	0xae, 0x96, 0xb1, 0xbe, 0x59, 0x70, 0x84, 0xec, 0x47, 0x50, 0x3a, 0xe8, 0x25, 0x74, 0x8f, 0xa4,
+	0x8c, 0xe7, 0xab, 0xbc, 0x19, 0x64, 0x3f, 0xab, 0x45, 0x6d, 0x4f, 0xc7, 0x77, 0x5d, 0x2d, 0x6a,
+	0x7b, 0xf8, 0x05, 0x8e, 0x96, 0xbf, 0x5d, 0x21, 0xd3, 0x2f, 0x5d, 0x18, 0x9b, 0x73, 0x15, 0xd2,
+	0x4d, 0x72, 0x32, 0x93, 0x63, 0x3e, 0x80, 0xe6, 0x73, 0x60, 0x0d, 0x10, 0x0f, 0xc8, 0x14, 0x22,
+	0x96, 0x35, 0x18, 0x17, 0xc9, 0x49, 0xbe, 0x79, 0x91, 0x12, 0x4b, 0x19, 0x56, 0x75, 0x03, 0xd8,
+	0x21, 0xd5, 0x8d, 0x74, 0x27, 0x64, 0xe1, 0xf1, 0xa2, 0x9d, 0x63, 0x56, 0xda, 0x7f, 0x49, 0x3a,
+	0x1a, 0xdb, 0xdd, 0x11, 0x8b, 0xfb, 0x66, 0x79, 0x38, 0x55, 0x26, 0x86, 0xf5, 0xee, 0xd6, 0x5d,
+	0x60, 0xc2, 0x79, 0xbf, 0x5d, 0x25, 0x93, 0x32, 0x46, 0x6d, 0x80, 0xa1, 0x7c, 0x9a, 0x0e, 0x5f,
+	0x1d, 0x0c, 0x32, 0x4f, 0x6e, 0xa5, 0x8c, 0x2c, 0x44, 0x1c, 0x81, 0xf2, 0x8b, 0xa0, 0x27, 0x57,
+	0x19, 0x0c, 0x60, 0x12, 0x03, 0x9b, 0xb6, 0x7b, 0x03, 0x73, 0x45, 0x12, 0xba, 0x3b, 0x0c, 0x9f,
+	0xb2, 0x67, 0xac, 0xb2, 0x39, 0xbc, 0x3c, 0x1b, 0xd7, 0x14, 0x46, 0x84, 0x35, 0x14, 0xa4, 0xd6,
+	0xf0, 0x74, 0x1b, 0x18, 0x98, 0xf0, 0x7e, 0x9c, 0xb6, 0x99, 0x1e, 0x0c, 0xe5, 0xc4, 0x00, 0x0e,
+	0x72, 0x8e, 0x3d, 0xc2, 0xb9, 0xb1, 0xf7, 0x8b, 0x15, 0x72, 0x22, 0x3d, 0x93, 0xee, 0x7b, 0x31,
+	0x0c, 0x4e, 0x5f, 0xb9, 0x98, 0x0a, 0x5f, 0x9b, 0x06, 0xa3, 0x8f, 0x72, 0x8c, 0x73, 0xd9, 0x9b,
+	0xc7, 0xe7, 0x4c, 0x10, 0xb0, 0x90, 0xf1, 0x43, 0x65, 0x11, 0xfd, 0xb0, 0xb0, 0x47, 0x25, 0xb9,
+	0x38, 0x19, 0x36, 0x0e, 0x95, 0xcd, 0x5e, 0x48, 0x41, 0x63, 0x32, 0xa5, 0xd1, 0x72, 0x2d, 0x08,
+	0xb7, 0x77, 0x36, 0xa3, 0x58, 0xda, 0xab, 0x46, 0xd4, 0x5f, 0x16, 0x06, 0x72, 0x9f, 0x44, 0xc5,
+	0xa8, 0xe9, 0x77, 0xfd, 0x66, 0xd8, 0xdb, 0x13, 0xbe, 0x7d, 0xc5, 0xc6, 0x17, 0x45, 0x3b, 0x28,
+	0x08, 0xef, 0xef, 0x8f, 0xd1, 0x19, 0x63, 0x71, 0xb7, 0x81, 0x0a, 0x2b, 0xa7, 0x33, 0x56, 0xa7,
+	0x8c, 0x2f, 0xe6, 0xce, 0x0a, 0x67, 0x68, 0xd6, 0xa5, 0x6b, 0x15, 0x48, 0x24, 0xa0, 0xf1, 0x61,
+	0x78, 0x3a, 0x15, 0xae, 0x61, 0xb2, 0xc3, 0xb0, 0x57, 0xee, 0xcd, 0x15, 0x72, 0x51, 0x61, 0x00,
+	0x03, 0x9b, 0xfb, 0x56, 0x52, 0xa3, 0xeb, 0x2d, 0x91, 0x7e, 0xba, 0x57, 0x49, 0x3e, 0xb1, 0x8e,
+	0x8d, 0x18, 0x60, 0x9d, 0x7e, 0x55, 0xd6, 0x01, 0xfc, 0x21, 0x93, 0xcb, 0x8f, 0x1d, 0x7c, 0x93,
+	0x51, 0x2b, 0xde, 0x6b, 0x5c, 0x9e, 0x4f, 0xdf, 0x7d, 0xb3, 0xc4, 0x5a, 0x41, 0xf4, 0x22, 0x4f,
+	0xda, 0xe1, 0x24, 0x5b, 0x08, 0x3c, 0x6e, 0x6b, 0x1c, 0x97, 0x75, 0x17, 0x98, 0x70, 0x58, 0x3e,
+	0x30, 0x1d, 0x95, 0x3d, 0x71, 0x08, 0x59, 0x3b, 0x83, 0xc6, 0x63, 0x5f, 0x20, 0x75, 0x31, 0xd4,
+	0x8d, 0x08, 0x9d, 0x37, 0xdc, 0x0d, 0xb4, 0x40, 0x85, 0x50, 0x73, 0x27, 0xed, 0xbc, 0xd9, 0x30,
+	0xfa, 0xc0, 0x82, 0xf4, 0x56, 0xc9, 0xd8, 0x80, 0x4c, 0x76, 0x20, 0x9b, 0x9c, 0x9a, 0xf9, 0x88,
+	0x4e, 0x1a, 0x68, 0x65, 0xa0, 0x8c, 0xc8, 0xa4, 0xbc, 0x17, 0xd3, 0xf5, 0x48, 0x35, 0xf4, 0x65,
+	0x8c, 0x88, 0xda, 0x42, 0xcb, 0x49, 0xd2, 0x67, 0xcb, 0x0e, 0x3b, 0x29, 0xd2, 0x6a, 0x70, 0xb7,
+	0x9b, 0x0e, 0x06, 0xb9, 0x70, 0xb7, 0x4b, 0x2d, 0xa4, 0x04, 0x81, 0x68, 0xaf, 0x7b, 0x96, 0x54,
+	0xc2, 0x96, 0x58, 0x91, 0x44, 0xc0, 0x54, 0xa8, 0x52, 0x4a, 0x5b, 0xbd, 0xbb, 0xa4, 0xae, 0x2e,
+	0xe2, 0xc4, 0xb8, 0x6b, 0xae, 0x52, 0x39, 0x65, 0xc4, 0x5d, 0x4b, 0xbc, 0x05, 0xca, 0x54, 0x9f,
+	0x10, 0x5d, 0x04, 0xa3, 0x2c, 0x11, 0x4c, 0xd1, 0x34, 0x23, 0x51, 0xbe, 0x68, 0x52, 0xa3, 0x61,
+	0xba, 0x14, 0xeb, 0xa1, 0xaa, 0xca, 0xcc, 0xd5, 0x0e, 0xd5, 0x98, 0x51, 0xc7, 0x65, 0xe5, 0xa1,
+	0x11, 0xf1, 0x16, 0xfe, 0x91, 0xd6, 0xdc, 0x59, 0x2f, 0xf0, 0x3e, 0x55, 0xb8, 0xb6, 0x52, 0x54,
+	0xb8, 0xd6, 0xfb, 0xa8, 0x43, 0xa6, 0x55, 0x36, 0xfd, 0xa5, 0xdb, 0xb7, 0x10, 0xef, 0x36, 0x5e,
+	0xef, 0x9b, 0xc6, 0xcb, 0xee, 0xfc, 0x05, 0xde, 0x67, 0x96, 0x99, 0xa8, 0x1c, 0x50, 0x66, 0x82,
+	0x0e, 0xe1, 0x56, 0xd8, 0x69, 0xa5, 0x9d, 0x9d, 0x78, 0x7b, 0x30, 0xb0, 0x1e, 0xef, 0x2f, 0x1c,
+	0x72, 0x42, 0x0d, 0x41, 0xea, 0x4c, 0x74, 0xbb, 0x6c, 0xf6, 0xc3, 0x76, 0x4b, 0xd6, 0xbd, 0x4e,
+	0x6d, 0x97, 0x05, 0xa3, 0x0f, 0x2c, 0x48, 0xf4, 0xcc, 0x6c, 0x86, 0x1d, 0x3f, 0xde, 0x5b, 0xd7,
+	0x4a, 0x9a, 0x92, 0xdb, 0x0b, 0xaa, 0x07, 0x0c, 0x28, 0xac, 0x8e, 0x70, 0x5b, 0x9e, 0xca, 0x56,
+	0x4b, 0xad, 0x8e, 0x20, 0xe6, 0x43, 0xef, 0x04, 0x75, 0xcc, 0xab, 0x28, 0x7a, 0x9f, 0xab, 0x92,
+	0x19, 0xbb, 0xa2, 0xc1, 0x00, 0x9e, 0x13, 0xfa, 0x9d, 0x58, 0x91, 0x83, 0xf4, 0xc2, 0xe2, 0x85,
+	0xaa, 0x79, 0x1f, 0x86, 0x8f, 0x72, 0x56, 0x52, 0xce, 0xad, 0xad, 0x6a, 0x90, 0xca, 0x3f, 0xcb,
+	0x22, 0xb8, 0x85, 0xbb, 0x5b, 0x90, 0xc2, 0xb0, 0xa0, 0x89, 0xa8, 0x6b, 0x56, 0x4c, 0x7d, 0x77,
+	0x99, 0xd5, 0x1e, 0x44, 0x4a, 0xb5, 0xd0, 0x86, 0xd4, 0xc2, 0x93, 0x8b, 0x41, 0x92, 0x3e, 0xfb,
+	0x66, 0x32, 0x6d, 0x42, 0x1e, 0xa4, 0x10, 0x4d, 0x9a, 0x0a, 0xd1, 0xa7, 0xcd, 0x25, 0x29, 0xea,
+	0x59, 0x0c, 0xb0, 0xd9, 0xaf, 0x93, 0x5a, 0x53, 0x85, 0xb9, 0xdd, 0xd3, 0x5d, 0x0d, 0xaa, 0xde,
+	0x1b, 0x0b, 0x21, 0xe0, 0xd8, 0x30, 0x06, 0x60, 0xc6, 0x18, 0x4d, 0xb2, 0xdc, 0xa2, 0xe6, 0x52,
+	0x75, 0xfb, 0xf6, 0x2d, 0xa1, 0x64, 0x5c, 0x29, 0x69, 0x7a, 0xe9, 0xf6, 0xd7, 0x3b, 0xcc, 0x6c,
+	0x05, 0x24, 0x36, 0xc0, 0x21, 0x82, 0x55, 0xf6, 0xa4, 0x7a, 0x70, 0xd9, 0x13, 0xef, 0x8b, 0x15,
+	0x72, 0x32, 0xb3, 0xa8, 0xa8, 0x16, 0x5d, 0x8b, 0xf1, 0x2d, 0xc5, 0xeb, 0xad, 0x94, 0x56, 0xa8,
+	0x84, 0xe2, 0xd4, 0xc2, 0xdb, 0x6e, 0x07, 0x4e, 0x12, 0x23, 0xb6, 0x74, 0x30, 0xa6, 0x3a, 0xc1,
+	0xe0, 0xaf, 0xac, 0x22, 0xb6, 0xe6, 0x33, 0x10, 0x90, 0xf3, 0x14, 0x9e, 0xc0, 0xd9, 0x07, 0x21,
+	0xa9, 0x1a, 0xdc, 0xfb, 0x9d, 0x69, 0x78, 0x9f, 0x37, 0x97, 0xe0, 0x0d, 0xcd, 0x4c, 0x47, 0x35,
+	0x4e, 0x33, 0x9c, 0xb5, 0x3a, 0x28, 0x67, 0xf5, 0x7e, 0xad, 0x42, 0x8e, 0x59, 0x35, 0x75, 0xdd,
+	0x36, 0x99, 0xa4, 0xe3, 0xdd, 0x65, 0xf5, 0x51, 0xb8, 0xf4, 0x1d, 0xf5, 0x7a, 0x1d, 0xc5, 0x27,
+	0x2f, 0x08, 0xbc, 0xa0, 0x28, 0x3c, 0x18, 0xb1, 0x65, 0x74, 0xfa, 0xe4, 0x80, 0xde, 0xed, 0xef,
+	0xb6, 0xd3, 0xd3, 0x77, 0xc1, 0xe8, 0x03, 0x0b, 0xd2, 0xfb, 0xad, 0x2a, 0x99, 0xe5, 0x47, 0xdc,
+	0x2d, 0xb5, 0x19, 0x54, 0xa8, 0xca, 0xa7, 0x74, 0xe5, 0x6b, 0xa7, 0x8c, 0x3b, 0xe4, 0x8b, 0x08,
+	0x0d, 0x14, 0x12, 0xfd, 0xe5, 0x54, 0x48, 0x34, 0x37, 0xd5, 0xb7, 0x0f, 0x69, 0x44, 0xdf, 0x5d,
+	0x31, 0xd2, 0xff, 0xb8, 0x42, 0x8e, 0xa7, 0xae, 0x0a, 0xc4, 0x0a, 0x88, 0xe6, 0xed, 0x32, 0x4e,
+	0x19, 0xc7, 0x7f, 0xfb, 0xde, 0x1e, 0x37, 0xdc, 0x1d, 0x33, 0xf7, 0x69, 0xab, 0x78, 0xdf, 0xaa,
+	0x90, 0x19, 0xfb, 0x8e, 0xc3, 0x07, 0x70, 0xa6, 0x5e, 0x4b, 0xea, 0xec, 0x1a, 0xaf, 0xab, 0xc1,
+	0x9e, 0x3c, 0x65, 0xe4, 0x37, 0x26, 0xc9, 0x46, 0xd0, 0xfd, 0x0f, 0xc4, 0xd5, 0x3d, 0xde, 0x3f,
+	0x71, 0xc8, 0x69, 0xfe, 0x96, 0xe9, 0x75, 0xf8, 0xb7, 0xf2, 0x66, 0xf7, 0xfd, 0xe5, 0x0e, 0x30,
+	0x55, 0xb1, 0xfd, 0xa0, 0xf9, 0x45, 0xe5, 0xe5, 0x94, 0x18, 0xad, 0xbd, 0x14, 0x1e, 0xc0, 0xc1,
+	0x0e, 0xb5, 0x18, 0xbc, 0x7f, 0x5f, 0x21, 0x53, 0x6b, 0x8b, 0xcb, 0x8a, 0x85, 0x63, 0x00, 0x55,
+	0x1c, 0xf8, 0xda, 0xfd, 0x63, 0x06, 0x50, 0xc9, 0x0e, 0xd0, 0x30, 0x68, 0x45, 0xf1, 0x00, 0xc4,
+	0x24, 0x6d, 0x45, 0xf1, 0xf8, 0x44, 0xaa, 0xcc, 0x8a, 0x7e, 0xf4, 0x4e, 0xb1, 0xa4, 0x6b, 0x0c,
+	0x0a, 0xac, 0xda, 0xc7, 0x76, 0x2c, 0x29, 0x1b, 0x4f, 0x3b, 0x15, 0x04, 0x22, 0x6e, 0x45, 0xcd,
+	0x04, 0x81, 0x53, 0x1e, 0x99, 0x25, 0x6c, 0xc6, 0x93, 0x51, 0xd1, 0xcf, 0x6a, 0x66, 0x32, 0xaf,
+	0x05, 0x02, 0xd7, 0xec, 0x41, 0x73, 0xf7, 0x06, 0x82, 0x6b, 0x98, 0x61, 0x6a, 0xab, 0xa6, 0xd2,
+	0xf3, 0x26, 0x06, 0x4b, 0xcf, 0xf3, 0xbe, 0x55, 0x25, 0x75, 0xed, 0x54, 0x0b, 0x45, 0xa5, 0x91,
+	0x52, 0x6e, 0x04, 0xc0, 0x94, 0x0f, 0x85, 0x9a, 0x47, 0x13, 0x18, 0x85, 0x46, 0x7e, 0xcc, 0xc1,
+	0x03, 0xfa, 0xb0, 0x17, 0xfa, 0xcc, 0x37, 0x58, 0xce, 0xcd, 0xea, 0x8a, 0xdc, 0x32, 0xc7, 0x4c,
+	0x57, 0xa1, 0x71, 0xe4, 0xaf, 0x88, 0x81, 0x49, 0xd9, 0xfd, 0xa0, 0xc8, 0x06, 0xab, 0x96, 0x56,
+	0xae, 0x67, 0x32, 0x95, 0x02, 0xd6, 0x45, 0x1d, 0xbb, 0x17, 0x97, 0x54, 0xe5, 0x0a, 0x10, 0x95,
+	0xba, 0x99, 0x46, 0x59, 0x31, 0xac, 0x19, 0x38, 0x21, 0x2f, 0x21, 0x6e, 0x76, 0x2e, 0x86, 0xcc,
+	0xb4, 0xc1, 0x5c, 0xa2, 0x3e, 0x55, 0x89, 0x71, 0x9a, 0x44, 0xc0, 0x80, 0xce, 0x25, 0x92, 0x1d,
+	0xa0, 0x61, 0xbc, 0xcf, 0xd5, 0x48, 0xaa, 0xee, 0x87, 0x7b, 0x97, 0xd4, 0x55, 0xe5, 0x8f, 0x72,
+	0x32, 0x57, 0xf5, 0x8a, 0x52, 0x83, 0x51, 0x4d, 0xa0, 0x89, 0xb9, 0xdb, 0xd2, 0xcd, 0xca, 0x77,
+	0xfb, 0x3b, 0xd3, 0x6e, 0xd6, 0x1f, 0x1c, 0xec, 0xd4, 0x0d, 0xd7, 0xea, 0x79, 0x5e, 0xe9, 0x71,
+	0xee, 0x40, 0x8f, 0xec, 0x41, 0x77, 0xcb, 0x7f, 0x4c, 0xdc, 0x03, 0x47, 0x8d, 0xa0, 0x7e, 0xbb,
+	0x27, 0x56, 0xc3, 0x3b, 0x4b, 0xdc, 0x65, 0x1c, 0xb1, 0xae, 0x9f, 0xc5, 0x7f, 0x83, 0x41, 0xd4,
+	0xf6, 0x9b, 0x8f, 0x1f, 0xaa, 0xdf, 0x7c, 0xa2, 0x54, 0xbf, 0xf9, 0x53, 0x84, 0xb0, 0xb5, 0xcd,
+	0x33, 0x02, 0x26, 0x99, 0x3b, 0x53, 0x89, 0x18, 0x50, 0x3d, 0x60, 0x40, 0x79, 0x3f, 0x40, 0xec,
+	0x02, 0x70, 0x98, 0x8c, 0xc9, 0xeb, 0xcd, 0xf1, 0x13, 0x41, 0x96, 0x8c, 0x69, 0x95, 0x86, 0xfb,
+	0x15, 0xca, 0x96, 0x8c, 0x2a, 0x75, 0xee, 0x73, 0xbc, 0x1c, 0x9e, 0x53, 0xc6, 0x09, 0x93, 0x81,
+	0x97, 0x2a, 0xe8, 0xdd, 0x54, 0xb4, 0x93, 0xac, 0x89, 0x87, 0x21, 0x48, 0xb2, 0x77, 0x28, 0x65,
+	0xf9, 0x23, 0xe4, 0x21, 0x59, 0x5b, 0x41, 0x1e, 0x06, 0x89, 0xa8, 0x83, 0x83, 0x7d, 0x8c, 0xd2,
+	0x71, 0x58, 0x29, 0x72, 0x1c, 0x2a, 0x6b, 0xb8, 0x5a, 0x58, 0xe8, 0xfe, 0x57, 0x1d, 0xf2, 0x78,
+	0x7a, 0x00, 0xc9, 0x6a, 0x44, 0xb9, 0x4f, 0x14, 0x53, 0x05, 0xa1, 0x17, 0x76, 0xb6, 0x59, 0xd5,
+	0xe2, 0x3b, 0x7e, 0x2c, 0x6f, 0xae, 0x62, 0x8c, 0xf2, 0x26, 0xfd, 0x0d, 0xac, 0x15, 0x33, 0x53,
+	0x79, 0x08, 0xb5, 0xb0, 0x82, 0x46, 0xdc, 0x1b, 0x39, 0xd3, 0xa1, 0xcd, 0x30, 0x1e, 0xbe, 0x0d,
+	0x82, 0xa0, 0xf7, 0x6d, 0x87, 0xb2, 0x4c, 0x2a, 0x4c, 0xe3, 0xb0, 0x65, 0x04, 0x7d, 0xb3, 0xfb,
+	0x54, 0x8d, 0x7b, 0x53, 0xcd, 0x82, 0x2e, 0xa9, 0xfb, 0x54, 0x8d, 0x5f, 0xf9, 0xf7, 0xa9, 0x56,
+	0x86, 0xbb, 0x4f, 0xd5, 0x5d, 0x23, 0xa7, 0x77, 0xb9, 0x19, 0xc7, 0xef, 0x28, 0xe4, 0x36, 0x9d,
+	0xca, 0x90, 0x3f, 0x83, 0x35, 0x40, 0x57, 0xf3, 0x00, 0x20, 0xff, 0x39, 0xef, 0x0d, 0xc4, 0xe5,
+	0xb1, 0xde, 0x8b, 0x79, 0xe1, 0xaa, 0x85, 0x6e, 0x0e, 0xef, 0x4b, 0x35, 0x72, 0x3c, 0x75, 0xaf,
+	0x09, 0x9a, 0xd0, 0xd9, 0xf8, 0xd8, 0x91, 0xe5, 0x77, 0x76, 0x78, 0x03, 0x45, 0xdc, 0x76, 0x48,
+	0x2d, 0xec, 0x74, 0xfb, 0xbd, 0x72, 0x0a, 0x74, 0xf0, 0x41, 0x2c, 0x23, 0x42, 0xe3, 0x5c, 0x02,
+	0x7f, 0x02, 0x27, 0x53, 0x66, 0xfc, 0xae, 0x65, 0xe4, 0x8c, 0xdd, 0x27, 0x37, 0xcb, 0xc7, 0x74,
+	0x34, 0x6d, 0xad, 0x0c, 0x1f, 0x72, 0x6a, 0xb1, 0x1c, 0x76, 0xa8, 0xd5, 0x2f, 0x51, 0xdb, 0xc0,
+	0xf8, 0x68, 0xee, 0xcf, 0xd9, 0x35, 0x5c, 0x9d, 0xf2, 0x5e, 0x89, 0xe1, 0x9f, 0xd3, 0x55, 0x5a,
+	0xf9, 0x2b, 0xbd, 0x2a, 0x5b, 0xbe, 0x95, 0x2a, 0x18, 0x27, 0x52, 0x05, 0x5a, 0xad, 0x92, 0xae,
+	0x67, 0x3f, 0x4c, 0xb7, 0x94, 0x8d, 0x26, 0xe7, 0x95, 0x37, 0xcc, 0x57, 0x1e, 0xd9, 0xdd, 0x67,
+	0x4e, 0xd9, 0x57, 0x71, 0xca, 0x44, 0x5d, 0x80, 0xa8, 0x1d, 0x0c, 0xe0, 0xeb, 0x4c, 0xd9, 0x17,
+	0x95, 0x01, 0xcb, 0x7f, 0xbc, 0x86, 0x4c, 0x76, 0xb1, 0x70, 0x67, 0xa8, 0x4a, 0xc0, 0xb3, 0x82,
+	0x23, 0xeb, 0xa2, 0x0d, 0x54, 0xaf, 0x7b, 0x87, 0xd4, 0x9f, 0xbd, 0xd3, 0xe3, 0xc7, 0x8c, 0xe2,
+	0x28, 0xa3, 0xac, 0xd3, 0x45, 0xa5, 0xb4, 0xa8, 0x73, 0x4c, 0xd0, 0xb4, 0xb0, 0x50, 0x0e, 0x13,
+	0x82, 0x32, 0x47, 0x90, 0x1d, 0xb3, 0x30, 0xe9, 0x48, 0x57, 0x27, 0xef, 0xf1, 0xfe, 0xed, 0x14,
+	0x39, 0x95, 0x77, 0xb9, 0x94, 0xfb, 0x21, 0xfa, 0x30, 0x1b, 0x63, 0x39, 0xf7, 0x17, 0xe6, 0xd1,
+	0xb8, 0xc4, 0x10, 0x8a, 0x61, 0xb1, 0xbf, 0x41, 0xd0, 0x14, 0xd4, 0xdb, 0xfe, 0xa6, 0x58, 0x21,
+	0x87, 0x43, 0x7d, 0xc5, 0xd7, 0xd4, 0xe9, 0xdf, 0x20, 0x68, 0x52, 0xe5, 0xbe, 0x46, 0xff, 0x0a,
+	0x7c, 0xe1, 0x9c, 0xb9, 0x79, 0x28, 0xc4, 0x03, 0x9f, 0x6b, 0x69, 0xec, 0x4f, 0xe0, 0x04, 0x31,
+	0xf1, 0xeb, 0xf8, 0xa6, 0x5d, 0x77, 0x48, 0x30, 0x4f, 0xff, 0x10, 0x2e, 0x10, 0xb3, 0x09, 0xf1,
+	0x0b, 0x85, 0x53, 0x8d, 0x90, 0x1e, 0x0e, 0x66, 0x28, 0x4c, 0x6c, 0x85, 0x6d, 0xe3, 0x86, 0x96,
+	0x43, 0xf8, 0x38, 0x17, 0x19, 0x01, 0x6d, 0x71, 0xf0, 0xdf, 0x09, 0x48, 0xca, 0x45, 0x92, 0x6a,
+	0x7c, 0x54, 0x49, 0x35, 0x71, 0x9f, 0x24, 0xd5, 0x27, 0x1d, 0x52, 0x57, 0x33, 0x2d, 0xea, 0xb7,
+	0xbc, 0xf7, 0x10, 0x3f, 0x39, 0xf7, 0x48, 0xa9, 0x9f, 0xa0, 0x89, 0x63, 0xe6, 0xf7, 0x94, 0xff,
+	0x7c, 0x1f, 0xef, 0xa6, 0xb9, 0x4d, 0x8d, 0x46, 0x51, 0xb2, 0xf6, 0xfd, 0xe5, 0x0f, 0x66, 0x1e,
+	0x89, 0x2c, 0x05, 0xb7, 0xd7, 0xba, 0x89, 0xc8, 0x5f, 0xd6, 0x0d, 0x60, 0x0e, 0x01, 0x6b, 0x99,
+	0x4a, 0x39, 0x4e, 0xca, 0x28, 0x5c, 0x9e, 0x37, 0x9a, 0x81, 0xd2, 0xf1, 0x03, 0xf2, 0x28, 0x16,
+	0x72, 0x0c, 0x3b, 0xfd, 0x60, 0xad, 0x83, 0x09, 0x02, 0xd7, 0xa2, 0xde, 0x45, 0x6a, 0x91, 0xb5,
+	0x2e, 0xc4, 0x71, 0x14, 0xb3, 0x02, 0x35, 0xc6, 0xb5, 0xb5, 0x8b, 0xc5, 0xa0, 0xb0, 0x1f, 0x9e,
+	0x51, 0x74, 0x86, 0x17, 0x2a, 0xe4, 0xdc, 0x01, 0x93, 0x8d, 0xa7, 0x4f, 0x51, 0xbc, 0xed, 0x77,
+	0xc2, 0xe7, 0xcd, 0x9a, 0x6b, 0x4a, 0x21, 0x5d, 0x33, 0xfa, 0xc0, 0x82, 0x34, 0x8b, 0xf1, 0x54,
+	0x0e, 0x28, 0xc6, 0x43, 0x25, 0x2f, 0x26, 0x4e, 0xa4, 0xed, 0x2a, 0x96, 0x72, 0xc8, 0x7a, 0x30,
+	0x3d, 0x90, 0x7e, 0x22, 0xe1, 0x5c, 0x54, 0xe6, 0xe2, 0xfc, 0xfa, 0x32, 0x60, 0xbb, 0x55, 0x1b,
+	0xac, 0x76, 0x24, 0xb5, 0xc1, 0x50, 0x62, 0x8a, 0xe3, 0xb3, 0x71, 0x2d, 0x31, 0xed, 0x63, 0x2d,
+	0xef, 0x8b, 0x55, 0xf2, 0xf2, 0x7d, 0xb7, 0x96, 0x0e, 0x59, 0x77, 0xf6, 0x09, 0x59, 0x97, 0xd3,
+	0x53, 0x39, 0x68, 0x7a, 0xaa, 0x05, 0xd3, 0xf3, 0x71, 0xe4, 0x18, 0xb2, 0x56, 0x9d, 0x10, 0x12,
+	0x23, 0xa6, 0x11, 0x14, 0x95, 0xbe, 0x13, 0xcc, 0x42, 0xf6, 0x82, 0xa6, 0x8b, 0xe6, 0x92, 0x55,
+	0x88, 0xa6, 0x56, 0x86, 0xc4, 0x2c, 0xac, 0x17, 0xc7, 0xd9, 0x44, 0x51, 0x75, 0x1b, 0xef, 0xd7,
+	0xc7, 0xc8, 0x13, 0x03, 0x08, 0x3a, 0x73, 0x15, 0x3b, 0x03, 0xae, 0xe2, 0xef, 0xf2, 0xcf, 0xf4,
+	0x89, 0xdc, 0xcf, 0x04, 0xe5, 0x7f, 0xa6, 0xfd, 0xbf, 0x10, 0x3b, 0x81, 0xe8, 0x24, 0x78, 0xed,
+	0x1f, 0x4f, 0xdf, 0x31, 0xf2, 0x91, 0x97, 0x45, 0x3b, 0x28, 0x08, 0x34, 0x7f, 0x9b, 0x3e, 0x6e,
+	0xff, 0x89, 0x92, 0x0a, 0x8f, 0x98, 0xa9, 0xcd, 0x5c, 0xfb, 0x5a, 0x9c, 0x47, 0x0e, 0xc0, 0xc9,
+	0x60, 0xf9, 0xc7, 0xb3, 0xc5, 0xda, 0x08, 0x16, 0xde, 0xd8, 0x64, 0xc1, 0x94, 0xab, 0x2c, 0x64,
+	0x4a, 0x2c, 0x1d, 0xf6, 0xbe, 0xba, 0x19, 0x4c, 0x18, 0xf4, 0x97, 0x98, 0x51, 0x98, 0xab, 0x46,
+	0xac, 0x15, 0xf3, 0x97, 0x6c, 0xa4, 0x3b, 0x21, 0x0b, 0x8f, 0x95, 0xe7, 0x7a, 0x54, 0x31, 0x0d,
+	0xf8, 0xd3, 0x7c, 0xa1, 0x31, 0x87, 0xe2, 0x86, 0x6a, 0x05, 0x03, 0xc2, 0xfb, 0xe3, 0x6a, 0xfe,
+	0x6b, 0x70, 0x2d, 0x77, 0x98, 0xd5, 0x2f, 0xd6, 0x76, 0x65, 0x00, 0x0e, 0x5d, 0x3d, 0x6a, 0x0e,
+	0x3d, 0x56, 0xc4, 0xa1, 0xb1, 0xee, 0x9c, 0x71, 0x11, 0x2e, 0x2f, 0x5d, 0xc3, 0x0f, 0xa5, 0x54,
+	0xdd, 0xb9, 0xf5, 0x54, 0x3f, 0x64, 0x9e, 0x78, 0xc0, 0x97, 0xea, 0xd7, 0x2b, 0xe4, 0x4c, 0xa1,
+	0x61, 0x71, 0x44, 0x12, 0xc8, 0xfc, 0xfc, 0x63, 0x47, 0xf3, 0xf9, 0xcd, 0x8f, 0x52, 0x3b, 0xf0,
+	0xa3, 0x0c, 0x22, 0xce, 0x7f, 0xaf, 0x52, 0xb8, 0x59, 0xd0, 0x10, 0xfd, 0x9e, 0x9d, 0xc9, 0xb7,
+	0x90, 0x63, 0xf4, 0x49, 0x0e, 0xc7, 0x32, 0x33, 0x52, 0xb5, 0x30, 0xe7, 0xcd, 0x4e, 0xb0, 0x61,
+	0x07, 0x9a, 0xd8, 0x3f, 0xa4, 0x82, 0x8f, 0x12, 0xe2, 0x1c, 0x0e, 0xaf, 0x7a, 0x60, 0x53, 0xe4,
+	0x94, 0x71, 0xd5, 0x03, 0x4e, 0x6c, 0x12, 0xb2, 0xfb, 0x0f, 0xf2, 0x26, 0x7b, 0xd4, 0xca, 0x0a,
+	0xea, 0xfa, 0xdc, 0x6a, 0xf1, 0xf5, 0xb9, 0xde, 0xd7, 0xea, 0xf8, 0x7a, 0xdd, 0x08, 0xef, 0xf0,
+	0x4c, 0xf0, 0xfb, 0xf6, 0xe3, 0xb6, 0x58, 0x24, 0xea, 0xfb, 0xe2, 0xa1, 0x37, 0xb6, 0x5b, 0xe7,
+	0x93, 0x95, 0xa1, 0x2a, 0x01, 0x56, 0x0f, 0xac, 0x04, 0x88, 0x55, 0xb1, 0x92, 0x9d, 0xf5, 0x38,
+	0xbc, 0x4d, 0xb9, 0x16, 0xe5, 0x17, 0x42, 0x9f, 0xd6, 0x55, 0xb1, 0x1a, 0x97, 0x75, 0x27, 0xd8,
+	0xb0, 0x58, 0x94, 0x4a, 0xd7, 0xe3, 0x0b, 0xe2, 0x1e, 0x4b, 0x79, 0xe4, 0x2b, 0x41, 0x95, 0x83,
+	0xd1, 0x15, 0xfc, 0x04, 0x00, 0x64, 0x9f, 0x41, 0x9e, 0x6b, 0x35, 0xe2, 0x40, 0xc6, 0x6d, 0x9e,
+	0x6b, 0xe1, 0xc1, 0xb1, 0x64, 0x9e, 0xc0, 0xfa, 0xfa, 0x7c, 0x61, 0xd0, 0xd5, 0x67, 0xbc, 0xd1,
+	0x84, 0x5d, 0x5f, 0xff, 0x52, 0x16, 0x04, 0xf2, 0x9e, 0x43, 0xd7, 0x9e, 0x6a, 0x5e, 0x5e, 0x12,
+	0x47, 0x6b, 0xca, 0xb5, 0xa7, 0xd0, 0x2c, 0xb7, 0xc0, 0x84, 0xc3, 0xeb, 0xdb, 0xf4, 0x4f, 0x9e,
+	0x42, 0xcf, 0xcf, 0x9b, 0x97, 0x44, 0xa9, 0x53, 0x75, 0x7d, 0xdb, 0xa5, 0x5c, 0xb0, 0x16, 0x14,
+	0x3d, 0xef, 0x6e, 0x92, 0xb3, 0xaa, 0xeb, 0x02, 0x1e, 0xa9, 0x74, 0xe3, 0x30, 0x09, 0xa8, 0xca,
+	0xc6, 0x22, 0x27, 0x08, 0x7b, 0x4f, 0x4f, 0x60, 0x3f, 0x4b, 0xb1, 0x5f, 0xce, 0x83, 0xa4, 0xab,
+	0x6a, 0x1f, 0x2c, 0x78, 0xbc, 0x1d, 0x74, 0xb0, 0xee, 0xdf, 0xda, 0xe2, 0xb2, 0xb0, 0x48, 0x75,
+	0x76, 0x84, 0xec, 0x00, 0x0d, 0xa3, 0xe2, 0xfb, 0xa7, 0x8b, 0xe2, 0xfb, 0x31, 0x51, 0x6a, 0xbb,
+	0xd9, 0x45, 0x2d, 0x33, 0x6c, 0x06, 0xf3, 0x4d, 0x16, 0x50, 0x8c, 0x1f, 0x86, 0x5f, 0x7c, 0xa0,
+	0x12, 0xa5, 0x2e, 0x2d, 0xae, 0x67, 0x60, 0x20, 0xf7, 0x49, 0x16, 0x78, 0x8e, 0x55, 0x06, 0x67,
+	0x1f, 0x4a, 0x05, 0x9e, 0x63, 0x23, 0xf0, 0x3e, 0x0c, 0xa3, 0x65, 0xc9, 0x82, 0x97, 0x7b, 0xbd,
+	0xae, 0x52, 0x6b, 0x67, 0x4f, 0xd9, 0x85, 0x0f, 0x2f, 0x66, 0x20, 0x20, 0xe7, 0x29, 0xd4, 0x7a,
+	0x3a, 0x11, 0xc3, 0x3e, 0xfb, 0x88, 0xad, 0xf5, 0x5c, 0xe3, 0xcd, 0x20, 0xfb, 0xdd, 0xf7, 0x91,
+	0x59, 0xba, 0x17, 0x99, 0xc1, 0x7c, 0x33, 0x8a, 0x6f, 0xb5, 0x23, 0xbf, 0xb5, 0xcc, 0xee, 0xe9,
+	0xed, 0xed, 0xcd, 0xce, 0x32, 0xe2, 0x8f, 0x8b, 0x67, 0x67, 0xaf, 0x17, 0xc0, 0x41, 0x21, 0x86,
+	0x74, 0xe5, 0xce, 0x33, 0x03, 0x56, 0xee, 0xa4, 0x9f, 0x40, 0xca, 0x35, 0xfa, 0xcd, 0xd4, 0x4b,
+	0xcf, 0x9e, 0xb5, 0x2f, 0xfe, 0x5b, 0xce, 0x81, 0x81, 0xdc, 0x27, 0xbd, 0x3f, 0x70, 0xc8, 0x31,
+	0xc5, 0xc1, 0x8e, 0x20, 0x69, 0xb9, 0x6d, 0x27, 0x2d, 0x5f, 0x1a, 0x5d, 0x06, 0xb0, 0x91, 0x17,
+	0xa4, 0xd8, 0x7c, 0xe1, 0x18, 0x21, 0x5a, 0x4e, 0x28, 0x11, 0xed, 0x14, 0x8a, 0xe8, 0x07, 0x96,
+	0x47, 0xe7, 0x55, 0x62, 0xac, 0xdd, 0xdf, 0x4a, 0x8c, 0x0d, 0x72, 0x5a, 0x2e, 0x29, 0x7e, 0xa4,
+	0x8c, 0x79, 0x9f, 0x92, 0xe5, 0x1b, 0x37, 0x39, 0x2e, 0xe7, 0x01, 0x41, 0xfe, 0xb3, 0x96, 0x6e,
+	0x37, 0x71, 0xa0, 0x6e, 0xa7, 0xb8, 0xdc, 0xca, 0x96, 0xbc, 0x67, 0x35, 0xc5, 0xe5, 0x56, 0x2e,
+	0x36, 0x40, 0xc3, 0xe4, 0x8b, 0xba, 0x7a, 0x49, 0xa2, 0x8e, 0x0c, 0x2d, 0xea, 0x24, 0xd3, 0x9d,
+	0x2a, 0x64, 0xba, 0xf2, 0xe8, 0x6a, 0xba, 0xf0, 0xe8, 0x8a, 0x2a, 0x3a, 0x61, 0x67, 0x27, 0x88,
+	0xe9, 0x8a, 0x6f, 0xb1, 0xbd, 0xc0, 0x18, 0xf2, 0xa4, 0x56, 0x74, 0x96, 0xad, 0x5e, 0x48, 0x41,
+	0xdb, 0x92, 0x62, 0x66, 0x00, 0x49, 0x51, 0x20, 0x9f, 0x8f, 0x97, 0x23, 0x9f, 0x4f, 0x8c, 0x2e,
+	0x9f, 0x4f, 0x1e, 0xaa, 0x7c, 0x76, 0x4b, 0x91, 0xcf, 0x03, 0x89, 0x3e, 0xc3, 0x48, 0x3f, 0x75,
+	0x80, 0x91, 0x5e, 0x24, 0x9c, 0x4f, 0xdf, 0xb3, 0x70, 0xce, 0x97, 0xbb, 0x0f, 0xbf, 0x24, 0x77,
+	0x4b, 0x91, 0xbb, 0x9f, 0xac, 0x90, 0xd3, 0x5a, 0x32, 0x21, 0x3f, 0x08, 0xb7, 0x90, 0x37, 0xb3,
+	0xcb, 0xcb, 0xf9, 0x81, 0xb7, 0x91, 0x2a, 0xaf, 0x8b, 0x05, 0xa8, 0x1e, 0x30, 0xa0, 0x58, 0xc6,
+	0x39, 0x45, 0xb1, 0xa1, 0x13, 0x34, 0x75, 0xc6, 0xb9, 0x68, 0x07, 0x05, 0x81, 0x93, 0x80, 0x7f,
+	0x8b, 0x82, 0x27, 0xe9, 0xb2, 0xe1, 0x8b, 0xba, 0x0b, 0x4c, 0x38, 0x3c, 0xec, 0x6e, 0x4a, 0x96,
+	0x89, 0xa2, 0x6b, 0x9a, 0x9b, 0x95, 0x8a, 0x4b, 0xaa, 0x5e, 0x39, 0x1c, 0x56, 0x11, 0xa1, 0x96,
+	0x1d, 0x0e, 0x8b, 0x1d, 0x55, 0x10, 0xde, 0xff, 0x72, 0xc8, 0x99, 0xdc, 0xa9, 0x38, 0x02, 0x75,
+	0xe4, 0xae, 0xad, 0x8e, 0x34, 0xca, 0x32, 0x49, 0x8d, 0xb7, 0x28, 0x50, 0x4d, 0xfe, 0xa3, 0x43,
+	0x66, 0x34, 0xfc, 0x11, 0xbc, 0x6a, 0x68, 0xbf, 0x6a, 0x79, 0xd6, 0x77, 0x3d, 0xf3, 0x6e, 0xbf,
+	0x55, 0x21, 0xaa, 0x94, 0xff, 0x7c, 0xb3, 0x37, 0x58, 0xba, 0xd9, 0x1e, 0x19, 0x67, 0x11, 0x24,
+	0x49, 0x39, 0xd1, 0x71, 0x36, 0x7d, 0x16, 0x8d, 0xa2, 0x0f, 0xf4, 0xd8, 0xcf, 0x04, 0x04, 0x41,
+	0x76, 0xf5, 0x10, 0xaf, 0x92, 0xde, 0x12, 0x89, 0xd3, 0xfa, 0xea, 0x21, 0xd1, 0x0e, 0x0a, 0x02,
+	0x05, 0x66, 0x48, 0x75, 0xa1, 0xc5, 0x36, 0x55, 0xec, 0x84, 0x0e, 0xa7, 0x04, 0xe6, 0xb2, 0xec,
+	0x00, 0x0d, 0xc3, 0x82, 0x4b, 0xc2, 0xa4, 0xdb, 0xf6, 0xf7, 0x0c, 0x1f, 0x8b, 0x51, 0xd8, 0x4b,
+	0x75, 0x81, 0x09, 0xe7, 0xed, 0x92, 0x59, 0xfb, 0x25, 0x96, 0x82, 0x2d, 0x16, 0xd9, 0x3d, 0xd0,
+	0x74, 0x62, 0x7c, 0x33, 0x7b, 0x6a, 0xa5, 0xef, 0x0b, 0x9e, 0xa0, 0xe3, 0x9b, 0x65, 0x07, 0x68,
+	0x18, 0xef, 0x8d, 0xe4, 0xa1, 0x9c, 0x39, 0x1b, 0x20, 0x80, 0xee, 0xd7, 0x2a, 0xe4, 0xb8, 0xfd,
+	0x64, 0xc2, 0x72, 0x1f, 0xf9, 0x98, 0xc3, 0xa4, 0x19, 0x51, 0x36, 0xb5, 0x87, 0xc3, 0x70, 0x52,
+	0xb9, 0x8f, 0x19, 0x08, 0xc8, 0x79, 0x8a, 0xdd, 0xaa, 0xd1, 0x52, 0xaf, 0x2e, 0x97, 0xc7, 0x8d,
+	0x32, 0x97, 0x87, 0x9e, 0x59, 0x33, 0xe8, 0x47, 0x91, 0x04, 0x93, 0x3e, 0xea, 0x3f, 0x2c, 0x73,
+	0x03, 0xd3, 0x1b, 0x7b, 0x61, 0x47, 0xbc, 0xb2, 0x58, 0x38, 0x4a, 0xff, 0x59, 0xcd, 0x82, 0x40,
+	0xde, 0x73, 0xde, 0xb7, 0xc7, 0x88, 0xaa, 0x80, 0xc2, 0x82, 0x32, 0x4b, 0x0a, 0x69, 0x1d, 0x36,
+	0x83, 0x56, 0x7d, 0xe9, 0xb1, 0xfd, 0xa2, 0xa4, 0xb8, 0x97, 0xcc, 0x74, 0xa7, 0xab, 0x09, 0xdb,
+	0xd0, 0x5d, 0x60, 0xc2, 0xe1, 0x48, 0xda, 0xe1, 0xed, 0x80, 0x3f, 0x34, 0x6e, 0x8f, 0x64, 0x45,
+	0x76, 0x80, 0x86, 0x61, 0x45, 0xb4, 0xe9, 0x4c, 0x08, 0x97, 0x8f, 0x2e, 0xa2, 0x4d, 0xdb, 0x80,
+	0xf5, 0xf0, 0x7b, 0x97, 0xa2, 0x5b, 0x42, 0xe7, 0x37, 0xee, 0x5d, 0x8a, 0x6e, 0x01, 0xeb, 0xc1,
+	0xaf, 0x44, 0xed, 0x8a, 0x5d, 0xbf, 0x1d, 0x3e, 0x1f, 0xb4, 0x14, 0x15, 0xa1, 0xeb, 0xab, 0xaf,
+	0x74, 0x2d, 0x0b, 0x02, 0x79, 0xcf, 0xe1, 0x82, 0xee, 0x52, 0x75, 0x39, 0x6c, 0xf6, 0x4c, 0x6c,
+	0xc4, 0x5e, 0xd0, 0xeb, 0x19, 0x08, 0xc8, 0x79, 0x0a, 0x4b, 0xc7, 0xc9, 0x0a, 0x36, 0xb2, 0xea,
+	0xe3, 0x94, 0x5d, 0x3a, 0x0e, 0xec, 0x6e, 0x48, 0xc3, 0x23, 0xc7, 0xda, 0x15, 0xb5, 0x68, 0x99,
+	0x69, 0x60, 0x70, 0x2c, 0x59, 0xa3, 0x16, 0x14, 0x84, 0xf7, 0xb1, 0x2a, 0x4a, 0xd8, 0x82, 0x92,
+	0xcf, 0x47, 0x16, 0x42, 0x6d, 0xaf, 0xc8, 0xb1, 0x01, 0x56, 0x24, 0x86, 0x27, 0x27, 0x94, 0x11,
+	0xc9, 0xf0, 0xe4, 0x5a, 0x61, 0x78, 0xb2, 0x01, 0x95, 0x1f, 0x9e, 0x3c, 0x5e, 0x56, 0x78, 0xf2,
+	0xc4, 0x3d, 0x86, 0x27, 0xff, 0xab, 0x1a, 0x51, 0x57, 0x96, 0x5e, 0x0b, 0x7a, 0x77, 0xa8, 0x66,
+	0x1a, 0x76, 0xb6, 0x59, 0x35, 0x96, 0xaf, 0x38, 0xb2, 0xa0, 0xcb, 0x8a, 0x99, 0xb6, 0xbb, 0x55,
+	0xd2, 0xb5, 0x93, 0x16, 0xb1, 0xb9, 0x0d, 0x83, 0x10, 0x0f, 0x73, 0x49, 0x15, 0x8e, 0x11, 0x1e,
+	0x7c, 0x6b, 0x44, 0xee, 0x87, 0x09, 0x91, 0xfe, 0xf1, 0x2d, 0xc9, 0x81, 0x4b, 0xbc, 0x5b, 0x51,
+	0xe9, 0xb7, 0x1b, 0x8a, 0x08, 0x18, 0x04, 0x31, 0x30, 0x4a, 0x9e, 0x35, 0xf0, 0x3c, 0xa6, 0x0f,
+	0x1e, 0xca, 0xdc, 0x0c, 0x92, 0xd0, 0x0c, 0x64, 0x82, 0x82, 0xe3, 0x3a, 0x11, 0x61, 0x9c, 0xaf,
+	0xce, 0x2b, 0xf6, 0xb5, 0x42, 0x8d, 0x8e, 0x05, 0xbf, 0xed, 0xd3, 0x0d, 0x16, 0x2f, 0x73, 0x70,
+	0x6d, 0xf3, 0x88, 0x06, 0x90, 0x88, 0x32, 0xf7, 0xaa, 0xd6, 0x06, 0xb9, 0x57, 0xf5, 0xec, 0x3b,
+	0xc8, 0xc9, 0xcc, 0xc7, 0x1c, 0x2a, 0x7f, 0x79, 0x84, 0x32, 0x5f, 0xbf, 0x3e, 0xae, 0x85, 0x16,
+	0x16, 0x36, 0x63, 0x97, 0x49, 0xc6, 0xfa, 0x8b, 0x0a, 0xfd, 0xb5, 0xc4, 0x25, 0xa2, 0xc4, 0x8c,
+	0xd1, 0x08, 0x26, 0x49, 0x5c, 0xa3, 0x78, 0x7b, 0x41, 0xe7, 0xb0, 0xd7, 0xe8, 0xba, 0x22, 0x02,
+	0x06, 0x41, 0x77, 0xc7, 0x4a, 0xb4, 0xbb, 0x38, 0x7a, 0xa2, 0x1d, 0x2b, 0xbd, 0x9a, 0x77, 0xe7,
+	0xda, 0xe7, 0xa9, 0xe9, 0xd0, 0xb1, 0x56, 0x6e, 0x39, 0xb1, 0xf5, 0xf9, 0xbb, 0x82, 0xdf, 0x78,
+	0x6d, 0xb7, 0x41, 0x8a, 0x7e, 0x9e, 0x48, 0xab, 0x0d, 0x29, 0xd2, 0xf4, 0x35, 0xc1, 0xe3, 0x45,
+	0xd7, 0x04, 0xbb, 0x1d, 0x75, 0x7f, 0xfb, 0x44, 0x19, 0xe5, 0x4a, 0xac, 0xcb, 0xdb, 0x49, 0xce,
+	0xc5, 0xed, 0x37, 0xcd, 0x3c, 0xdc, 0xe1, 0xef, 0xf1, 0x3e, 0x56, 0x94, 0xaf, 0xeb, 0xfd, 0xdf,
+	0x31, 0x72, 0x42, 0xce, 0x88, 0xcc, 0xcb, 0x41, 0xf9, 0xc8, 0xe9, 0x6a, 0x5d, 0x59, 0xc9, 0xc7,
+	0xcb, 0xb2, 0x03, 0x34, 0x0c, 0xea, 0x63, 0xfd, 0x04, 0x4b, 0xa9, 0x75, 0x56, 0xc2, 0xcd, 0x44,
+	0x9c, 0x85, 0xab, 0x8d, 0x72, 0x5d, 0x77, 0x81, 0x09, 0xc7, 0x92, 0x85, 0x9b, 0x66, 0xc5, 0x0e,
+	0x9d, 0x2c, 0x2c, 0x14, 0x55, 0xd9, 0xef, 0xfe, 0x4c, 0xee, 0x1d, 0x14, 0xe5, 0x64, 0xb3, 0x66,
+	0xd2, 0x91, 0x86, 0xbb, 0x7c, 0xc2, 0xfd, 0x07, 0x0e, 0x39, 0xcd, 0x5b, 0xe5, 0x4c, 0x5e, 0xef,
+	0xe2, 0x0d, 0x2b, 0x49, 0x39, 0xf7, 0x75, 0xe5, 0x8c, 0x4f, 0xbb, 0xb4, 0xf3, 0xc8, 0x42, 0xfe,
+	0x68, 0xb0, 0x50, 0xc1, 0xf1, 0x5b, 0x56, 0xc5, 0x2d, 0x29, 0x3a, 0x46, 0x2d, 0x47, 0x63, 0x21,
+	0xd5, 0x5b, 0xcd, 0x6e, 0x4f, 0x20, 0x4d, 0x1d, 0xef, 0xb7, 0x31, 0xd9, 0xe8, 0xd1, 0x17, 0xea,
+	0x1a, 0x5e, 0x15, 0x94, 0xda, 0x65, 0xad, 0x50, 0xbb, 0xc4, 0xd3, 0xf7, 0xb0, 0x25, 0xec, 0x0b,
+	0x7d, 0xfa, 0xbe, 0xbc, 0x04, 0xd8, 0xee, 0xfd, 0x51, 0x4d, 0xfb, 0x24, 0x44, 0xb2, 0xe8, 0xf7,
+	0xc4, 0x6b, 0x6f, 0xa9, 0x0a, 0xbc, 0xfc, 0xcd, 0xaf, 0x65, 0x2a, 0xf0, 0xbe, 0x75, 0xf8, 0x5c,
+	0x60, 0x3e, 0x41, 0x45, 0x05, 0x78, 0x27, 0x0e, 0x48, 0x04, 0x7e, 0x96, 0x4c, 0xa2, 0x09, 0xc6,
+	0x9c, 0x8b, 0x93, 0xd6, 0xa0, 0x26, 0x2f, 0x8b, 0x76, 0x3a, 0xac, 0x37, 0x0f, 0x3f, 0x2c, 0xf9,
+	0x34, 0x28, 0xfc, 0x6e, 0x42, 0x79, 0x26, 0xfd, 0x9b, 0xe5, 0x2c, 0x0b, 0xe3, 0xee, 0xba, 0xe2,
+	0x99, 0xb2, 0xa3, 0x94, 0x84, 0x68, 0x4d, 0x87, 0x8a, 0xa1, 0x3a, 0x02, 0x72, 0xa2, 0xdc, 0x06,
+	0x5c, 0x57, 0x99, 0xc3, 0xb2, 0x83, 0x12, 0x7d, 0xcb, 0xf0, 0x44, 0xd5, 0xe3, 0xa0, 0x49, 0x18,
+	0xa2, 0x71, 0xaa, 0x48, 0x34, 0x7a, 0xff, 0x6f, 0x4c, 0xaf, 0x6f, 0x51, 0x9c, 0xf9, 0x7b, 0x62,
+	0x7d, 0xbf, 0x29, 0xb5, 0xbe, 0x1f, 0xcf, 0xac, 0xef, 0x19, 0x9c, 0xb3, 0x9c, 0x92, 0xd1, 0x47,
+	0xad, 0x2c, 0x1c, 0xec, 0x93, 0x60, 0x5a, 0xd2, 0x73, 0x7d, 0x2c, 0x4d, 0xb9, 0x1e, 0xf7, 0x3b,
+	0x58, 0x23, 0xb9, 0xce, 0x80, 0x0d, 0x2d, 0xc9, 0xea, 0x86, 0x34, 0x3c, 0x1a, 0xfe, 0xb8, 0x2e,
+	0x6e, 0xfa, 0xb7, 0xf9, 0xca, 0x33, 0x0a, 0x63, 0x36, 0x44, 0x3b, 0x28, 0x08, 0xaa, 0x93, 0x3e,
+	0x26, 0x11, 0x2c, 0x05, 0xed, 0x00, 0x5f, 0x88, 0x45, 0x15, 0xc6, 0xbb, 0x3c, 0xe6, 0x9f, 0x07,
+	0x86, 0xbc, 0x52, 0x60, 0x78, 0x0c, 0xf6, 0x81, 0x85, 0x7d, 0x31, 0x79, 0x5f, 0x65, 0x71, 0x04,
+	0x46, 0xe9, 0x06, 0x5c, 0x7d, 0xed, 0x70, 0x37, 0x94, 0xf5, 0x3b, 0xd5, 0xea, 0x5b, 0xc1, 0x46,
+	0xe0, 0x7d, 0xee, 0x1d, 0x32, 0xb1, 0xc9, 0xef, 0x7f, 0x2f, 0xe7, 0xde, 0x25, 0x71, 0x99, 0x3c,
+	0xab, 0xdd, 0x2d, 0x6f, 0x96, 0x7f, 0x51, 0xff, 0x09, 0x92, 0x9a, 0xf7, 0xcd, 0x1a, 0x3a, 0x2d,
+	0x79, 0xac, 0xd7, 0xe5, 0x30, 0x61, 0xe1, 0x01, 0xe6, 0x85, 0x06, 0x95, 0x03, 0x2f, 0x34, 0xf8,
+	0x00, 0x21, 0xad, 0xa0, 0xdb, 0x8e, 0xf6, 0x98, 0x72, 0x38, 0x36, 0xb4, 0x72, 0xa8, 0xec, 0x89,
+	0x25, 0x85, 0x05, 0x0c, 0x8c, 0xa2, 0x68, 0x29, 0xbf, 0x1f, 0x21, 0x55, 0xb4, 0xd4, 0xb8, 0x9d,
+	0x6d, 0xfc, 0x68, 0x6f, 0x67, 0x0b, 0xc9, 0x71, 0x3e, 0x44, 0x55, 0x20, 0xe1, 0x1e, 0xea, 0x20,
+	0xb0, 0x14, 0xb3, 0x25, 0x1b, 0x0d, 0xa4, 0xf1, 0x9a, 0x57, 0xaf, 0x4d, 0x1e, 0xf5, 0xd5, 0x6b,
+	0xaf, 0x25, 0x75, 0xf9, 0x9d, 0x31, 0xf5, 0x49, 0x15, 0xef, 0x91, 0xcb, 0x20, 0x01, 0xdd, 0x9f,
+	0xa9, 0xf5, 0x42, 0xee, 0x57, 0xad, 0x17, 0xef, 0xf3, 0x55, 0xb4, 0x2a, 0xf8, 0xb8, 0x86, 0xbe,
+	0xb9, 0xf0, 0xb2, 0x71, 0x73, 0xe1, 0x70, 0xdf, 0x73, 0x32, 0x75, 0xc3, 0xe1, 0x63, 0x64, 0xac,
+	0xe7, 0x6f, 0xcb, 0x8c, 0x58, 0xd6, 0xbb, 0xe1, 0xe3, 0x45, 0x3b, 0xd8, 0x3a, 0x4c, 0x8d, 0x67,
+	0x8c, 0x98, 0xa1, 0x3a, 0x35, 0xe5, 0xb8, 0x71, 0x60, 0x1c, 0x26, 0xea, 0x88, 0x19, 0xb3, 0x13,
+	0x6c, 0x58, 0xcc, 0xb9, 0x20, 0x71, 0xa0, 0x6c, 0x96, 0xf1, 0x32, 0xd6, 0x90, 0x62, 0x03, 0x12,
+	0xaf, 0x59, 0xa3, 0x43, 0xd9, 0x2a, 0x06, 0x59, 0xef, 0x13, 0xd4, 0x80, 0xca, 0x3c, 0xe5, 0x76,
+	0xc9, 0x78, 0x93, 0xdd, 0x2f, 0x59, 0x4e, 0x5d, 0x4a, 0xfb, 0xae, 0x4a, 0x2e, 0x9c, 0x78, 0x1b,
+	0x08, 0x3a, 0xde, 0xd7, 0xa6, 0xc9, 0xa9, 0xc6, 0xe2, 0xaa, 0xbc, 0x95, 0xe8, 0xd0, 0x52, 0x7c,
+	0xf3, 0x68, 0x1c, 0x5d, 0x8a, 0x6f, 0x01, 0xf5, 0xb6, 0x91, 0xe2, 0xdb, 0x36, 0x52, 0x7c, 0xed,
+	0x7c, 0xcb, 0x6a, 0x19, 0xf9, 0x96, 0x79, 0x23, 0x18, 0x24, 0xdf, 0xf2, 0xd0, 0x72, 0x7e, 0xf7,
+	0x1d, 0xd0, 0x50, 0x39, 0xbf, 0x2a, 0x21, 0xba, 0x94, 0xf4, 0xae, 0x82, 0x4f, 0x95, 0x9b, 0x10,
+	0xad, 0x92, 0x51, 0x79, 0xea, 0xa2, 0x10, 0x7a, 0xef, 0x2f, 0x7f, 0x00, 0x03, 0x24, 0xa3, 0x8a,
+	0xec, 0x49, 0x33, 0x01, 0x7a, 0xa2, 0x8c, 0x04, 0xe8, 0xbc, 0xe1, 0x1c, 0x98, 0x00, 0x8d, 0x17,
+	0x33, 0xb6, 0xa3, 0x4e, 0x40, 0x9f, 0xec, 0x45, 0xcd, 0x48, 0xde, 0xe6, 0xad, 0x2f, 0x66, 0x34,
+	0x3b, 0xc1, 0x86, 0x2d, 0xca, 0x9e, 0xae, 0x8f, 0x9a, 0x3d, 0x4d, 0xee, 0x53, 0xf6, 0xb4, 0x91,
+	0x1f, 0x3c, 0x55, 0x46, 0x7e, 0x70, 0xde, 0x17, 0x19, 0x28, 0x3f, 0xf8, 0x8b, 0xfc, 0x32, 0x7b,
+	0x34, 0x46, 0x38, 0x17, 0x66, 0x47, 0x74, 0x53, 0x4f, 0x3d, 0x73, 0x08, 0x0b, 0xf6, 0x66, 0x43,
+	0x93, 0x51, 0x17, 0xdc, 0xeb, 0x26, 0xb0, 0x07, 0x32, 0x4a, 0x4e, 0xf1, 0x97, 0x2a, 0xe4, 0xfb,
+	0x0e, 0x1c, 0x02, 0xd5, 0x4c, 0x09, 0x95, 0xf2, 0x62, 0xa1, 0x8a, 0x83, 0xac, 0x11, 0x83, 0x7c,
+	0x37, 0x24, 0x3e, 0x91, 0xef, 0xa6, 0xd0, 0x83, 0x41, 0x8a, 0xc5, 0xf6, 0x46, 0xed, 0x4c, 0x49,
+	0x69, 0xac, 0xff, 0x01, 0xac, 0x07, 0x15, 0xa1, 0x38, 0xd8, 0x46, 0xe5, 0xbe, 0x6a, 0x2b, 0x42,
+	0xc0, 0x5a, 0x41, 0xf4, 0xa2, 0x57, 0xd5, 0x6f, 0xb7, 0x79, 0xee, 0x5d, 0x90, 0x88, 0x1b, 0x53,
+	0x75, 0x21, 0x59, 0xdd, 0x05, 0x26, 0x9c, 0xf7, 0x67, 0x15, 0x72, 0xee, 0x00, 0x9e, 0x92, 0xc9,
+	0xb9, 0xae, 0x0d, 0x9c, 0x73, 0x2d, 0x72, 0x87, 0xc6, 0x0b, 0x72, 0x87, 0xf0, 0x64, 0x3e, 0xc0,
+	0x8b, 0xc5, 0x78, 0xb4, 0x60, 0xaa, 0x3e, 0xe2, 0x86, 0xee, 0x02, 0x13, 0x0e, 0xb9, 0xd8, 0x8c,
+	0xdf, 0xa4, 0x7a, 0x4a, 0x22, 0x93, 0x83, 0x84, 0x97, 0xbb, 0xb4, 0xcc, 0x23, 0x76, 0x78, 0x30,
+	0x6f, 0x91, 0x80, 0x14, 0xc9, 0xf4, 0x84, 0xd7, 0x07, 0x9c, 0xf0, 0x9f, 0xaf, 0x90, 0x97, 0xef,
+	0x2b, 0xdd, 0x06, 0xce, 0xdb, 0xc2, 0x80, 0xee, 0xf4, 0xc2, 0xc1, 0x70, 0x6f, 0x60, 0x3d, 0x7c,
+	0x96, 0xba, 0x5d, 0x15, 0xd2, 0x5d, 0x7e, 0xa2, 0x23, 0x9f, 0x25, 0x8b, 0x04, 0xa4, 0x48, 0xde,
+	0xeb, 0xb2, 0xfc, 0xe6, 0x18, 0x79, 0x62, 0x00, 0x1d, 0xa0, 0xc4, 0x84, 0x50, 0x3b, 0xd9, 0xb9,
+	0x7a, 0x9f, 0x92, 0x9d, 0xef, 0x6d, 0xba, 0x5e, 0xca, 0x91, 0x1e, 0x28, 0xf1, 0xf4, 0xab, 0x15,
+	0x72, 0xb6, 0x58, 0x61, 0x71, 0xdf, 0x86, 0x7e, 0x2e, 0x19, 0x1f, 0x68, 0xe6, 0x49, 0x3f, 0xc4,
+	0x7d, 0x5c, 0x56, 0x17, 0xa4, 0x61, 0x31, 0xd5, 0x19, 0xcb, 0xcb, 0x27, 0x17, 0xee, 0x86, 0x49,
+	0x4f, 0x14, 0x96, 0x9b, 0xe1, 0x27, 0xaf, 0xb2, 0x15, 0x0c, 0x08, 0x24, 0xc7, 0x7e, 0x2d, 0x61,
+	0x01, 0x0d, 0xfe, 0x10, 0x37, 0x3d, 0x1f, 0x92, 0xd7, 0x30, 0x1a, 0x5d, 0x90, 0x86, 0x45, 0x72,
+	0xec, 0x6c, 0x9f, 0x0f, 0x74, 0x4c, 0x67, 0x56, 0xaf, 0xa8, 0x56, 0x30, 0x20, 0xd2, 0x19, 0xe0,
+	0xb5, 0x83, 0x33, 0xc0, 0xbd, 0x7f, 0x5e, 0x21, 0x67, 0x0a, 0x15, 0xde, 0xc1, 0xd8, 0xd4, 0x83,
+	0x97, 0x85, 0x7d, 0x8f, 0x3b, 0x6c, 0xa8, 0xec, 0x5d, 0xef, 0x0f, 0x0b, 0x56, 0x9a, 0xc8, 0xcc,
+	0xbd, 0xf7, 0x22, 0x26, 0x0f, 0xde, 0x7c, 0x66, 0x92, 0x71, 0xc7, 0x86, 0x48, 0xc6, 0x4d, 0x7d,
+	0x8c, 0xda, 0x80, 0xd2, 0xe1, 0xbf, 0x8e, 0x15, 0x4e, 0x2f, 0x1a, 0xc8, 0x03, 0x9d, 0x20, 0x2c,
+	0x91, 0x13, 0x61, 0x87, 0x5d, 0xac, 0xdb, 0xe8, 0x6f, 0x8a, 0x5a, 0x63, 0xbc, 0xa0, 0xae, 0x4a,
+	0x85, 0x59, 0x4e, 0xf5, 0x43, 0xe6, 0x89, 0x07, 0x30, 0x39, 0xfa, 0xde, 0xa6, 0x74, 0x48, 0xce,
+	0xbd, 0x86, 0x49, 0x54, 0x7c, 0x2a, 0x76, 0x28, 0xf7, 0x6f, 0x09, 0x61, 0x9b, 0x88, 0xe4, 0xa7,
+	0x33, 0x3c, 0x81, 0x2a, 0x07, 0x00, 0xf2, 0x9f, 0x63, 0xb7, 0xa0, 0x46, 0xdd, 0xb0, 0x29, 0x4c,
+	0x41, 0x7d, 0x0b, 0x2a, 0x36, 0x02, 0xef, 0xd3, 0xf2, 0xa2, 0x7e, 0x34, 0xf2, 0xe2, 0x03, 0xa4,
+	0xae, 0xe6, 0x9b, 0x27, 0x38, 0xa8, 0x45, 0x9e, 0x49, 0x70, 0x50, 0x2b, 0xdc, 0x80, 0xc2, 0xd5,
+	0x81, 0x86, 0x4a, 0x6a, 0xb7, 0x22, 0x3d, 0x6c, 0xf7, 0x9e, 0x26, 0xd3, 0xca, 0x17, 0x38, 0xe8,
+	0x5d, 0xb4, 0xde, 0x5f, 0x54, 0x48, 0xea, 0xda, 0x35, 0x2c, 0xe8, 0x8c, 0xd7, 0xc6, 0x71, 0xd7,
+	0x7a, 0x29, 0x05, 0x9d, 0x97, 0x24, 0x3a, 0x7d, 0x10, 0xa6, 0x9a, 0x40, 0x13, 0x73, 0x3f, 0xc4,
+	0x6b, 0x27, 0x0b, 0xd2, 0x95, 0x32, 0x12, 0xe4, 0x1b, 0x0a, 0x9f, 0x79, 0xd9, 0xa4, 0x6c, 0x03,
+	0x83, 0x9e, 0xdb, 0x23, 0xf5, 0x1d, 0x79, 0xbd, 0x5c, 0x39, 0xec, 0x4e, 0xdd, 0x56, 0xc7, 0x55,
+	0x34, 0xf5, 0x13, 0x34, 0x21, 0xef, 0x0f, 0x2a, 0xe4, 0x94, 0xfd, 0x01, 0xc4, 0xc1, 0xe5, 0x2f,
+	0x3a, 0xe4, 0x11, 0xbc, 0x64, 0xb5, 0xd1, 0x67, 0x86, 0xc2, 0x56, 0xbf, 0xbd, 0x96, 0x2a, 0xb3,
+	0x3d, 0xaa, 0xb3, 0x45, 0x21, 0x4e, 0x5f, 0x47, 0xb8, 0xf0, 0x28, 0xa6, 0x8c, 0xad, 0xe4, 0x13,
+	0x87, 0xa2, 0x51, 0xa1, 0x87, 0xea, 0x04, 0xdd, 0xcf, 0x18, 0x0c, 0xa6, 0x87, 0xca, 0xbf, 0xe2,
+	0xb5, 0x52, 0x26, 0x52, 0x0f, 0xf0, 0x14, 0x32, 0xd4, 0xc5, 0x14, 0x2d, 0xc8, 0x50, 0xf7, 0x3e,
+	0x85, 0x92, 0xb3, 0xf0, 0x3d, 0xff, 0x92, 0xdd, 0x9f, 0xf8, 0x27, 0xe3, 0xe4, 0x98, 0x55, 0x4b,
+	0xdc, 0x3a, 0xec, 0x73, 0x0e, 0x3c, 0xec, 0x63, 0xe9, 0x7a, 0xfd, 0x8e, 0xbc, 0x5a, 0xde, 0x48,
+	0xd7, 0xa3, 0x8d, 0xc0, 0xfb, 0xc4, 0x94, 0x42, 0xbf, 0x23, 0x72, 0x01, 0xcc, 0x29, 0xa5, 0xad,
+	0x20, 0x7a, 0x31, 0x56, 0x72, 0x9a, 0x6d, 0x3e, 0x71, 0x54, 0x2a, 0x04, 0xda, 0x95, 0x12, 0xb6,
+	0xbb, 0xac, 0x9b, 0xcf, 0x62, 0x47, 0xcd, 0x16, 0xb0, 0x28, 0xe2, 0xc5, 0x6a, 0x75, 0x75, 0x8f,
+	0xad, 0x38, 0x1b, 0x69, 0x94, 0x5b, 0xaa, 0x3d, 0xc5, 0xf5, 0x54, 0xcd, 0x6c, 0xd0, 0x84, 0xf1,
+	0x52, 0x39, 0x71, 0x8e, 0x39, 0x71, 0x38, 0xe7, 0x98, 0x24, 0xe7, 0x0c, 0x13, 0x6f, 0xe6, 0xa0,
+	0x7a, 0xe0, 0x56, 0x90, 0xf4, 0xf8, 0xd1, 0xa2, 0xbc, 0x99, 0x43, 0x36, 0x82, 0xee, 0x47, 0x65,
+	0x3f, 0x61, 0x2f, 0xd6, 0x33, 0xce, 0x02, 0x99, 0xb2, 0xdf, 0xd0, 0xcd, 0x60, 0xc2, 0x98, 0x07,
+	0x97, 0xe4, 0xbe, 0x1e, 0x5c, 0x4e, 0x1d, 0x70, 0x70, 0xd9, 0x20, 0xa7, 0xf1, 0x7a, 0x03, 0x0c,
+	0x63, 0x98, 0xef, 0xa1, 0x1b, 0xb5, 0x97, 0xf0, 0xf2, 0xf3, 0xd3, 0xcc, 0x05, 0xac, 0xa2, 0xdd,
+	0x1a, 0x41, 0x7b, 0x2b, 0x03, 0x04, 0xf9, 0xcf, 0x7a, 0xff, 0xd4, 0x21, 0xa7, 0x73, 0x97, 0xc2,
+	0x83, 0x9b, 0x67, 0xe0, 0xfd, 0x54, 0x8d, 0x3c, 0x94, 0x73, 0xd3, 0x80, 0xbb, 0x67, 0x6e, 0x12,
+	0xa7, 0x8c, 0x90, 0x3d, 0x3b, 0x02, 0x4d, 0x7e, 0x9b, 0x9c, 0x9d, 0x31, 0x5c, 0x2c, 0x82, 0x8e,
+	0x07, 0xa8, 0x1e, 0x6d, 0x3c, 0x80, 0xb1, 0xd6, 0xc7, 0xee, 0xeb, 0x5a, 0xaf, 0x1d, 0xb0, 0xd6,
+	0x7f, 0xc9, 0x21, 0xb3, 0xbb, 0x05, 0xd7, 0x86, 0x89, 0xf3, 0xa4, 0x1b, 0x87, 0x73, 0x29, 0xd9,
+	0xc2, 0x63, 0x98, 0xab, 0x5c, 0xd4, 0x0b, 0x85, 0xa3, 0xf2, 0xbe, 0x5d, 0x25, 0x4c, 0x5f, 0x63,
+	0xd5, 0xa4, 0xf7, 0xdc, 0x8f, 0x98, 0x17, 0x96, 0x38, 0x65, 0x5d, 0xae, 0xc1, 0x91, 0xab, 0x0b,
+	0x4f, 0xf8, 0x0c, 0xe6, 0xdd, 0x7f, 0x92, 0xe6, 0x84, 0x95, 0x01, 0x38, 0x61, 0x5b, 0xde, 0x0c,
+	0x53, 0x2d, 0xff, 0x66, 0x98, 0x7a, 0xfa, 0x56, 0x98, 0xfd, 0x3f, 0xf1, 0xd8, 0x03, 0xf9, 0x89,
+	0x7f, 0xc3, 0xe1, 0x8c, 0x27, 0xf5, 0x15, 0xb4, 0xba, 0xe1, 0xec, 0xa3, 0x6e, 0x60, 0x28, 0x98,
+	0xe0, 0xcc, 0x42, 0x2d, 0xd1, 0xa1, 0x60, 0xa2, 0x1d, 0x14, 0x04, 0x5a, 0x5d, 0xd4, 0x4a, 0x8d,
+	0xee, 0x5c, 0xa0, 0xac, 0x7a, 0x4f, 0x28, 0x28, 0xca, 0x2c, 0x98, 0x57, 0x3d, 0x60, 0x40, 0xd1,
+	0x61, 0x8c, 0xf3, 0xb2, 0x0f, 0xc2, 0xb9, 0x33, 0x85, 0xfb, 0x90, 0xd7, 0x84, 0x68, 0x81, 0xe8,
+	0xf2, 0x76, 0x88, 0x61, 0x55, 0xdc, 0xfb, 0xdd, 0xd4, 0x07, 0x5f, 0x37, 0xe9, 0xfd, 0xbd, 0x8a,
+	0x20, 0xc5, 0xad, 0x04, 0x1d, 0x19, 0xe8, 0x0c, 0x19, 0x19, 0x48, 0x8d, 0x2d, 0xba, 0x00, 0x30,
+	0x77, 0xa3, 0xb5, 0x11, 0x95, 0x63, 0x6c, 0x2d, 0x2a, 0x7c, 0x7a, 0x56, 0x75, 0x1b, 0x18, 0xf4,
+	0x2c, 0xd6, 0x5e, 0x3d, 0x90, 0xb5, 0x5b, 0x5c, 0x6e, 0x6c, 0x7f, 0x2e, 0xe7, 0xfd, 0x19, 0xd5,
+	0x2c, 0x4d, 0xad, 0x0f, 0xef, 0x66, 0xc2, 0xe1, 0xee, 0x09, 0x86, 0xb1, 0x56, 0x9e, 0x8a, 0x89,
+	0x9c, 0x5a, 0xec, 0x42, 0xf6, 0x27, 0x70, 0x42, 0x74, 0xcf, 0xf3, 0x28, 0xc8, 0x52, 0x8c, 0x1f,
+	0x93, 0x20, 0xc6, 0x51, 0xf2, 0x60, 0x22, 0x1d, 0x51, 0xe9, 0xbd, 0x89, 0x9c, 0xcc, 0x0c, 0x8a,
+	0xdd, 0x67, 0x1d, 0x49, 0x0b, 0xde, 0xd8, 0x3d, 0xac, 0xfa, 0x02, 0xf0, 0x3e, 0x0c, 0x58, 0x3c,
+	0x91, 0x46, 0x8f, 0x27, 0xb7, 0x27, 0x93, 0x34, 0xbe, 0xc3, 0x9a, 0x3b, 0x95, 0xed, 0x90, 0xe9,
+	0x82, 0xec, 0x20, 0xbc, 0xff, 0x21, 0xa4, 0xc1, 0x4d, 0xaa, 0x03, 0x45, 0x77, 0x94, 0x9e, 0xe4,
+	0x14, 0xea, 0x49, 0xc8, 0x1e, 0x9a, 0x3b, 0x41, 0xab, 0xdf, 0xce, 0xd4, 0x84, 0x68, 0x88, 0x76,
+	0x50, 0x10, 0x2c, 0x05, 0xbe, 0x2f, 0xec, 0xd6, 0xd4, 0xa2, 0x5c, 0x12, 0xed, 0xa0, 0x20, 0x30,
+	0x61, 0xcd, 0x78, 0x49, 0xb9, 0x2e, 0x99, 0xd1, 0x61, 0x48, 0xf0, 0x04, 0x2c, 0x28, 0x74, 0xb4,
+	0x2b, 0x9d, 0x4b, 0x4a, 0x6c, 0xe6, 0x68, 0x57, 0x8c, 0x31, 0x01, 0x03, 0x82, 0x15, 0x9c, 0x68,
+	0xf7, 0x13, 0x76, 0x92, 0x3c, 0xae, 0x6f, 0x57, 0x58, 0x14, 0x6d, 0xa0, 0x7a, 0x91, 0xb9, 0x51,
+	0x1e, 0xdb, 0xf7, 0xdb, 0x38, 0x43, 0xc2, 0x75, 0xa6, 0xb6, 0xe1, 0xaa, 0xea, 0x01, 0x03, 0x0a,
+	0xdf, 0xb8, 0x17, 0xee, 0x06, 0xef, 0x89, 0x3a, 0x32, 0x4a, 0x5d, 0x07, 0x17, 0x88, 0x76, 0x50,
+	0x10, 0x94, 0xd9, 0x4c, 0xf9, 0x9d, 0x16, 0x57, 0x10, 0xa9, 0x2d, 0x5b, 0xb7, 0x4b, 0xec, 0x60,
+	0x25, 0x12, 0xdd, 0x0b, 0x26, 0x68, 0xfa, 0x6a, 0x09, 0x32, 0xe0, 0xd5, 0x75, 0x7f, 0xea, 0x90,
+	0xe3, 0xba, 0x82, 0x10, 0xf3, 0xb0, 0x59, 0xae, 0x45, 0xe7, 0x40, 0xd7, 0xa2, 0x5d, 0x48, 0xa4,
+	0x32, 0x50, 0x21, 0x11, 0xb3, 0xc6, 0x47, 0x75, 0xdf, 0x1a, 0x1f, 0xdf, 0x4f, 0x26, 0x6e, 0x05,
+	0x7b, 0x46, 0x31, 0x10, 0x26, 0x1c, 0xae, 0xf2, 0x26, 0x90, 0x7d, 0x18, 0xba, 0xde, 0xf4, 0x55,
+	0x41, 0xc1, 0x69, 0x11, 0x9b, 0x36, 0xcf, 0x80, 0x44, 0x8f, 0xb7, 0x46, 0xea, 0xea, 0x50, 0x5f,
+	0x7a, 0xfa, 0x9c, 0x7c, 0x4f, 0x1f, 0xee, 0x6d, 0x23, 0x3e, 0x41, 0xef, 0x6d, 0x16, 0xd5, 0x20,
+	0xc2, 0x15, 0x16, 0x36, 0xbf, 0xf1, 0xc7, 0xaf, 0x78, 0xd9, 0xef, 0xd2, 0x7f, 0xbf, 0x4f, 0xff,
+	0x7d, 0xf4, 0x3b, 0xaf, 0x70, 0xbe, 0x41, 0xff, 0xfd, 0x2e, 0xfd, 0xf7, 0xfb, 0xf4, 0xdf, 0xb7,
+	0xe9, 0xbf, 0xcf, 0xff, 0x97, 0x57, 0xbc, 0xec, 0x3d, 0xb9, 0x79, 0x11, 0xf8, 0xc7, 0x93, 0xcd,
+	0xd6, 0xf9, 0xdb, 0x4f, 0xb3, 0xd0, 0x7c, 0xdc, 0xcf, 0xe7, 0x8d, 0x45, 0x7c, 0x5e, 0xee, 0xe7,
+	0xff, 0x0f, 0xae, 0x29, 0x9f, 0x31, 0xdb, 0x01, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -8661,6 +8657,43 @@ func (m *ApplicationTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemovedHosts) > 0 {
+		for iNdEx := len(m.RemovedHosts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedHosts[iNdEx])
+			copy(dAtA[i:], m.RemovedHosts[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RemovedHosts[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RemovedOrphanedNodes) > 0 {
+		for iNdEx := len(m.RemovedOrphanedNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovedOrphanedNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RemovedNodes) > 0 {
+		for iNdEx := len(m.RemovedNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovedNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ShardsCount))
 	i--
 	dAtA[i] = 0x20
@@ -17212,6 +17245,24 @@ func (m *ApplicationTree) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.ShardsCount))
+	if len(m.RemovedNodes) > 0 {
+		for _, e := range m.RemovedNodes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.RemovedOrphanedNodes) > 0 {
+		for _, e := range m.RemovedOrphanedNodes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.RemovedHosts) > 0 {
+		for _, s := range m.RemovedHosts {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -20745,11 +20796,24 @@ func (this *ApplicationTree) String() string {
 		repeatedStringForHosts += strings.Replace(strings.Replace(f.String(), "HostInfo", "HostInfo", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForHosts += "}"
+	repeatedStringForRemovedNodes := "[]ResourceRef{"
+	for _, f := range this.RemovedNodes {
+		repeatedStringForRemovedNodes += strings.Replace(strings.Replace(f.String(), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRemovedNodes += "}"
+	repeatedStringForRemovedOrphanedNodes := "[]ResourceRef{"
+	for _, f := range this.RemovedOrphanedNodes {
+		repeatedStringForRemovedOrphanedNodes += strings.Replace(strings.Replace(f.String(), "ResourceRef", "ResourceRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRemovedOrphanedNodes += "}"
 	s := strings.Join([]string{`&ApplicationTree{`,
 		`Nodes:` + repeatedStringForNodes + `,`,
 		`OrphanedNodes:` + repeatedStringForOrphanedNodes + `,`,
 		`Hosts:` + repeatedStringForHosts + `,`,
 		`ShardsCount:` + fmt.Sprintf("%v", this.ShardsCount) + `,`,
+		`RemovedNodes:` + repeatedStringForRemovedNodes + `,`,
+		`RemovedOrphanedNodes:` + repeatedStringForRemovedOrphanedNodes + `,`,
+		`RemovedHosts:` + fmt.Sprintf("%v", this.RemovedHosts) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -32045,6 +32109,106 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedNodes = append(m.RemovedNodes, ResourceRef{})
+			if err := m.RemovedNodes[len(m.RemovedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedOrphanedNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedOrphanedNodes = append(m.RemovedOrphanedNodes, ResourceRef{})
+			if err := m.RemovedOrphanedNodes[len(m.RemovedOrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedHosts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedHosts = append(m.RemovedHosts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
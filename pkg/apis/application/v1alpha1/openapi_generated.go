@@ -2569,11 +2569,54 @@ func schema_pkg_apis_application_v1alpha1_ApplicationTree(ref common.ReferenceCa
 							},
 						},
 					},
+					"removedNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovedNodes lists the nodes removed since the previous tree of a resource tree stream in delta mode.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceRef"),
+									},
+								},
+							},
+						},
+					},
+					"removedOrphanedNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovedOrphanedNodes lists the orphaned nodes removed since the previous tree of a resource tree stream in delta mode.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceRef"),
+									},
+								},
+							},
+						},
+					},
+					"removedHosts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemovedHosts lists the names of the hosts removed since the previous tree of a resource tree stream in delta mode.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostInfo", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceNode"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostInfo", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceNode", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceRef"},
 	}
 }
 
@@ -1806,6 +1806,8 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionIgnoredSourceWarning indicates that application has a source which is ignored because it has multiple sources
+	ApplicationConditionIgnoredSourceWarning = "IgnoredSourceWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -1927,6 +1929,13 @@ type ApplicationTree struct {
 	// ShardsCount represents the total number of shards the application tree is split into.
 	// This is used to distribute resource processing across multiple shards.
 	ShardsCount int64 `json:"shardsCount,omitempty" protobuf:"bytes,4,opt,name=shardsCount"`
+	// RemovedNodes lists the nodes removed since the previous tree of a resource tree stream in delta mode.
+	RemovedNodes []ResourceRef `json:"removedNodes,omitempty" protobuf:"bytes,5,rep,name=removedNodes"`
+	// RemovedOrphanedNodes lists the orphaned nodes removed since the previous tree of a resource tree stream in
+	// delta mode.
+	RemovedOrphanedNodes []ResourceRef `json:"removedOrphanedNodes,omitempty" protobuf:"bytes,6,rep,name=removedOrphanedNodes"`
+	// RemovedHosts lists the names of the hosts removed since the previous tree of a resource tree stream in delta mode.
+	RemovedHosts []string `json:"removedHosts,omitempty" protobuf:"bytes,7,rep,name=removedHosts"`
 }
 
 func (t *ApplicationTree) Merge(other *ApplicationTree) {
@@ -3059,6 +3068,34 @@ func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
 }
 
+// NextChange returns the time at which the sync window next changes state: the end of the currently active
+// occurrence if the window is active, or the start of its next occurrence otherwise
+func (w SyncWindow) NextChange() (time.Time, error) {
+	return w.nextChange(time.Now())
+}
+
+func (w SyncWindow) nextChange(currentTime time.Time) (time.Time, error) {
+	currentTime = currentTime.UTC()
+
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, sErr := specParser.Parse(w.Schedule)
+	if sErr != nil {
+		return time.Time{}, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
+	}
+	duration, dErr := time.ParseDuration(w.Duration)
+	if dErr != nil {
+		return time.Time{}, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+	}
+
+	// The schedule is evaluated in the window's time zone, so the result is shifted back by the same offset
+	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
+	nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
+	if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+		return nextWindow.Add(duration - timeZoneOffsetDuration), nil
+	}
+	return nextWindow.Add(-timeZoneOffsetDuration), nil
+}
+
 // Update updates a sync window's settings with the given parameter
 func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string, description string) error {
 	if s == "" && d == "" && len(a) == 0 && len(n) == 0 && len(c) == 0 && description == "" {
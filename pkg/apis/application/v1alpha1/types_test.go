@@ -105,6 +105,34 @@ func TestAppProject_IsNegatedSourcePermitted(t *testing.T) {
 	}
 }
 
+func TestAppProject_MatchSourceRepos(t *testing.T) {
+	testData := []struct {
+		projSources []string
+		appSource   string
+		isPermitted bool
+		pattern     string
+	}{{
+		projSources: []string{"https://github.com/argoproj/test1.git", "https://github.com/argoproj/*"}, appSource: "https://github.com/argoproj/test2.git", isPermitted: true, pattern: "https://github.com/argoproj/*",
+	}, {
+		projSources: []string{"!https://github.com/argoproj/*.git", "*"}, appSource: "https://github.com/argoproj1/test2.git", isPermitted: true, pattern: "*",
+	}, {
+		projSources: []string{"*", "!https://github.com/argoproj/*"}, appSource: "https://github.com/argoproj/test.git", isPermitted: false, pattern: "!https://github.com/argoproj/*",
+	}, {
+		projSources: []string{"https://github.com/argoproj/test1.git"}, appSource: "https://github.com/argoproj/test2.git", isPermitted: false, pattern: "",
+	}}
+
+	for _, data := range testData {
+		proj := AppProject{
+			Spec: AppProjectSpec{
+				SourceRepos: data.projSources,
+			},
+		}
+		permitted, pattern := proj.MatchSourceRepos(ApplicationSource{RepoURL: data.appSource})
+		assert.Equal(t, data.isPermitted, permitted)
+		assert.Equal(t, data.pattern, pattern)
+	}
+}
+
 func TestAppProject_IsDestinationPermitted(t *testing.T) {
 	t.Parallel()
 
@@ -3024,6 +3052,37 @@ func TestSyncWindow_Active(t *testing.T) {
 	}
 }
 
+func TestSyncWindow_NextChange(t *testing.T) {
+	now := time.Now().UTC()
+	timeWithHour := func(hour int) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("Active", func(t *testing.T) {
+		window := SyncWindow{Kind: "deny", Schedule: "0 10 * * *", Duration: "2h"}
+		nextChange, err := window.nextChange(timeWithHour(11))
+		require.NoError(t, err)
+		assert.Equal(t, timeWithHour(12), nextChange)
+	})
+	t.Run("Inactive", func(t *testing.T) {
+		window := SyncWindow{Kind: "allow", Schedule: "0 10 * * *", Duration: "2h"}
+		nextChange, err := window.nextChange(timeWithHour(8))
+		require.NoError(t, err)
+		assert.Equal(t, timeWithHour(10), nextChange)
+	})
+	t.Run("InactiveUntilNextDay", func(t *testing.T) {
+		window := SyncWindow{Kind: "allow", Schedule: "0 10 * * *", Duration: "2h"}
+		nextChange, err := window.nextChange(timeWithHour(13))
+		require.NoError(t, err)
+		assert.Equal(t, timeWithHour(10).AddDate(0, 0, 1), nextChange)
+	})
+	t.Run("InvalidDuration", func(t *testing.T) {
+		window := SyncWindow{Kind: "allow", Schedule: "0 10 * * *", Duration: "2a"}
+		_, err := window.nextChange(timeWithHour(8))
+		require.Error(t, err)
+	})
+}
+
 func TestSyncWindow_Update(t *testing.T) {
 	e := SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"app1"}}
 	t.Run("AddApplication", func(t *testing.T) {
@@ -1499,6 +1499,21 @@ func (in *ApplicationTree) DeepCopyInto(out *ApplicationTree) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RemovedNodes != nil {
+		in, out := &in.RemovedNodes, &out.RemovedNodes
+		*out = make([]ResourceRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovedOrphanedNodes != nil {
+		in, out := &in.RemovedOrphanedNodes, &out.RemovedOrphanedNodes
+		*out = make([]ResourceRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovedHosts != nil {
+		in, out := &in.RemovedHosts, &out.RemovedHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
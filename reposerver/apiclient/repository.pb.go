@@ -63,10 +63,13 @@ type ManifestRequest struct {
 	// argocd.argoproj.io/manifest-generate-paths annotation value of the Application to allow optimize which resources propagated to cmpserver
 	AnnotationManifestGeneratePaths string `protobuf:"bytes,26,opt,name=annotationManifestGeneratePaths,proto3" json:"annotationManifestGeneratePaths,omitempty"`
 	// Holds instance installation id
-	InstallationID       string   `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	InstallationID string `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	// ClusterLabels are the labels of the destination cluster, exposed to config management tools as
+	// ARGOCD_APP_CLUSTER_LABEL_<KEY> environment variables.
+	ClusterLabels        map[string]string `protobuf:"bytes,28,rep,name=clusterLabels,proto3" json:"clusterLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
@@ -270,6 +273,13 @@ func (m *ManifestRequest) GetInstallationID() string {
 	return ""
 }
 
+func (m *ManifestRequest) GetClusterLabels() map[string]string {
+	if m != nil {
+		return m.ClusterLabels
+	}
+	return nil
+}
+
 type ManifestRequestWithFiles struct {
 	// Types that are valid to be assigned to Part:
 	//	*ManifestRequestWithFiles_Request
@@ -711,10 +721,15 @@ type ManifestResponse struct {
 	// Raw response of git verify-commit operation (always the empty string for Helm)
 	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
 	// Commands is the list of commands used to hydrate the manifests
-	Commands             []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Commands []string `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	// GenerationSettings are the effective settings the manifests of each source were generated with
+	GenerationSettings []*ManifestGenerationSettings `protobuf:"bytes,9,rep,name=generationSettings,proto3" json:"generationSettings,omitempty"`
+	// LiveDiffs are the differences between each of the manifests and the live state of its resource, in the same
+	// order as the manifests. Only set by the API server when requested.
+	LiveDiffs            []*ManifestLiveDiff `protobuf:"bytes,10,rep,name=liveDiffs,proto3" json:"liveDiffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
@@ -799,6 +814,263 @@ func (m *ManifestResponse) GetCommands() []string {
 	return nil
 }
 
+func (m *ManifestResponse) GetGenerationSettings() []*ManifestGenerationSettings {
+	if m != nil {
+		return m.GenerationSettings
+	}
+	return nil
+}
+
+func (m *ManifestResponse) GetLiveDiffs() []*ManifestLiveDiff {
+	if m != nil {
+		return m.LiveDiffs
+	}
+	return nil
+}
+
+// ManifestLiveDiff is the difference between a generated manifest and the live state of its resource
+type ManifestLiveDiff struct {
+	Group     string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind      string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// NormalizedLiveState is the normalized live state of the resource, empty if the resource does not exist
+	NormalizedLiveState string `protobuf:"bytes,5,opt,name=normalizedLiveState,proto3" json:"normalizedLiveState,omitempty"`
+	// PredictedLiveState is the live state the resource would have after applying the manifest
+	PredictedLiveState   string   `protobuf:"bytes,6,opt,name=predictedLiveState,proto3" json:"predictedLiveState,omitempty"`
+	Modified             bool     `protobuf:"varint,7,opt,name=modified,proto3" json:"modified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManifestLiveDiff) Reset()         { *m = ManifestLiveDiff{} }
+func (m *ManifestLiveDiff) String() string { return proto.CompactTextString(m) }
+func (*ManifestLiveDiff) ProtoMessage()    {}
+func (*ManifestLiveDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{9}
+}
+func (m *ManifestLiveDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestLiveDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestLiveDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManifestLiveDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestLiveDiff.Merge(m, src)
+}
+func (m *ManifestLiveDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestLiveDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestLiveDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestLiveDiff proto.InternalMessageInfo
+
+func (m *ManifestLiveDiff) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ManifestLiveDiff) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ManifestLiveDiff) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ManifestLiveDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ManifestLiveDiff) GetNormalizedLiveState() string {
+	if m != nil {
+		return m.NormalizedLiveState
+	}
+	return ""
+}
+
+func (m *ManifestLiveDiff) GetPredictedLiveState() string {
+	if m != nil {
+		return m.PredictedLiveState
+	}
+	return ""
+}
+
+func (m *ManifestLiveDiff) GetModified() bool {
+	if m != nil {
+		return m.Modified
+	}
+	return false
+}
+
+// ManifestGenerationSettings are the effective settings manifests were generated with. They contain no credentials.
+type ManifestGenerationSettings struct {
+	RepoURL string `protobuf:"bytes,1,opt,name=repoURL,proto3" json:"repoURL,omitempty"`
+	// revision, potentially un-resolved
+	Revision             string                      `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	ApplicationSource    *v1alpha1.ApplicationSource `protobuf:"bytes,3,opt,name=applicationSource,proto3" json:"applicationSource,omitempty"`
+	AppLabelKey          string                      `protobuf:"bytes,4,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
+	Namespace            string                      `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	KubeVersion          string                      `protobuf:"bytes,6,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
+	ApiVersions          []string                    `protobuf:"bytes,7,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
+	TrackingMethod       string                      `protobuf:"bytes,8,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	InstallationID       string                      `protobuf:"bytes,9,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	KustomizeOptions     *v1alpha1.KustomizeOptions  `protobuf:"bytes,10,opt,name=kustomizeOptions,proto3" json:"kustomizeOptions,omitempty"`
+	EnabledSourceTypes   map[string]bool             `protobuf:"bytes,11,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	HelmOptions          *v1alpha1.HelmOptions       `protobuf:"bytes,12,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
+	ClusterLabels        map[string]string           `protobuf:"bytes,13,rep,name=clusterLabels,proto3" json:"clusterLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ManifestGenerationSettings) Reset()         { *m = ManifestGenerationSettings{} }
+func (m *ManifestGenerationSettings) String() string { return proto.CompactTextString(m) }
+func (*ManifestGenerationSettings) ProtoMessage()    {}
+func (*ManifestGenerationSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{10}
+}
+func (m *ManifestGenerationSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestGenerationSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestGenerationSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManifestGenerationSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestGenerationSettings.Merge(m, src)
+}
+func (m *ManifestGenerationSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestGenerationSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestGenerationSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestGenerationSettings proto.InternalMessageInfo
+
+func (m *ManifestGenerationSettings) GetRepoURL() string {
+	if m != nil {
+		return m.RepoURL
+	}
+	return ""
+}
+
+func (m *ManifestGenerationSettings) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *ManifestGenerationSettings) GetApplicationSource() *v1alpha1.ApplicationSource {
+	if m != nil {
+		return m.ApplicationSource
+	}
+	return nil
+}
+
+func (m *ManifestGenerationSettings) GetAppLabelKey() string {
+	if m != nil {
+		return m.AppLabelKey
+	}
+	return ""
+}
+
+func (m *ManifestGenerationSettings) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ManifestGenerationSettings) GetKubeVersion() string {
+	if m != nil {
+		return m.KubeVersion
+	}
+	return ""
+}
+
+func (m *ManifestGenerationSettings) GetApiVersions() []string {
+	if m != nil {
+		return m.ApiVersions
+	}
+	return nil
+}
+
+func (m *ManifestGenerationSettings) GetTrackingMethod() string {
+	if m != nil {
+		return m.TrackingMethod
+	}
+	return ""
+}
+
+func (m *ManifestGenerationSettings) GetInstallationID() string {
+	if m != nil {
+		return m.InstallationID
+	}
+	return ""
+}
+
+func (m *ManifestGenerationSettings) GetKustomizeOptions() *v1alpha1.KustomizeOptions {
+	if m != nil {
+		return m.KustomizeOptions
+	}
+	return nil
+}
+
+func (m *ManifestGenerationSettings) GetEnabledSourceTypes() map[string]bool {
+	if m != nil {
+		return m.EnabledSourceTypes
+	}
+	return nil
+}
+
+func (m *ManifestGenerationSettings) GetHelmOptions() *v1alpha1.HelmOptions {
+	if m != nil {
+		return m.HelmOptions
+	}
+	return nil
+}
+
+func (m *ManifestGenerationSettings) GetClusterLabels() map[string]string {
+	if m != nil {
+		return m.ClusterLabels
+	}
+	return nil
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -810,7 +1082,7 @@ func (m *ListRefsRequest) Reset()         { *m = ListRefsRequest{} }
 func (m *ListRefsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefsRequest) ProtoMessage()    {}
 func (*ListRefsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{9}
+	return fileDescriptor_dd8723cfcc820480, []int{11}
 }
 func (m *ListRefsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +1131,7 @@ func (m *Refs) Reset()         { *m = Refs{} }
 func (m *Refs) String() string { return proto.CompactTextString(m) }
 func (*Refs) ProtoMessage()    {}
 func (*Refs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{10}
+	return fileDescriptor_dd8723cfcc820480, []int{12}
 }
 func (m *Refs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1188,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{11}
+	return fileDescriptor_dd8723cfcc820480, []int{13}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1250,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{12}
+	return fileDescriptor_dd8723cfcc820480, []int{14}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,7 +1297,7 @@ func (m *PluginInfo) Reset()         { *m = PluginInfo{} }
 func (m *PluginInfo) String() string { return proto.CompactTextString(m) }
 func (*PluginInfo) ProtoMessage()    {}
 func (*PluginInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{13}
+	return fileDescriptor_dd8723cfcc820480, []int{15}
 }
 func (m *PluginInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1073,7 +1345,7 @@ func (m *PluginList) Reset()         { *m = PluginList{} }
 func (m *PluginList) String() string { return proto.CompactTextString(m) }
 func (*PluginList) ProtoMessage()    {}
 func (*PluginList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{14}
+	return fileDescriptor_dd8723cfcc820480, []int{16}
 }
 func (m *PluginList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1403,7 @@ func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQ
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{15}
+	return fileDescriptor_dd8723cfcc820480, []int{17}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1253,7 +1525,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{16}
+	return fileDescriptor_dd8723cfcc820480, []int{18}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1605,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{17}
+	return fileDescriptor_dd8723cfcc820480, []int{19}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1399,7 +1671,7 @@ func (m *RepoServerRevisionChartDetailsRequest) Reset()         { *m = RepoServe
 func (m *RepoServerRevisionChartDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionChartDetailsRequest) ProtoMessage()    {}
 func (*RepoServerRevisionChartDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{18}
+	return fileDescriptor_dd8723cfcc820480, []int{20}
 }
 func (m *RepoServerRevisionChartDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1468,7 +1740,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{19}
+	return fileDescriptor_dd8723cfcc820480, []int{21}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1817,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{20}
+	return fileDescriptor_dd8723cfcc820480, []int{22}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +1864,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{21}
+	return fileDescriptor_dd8723cfcc820480, []int{23}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,7 +1924,7 @@ func (m *ParameterAnnouncement) Reset()         { *m = ParameterAnnouncement{} }
 func (m *ParameterAnnouncement) String() string { return proto.CompactTextString(m) }
 func (*ParameterAnnouncement) ProtoMessage()    {}
 func (*ParameterAnnouncement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{22}
+	return fileDescriptor_dd8723cfcc820480, []int{24}
 }
 func (m *ParameterAnnouncement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1756,7 +2028,7 @@ func (m *PluginAppSpec) Reset()         { *m = PluginAppSpec{} }
 func (m *PluginAppSpec) String() string { return proto.CompactTextString(m) }
 func (*PluginAppSpec) ProtoMessage()    {}
 func (*PluginAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{23}
+	return fileDescriptor_dd8723cfcc820480, []int{25}
 }
 func (m *PluginAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +2075,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{24}
+	return fileDescriptor_dd8723cfcc820480, []int{26}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1851,7 +2123,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{25}
+	return fileDescriptor_dd8723cfcc820480, []int{27}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1905,7 +2177,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{26}
+	return fileDescriptor_dd8723cfcc820480, []int{28}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1958,7 +2230,7 @@ func (m *GitFilesRequest) Reset()         { *m = GitFilesRequest{} }
 func (m *GitFilesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitFilesRequest) ProtoMessage()    {}
 func (*GitFilesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{27}
+	return fileDescriptor_dd8723cfcc820480, []int{29}
 }
 func (m *GitFilesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2048,7 +2320,7 @@ func (m *GitFilesResponse) Reset()         { *m = GitFilesResponse{} }
 func (m *GitFilesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitFilesResponse) ProtoMessage()    {}
 func (*GitFilesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{28}
+	return fileDescriptor_dd8723cfcc820480, []int{30}
 }
 func (m *GitFilesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2099,7 +2371,7 @@ func (m *GitDirectoriesRequest) Reset()         { *m = GitDirectoriesRequest{} }
 func (m *GitDirectoriesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesRequest) ProtoMessage()    {}
 func (*GitDirectoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{29}
+	return fileDescriptor_dd8723cfcc820480, []int{31}
 }
 func (m *GitDirectoriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2175,7 +2447,7 @@ func (m *GitDirectoriesResponse) Reset()         { *m = GitDirectoriesResponse{}
 func (m *GitDirectoriesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesResponse) ProtoMessage()    {}
 func (*GitDirectoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{30}
+	return fileDescriptor_dd8723cfcc820480, []int{32}
 }
 func (m *GitDirectoriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2236,7 +2508,7 @@ func (m *UpdateRevisionForPathsRequest) Reset()         { *m = UpdateRevisionFor
 func (m *UpdateRevisionForPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsRequest) ProtoMessage()    {}
 func (*UpdateRevisionForPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{31}
+	return fileDescriptor_dd8723cfcc820480, []int{33}
 }
 func (m *UpdateRevisionForPathsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2385,7 +2657,7 @@ func (m *UpdateRevisionForPathsResponse) Reset()         { *m = UpdateRevisionFo
 func (m *UpdateRevisionForPathsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsResponse) ProtoMessage()    {}
 func (*UpdateRevisionForPathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{32}
+	return fileDescriptor_dd8723cfcc820480, []int{34}
 }
 func (m *UpdateRevisionForPathsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2430,6 +2702,7 @@ func (m *UpdateRevisionForPathsResponse) GetRevision() string {
 
 func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
+	proto.RegisterMapType((map[string]string)(nil), "repository.ManifestRequest.ClusterLabelsEntry")
 	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
 	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.ManifestRequest.RefSourcesEntry")
 	proto.RegisterType((*ManifestRequestWithFiles)(nil), "repository.ManifestRequestWithFiles")
@@ -2440,6 +2713,10 @@ func init() {
 	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
 	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterType((*ManifestLiveDiff)(nil), "repository.ManifestLiveDiff")
+	proto.RegisterType((*ManifestGenerationSettings)(nil), "repository.ManifestGenerationSettings")
+	proto.RegisterMapType((map[string]string)(nil), "repository.ManifestGenerationSettings.ClusterLabelsEntry")
+	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestGenerationSettings.EnabledSourceTypesEntry")
 	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
 	proto.RegisterType((*Refs)(nil), "repository.Refs")
 	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
@@ -2478,158 +2755,173 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0xcb, 0x72, 0x1c, 0x49,
-	0x71, 0x9e, 0xd2, 0x4c, 0xea, 0x5d, 0xb6, 0xe5, 0xf6, 0xd8, 0x16, 0xda, 0x06, 0x3b, 0xbc, 0xf6,
-	0xee, 0x28, 0x6c, 0xc7, 0xae, 0xc1, 0xbb, 0x2c, 0xa1, 0x95, 0x6d, 0x49, 0x6b, 0xcb, 0x16, 0x6d,
-	0xef, 0x12, 0x06, 0x03, 0x51, 0xd3, 0x53, 0xea, 0xe9, 0x55, 0x3f, 0xca, 0xdd, 0xd5, 0x5a, 0xe4,
-	0x08, 0x2e, 0x40, 0x70, 0xe1, 0xc2, 0x69, 0x0f, 0x5c, 0xf9, 0x06, 0x82, 0x23, 0x27, 0x02, 0x8e,
-	0x04, 0x17, 0x2e, 0x44, 0x40, 0xf8, 0x4b, 0x88, 0x7a, 0xf4, 0x73, 0x7a, 0x46, 0x5a, 0x8f, 0xad,
-	0x05, 0x2e, 0x52, 0x57, 0x56, 0x56, 0x66, 0x56, 0x56, 0x66, 0x56, 0x66, 0xd6, 0xc0, 0xe5, 0x80,
-	0x50, 0x3f, 0x24, 0xc1, 0x01, 0x09, 0xd6, 0xc4, 0xa7, 0xcd, 0xfc, 0xe0, 0x30, 0xf3, 0xd9, 0xa5,
-	0x81, 0xcf, 0x7c, 0x04, 0x29, 0xa4, 0xf3, 0xc0, 0xb2, 0xd9, 0x20, 0xea, 0x75, 0x4d, 0xdf, 0x5d,
-	0xc3, 0x81, 0xe5, 0xd3, 0xc0, 0xff, 0x5c, 0x7c, 0xbc, 0x6b, 0xf6, 0xd7, 0x0e, 0x6e, 0xae, 0xd1,
-	0x7d, 0x6b, 0x0d, 0x53, 0x3b, 0x5c, 0xc3, 0x94, 0x3a, 0xb6, 0x89, 0x99, 0xed, 0x7b, 0x6b, 0x07,
-	0xd7, 0xb1, 0x43, 0x07, 0xf8, 0xfa, 0x9a, 0x45, 0x3c, 0x12, 0x60, 0x46, 0xfa, 0x92, 0x72, 0xe7,
-	0xbc, 0xe5, 0xfb, 0x96, 0x43, 0xd6, 0xc4, 0xa8, 0x17, 0xed, 0xad, 0x11, 0x97, 0x32, 0xc5, 0x56,
-	0xff, 0xe7, 0x1c, 0x2c, 0xec, 0x60, 0xcf, 0xde, 0x23, 0x21, 0x33, 0xc8, 0xf3, 0x88, 0x84, 0x0c,
-	0x3d, 0x83, 0x06, 0x17, 0x46, 0xab, 0xae, 0x56, 0xaf, 0xcc, 0xdc, 0xd8, 0xea, 0xa6, 0xd2, 0x74,
-	0x63, 0x69, 0xc4, 0xc7, 0x4f, 0xcd, 0x7e, 0xf7, 0xe0, 0x66, 0x97, 0xee, 0x5b, 0x5d, 0x2e, 0x4d,
-	0x37, 0x23, 0x4d, 0x37, 0x96, 0xa6, 0x6b, 0x24, 0xdb, 0x32, 0x04, 0x55, 0xd4, 0x81, 0x56, 0x40,
-	0x0e, 0xec, 0xd0, 0xf6, 0x3d, 0xad, 0xb6, 0x5a, 0xbd, 0xd2, 0x36, 0x92, 0x31, 0xd2, 0x60, 0xda,
-	0xf3, 0x37, 0xb0, 0x39, 0x20, 0x5a, 0x7d, 0xb5, 0x7a, 0xa5, 0x65, 0xc4, 0x43, 0xb4, 0x0a, 0x33,
-	0x98, 0xd2, 0x07, 0xb8, 0x47, 0x9c, 0xfb, 0xe4, 0x50, 0x6b, 0x88, 0x85, 0x59, 0x10, 0x5f, 0x8b,
-	0x29, 0x7d, 0x88, 0x5d, 0xa2, 0x35, 0xc5, 0x6c, 0x3c, 0x44, 0x17, 0xa0, 0xed, 0x61, 0x97, 0x84,
-	0x14, 0x9b, 0x44, 0x6b, 0x89, 0xb9, 0x14, 0x80, 0x7e, 0x0e, 0x4b, 0x19, 0xc1, 0x1f, 0xfb, 0x51,
-	0x60, 0x12, 0x0d, 0xc4, 0xd6, 0x1f, 0x4d, 0xb6, 0xf5, 0xf5, 0x22, 0x59, 0x63, 0x98, 0x13, 0xfa,
-	0x09, 0x34, 0xc5, 0xc9, 0x6b, 0x33, 0xab, 0xf5, 0xd7, 0xaa, 0x6d, 0x49, 0x16, 0x79, 0x30, 0x4d,
-	0x9d, 0xc8, 0xb2, 0xbd, 0x50, 0x9b, 0x15, 0x1c, 0x9e, 0x4c, 0xc6, 0x61, 0xc3, 0xf7, 0xf6, 0x6c,
-	0x6b, 0x07, 0x7b, 0xd8, 0x22, 0x2e, 0xf1, 0xd8, 0xae, 0x20, 0x6e, 0xc4, 0x4c, 0xd0, 0x0b, 0x58,
-	0xdc, 0x8f, 0x42, 0xe6, 0xbb, 0xf6, 0x0b, 0xf2, 0x88, 0xf2, 0xb5, 0xa1, 0x36, 0x27, 0xb4, 0xf9,
-	0x70, 0x32, 0xc6, 0xf7, 0x0b, 0x54, 0x8d, 0x21, 0x3e, 0xdc, 0x48, 0xf6, 0xa3, 0x1e, 0xf9, 0x8c,
-	0x04, 0xc2, 0xba, 0xe6, 0xa5, 0x91, 0x64, 0x40, 0xd2, 0x8c, 0x6c, 0x35, 0x0a, 0xb5, 0x85, 0xd5,
-	0xba, 0x34, 0xa3, 0x04, 0x84, 0xae, 0xc0, 0xc2, 0x01, 0x09, 0xec, 0xbd, 0xc3, 0xc7, 0xb6, 0xe5,
-	0x61, 0x16, 0x05, 0x44, 0x5b, 0x14, 0xa6, 0x58, 0x04, 0x23, 0x17, 0xe6, 0x06, 0xc4, 0x71, 0xb9,
-	0xca, 0x37, 0x02, 0xd2, 0x0f, 0xb5, 0x25, 0xa1, 0xdf, 0xcd, 0xc9, 0x4f, 0x50, 0x90, 0x33, 0xf2,
-	0xd4, 0xb9, 0x60, 0x9e, 0x6f, 0x28, 0x4f, 0x91, 0x3e, 0x82, 0xa4, 0x60, 0x05, 0x30, 0xba, 0x0c,
-	0xf3, 0x2c, 0xc0, 0xe6, 0xbe, 0xed, 0x59, 0x3b, 0x84, 0x0d, 0xfc, 0xbe, 0x76, 0x4a, 0x68, 0xa2,
-	0x00, 0x45, 0x26, 0x20, 0xe2, 0xe1, 0x9e, 0x43, 0xfa, 0xd2, 0x16, 0x9f, 0x1c, 0x52, 0x12, 0x6a,
-	0xa7, 0xc5, 0x2e, 0x6e, 0x76, 0x33, 0x11, 0xaa, 0x10, 0x20, 0xba, 0x77, 0x87, 0x56, 0xdd, 0xf5,
-	0x58, 0x70, 0x68, 0x94, 0x90, 0x43, 0xfb, 0x30, 0xc3, 0xf7, 0x11, 0x9b, 0xc2, 0x19, 0x61, 0x0a,
-	0xdb, 0x93, 0xe9, 0x68, 0x2b, 0x25, 0x68, 0x64, 0xa9, 0xa3, 0x2e, 0xa0, 0x01, 0x0e, 0x77, 0x22,
-	0x87, 0xd9, 0xd4, 0x21, 0x52, 0x8c, 0x50, 0x5b, 0x16, 0x6a, 0x2a, 0x99, 0x41, 0xf7, 0x01, 0x02,
-	0xb2, 0x17, 0xe3, 0x9d, 0x15, 0x3b, 0xbf, 0x36, 0x6e, 0xe7, 0x46, 0x82, 0x2d, 0x77, 0x9c, 0x59,
-	0xce, 0x99, 0xf3, 0x6d, 0x10, 0x93, 0x29, 0x6f, 0x17, 0x6e, 0xad, 0x09, 0x13, 0x2b, 0x99, 0xe1,
-	0xb6, 0xa8, 0xa0, 0x22, 0x68, 0x9d, 0x93, 0xd6, 0x9a, 0x01, 0xa1, 0x2d, 0xf8, 0x06, 0xf6, 0x3c,
-	0x9f, 0x89, 0xed, 0xc7, 0xa2, 0x6c, 0xaa, 0xf0, 0xbe, 0x8b, 0xd9, 0x20, 0xd4, 0x3a, 0x62, 0xd5,
-	0x51, 0x68, 0xdc, 0x24, 0x6c, 0x2f, 0x64, 0xd8, 0x71, 0x04, 0xd2, 0xf6, 0x1d, 0xed, 0xbc, 0x34,
-	0x89, 0x3c, 0xb4, 0x73, 0x17, 0xce, 0x8e, 0x38, 0x5c, 0xb4, 0x08, 0xf5, 0x7d, 0x72, 0x28, 0x2e,
-	0x85, 0xb6, 0xc1, 0x3f, 0xd1, 0x69, 0x68, 0x1e, 0x60, 0x27, 0x22, 0x22, 0x8c, 0xb7, 0x0c, 0x39,
-	0xb8, 0x5d, 0xfb, 0x76, 0xb5, 0xf3, 0xeb, 0x2a, 0x2c, 0x14, 0x54, 0x55, 0xb2, 0xfe, 0xc7, 0xd9,
-	0xf5, 0xaf, 0xc1, 0x71, 0xf6, 0x9e, 0xe0, 0xc0, 0x22, 0x2c, 0x23, 0x88, 0xfe, 0xf7, 0x2a, 0x68,
-	0x85, 0x33, 0xfc, 0x81, 0xcd, 0x06, 0xf7, 0x6c, 0x87, 0x84, 0xe8, 0x16, 0x4c, 0x07, 0x12, 0xa6,
-	0xae, 0xba, 0xf3, 0x63, 0x8e, 0x7e, 0xab, 0x62, 0xc4, 0xd8, 0xe8, 0x23, 0x68, 0xb9, 0x84, 0xe1,
-	0x3e, 0x66, 0x58, 0xc9, 0xbe, 0x5a, 0xb6, 0x92, 0x73, 0xd9, 0x51, 0x78, 0x5b, 0x15, 0x23, 0x59,
-	0x83, 0xde, 0x83, 0xa6, 0x39, 0x88, 0xbc, 0x7d, 0x71, 0xc9, 0xcd, 0xdc, 0xb8, 0x38, 0x6a, 0xf1,
-	0x06, 0x47, 0xda, 0xaa, 0x18, 0x12, 0xfb, 0xe3, 0x29, 0x68, 0x50, 0x1c, 0x30, 0xfd, 0x1e, 0x9c,
-	0x2e, 0x63, 0xc1, 0x6f, 0x56, 0x73, 0x40, 0xcc, 0xfd, 0x30, 0x72, 0x95, 0x9a, 0x93, 0x31, 0x42,
-	0xd0, 0x08, 0xed, 0x17, 0x52, 0xd5, 0x75, 0x43, 0x7c, 0xeb, 0x6f, 0xc3, 0xd2, 0x10, 0x37, 0x7e,
-	0xa8, 0x52, 0x36, 0x4e, 0x61, 0x56, 0xb1, 0xd6, 0x23, 0x38, 0xf3, 0x44, 0xe8, 0x22, 0xb9, 0x5e,
-	0x4e, 0x22, 0x57, 0xd0, 0xb7, 0x60, 0xb9, 0xc8, 0x36, 0xa4, 0xbe, 0x17, 0x12, 0xee, 0x6c, 0x22,
-	0x1e, 0xdb, 0xa4, 0x9f, 0xce, 0x0a, 0x29, 0x5a, 0x46, 0xc9, 0x8c, 0xfe, 0xfb, 0x1a, 0x2c, 0x1b,
-	0x24, 0xf4, 0x9d, 0x03, 0x12, 0x07, 0xcb, 0x93, 0x49, 0x77, 0x7e, 0x04, 0x75, 0x4c, 0xa9, 0x32,
-	0x93, 0xed, 0xd7, 0x96, 0x50, 0x18, 0x9c, 0x2a, 0x7a, 0x07, 0x96, 0xb0, 0xdb, 0xb3, 0xad, 0xc8,
-	0x8f, 0xc2, 0x78, 0x5b, 0xc2, 0xa8, 0xda, 0xc6, 0xf0, 0x04, 0x0f, 0x38, 0xa1, 0xf0, 0xc8, 0x6d,
-	0xaf, 0x4f, 0x7e, 0x26, 0x72, 0xa8, 0xba, 0x91, 0x05, 0xe9, 0x26, 0x9c, 0x1d, 0x52, 0x92, 0x52,
-	0x78, 0x36, 0x6d, 0xab, 0x16, 0xd2, 0xb6, 0x52, 0x31, 0x6a, 0x23, 0xc4, 0xd0, 0x5f, 0x56, 0x61,
-	0x31, 0x75, 0x2e, 0x45, 0xfe, 0x02, 0xb4, 0x5d, 0x05, 0x0b, 0xb5, 0xaa, 0x88, 0x99, 0x29, 0x20,
-	0x9f, 0xc1, 0xd5, 0x8a, 0x19, 0xdc, 0x32, 0x4c, 0xc9, 0x04, 0x5b, 0x6d, 0x5d, 0x8d, 0x72, 0x22,
-	0x37, 0x0a, 0x22, 0xaf, 0x00, 0x84, 0x49, 0x84, 0xd3, 0xa6, 0xc4, 0x6c, 0x06, 0x82, 0x74, 0x98,
-	0x95, 0xf7, 0xbd, 0x41, 0xc2, 0xc8, 0x61, 0xda, 0xb4, 0xc0, 0xc8, 0xc1, 0x84, 0xbf, 0xf9, 0xae,
-	0x8b, 0xbd, 0x7e, 0xa8, 0xb5, 0x84, 0xc8, 0xc9, 0x58, 0xf7, 0x61, 0xe1, 0x81, 0xcd, 0xf7, 0xb7,
-	0x17, 0x9e, 0x8c, 0xab, 0xbc, 0x0f, 0x0d, 0xce, 0x8c, 0x0b, 0xd5, 0x0b, 0xb0, 0x67, 0x0e, 0x48,
-	0xac, 0xc7, 0x64, 0xcc, 0x83, 0x00, 0xc3, 0x56, 0xa8, 0xd5, 0x04, 0x5c, 0x7c, 0xeb, 0x7f, 0xac,
-	0x49, 0x49, 0xd7, 0x29, 0x0d, 0xbf, 0xfe, 0x02, 0xa0, 0x3c, 0x25, 0xa9, 0x0f, 0xa7, 0x24, 0x05,
-	0x91, 0xbf, 0x4a, 0x4a, 0xf2, 0x9a, 0x2e, 0x39, 0x3d, 0x82, 0xe9, 0x75, 0x4a, 0xb9, 0x20, 0xe8,
-	0x3a, 0x34, 0x30, 0xa5, 0x52, 0xe1, 0x85, 0x78, 0xae, 0x50, 0xf8, 0x7f, 0x25, 0x92, 0x40, 0xed,
-	0xdc, 0x82, 0x76, 0x02, 0x3a, 0x8a, 0x6d, 0x3b, 0xcb, 0x76, 0x15, 0x40, 0xe6, 0xdc, 0xdb, 0xde,
-	0x9e, 0xcf, 0x8f, 0x94, 0x3b, 0x82, 0x5a, 0x2a, 0xbe, 0xf5, 0xdb, 0x31, 0x86, 0x90, 0xed, 0x1d,
-	0x68, 0xda, 0x8c, 0xb8, 0xb1, 0x70, 0xcb, 0x59, 0xe1, 0x52, 0x42, 0x86, 0x44, 0xd2, 0xff, 0xd2,
-	0x82, 0x73, 0xfc, 0xc4, 0x1e, 0x0b, 0x17, 0x5a, 0xa7, 0xf4, 0x0e, 0x61, 0xd8, 0x76, 0xc2, 0xef,
-	0x47, 0x24, 0x38, 0x7c, 0xc3, 0x86, 0x61, 0xc1, 0x94, 0xf4, 0x40, 0x15, 0x2d, 0x5f, 0x7b, 0xf9,
-	0xa5, 0xc8, 0xa7, 0x35, 0x57, 0xfd, 0xcd, 0xd4, 0x5c, 0x65, 0x35, 0x50, 0xe3, 0x84, 0x6a, 0xa0,
-	0xd1, 0x65, 0x70, 0xa6, 0xb8, 0x9e, 0xca, 0x17, 0xd7, 0x25, 0xa5, 0xc5, 0xf4, 0x71, 0x4b, 0x8b,
-	0x56, 0x69, 0x69, 0xe1, 0x96, 0xfa, 0x71, 0x5b, 0xa8, 0xfb, 0xbb, 0x59, 0x0b, 0x1c, 0x69, 0x6b,
-	0x93, 0x14, 0x19, 0xf0, 0x46, 0x8b, 0x8c, 0x4f, 0x73, 0x45, 0x83, 0x2c, 0xdb, 0xdf, 0x3b, 0xde,
-	0x9e, 0xc6, 0x94, 0x0f, 0xff, 0x77, 0xa9, 0xf7, 0xaf, 0x44, 0xc6, 0x45, 0xfd, 0x54, 0x07, 0xc9,
-	0x65, 0xcf, 0xef, 0x21, 0x7e, 0xed, 0xaa, 0xa0, 0xc5, 0xbf, 0xd1, 0x35, 0x68, 0x70, 0x25, 0xab,
-	0x94, 0xf8, 0x6c, 0x56, 0x9f, 0xfc, 0x24, 0xd6, 0x29, 0x7d, 0x4c, 0x89, 0x69, 0x08, 0x24, 0x74,
-	0x1b, 0xda, 0x89, 0xe1, 0x2b, 0xcf, 0xba, 0x90, 0x5d, 0x91, 0xf8, 0x49, 0xbc, 0x2c, 0x45, 0xe7,
-	0x6b, 0xfb, 0x76, 0x40, 0x4c, 0x91, 0x30, 0x36, 0x87, 0xd7, 0xde, 0x89, 0x27, 0x93, 0xb5, 0x09,
-	0x3a, 0xba, 0x0e, 0x53, 0xb2, 0xcf, 0x21, 0x3c, 0x68, 0xe6, 0xc6, 0xb9, 0xe1, 0x60, 0x1a, 0xaf,
-	0x52, 0x88, 0xfa, 0x9f, 0xab, 0xf0, 0x56, 0x6a, 0x10, 0xb1, 0x37, 0xc5, 0x39, 0xfb, 0xd7, 0x7f,
-	0xe3, 0x5e, 0x86, 0x79, 0x51, 0x24, 0xa4, 0xed, 0x0e, 0xd9, 0x79, 0x2b, 0x40, 0xf5, 0x3f, 0x54,
-	0xe1, 0xd2, 0xf0, 0x3e, 0x36, 0x06, 0x38, 0x60, 0xc9, 0xf1, 0x9e, 0xc4, 0x5e, 0xe2, 0x0b, 0xaf,
-	0x96, 0x5e, 0x78, 0xb9, 0xfd, 0xd5, 0xf3, 0xfb, 0xd3, 0xff, 0x54, 0x83, 0x99, 0x8c, 0x01, 0x95,
-	0x5d, 0x98, 0x3c, 0x19, 0x14, 0x76, 0x2b, 0xca, 0x42, 0x71, 0x29, 0xb4, 0x8d, 0x0c, 0x04, 0xed,
-	0x03, 0x50, 0x1c, 0x60, 0x97, 0x30, 0x12, 0xf0, 0x48, 0xce, 0x3d, 0xfe, 0xfe, 0xe4, 0xd1, 0x65,
-	0x37, 0xa6, 0x69, 0x64, 0xc8, 0xf3, 0x6c, 0x56, 0xb0, 0x0e, 0x55, 0xfc, 0x56, 0x23, 0xf4, 0x05,
-	0xcc, 0xef, 0xd9, 0x0e, 0xd9, 0x4d, 0x05, 0x99, 0x12, 0x82, 0x3c, 0x9a, 0x5c, 0x90, 0x7b, 0x59,
-	0xba, 0x46, 0x81, 0x8d, 0x7e, 0x15, 0x16, 0x8b, 0xfe, 0xc4, 0x85, 0xb4, 0x5d, 0x6c, 0x25, 0xda,
-	0x52, 0x23, 0x1d, 0xc1, 0x62, 0xd1, 0x7f, 0xf4, 0x7f, 0xd5, 0xe0, 0x4c, 0x42, 0x6e, 0xdd, 0xf3,
-	0xfc, 0xc8, 0x33, 0x45, 0xeb, 0xb0, 0xf4, 0x2c, 0x4e, 0x43, 0x93, 0xd9, 0xcc, 0x49, 0x12, 0x1f,
-	0x31, 0xe0, 0x77, 0x17, 0xf3, 0x7d, 0x87, 0xd9, 0x54, 0x1d, 0x70, 0x3c, 0x94, 0x67, 0xff, 0x3c,
-	0xb2, 0x03, 0xd2, 0x17, 0x91, 0xa0, 0x65, 0x24, 0x63, 0x3e, 0xc7, 0xb3, 0x1a, 0x91, 0xe2, 0x4b,
-	0x65, 0x26, 0x63, 0x61, 0xf7, 0xbe, 0xe3, 0x10, 0x93, 0xab, 0x23, 0x53, 0x04, 0x14, 0xa0, 0xa2,
-	0xb8, 0x60, 0x81, 0xed, 0x59, 0xaa, 0x04, 0x50, 0x23, 0x2e, 0x27, 0x0e, 0x02, 0x7c, 0xa8, 0x32,
-	0x7f, 0x39, 0x40, 0x1f, 0x42, 0xdd, 0xc5, 0x54, 0x5d, 0x74, 0x57, 0x73, 0xd1, 0xa1, 0x4c, 0x03,
-	0xdd, 0x1d, 0x4c, 0xe5, 0x4d, 0xc0, 0x97, 0x75, 0xde, 0x87, 0x56, 0x0c, 0xf8, 0x4a, 0x29, 0xe1,
-	0xe7, 0x30, 0x97, 0x0b, 0x3e, 0xe8, 0x29, 0x2c, 0xa7, 0x16, 0x95, 0x65, 0xa8, 0x92, 0xc0, 0xb7,
-	0x8e, 0x94, 0xcc, 0x18, 0x41, 0x40, 0x7f, 0x0e, 0x4b, 0xdc, 0x64, 0x84, 0xe3, 0x9f, 0x50, 0x69,
-	0xf3, 0x01, 0xb4, 0x13, 0x96, 0xa5, 0x36, 0xd3, 0x81, 0xd6, 0x41, 0xdc, 0xd2, 0x95, 0xb5, 0x4d,
-	0x32, 0xd6, 0xd7, 0x01, 0x65, 0xe5, 0x55, 0x37, 0xd0, 0xb5, 0x7c, 0x52, 0x7c, 0xa6, 0x78, 0xdd,
-	0x08, 0xf4, 0x38, 0x27, 0xfe, 0x47, 0x0d, 0x16, 0x36, 0x6d, 0xd1, 0x23, 0x39, 0xa1, 0x20, 0x77,
-	0x15, 0x16, 0xc3, 0xa8, 0xe7, 0xfa, 0xfd, 0xc8, 0x21, 0x2a, 0x29, 0x50, 0x37, 0xfd, 0x10, 0x7c,
-	0x5c, 0xf0, 0xe3, 0xca, 0xa2, 0x98, 0x0d, 0x54, 0xf5, 0x2b, 0xbe, 0xd1, 0x87, 0x70, 0xee, 0x21,
-	0xf9, 0x42, 0xed, 0x67, 0xd3, 0xf1, 0x7b, 0x3d, 0xdb, 0xb3, 0x62, 0x26, 0x4d, 0xc1, 0x64, 0x34,
-	0x42, 0x59, 0xaa, 0x38, 0x55, 0x9e, 0x2a, 0x26, 0x15, 0xf4, 0x86, 0xef, 0xba, 0x36, 0x53, 0x19,
-	0x65, 0x0e, 0xa6, 0xff, 0xb2, 0x0a, 0x8b, 0xa9, 0x66, 0xd5, 0xd9, 0xdc, 0x92, 0x3e, 0x24, 0x4f,
-	0xe6, 0x52, 0xf6, 0x64, 0x8a, 0xa8, 0xaf, 0xee, 0x3e, 0xb3, 0x59, 0xf7, 0xf9, 0x4d, 0x0d, 0xce,
-	0x6c, 0xda, 0x2c, 0x0e, 0x5c, 0xf6, 0xff, 0xda, 0x29, 0x97, 0x9c, 0x49, 0xe3, 0x78, 0x67, 0xd2,
-	0x2c, 0x39, 0x93, 0x2e, 0x2c, 0x17, 0x95, 0xa1, 0x0e, 0xe6, 0x34, 0x34, 0xa9, 0x68, 0x3a, 0xcb,
-	0xbe, 0x82, 0x1c, 0xe8, 0xbf, 0x98, 0x86, 0x8b, 0x9f, 0xd2, 0x3e, 0x66, 0x49, 0xcf, 0xe8, 0x9e,
-	0x1f, 0x88, 0xae, 0xf3, 0xc9, 0x68, 0xb1, 0xf0, 0x32, 0x58, 0x1b, 0xfb, 0x32, 0x58, 0x1f, 0xf3,
-	0x32, 0xd8, 0x38, 0xd6, 0xcb, 0x60, 0xf3, 0xc4, 0x5e, 0x06, 0x87, 0x6b, 0xad, 0xa9, 0xd2, 0x5a,
-	0xeb, 0x69, 0xae, 0x1e, 0x99, 0x16, 0x6e, 0xf3, 0x9d, 0xac, 0xdb, 0x8c, 0x3d, 0x9d, 0xb1, 0x4f,
-	0x1a, 0x85, 0x07, 0xb5, 0xd6, 0x91, 0x0f, 0x6a, 0xed, 0xe1, 0x07, 0xb5, 0xf2, 0x37, 0x19, 0x18,
-	0xf9, 0x26, 0x73, 0x19, 0xe6, 0xc3, 0x43, 0xcf, 0x24, 0xfd, 0xa4, 0x93, 0x38, 0x23, 0xb7, 0x9d,
-	0x87, 0xe6, 0x3c, 0x62, 0xb6, 0xe0, 0x11, 0x89, 0xa5, 0xce, 0x65, 0x2c, 0xb5, 0xcc, 0x4f, 0xe6,
-	0x47, 0x96, 0xb9, 0x85, 0xe7, 0x92, 0x85, 0xd2, 0xe7, 0x92, 0xff, 0x9a, 0x62, 0xeb, 0x33, 0x58,
-	0x19, 0x75, 0xca, 0xca, 0x79, 0x35, 0x98, 0x36, 0x07, 0xd8, 0xb3, 0x44, 0x5b, 0x50, 0x54, 0xff,
-	0x6a, 0x38, 0xae, 0x3a, 0xb8, 0xf1, 0xe5, 0x2c, 0x2c, 0xa5, 0x59, 0x3f, 0xff, 0x6b, 0x9b, 0x04,
-	0x3d, 0x82, 0xc5, 0xf8, 0x79, 0x29, 0x6e, 0xe4, 0xa2, 0x71, 0x6f, 0x27, 0x9d, 0x0b, 0xe5, 0x93,
-	0x52, 0x34, 0xbd, 0x82, 0x4c, 0x38, 0x57, 0x24, 0x98, 0x3e, 0xd3, 0x7c, 0x6b, 0x0c, 0xe5, 0x04,
-	0xeb, 0x28, 0x16, 0x57, 0xaa, 0xe8, 0x29, 0xcc, 0xe7, 0x1f, 0x13, 0x50, 0x2e, 0x0d, 0x2a, 0x7d,
-	0xdf, 0xe8, 0xe8, 0xe3, 0x50, 0x12, 0xf9, 0x9f, 0x71, 0x33, 0xc8, 0xf5, 0xcd, 0x91, 0x9e, 0xef,
-	0x08, 0x94, 0xbd, 0x3c, 0x74, 0xbe, 0x39, 0x16, 0x27, 0xa1, 0xfe, 0x01, 0xb4, 0xe2, 0x5e, 0x72,
-	0x5e, 0xcd, 0x85, 0x0e, 0x73, 0x67, 0x31, 0x4f, 0x6f, 0x2f, 0xd4, 0x2b, 0xe8, 0x23, 0x98, 0xe1,
-	0x68, 0x8f, 0x36, 0xb6, 0x9f, 0x60, 0xeb, 0x95, 0xd6, 0xb7, 0xe2, 0x5e, 0xeb, 0xf0, 0xe2, 0x4c,
-	0x07, 0xb6, 0x73, 0xaa, 0xa4, 0xeb, 0xa9, 0x57, 0xd0, 0xf7, 0x24, 0xff, 0x5d, 0xf5, 0xf3, 0x80,
-	0xe5, 0xae, 0xfc, 0x35, 0x4a, 0x37, 0xfe, 0x35, 0x4a, 0xf7, 0xae, 0x4b, 0xd9, 0x61, 0xa7, 0xa4,
-	0x2d, 0xa9, 0x08, 0x3c, 0x83, 0xb9, 0x4d, 0xc2, 0xd2, 0x2e, 0x02, 0xba, 0x74, 0xac, 0x5e, 0x4b,
-	0x47, 0x2f, 0xa2, 0x0d, 0x37, 0x22, 0xf4, 0x0a, 0xfa, 0xb2, 0x0a, 0xa7, 0x36, 0x09, 0x2b, 0xd6,
-	0xe5, 0xe8, 0xdd, 0x72, 0x26, 0x23, 0xea, 0xf7, 0xce, 0xc3, 0x49, 0x7d, 0x3a, 0x4f, 0x56, 0xaf,
-	0xa0, 0xdf, 0x56, 0x61, 0x7e, 0x93, 0xf0, 0x73, 0x4b, 0x64, 0xba, 0x3e, 0x5e, 0xa6, 0x92, 0x5a,
-	0xbc, 0x33, 0x61, 0x0f, 0x2c, 0xc3, 0x5d, 0xaf, 0xa0, 0xdf, 0x55, 0xe1, 0x6c, 0x46, 0x57, 0x59,
-	0x7e, 0xaf, 0x22, 0xdb, 0x27, 0x13, 0xfe, 0x10, 0x25, 0x43, 0x52, 0xaf, 0xa0, 0x5d, 0x61, 0x26,
-	0x69, 0xaa, 0x8f, 0x2e, 0x96, 0xe6, 0xf4, 0x09, 0xf7, 0x95, 0x51, 0xd3, 0x89, 0x69, 0x7c, 0x02,
-	0x33, 0x9b, 0x84, 0xc5, 0x39, 0x67, 0xde, 0xf8, 0x0b, 0xe5, 0x40, 0x3e, 0xfa, 0x14, 0xd3, 0x54,
-	0x61, 0xc4, 0x4b, 0x92, 0x56, 0x26, 0xaf, 0xca, 0x87, 0x9f, 0xd2, 0x04, 0x34, 0x6f, 0xc4, 0xe5,
-	0x69, 0x99, 0x5e, 0x41, 0xcf, 0x61, 0xb9, 0x3c, 0xfa, 0xa3, 0xb7, 0x8f, 0x9d, 0x07, 0x74, 0xae,
-	0x1e, 0x07, 0x35, 0x66, 0xf9, 0xf1, 0xfa, 0x5f, 0x5f, 0xae, 0x54, 0xff, 0xf6, 0x72, 0xa5, 0xfa,
-	0xef, 0x97, 0x2b, 0xd5, 0x1f, 0xde, 0x3c, 0xe2, 0x07, 0x6b, 0x99, 0xdf, 0xc0, 0x61, 0x6a, 0x9b,
-	0x8e, 0x4d, 0x3c, 0xd6, 0x9b, 0x12, 0x21, 0xe0, 0xe6, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc9,
-	0x9b, 0xb9, 0x62, 0x22, 0x27, 0x00, 0x00,
+	// 2650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdd, 0x1a, 0x4d, 0x73, 0x1c, 0x47,
+	0x35, 0xfb, 0x21, 0x69, 0xf5, 0xf4, 0xdd, 0xb6, 0xa5, 0xf1, 0xfa, 0x03, 0x67, 0xc0, 0x2e, 0xc7,
+	0x4e, 0x56, 0xd8, 0xae, 0xc4, 0xe0, 0x80, 0xc1, 0x91, 0x6d, 0x59, 0xf1, 0x97, 0x18, 0xc9, 0xa6,
+	0x02, 0x86, 0xd4, 0xec, 0x6e, 0xef, 0xee, 0x44, 0xb3, 0x33, 0xe3, 0x99, 0x59, 0x05, 0xa5, 0x8a,
+	0x0b, 0x50, 0x5c, 0xb8, 0x70, 0xca, 0x81, 0x2b, 0xbf, 0x81, 0xe2, 0xc8, 0x89, 0x82, 0x23, 0xc5,
+	0x85, 0x23, 0x14, 0x27, 0x0e, 0x1c, 0x29, 0xce, 0xbc, 0xfe, 0x98, 0xef, 0xde, 0x95, 0xec, 0xb5,
+	0x37, 0xc0, 0x41, 0xda, 0xe9, 0xee, 0xd7, 0xef, 0xbd, 0x7e, 0xfd, 0xbe, 0x67, 0xe0, 0x82, 0x4f,
+	0x3d, 0x37, 0xa0, 0xfe, 0x3e, 0xf5, 0xd7, 0xf9, 0xa3, 0x15, 0xba, 0xfe, 0x41, 0xea, 0xb1, 0xe1,
+	0xf9, 0x6e, 0xe8, 0x12, 0x48, 0x66, 0xea, 0x0f, 0xba, 0x56, 0xd8, 0x1b, 0x34, 0x1b, 0x2d, 0xb7,
+	0xbf, 0x6e, 0xfa, 0x5d, 0x17, 0x21, 0x3e, 0xe1, 0x0f, 0xef, 0xb4, 0xda, 0xeb, 0xfb, 0xd7, 0xd6,
+	0xbd, 0xbd, 0xee, 0xba, 0xe9, 0x59, 0x01, 0xfe, 0xf3, 0x6c, 0xab, 0x65, 0x86, 0x96, 0xeb, 0xac,
+	0xef, 0x5f, 0x31, 0x6d, 0xaf, 0x67, 0x5e, 0x59, 0xef, 0x52, 0x87, 0xfa, 0x66, 0x48, 0xdb, 0x02,
+	0x73, 0xfd, 0x54, 0xd7, 0x75, 0xbb, 0x36, 0x5d, 0xe7, 0xa3, 0xe6, 0xa0, 0xb3, 0x4e, 0xfb, 0x5e,
+	0x28, 0xc9, 0xea, 0xff, 0x5a, 0x84, 0xa5, 0x87, 0xa6, 0x63, 0x75, 0x68, 0x10, 0x1a, 0xf4, 0xf9,
+	0x00, 0x7f, 0xc8, 0x33, 0xa8, 0x32, 0x66, 0xb4, 0xd2, 0xb9, 0xd2, 0xc5, 0xb9, 0xab, 0xf7, 0x1a,
+	0x09, 0x37, 0x8d, 0x88, 0x1b, 0xfe, 0xf0, 0x71, 0xab, 0xdd, 0xd8, 0xbf, 0xd6, 0x40, 0x6e, 0x1a,
+	0x8c, 0x9b, 0x46, 0x8a, 0x9b, 0x46, 0xc4, 0x4d, 0xc3, 0x88, 0x8f, 0x65, 0x70, 0xac, 0xa4, 0x0e,
+	0x35, 0x9f, 0xee, 0x5b, 0x01, 0x42, 0x69, 0x65, 0xa4, 0x30, 0x6b, 0xc4, 0x63, 0xa2, 0xc1, 0x8c,
+	0xe3, 0x6e, 0x98, 0xad, 0x1e, 0xd5, 0x2a, 0xb8, 0x54, 0x33, 0xa2, 0x21, 0x39, 0x07, 0x73, 0x88,
+	0xfe, 0x81, 0xd9, 0xa4, 0xf6, 0x7d, 0x7a, 0xa0, 0x55, 0xf9, 0xc6, 0xf4, 0x14, 0xdb, 0x8b, 0xc3,
+	0x47, 0x66, 0x9f, 0x6a, 0x53, 0x7c, 0x35, 0x1a, 0x92, 0xd3, 0x30, 0xeb, 0xe0, 0x6f, 0xe0, 0x99,
+	0x2d, 0xaa, 0xd5, 0xf8, 0x5a, 0x32, 0x41, 0x7e, 0x0c, 0x2b, 0x29, 0xc6, 0x77, 0xdc, 0x81, 0x8f,
+	0x50, 0xc0, 0x8f, 0xfe, 0x78, 0xbc, 0xa3, 0xdf, 0xca, 0xa3, 0x35, 0x8a, 0x94, 0xc8, 0x0f, 0x61,
+	0x8a, 0xdf, 0xbc, 0x36, 0x77, 0xae, 0xf2, 0x4a, 0xa5, 0x2d, 0xd0, 0x12, 0x07, 0x66, 0x3c, 0x7b,
+	0xd0, 0xb5, 0x9c, 0x40, 0x9b, 0xe7, 0x14, 0x76, 0xc7, 0xa3, 0xb0, 0xe1, 0x3a, 0x1d, 0xab, 0x8b,
+	0x2a, 0x63, 0x76, 0x69, 0x9f, 0x3a, 0xe1, 0x36, 0x47, 0x6e, 0x44, 0x44, 0xc8, 0x67, 0xb0, 0xbc,
+	0x37, 0x08, 0x42, 0xb7, 0x6f, 0x7d, 0x46, 0x1f, 0x7b, 0x6c, 0x6f, 0xa0, 0x2d, 0x70, 0x69, 0x3e,
+	0x1a, 0x8f, 0xf0, 0xfd, 0x1c, 0x56, 0xa3, 0x40, 0x87, 0x29, 0xc9, 0xde, 0xa0, 0x49, 0x9f, 0x52,
+	0x9f, 0x6b, 0xd7, 0xa2, 0x50, 0x92, 0xd4, 0x94, 0x50, 0x23, 0x4b, 0x8e, 0x02, 0x6d, 0x09, 0x25,
+	0xc2, 0xd5, 0x28, 0x9e, 0x22, 0x17, 0x61, 0x09, 0x4d, 0xd5, 0xea, 0x1c, 0xec, 0x58, 0x5d, 0xc7,
+	0x0c, 0x07, 0x3e, 0xd5, 0x96, 0xb9, 0x2a, 0xe6, 0xa7, 0x49, 0x1f, 0x16, 0x7a, 0xd4, 0xee, 0x33,
+	0x91, 0x6f, 0xf8, 0xb4, 0x1d, 0x68, 0x2b, 0x5c, 0xbe, 0x9b, 0xe3, 0xdf, 0x20, 0x47, 0x67, 0x64,
+	0xb1, 0x33, 0xc6, 0x1c, 0xd7, 0x90, 0x96, 0x22, 0x6c, 0x84, 0x08, 0xc6, 0x72, 0xd3, 0xe4, 0x02,
+	0x2c, 0x86, 0xbe, 0xd9, 0xda, 0xb3, 0x9c, 0xee, 0x43, 0x1a, 0xf6, 0xdc, 0xb6, 0x76, 0x8c, 0x4b,
+	0x22, 0x37, 0x4b, 0x5a, 0x40, 0xa8, 0x63, 0x36, 0x6d, 0xda, 0x16, 0xba, 0xb8, 0x7b, 0xe0, 0xd1,
+	0x40, 0x3b, 0xce, 0x4f, 0x71, 0xad, 0x91, 0xf2, 0x50, 0x39, 0x07, 0xd1, 0xb8, 0x53, 0xd8, 0x75,
+	0xc7, 0x09, 0x51, 0xe5, 0x14, 0xe8, 0xc8, 0x1e, 0xcc, 0xb1, 0x73, 0x44, 0xaa, 0x70, 0x82, 0xab,
+	0xc2, 0xd6, 0x78, 0x32, 0xba, 0x97, 0x20, 0x34, 0xd2, 0xd8, 0x49, 0x03, 0x48, 0xcf, 0x0c, 0x1e,
+	0x0e, 0xec, 0xd0, 0xf2, 0x6c, 0x2a, 0xd8, 0x08, 0xb4, 0x55, 0x2e, 0x26, 0xc5, 0x0a, 0xb9, 0x0f,
+	0xe8, 0x76, 0x3b, 0x11, 0xdc, 0x1a, 0x3f, 0xf9, 0xe5, 0x51, 0x27, 0x37, 0x62, 0x68, 0x71, 0xe2,
+	0xd4, 0x76, 0x46, 0x9c, 0x1d, 0x83, 0xb6, 0x42, 0x69, 0xed, 0xdc, 0xac, 0x35, 0xae, 0x62, 0x8a,
+	0x15, 0xa6, 0x8b, 0x72, 0x96, 0x3b, 0xad, 0x93, 0x42, 0x5b, 0x53, 0x53, 0xe4, 0x1e, 0x7c, 0xc9,
+	0x74, 0x1c, 0x37, 0xe4, 0xc7, 0x8f, 0x58, 0xd9, 0x94, 0xee, 0x7d, 0xdb, 0x0c, 0x7b, 0x81, 0x56,
+	0xe7, 0xbb, 0x0e, 0x03, 0x63, 0x2a, 0x81, 0xc6, 0x19, 0x9a, 0xb6, 0xcd, 0x81, 0xb6, 0x6e, 0x6b,
+	0xa7, 0x84, 0x4a, 0x64, 0x67, 0xc9, 0x2e, 0x2c, 0xb4, 0x6c, 0x34, 0x2b, 0xea, 0x73, 0xbf, 0x1a,
+	0x68, 0xa7, 0xb9, 0x4c, 0x1a, 0xa3, 0x64, 0xb2, 0x91, 0xde, 0x20, 0xc4, 0x92, 0x45, 0x52, 0xbf,
+	0x03, 0x6b, 0x43, 0x54, 0x86, 0x2c, 0x43, 0x65, 0x0f, 0xfd, 0x79, 0x89, 0x73, 0xc3, 0x1e, 0xc9,
+	0x71, 0x98, 0xda, 0x37, 0xed, 0x01, 0xe5, 0xc1, 0xa1, 0x66, 0x88, 0xc1, 0x8d, 0xf2, 0xd7, 0x4a,
+	0xf5, 0x9f, 0x97, 0x60, 0x29, 0x77, 0x01, 0x8a, 0xfd, 0x3f, 0x48, 0xef, 0x7f, 0x05, 0xe6, 0xd8,
+	0xd9, 0x45, 0x60, 0x1a, 0xa6, 0x19, 0xf9, 0x36, 0x90, 0xe2, 0xa1, 0x0f, 0x3b, 0xca, 0x6c, 0x0a,
+	0x83, 0xfe, 0xe7, 0x12, 0x68, 0x39, 0x39, 0x7e, 0x17, 0xd9, 0xbc, 0x6b, 0xd9, 0xa8, 0x48, 0xd7,
+	0x61, 0xc6, 0x17, 0x73, 0x32, 0x04, 0x9f, 0x1a, 0x21, 0xfe, 0x7b, 0x6f, 0x18, 0x11, 0x34, 0xb9,
+	0x09, 0xb5, 0x3e, 0x0d, 0xcd, 0xb6, 0x19, 0x9a, 0xf2, 0xf4, 0xe7, 0x54, 0x3b, 0x19, 0x95, 0x87,
+	0x12, 0x0e, 0xb7, 0xc7, 0x7b, 0xc8, 0xbb, 0x30, 0xd5, 0xea, 0x0d, 0x9c, 0x3d, 0x1e, 0x7c, 0xe7,
+	0xae, 0x9e, 0x19, 0xb6, 0x79, 0x83, 0x01, 0xe1, 0x4e, 0x01, 0xfd, 0xc1, 0x34, 0x54, 0x3d, 0xd3,
+	0x0f, 0xf5, 0xbb, 0x70, 0x5c, 0x45, 0x82, 0x45, 0x7c, 0x74, 0x4b, 0xad, 0xbd, 0x60, 0xd0, 0x97,
+	0xd2, 0x89, 0xc7, 0x84, 0x40, 0x35, 0x40, 0x0f, 0xce, 0xd9, 0xad, 0x18, 0xfc, 0x59, 0x7f, 0x0b,
+	0x56, 0x0a, 0xd4, 0x98, 0x2c, 0x05, 0x6f, 0x0c, 0xc3, 0xbc, 0x24, 0xad, 0x0f, 0xe0, 0xc4, 0x2e,
+	0x97, 0x45, 0x1c, 0xf6, 0x26, 0x91, 0xc3, 0xe8, 0xf7, 0x60, 0x35, 0x4f, 0x36, 0xf0, 0xd0, 0x01,
+	0x51, 0xe6, 0x04, 0x78, 0x9c, 0xb0, 0x68, 0x3b, 0x59, 0xe5, 0x5c, 0xa0, 0x07, 0x2a, 0xae, 0xe8,
+	0xbf, 0x2e, 0xc3, 0x2a, 0x6e, 0x76, 0xed, 0x7d, 0x1a, 0x39, 0xf1, 0xc9, 0xa4, 0x61, 0xdf, 0x87,
+	0x0a, 0x02, 0x4a, 0x35, 0xd9, 0x7a, 0x65, 0x89, 0x8e, 0xc1, 0xb0, 0x92, 0xb7, 0x31, 0xa7, 0xea,
+	0x37, 0xad, 0xee, 0xc0, 0x1d, 0x04, 0xd1, 0xb1, 0xb8, 0x52, 0xcd, 0x1a, 0xc5, 0x05, 0xe6, 0x08,
+	0x03, 0x6e, 0xd3, 0x5b, 0x4e, 0x9b, 0xfe, 0x88, 0xe7, 0x76, 0x15, 0x23, 0x3d, 0xa5, 0xb7, 0x60,
+	0xad, 0x20, 0x24, 0x29, 0xf0, 0x74, 0x3a, 0x59, 0xca, 0xa5, 0x93, 0x4a, 0x36, 0xca, 0x43, 0xd8,
+	0xd0, 0xff, 0x5d, 0x86, 0xe5, 0xc4, 0xb8, 0x24, 0x7a, 0xcc, 0x1d, 0xfb, 0x72, 0x2e, 0x40, 0xfc,
+	0xcc, 0x97, 0x27, 0x13, 0xd9, 0xcc, 0xb2, 0x9c, 0xcf, 0x2c, 0x57, 0x61, 0x5a, 0x24, 0xfe, 0xf2,
+	0xe8, 0x72, 0x94, 0x61, 0xb9, 0x9a, 0x63, 0xf9, 0x2c, 0x40, 0x10, 0xfb, 0x48, 0x6d, 0x9a, 0xaf,
+	0xa6, 0x66, 0x88, 0x0e, 0xf3, 0x22, 0x0f, 0x41, 0x0e, 0x31, 0x98, 0x69, 0x33, 0x1c, 0x22, 0x33,
+	0xc7, 0xed, 0xcd, 0xed, 0x23, 0x97, 0x98, 0x93, 0xd4, 0x38, 0xcb, 0xf1, 0x98, 0x3c, 0x05, 0x22,
+	0xeb, 0x03, 0x96, 0x82, 0xd2, 0x30, 0xc4, 0x74, 0x20, 0xd0, 0x66, 0xb9, 0x97, 0xbf, 0xa0, 0xb2,
+	0xf7, 0xcd, 0x02, 0xb4, 0xa1, 0xc0, 0x40, 0x6e, 0xc0, 0xac, 0x6d, 0xed, 0xd3, 0xdb, 0x56, 0xa7,
+	0x13, 0x60, 0xf6, 0xcc, 0xd0, 0x9d, 0x56, 0xa1, 0x7b, 0x20, 0x81, 0x8c, 0x04, 0x5c, 0xff, 0x67,
+	0x29, 0x11, 0x7c, 0xb4, 0xce, 0xec, 0xbd, 0xeb, 0xbb, 0x03, 0x4f, 0x5e, 0xaa, 0x18, 0x30, 0x77,
+	0x81, 0x09, 0x4c, 0x5b, 0xca, 0x9a, 0x3f, 0x67, 0x2f, 0xa1, 0x92, 0xbf, 0x04, 0xdc, 0xc1, 0x06,
+	0x52, 0xd0, 0xfc, 0x99, 0x7c, 0x15, 0x8e, 0x39, 0xae, 0xdf, 0x37, 0x6d, 0xf4, 0x36, 0x6d, 0x46,
+	0x71, 0x07, 0x63, 0x67, 0x54, 0x36, 0xa8, 0x96, 0x44, 0x6c, 0xa7, 0x6d, 0xab, 0x15, 0xa6, 0x37,
+	0x88, 0xeb, 0x51, 0xac, 0xb0, 0x2b, 0xe8, 0xbb, 0x6d, 0x6e, 0xec, 0xfc, 0x8a, 0x6a, 0x46, 0x3c,
+	0xd6, 0xff, 0x31, 0x03, 0xf5, 0xe1, 0xd2, 0x65, 0x75, 0x0c, 0x93, 0xdb, 0x13, 0xe3, 0x81, 0x3c,
+	0x7a, 0x34, 0x1c, 0x59, 0x39, 0x29, 0xab, 0x98, 0xca, 0xc4, 0xaa, 0x98, 0xc3, 0xcb, 0xb3, 0xcc,
+	0x2d, 0x4d, 0xe5, 0x6f, 0x29, 0x97, 0xb9, 0x4f, 0x1f, 0x9a, 0xb9, 0xcf, 0x14, 0x33, 0xf7, 0x62,
+	0xda, 0x5b, 0x53, 0xa6, 0xbd, 0xc5, 0x5c, 0x68, 0x56, 0x99, 0x0b, 0xa9, 0x2a, 0x19, 0x98, 0x50,
+	0x25, 0xe3, 0x28, 0x53, 0x73, 0x51, 0x22, 0xde, 0x3c, 0x9a, 0x99, 0x8e, 0x93, 0xa5, 0xcf, 0xbf,
+	0xd6, 0x2c, 0xfd, 0xe3, 0x7c, 0x92, 0xb9, 0xc0, 0xcf, 0xf5, 0xf5, 0x23, 0x9e, 0x6b, 0x62, 0xf9,
+	0xe6, 0xf8, 0x69, 0x9e, 0x0b, 0x4b, 0x0f, 0x2c, 0x16, 0x4d, 0x3a, 0xc1, 0x64, 0x12, 0x93, 0xf7,
+	0xa0, 0xca, 0x88, 0x31, 0x57, 0xd1, 0xf4, 0x4d, 0x07, 0xd3, 0xac, 0x28, 0x6a, 0xc5, 0x63, 0xe6,
+	0x11, 0x43, 0x13, 0x9d, 0x7e, 0x99, 0xcf, 0xf3, 0x67, 0xfd, 0xb7, 0x65, 0xc1, 0x29, 0x1a, 0x7b,
+	0xf0, 0xc5, 0xb7, 0x81, 0xd4, 0x85, 0x69, 0xa5, 0x58, 0x98, 0xe6, 0x58, 0x7e, 0x11, 0x95, 0x7f,
+	0x45, 0x4a, 0x82, 0x19, 0xe8, 0x0c, 0x72, 0xc0, 0x18, 0x21, 0x57, 0xa0, 0x8a, 0x67, 0x17, 0x02,
+	0xcf, 0x65, 0xcf, 0x12, 0x84, 0xfd, 0x4a, 0x96, 0x38, 0x68, 0xfd, 0x3a, 0xcc, 0xc6, 0x53, 0x2f,
+	0xa4, 0x59, 0xe7, 0x00, 0x44, 0xe7, 0x65, 0xcb, 0xe9, 0xb8, 0x71, 0x90, 0x2b, 0x25, 0x41, 0x4e,
+	0xbf, 0x11, 0x41, 0x70, 0xde, 0xde, 0x86, 0x29, 0x2b, 0xa4, 0xfd, 0x88, 0xb9, 0xd5, 0x34, 0x73,
+	0x09, 0x22, 0x43, 0x00, 0xe9, 0x7f, 0xa8, 0xc1, 0x49, 0x76, 0x63, 0x3b, 0x3c, 0x61, 0x41, 0x0e,
+	0x6f, 0x63, 0x2e, 0x6f, 0xd9, 0xc1, 0x77, 0x06, 0x14, 0xf9, 0x7c, 0xbd, 0x8a, 0xd1, 0xc5, 0xac,
+	0x49, 0x84, 0xaf, 0xf2, 0xeb, 0x09, 0x5f, 0x12, 0x7d, 0xd2, 0x79, 0xab, 0xbc, 0x9e, 0xce, 0x9b,
+	0x2a, 0x7e, 0x54, 0x27, 0x14, 0x3f, 0x86, 0x37, 0x43, 0x53, 0x2d, 0xd6, 0xe9, 0x6c, 0x8b, 0x55,
+	0xd1, 0x60, 0x9a, 0x39, 0x6a, 0x83, 0x49, 0x1d, 0x69, 0xfb, 0x4a, 0x3b, 0x16, 0xc9, 0xe6, 0x37,
+	0xd3, 0x1a, 0x38, 0x54, 0xd7, 0xc6, 0x09, 0x62, 0xf0, 0x5a, 0x83, 0xd8, 0x93, 0x4c, 0xeb, 0x48,
+	0x44, 0xe6, 0x77, 0x8f, 0x76, 0xa6, 0x11, 0x4d, 0xa4, 0xff, 0xb7, 0x56, 0x89, 0xfe, 0x33, 0x5e,
+	0xdf, 0x7a, 0x6e, 0x22, 0x83, 0xb8, 0xb4, 0x62, 0x71, 0x88, 0x15, 0x39, 0xd2, 0x69, 0xb1, 0x67,
+	0x72, 0x19, 0xaa, 0x4c, 0xc8, 0x32, 0x73, 0x5d, 0x4b, 0xcb, 0x93, 0xdd, 0x04, 0x62, 0xd9, 0xf1,
+	0x68, 0xcb, 0xe0, 0x40, 0xac, 0xe6, 0x88, 0x15, 0x5f, 0x5a, 0x56, 0xa6, 0xe6, 0x88, 0xed, 0x24,
+	0xda, 0x96, 0x80, 0xb3, 0xbd, 0x6d, 0xcb, 0xa7, 0x2d, 0x5e, 0x9e, 0x4f, 0x15, 0xf7, 0xde, 0x8e,
+	0x16, 0xe3, 0xbd, 0x31, 0x38, 0xfa, 0xf9, 0x69, 0xd1, 0xed, 0xe6, 0x16, 0x34, 0x77, 0xf5, 0x64,
+	0xd1, 0x99, 0x46, 0xbb, 0x24, 0xa0, 0xfe, 0xfb, 0x12, 0xbc, 0x99, 0x28, 0x44, 0x64, 0x4d, 0x51,
+	0x87, 0xe4, 0x8b, 0x8f, 0xb8, 0x68, 0xd1, 0xbc, 0x25, 0x93, 0x34, 0xbd, 0xc5, 0xfb, 0x97, 0xdc,
+	0xac, 0xfe, 0x9b, 0x12, 0x9c, 0x2f, 0x9e, 0x63, 0xa3, 0x67, 0xfa, 0x61, 0x7c, 0xbd, 0x93, 0x38,
+	0x4b, 0x14, 0xf0, 0xca, 0xa9, 0xaa, 0x2e, 0x7d, 0xbe, 0x4a, 0xf6, 0x7c, 0xfa, 0xef, 0xca, 0x30,
+	0x97, 0x52, 0x20, 0x55, 0xc0, 0x64, 0xa5, 0x37, 0xd7, 0x5b, 0xde, 0x84, 0xe3, 0x41, 0x01, 0x4b,
+	0xef, 0x64, 0x06, 0xdd, 0x0b, 0x78, 0xa6, 0x8f, 0x90, 0x98, 0x10, 0x32, 0x4f, 0xce, 0x2c, 0xfe,
+	0xfe, 0xf8, 0xde, 0x65, 0x3b, 0xc2, 0x69, 0xa4, 0xd0, 0xb3, 0xde, 0x01, 0x27, 0x1d, 0x48, 0xff,
+	0x2d, 0x47, 0xe4, 0x53, 0x58, 0xec, 0x20, 0x37, 0xdb, 0x09, 0x23, 0xd3, 0x9c, 0x91, 0xc7, 0xe3,
+	0x33, 0x72, 0x37, 0x8d, 0xd7, 0xc8, 0x91, 0xd1, 0x2f, 0xc1, 0x72, 0xde, 0x9e, 0x18, 0x93, 0x56,
+	0xdf, 0xec, 0xc6, 0xd2, 0x92, 0x23, 0x9d, 0xc0, 0x72, 0xde, 0x7e, 0xf4, 0xbf, 0x96, 0xe1, 0x44,
+	0x8c, 0xee, 0x96, 0xe3, 0xb8, 0x03, 0xa7, 0xc5, 0x5f, 0x20, 0x29, 0xef, 0x02, 0x3d, 0x5b, 0x68,
+	0x85, 0x76, 0x9c, 0xf8, 0xf0, 0x01, 0x8b, 0x5d, 0xa1, 0xeb, 0xb2, 0x16, 0xbe, 0xbc, 0xe0, 0x68,
+	0x28, 0xee, 0xfe, 0xf9, 0x00, 0x89, 0xb6, 0xb9, 0x27, 0xa8, 0x19, 0xf1, 0x98, 0xad, 0xb1, 0xac,
+	0x86, 0x37, 0x54, 0x84, 0x30, 0xe3, 0x31, 0xd7, 0x7b, 0xd7, 0xb6, 0x91, 0x55, 0x14, 0x47, 0xaa,
+	0xe5, 0x92, 0x9b, 0xe5, 0xad, 0x9c, 0xd0, 0xc7, 0xc8, 0x26, 0x1b, 0x2e, 0x72, 0xc4, 0xf8, 0x34,
+	0x7d, 0xdf, 0x3c, 0x90, 0x7d, 0x16, 0x31, 0x20, 0xdf, 0x80, 0x4a, 0xdf, 0xf4, 0x64, 0xa0, 0xbb,
+	0x94, 0xf1, 0x0e, 0x2a, 0x09, 0x60, 0xb1, 0xe3, 0x89, 0x48, 0xc0, 0xb6, 0xd5, 0xdf, 0x83, 0x5a,
+	0x34, 0xf1, 0x42, 0x29, 0xe1, 0x27, 0xb0, 0x90, 0x71, 0x3e, 0xe4, 0x23, 0x58, 0x4d, 0x34, 0x2a,
+	0x4d, 0x50, 0x26, 0x81, 0x6f, 0x1e, 0xca, 0x99, 0x31, 0x04, 0x81, 0xfe, 0x1c, 0x56, 0x98, 0xca,
+	0x70, 0xc3, 0x9f, 0x50, 0x69, 0xf3, 0x3e, 0xcc, 0xc6, 0x24, 0x95, 0x3a, 0x83, 0xf7, 0xbc, 0x1f,
+	0xb5, 0x07, 0x44, 0x6d, 0x13, 0x8f, 0xf5, 0x5b, 0x40, 0xd2, 0xfc, 0xca, 0x08, 0x74, 0x39, 0x9b,
+	0x14, 0x9f, 0xc8, 0x87, 0x1b, 0x0e, 0x1e, 0xe5, 0xc4, 0x7f, 0xc1, 0x12, 0x69, 0xd3, 0xe2, 0x1d,
+	0xe9, 0x09, 0x39, 0x39, 0x34, 0xb9, 0x60, 0xd0, 0xec, 0xbb, 0xed, 0x81, 0x4d, 0x65, 0x52, 0x20,
+	0x23, 0x7d, 0x61, 0x7e, 0x94, 0xf3, 0x63, 0xc2, 0xf2, 0xcc, 0xb0, 0x17, 0xb5, 0xc0, 0xd8, 0x33,
+	0xaa, 0xe8, 0xc9, 0x47, 0xf4, 0x53, 0x79, 0x9e, 0x4d, 0xdb, 0x6d, 0x36, 0x51, 0x9d, 0x23, 0x22,
+	0x53, 0x9c, 0xc8, 0x70, 0x00, 0x55, 0xaa, 0x38, 0xad, 0x4e, 0x15, 0xe3, 0x7e, 0xe5, 0x86, 0xdb,
+	0xef, 0x5b, 0xa1, 0xcc, 0x28, 0x33, 0x73, 0xfa, 0x4f, 0x4b, 0xb0, 0x9c, 0x48, 0x56, 0xde, 0xcd,
+	0x75, 0x61, 0x43, 0xe2, 0x66, 0xce, 0xa7, 0x6f, 0x26, 0x0f, 0xfa, 0xf2, 0xe6, 0x33, 0x9f, 0x36,
+	0x9f, 0x5f, 0xa0, 0x83, 0x42, 0xd4, 0x91, 0xe3, 0xb2, 0xfe, 0xd7, 0x6e, 0x59, 0x71, 0x27, 0xd5,
+	0xa3, 0xdd, 0xc9, 0x94, 0xe2, 0x4e, 0x1a, 0xb0, 0x9a, 0x17, 0x86, 0xbc, 0x18, 0x94, 0xa0, 0xc7,
+	0x5f, 0x3d, 0x8a, 0xbe, 0x82, 0x18, 0xe8, 0x3f, 0x99, 0x81, 0x33, 0x4f, 0x3c, 0x4c, 0x66, 0xe2,
+	0x0e, 0xfd, 0x5d, 0xd7, 0xe7, 0xef, 0x1e, 0x27, 0x23, 0xc5, 0x5c, 0x03, 0xb2, 0x3c, 0xf2, 0xfb,
+	0x90, 0xca, 0x88, 0xef, 0x43, 0xaa, 0x47, 0xfa, 0x3e, 0x64, 0x6a, 0x62, 0x9d, 0xd5, 0x62, 0xad,
+	0x35, 0xad, 0xac, 0xb5, 0x3e, 0xca, 0xd4, 0x23, 0x33, 0xc5, 0x8e, 0xda, 0xc8, 0xdb, 0x19, 0xf9,
+	0x62, 0x3b, 0xd7, 0x9c, 0xad, 0x1d, 0xda, 0x9c, 0x9d, 0x2d, 0x36, 0x67, 0xd5, 0x6f, 0xe6, 0x61,
+	0xe8, 0x9b, 0x79, 0x3c, 0x76, 0x70, 0x80, 0xd1, 0xa6, 0x1d, 0xbf, 0xb7, 0x99, 0x13, 0xc7, 0xce,
+	0xce, 0x66, 0x2c, 0x62, 0x3e, 0x67, 0x11, 0xb1, 0xa6, 0x2e, 0xa4, 0x34, 0x55, 0x65, 0x27, 0x8b,
+	0x43, 0xcb, 0xdc, 0x5c, 0xa3, 0x78, 0x49, 0xd5, 0x28, 0xfe, 0xef, 0x29, 0xb6, 0x9e, 0xc2, 0xd9,
+	0x61, 0xb7, 0x2c, 0x8d, 0x17, 0x8d, 0xa0, 0xd5, 0x33, 0x9d, 0x2e, 0x6f, 0x0b, 0xf2, 0xea, 0x5f,
+	0x0e, 0x47, 0x55, 0x07, 0x57, 0x3f, 0x9f, 0x87, 0x95, 0x24, 0xeb, 0x67, 0xff, 0x2d, 0xd4, 0xcc,
+	0xc7, 0xe8, 0xb5, 0xe5, 0x47, 0x06, 0x51, 0xb7, 0x96, 0x8c, 0x7a, 0x53, 0x5d, 0x3f, 0xad, 0x5e,
+	0x14, 0xac, 0xe9, 0x6f, 0x90, 0x16, 0x9c, 0xcc, 0x23, 0x4c, 0x5e, 0x8a, 0x7f, 0x65, 0x04, 0xe6,
+	0x18, 0xea, 0x30, 0x12, 0x17, 0x4b, 0x68, 0x27, 0x8b, 0xd9, 0x57, 0xb7, 0x24, 0x93, 0x06, 0x29,
+	0xdf, 0x26, 0xd7, 0xf5, 0x51, 0x20, 0x31, 0xff, 0xcf, 0x98, 0x1a, 0x64, 0xde, 0x52, 0x12, 0x3d,
+	0xdb, 0x11, 0x50, 0xbd, 0xe7, 0xad, 0x7f, 0x79, 0x24, 0x4c, 0x8c, 0xfd, 0x7d, 0xa8, 0x45, 0xbd,
+	0xe4, 0xac, 0x98, 0x73, 0x1d, 0xe6, 0xfa, 0x72, 0x16, 0x5f, 0x27, 0xc0, 0xcd, 0x37, 0x61, 0x8e,
+	0x81, 0x3d, 0xde, 0xd8, 0xda, 0x35, 0xbb, 0x2f, 0xb5, 0xbf, 0x16, 0xf5, 0x5a, 0x8b, 0x9b, 0x53,
+	0x1d, 0xd8, 0xfa, 0x31, 0x45, 0xd7, 0x13, 0xf7, 0x7f, 0x4b, 0xd0, 0xdf, 0x96, 0x1f, 0x89, 0xad,
+	0x36, 0xc4, 0x37, 0x89, 0x8d, 0xe8, 0x9b, 0xc4, 0xc6, 0x1d, 0xf6, 0x4d, 0x62, 0x5d, 0xd1, 0x96,
+	0x94, 0x08, 0x9e, 0xc1, 0xc2, 0x26, 0x0d, 0x93, 0x2e, 0x02, 0x39, 0x7f, 0xa4, 0x5e, 0x4b, 0x5d,
+	0xcf, 0x83, 0x15, 0x1b, 0x11, 0x88, 0xfd, 0xf3, 0x12, 0x1c, 0x43, 0xf4, 0xf9, 0xba, 0x9c, 0xbc,
+	0xa3, 0x26, 0x32, 0xa4, 0x7e, 0xaf, 0x3f, 0x1a, 0xd7, 0xa6, 0xb3, 0x68, 0x91, 0xb1, 0x5f, 0x96,
+	0x60, 0x11, 0x19, 0xc3, 0x7b, 0x8b, 0x79, 0xba, 0x32, 0x9a, 0x27, 0x45, 0x2d, 0x5e, 0x1f, 0xb3,
+	0x07, 0x96, 0xa2, 0x8e, 0x2c, 0xfd, 0xaa, 0x04, 0x6b, 0x29, 0x59, 0xa5, 0xe9, 0xbd, 0x0c, 0x6f,
+	0x1f, 0x8e, 0xf9, 0x39, 0x62, 0x0a, 0x25, 0x32, 0xb7, 0xcd, 0xd5, 0x24, 0x49, 0xf5, 0xc9, 0x19,
+	0x65, 0x4e, 0x1f, 0x53, 0x3f, 0x3b, 0x6c, 0x39, 0x56, 0x8d, 0x0f, 0x61, 0x0e, 0x31, 0x46, 0x39,
+	0x67, 0x56, 0xf9, 0x73, 0xe5, 0x40, 0xd6, 0xfb, 0xe4, 0xd3, 0x54, 0xae, 0xc4, 0x2b, 0x02, 0x57,
+	0x2a, 0xaf, 0xca, 0xba, 0x1f, 0x65, 0x02, 0x9a, 0x55, 0x62, 0x75, 0x5a, 0x86, 0xd8, 0x9f, 0xc3,
+	0xaa, 0xda, 0xfb, 0x93, 0xb7, 0x8e, 0x9c, 0x07, 0xd4, 0x2f, 0x1d, 0x05, 0x34, 0x22, 0xf9, 0xc1,
+	0xad, 0x3f, 0xfe, 0xfd, 0x6c, 0xe9, 0x4f, 0xf8, 0xf7, 0x37, 0xfc, 0xfb, 0xde, 0xb5, 0x43, 0x3e,
+	0x5b, 0x4e, 0x7d, 0x09, 0x8d, 0x17, 0xda, 0xb2, 0x2d, 0xac, 0x24, 0x9b, 0xd3, 0xdc, 0x05, 0x5c,
+	0xfb, 0x0f, 0x1b, 0x4a, 0xf1, 0x12, 0x28, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3343,6 +3635,27 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ClusterLabels) > 0 {
+		for k := range m.ClusterLabels {
+			v := m.ClusterLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRepository(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
 	if len(m.InstallationID) > 0 {
 		i -= len(m.InstallationID)
 		copy(dAtA[i:], m.InstallationID)
@@ -4005,16 +4318,44 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Commands) > 0 {
-		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Commands[iNdEx])
-			copy(dAtA[i:], m.Commands[iNdEx])
-			i = encodeVarintRepository(dAtA, i, uint64(len(m.Commands[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.VerifyResult) > 0 {
+	if len(m.LiveDiffs) > 0 {
+		for iNdEx := len(m.LiveDiffs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiveDiffs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.GenerationSettings) > 0 {
+		for iNdEx := len(m.GenerationSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GenerationSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Commands) > 0 {
+		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commands[iNdEx])
+			copy(dAtA[i:], m.Commands[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Commands[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.VerifyResult) > 0 {
 		i -= len(m.VerifyResult)
 		copy(dAtA[i:], m.VerifyResult)
 		i = encodeVarintRepository(dAtA, i, uint64(len(m.VerifyResult)))
@@ -4061,6 +4402,247 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ManifestLiveDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestLiveDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestLiveDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Modified {
+		i--
+		if m.Modified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PredictedLiveState) > 0 {
+		i -= len(m.PredictedLiveState)
+		copy(dAtA[i:], m.PredictedLiveState)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.PredictedLiveState)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NormalizedLiveState) > 0 {
+		i -= len(m.NormalizedLiveState)
+		copy(dAtA[i:], m.NormalizedLiveState)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.NormalizedLiveState)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManifestGenerationSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestGenerationSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestGenerationSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClusterLabels) > 0 {
+		for k := range m.ClusterLabels {
+			v := m.ClusterLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRepository(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.HelmOptions != nil {
+		{
+			size, err := m.HelmOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.EnabledSourceTypes) > 0 {
+		for k := range m.EnabledSourceTypes {
+			v := m.EnabledSourceTypes[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.KustomizeOptions != nil {
+		{
+			size, err := m.KustomizeOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.InstallationID) > 0 {
+		i -= len(m.InstallationID)
+		copy(dAtA[i:], m.InstallationID)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.InstallationID)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TrackingMethod) > 0 {
+		i -= len(m.TrackingMethod)
+		copy(dAtA[i:], m.TrackingMethod)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TrackingMethod)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApiVersions) > 0 {
+		for iNdEx := len(m.ApiVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApiVersions[iNdEx])
+			copy(dAtA[i:], m.ApiVersions[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.ApiVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.KubeVersion) > 0 {
+		i -= len(m.KubeVersion)
+		copy(dAtA[i:], m.KubeVersion)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.KubeVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AppLabelKey) > 0 {
+		i -= len(m.AppLabelKey)
+		copy(dAtA[i:], m.AppLabelKey)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AppLabelKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ApplicationSource != nil {
+		{
+			size, err := m.ApplicationSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RepoURL) > 0 {
+		i -= len(m.RepoURL)
+		copy(dAtA[i:], m.RepoURL)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.RepoURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ListRefsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5708,6 +6290,14 @@ func (m *ManifestRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	if len(m.ClusterLabels) > 0 {
+		for k, v := range m.ClusterLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
+			n += mapEntrySize + 2 + sovRepository(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5916,19 +6506,144 @@ func (m *ManifestResponse) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if len(m.GenerationSettings) > 0 {
+		for _, e := range m.GenerationSettings {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.LiveDiffs) > 0 {
+		for _, e := range m.LiveDiffs {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ListRefsRequest) Size() (n int) {
+func (m *ManifestLiveDiff) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Repo != nil {
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.NormalizedLiveState)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.PredictedLiveState)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Modified {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestGenerationSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.ApplicationSource != nil {
+		l = m.ApplicationSource.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.AppLabelKey)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.KubeVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.ApiVersions) > 0 {
+		for _, s := range m.ApiVersions {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	l = len(m.TrackingMethod)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.InstallationID)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.KustomizeOptions != nil {
+		l = m.KustomizeOptions.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.EnabledSourceTypes) > 0 {
+		for k, v := range m.EnabledSourceTypes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	if m.HelmOptions != nil {
+		l = m.HelmOptions.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.ClusterLabels) > 0 {
+		for k, v := range m.ClusterLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRepository(uint64(len(k))) + 1 + len(v) + sovRepository(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRefsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
 		l = m.Repo.Size()
 		n += 1 + l + sovRepository(uint64(l))
 	}
@@ -7553,6 +8268,133 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.InstallationID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterLabels == nil {
+				m.ClusterLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ClusterLabels[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -8618,6 +9460,994 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenerationSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenerationSettings = append(m.GenerationSettings, &ManifestGenerationSettings{})
+			if err := m.GenerationSettings[len(m.GenerationSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveDiffs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiveDiffs = append(m.LiveDiffs, &ManifestLiveDiff{})
+			if err := m.LiveDiffs[len(m.LiveDiffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestLiveDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestLiveDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestLiveDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Modified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestGenerationSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestGenerationSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestGenerationSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplicationSource == nil {
+				m.ApplicationSource = &v1alpha1.ApplicationSource{}
+			}
+			if err := m.ApplicationSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLabelKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiVersions = append(m.ApiVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallationID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstallationID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KustomizeOptions == nil {
+				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			}
+			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledSourceTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnabledSourceTypes == nil {
+				m.EnabledSourceTypes = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EnabledSourceTypes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HelmOptions == nil {
+				m.HelmOptions = &v1alpha1.HelmOptions{}
+			}
+			if err := m.HelmOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterLabels == nil {
+				m.ClusterLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ClusterLabels[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -143,6 +143,11 @@ func clusterRuntimeInfoKey(info ClusterRuntimeInfo) uint32 {
 	return hash.FNVa(key)
 }
 
+// clusterLabelsInfo is implemented by cluster runtime info which also holds the labels of the cluster
+type clusterLabelsInfo interface {
+	GetClusterLabels() map[string]string
+}
+
 // clusterRuntimeInfoKeyUnhashed gets the cluster runtime info for a cache key, but does not hash the info. Does not
 // check if info is nil, the caller must do that.
 func clusterRuntimeInfoKeyUnhashed(info ClusterRuntimeInfo) string {
@@ -150,7 +155,18 @@ func clusterRuntimeInfoKeyUnhashed(info ClusterRuntimeInfo) string {
 	sort.Slice(apiVersions, func(i, j int) bool {
 		return apiVersions[i] < apiVersions[j]
 	})
-	return info.GetKubeVersion() + "|" + strings.Join(apiVersions, ",")
+	key := info.GetKubeVersion() + "|" + strings.Join(apiVersions, ",")
+	// cluster labels are only part of the key if there are any, so that the keys of clusters without labels don't change.
+	// The manifests cached for clusters with labels by earlier versions are not found anymore and are generated again.
+	if labelsInfo, ok := info.(clusterLabelsInfo); ok && len(labelsInfo.GetClusterLabels()) > 0 {
+		clusterLabels := make([]string, 0, len(labelsInfo.GetClusterLabels()))
+		for k, v := range labelsInfo.GetClusterLabels() {
+			clusterLabels = append(clusterLabels, k+"="+v)
+		}
+		sort.Strings(clusterLabels)
+		key += "|" + strings.Join(clusterLabels, ",")
+	}
+	return key
 }
 
 func listApps(repoURL, revision string) string {
@@ -8,11 +8,13 @@ import (
 	"fmt"
 	goio "io"
 	"io/fs"
+	"maps"
 	"net/url"
 	"os"
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -1500,6 +1502,9 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 
 	resourceTracking := argo.NewResourceTracking()
 
+	if err := validateClusterLabelEnvNames(q.ClusterLabels); err != nil {
+		return nil, err
+	}
 	env := newEnv(q, revision)
 
 	appSourceType, err := GetAppSourceType(ctx, q.ApplicationSource, appPath, repoRoot, q.AppName, q.EnabledSourceTypes, opt.cmpTarExcludedGlobs, env.Environ())
@@ -1607,7 +1612,7 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 func newEnv(q *apiclient.ManifestRequest, revision string) *v1alpha1.Env {
 	shortRevision := shortenRevision(revision, 7)
 	shortRevision8 := shortenRevision(revision, 8)
-	return &v1alpha1.Env{
+	env := v1alpha1.Env{
 		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_NAME", Value: q.AppName},
 		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_NAMESPACE", Value: q.Namespace},
 		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_PROJECT_NAME", Value: q.ProjectName},
@@ -1618,6 +1623,32 @@ func newEnv(q *apiclient.ManifestRequest, revision string) *v1alpha1.Env {
 		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_SOURCE_PATH", Value: q.ApplicationSource.Path},
 		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_SOURCE_TARGET_REVISION", Value: q.ApplicationSource.TargetRevision},
 	}
+	for _, key := range slices.Sorted(maps.Keys(q.ClusterLabels)) {
+		env = append(env, &v1alpha1.EnvEntry{Name: clusterLabelEnvName(key), Value: q.ClusterLabels[key]})
+	}
+	return &env
+}
+
+var clusterLabelEnvNameInvalidChars = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// clusterLabelEnvName returns the name of the environment variable holding the value of a cluster label, e.g.
+// ARGOCD_APP_CLUSTER_LABEL_TOPOLOGY_KUBERNETES_IO_REGION for topology.kubernetes.io/region
+func clusterLabelEnvName(key string) string {
+	return "ARGOCD_APP_CLUSTER_LABEL_" + clusterLabelEnvNameInvalidChars.ReplaceAllString(strings.ToUpper(key), "_")
+}
+
+// validateClusterLabelEnvNames rejects cluster labels whose keys map to the same environment variable, e.g. a.b and a_b,
+// since only one of their values could be passed to the config management tool
+func validateClusterLabelEnvNames(clusterLabels map[string]string) error {
+	keysByName := make(map[string]string, len(clusterLabels))
+	for _, key := range slices.Sorted(maps.Keys(clusterLabels)) {
+		name := clusterLabelEnvName(key)
+		if other, ok := keysByName[name]; ok {
+			return status.Errorf(codes.InvalidArgument, "cluster labels %q and %q both map to the environment variable %s", other, key, name)
+		}
+		keysByName[name] = key
+	}
+	return nil
 }
 
 func shortenRevision(revision string, length int) string {
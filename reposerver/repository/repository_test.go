@@ -32,6 +32,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -1942,6 +1944,26 @@ func Test_newEnv(t *testing.T) {
 	}, "my-revision"))
 }
 
+func Test_newEnvWithClusterLabels(t *testing.T) {
+	env := newEnv(&apiclient.ManifestRequest{
+		Repo:              &v1alpha1.Repository{Repo: "https://github.com/my-org/my-repo"},
+		ApplicationSource: &v1alpha1.ApplicationSource{},
+		ClusterLabels:     map[string]string{"topology.kubernetes.io/region": "eu-west-1", "env": "prod"},
+	}, "my-revision")
+	require.Len(t, *env, 11)
+	assert.Equal(t, &v1alpha1.EnvEntry{Name: "ARGOCD_APP_CLUSTER_LABEL_ENV", Value: "prod"}, (*env)[9])
+	assert.Equal(t, &v1alpha1.EnvEntry{Name: "ARGOCD_APP_CLUSTER_LABEL_TOPOLOGY_KUBERNETES_IO_REGION", Value: "eu-west-1"}, (*env)[10])
+}
+
+func Test_validateClusterLabelEnvNames(t *testing.T) {
+	require.NoError(t, validateClusterLabelEnvNames(map[string]string{"topology.kubernetes.io/region": "eu-west-1", "env": "prod"}))
+
+	err := validateClusterLabelEnvNames(map[string]string{"example.com/tier": "a", "example.com_tier": "b"})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "ARGOCD_APP_CLUSTER_LABEL_EXAMPLE_COM_TIER")
+}
+
 func TestService_newHelmClientResolveRevision(t *testing.T) {
 	service := newService(t, ".")
 
@@ -1,12 +1,17 @@
 package application
 
 import (
+	"cmp"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"math"
 	"reflect"
+	"regexp"
 	"slices"
 	"sort"
 	"strconv"
@@ -19,26 +24,38 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
-	"github.com/argoproj/gitops-engine/pkg/utils/text"
+	"github.com/argoproj/notifications-engine/pkg/subscriptions"
 	"github.com/argoproj/pkg/v2/sync"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 
 	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
@@ -55,6 +72,8 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/gpg"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/lua"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
@@ -62,6 +81,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/security"
 	"github.com/argoproj/argo-cd/v3/util/session"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/versions"
 
 	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
 
@@ -78,9 +98,52 @@ const (
 	foregroundPropagationPolicy string = "foreground"
 )
 
+const externalEventsListPageSize = 500
+
+// hardRefreshWarningHeader is the response header set by Get when a hard refresh could not regenerate the app details
+const hardRefreshWarningHeader = "argocd-refresh-warning"
+
+// createWarningHeader is the response header set by Create for each validation warning when strict validation is disabled
+const createWarningHeader = "argocd-create-warning"
+
+// operationIDHeader is the response header set by Sync to the ID of the started operation
+const operationIDHeader = "argocd-operation-id"
+
+// syncWaitHeader is the response header set by Sync when waiting for the operation to complete timed out
+const syncWaitHeader = "argocd-sync-wait"
+
+// defaultSyncWaitTimeout is how long Sync waits for the operation to complete when no wait timeout is requested
+const defaultSyncWaitTimeout = 5 * time.Minute
+
+// maxBatchGetWithTreesApplications is the maximum number of applications BatchGetWithTrees returns at once
+const maxBatchGetWithTreesApplications = 100
+
+const (
+	// manifestOutputFormatJSON serializes the manifests returned by GetManifests as JSON, the default
+	manifestOutputFormatJSON = "json"
+	// manifestOutputFormatYAML serializes the manifests returned by GetManifests as YAML
+	manifestOutputFormatYAML = "yaml"
+)
+
+const (
+	// defaultPodLogsSnapshotLines is the number of log lines returned by GetPodLogsSnapshot when tailLines is not set
+	defaultPodLogsSnapshotLines = 1000
+	// maxPodLogsSnapshotLines is the maximum number of log lines GetPodLogsSnapshot returns
+	maxPodLogsSnapshotLines = 10000
+)
+
 var (
 	ErrCacheMiss       = cacheutil.ErrCacheMiss
 	watchAPIBufferSize = env.ParseNumFromEnv(argocommon.EnvWatchAPIBufferSize, 1000, 0, math.MaxInt32)
+	// externalEventsListTimeout limits how long ListResourceEvents waits for an external cluster, 0 disables the limit
+	externalEventsListTimeout = env.ParseDurationFromEnv(argocommon.EnvExternalEventsListTimeout, 10*time.Second, 0, math.MaxInt64)
+	// hardRefreshAppDetailsTimeout limits how long a hard refresh waits for the application details, 0 disables the limit
+	hardRefreshAppDetailsTimeout = env.ParseDurationFromEnv(argocommon.EnvHardRefreshAppDetailsTimeout, time.Minute, 0, math.MaxInt64)
+	// manifestGenerationParallelism limits how many sources of an application manifests are generated for concurrently
+	manifestGenerationParallelism = env.ParseNumFromEnv(argocommon.EnvManifestGenerationParallelism, 4, 1, math.MaxInt32)
+	// maxLocalManifestsSize limits the total size of the manifests uploaded for a local sync, which are passed to the
+	// sync operation as a whole and so cannot be larger than a gRPC message
+	maxLocalManifestsSize = apiclient.MaxGRPCMessageSize
 )
 
 // Server provides an Application service
@@ -96,6 +159,7 @@ type Server struct {
 	db                     db.ArgoDB
 	enf                    *rbac.Enforcer
 	projectLock            sync.KeyLock
+	appOperationLock       sync.KeyLock
 	auditLogger            *argo.AuditLogger
 	settingsMgr            *settings.SettingsManager
 	cache                  *servercache.Cache
@@ -144,6 +208,7 @@ func NewServer(
 		kubectl:                kubectl,
 		enf:                    enf,
 		projectLock:            projectLock,
+		appOperationLock:       sync.NewKeyLock(),
 		auditLogger:            argo.NewAuditLogger(kubeclientset, "argocd-server", enableK8sEvent),
 		settingsMgr:            settingsMgr,
 		projInformer:           projInformer,
@@ -292,7 +357,13 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 
 	filteredApps := apps
 	// Filter applications by name
-	if q.Name != nil {
+	matchName, err := appNameMatcher(q)
+	if err != nil {
+		return nil, err
+	}
+	if matchName != nil {
+		filteredApps = filterByNameMatcher(filteredApps, matchName)
+	} else if q.Name != nil {
 		filteredApps = argo.FilterByNameP(filteredApps, *q.Name)
 	}
 
@@ -302,6 +373,44 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 	// Filter applications by source repo URL
 	filteredApps = argo.FilterByRepoP(filteredApps, q.GetRepo())
 
+	// Filter applications by whether automated sync is enabled
+	filteredApps = argo.FilterByAutoSyncP(filteredApps, q.AutoSyncEnabled)
+
+	// Filter applications by annotations, after the indexed label selector narrowed down the applications to scan
+	filteredApps, err = argo.FilterByAnnotationsP(filteredApps, q.GetAnnotationSelector())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error parsing the annotation selector: %v", err)
+	}
+
+	// Filter applications by the referenced saved filter
+	if q.GetSavedFilter() != "" {
+		filter, err := s.getApplicationFilter(ctx, q.GetSavedFilter())
+		if err != nil {
+			return nil, err
+		}
+		filteredApps, err = applyApplicationFilter(filteredApps, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if q.GetLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative, got %d", q.GetLimit())
+	}
+	resourceVersion := s.appInformer.LastSyncResourceVersion()
+	var after *listContinueToken
+	if q.GetContinue() != "" {
+		after, err = decodeListContinueToken(q.GetContinue())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid continue token: %v", err)
+		}
+		if after.ResourceVersion != resourceVersion {
+			// The informer resynced since the previous page. Since pages are keyed by name rather than by offset, resuming
+			// after the last returned application is still consistent, although changes may show up on the next pages.
+			log.Debugf("continuing application list from resource version %s at %s", after.ResourceVersion, resourceVersion)
+		}
+	}
+
 	newItems := make([]v1alpha1.Application, 0)
 	for _, a := range filteredApps {
 		// Skip any application that is neither in the control plane's namespace
@@ -309,25 +418,164 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 		if !s.isNamespaceEnabled(a.Namespace) {
 			continue
 		}
+		if after != nil && !after.before(a) {
+			continue
+		}
 		if s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns)) {
 			newItems = append(newItems, *a)
 		}
 	}
 
-	// Sort found applications by name
+	// Sort found applications by name, and by namespace for applications with the same name
 	sort.Slice(newItems, func(i, j int) bool {
-		return newItems[i].Name < newItems[j].Name
+		if newItems[i].Name != newItems[j].Name {
+			return newItems[i].Name < newItems[j].Name
+		}
+		return newItems[i].Namespace < newItems[j].Namespace
 	})
 
 	appList := v1alpha1.ApplicationList{
 		ListMeta: metav1.ListMeta{
-			ResourceVersion: s.appInformer.LastSyncResourceVersion(),
+			ResourceVersion: resourceVersion,
 		},
 		Items: newItems,
 	}
+	if limit := int(q.GetLimit()); limit > 0 && len(newItems) > limit {
+		last := newItems[limit-1]
+		token, err := encodeListContinueToken(&listContinueToken{Name: last.Name, Namespace: last.Namespace, ResourceVersion: resourceVersion})
+		if err != nil {
+			return nil, err
+		}
+		appList.Items = newItems[:limit]
+		appList.ListMeta.Continue = token
+		appList.ListMeta.RemainingItemCount = ptr.To(int64(len(newItems) - limit))
+	}
 	return &appList, nil
 }
 
+const (
+	nameMatchModeExact  = "exact"
+	nameMatchModePrefix = "prefix"
+	nameMatchModeRegex  = "regex"
+)
+
+// appNameMatcher returns the function matching application names for the prefix and regex name match modes of the
+// query, or nil if the name is matched exactly. The regular expression is compiled once per query.
+func appNameMatcher(q *application.ApplicationQuery) (func(name string) bool, error) {
+	name := q.GetName()
+	switch q.GetNameMatchMode() {
+	case "", nameMatchModeExact:
+		return nil, nil
+	case nameMatchModePrefix:
+		return func(appName string) bool {
+			return strings.HasPrefix(appName, name)
+		}, nil
+	case nameMatchModeRegex:
+		re, err := regexp.Compile(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid name regular expression %q: %v", name, err)
+		}
+		return re.MatchString, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported name match mode %q, must be one of %s, %s or %s", q.GetNameMatchMode(), nameMatchModeExact, nameMatchModePrefix, nameMatchModeRegex)
+	}
+}
+
+// filterByNameMatcher returns the applications whose name matches
+func filterByNameMatcher(apps []*v1alpha1.Application, matchName func(name string) bool) []*v1alpha1.Application {
+	items := make([]*v1alpha1.Application, 0)
+	for _, a := range apps {
+		if matchName(a.Name) {
+			items = append(items, a)
+		}
+	}
+	return items
+}
+
+// listContinueToken is the position of the last application returned by a paginated List call
+type listContinueToken struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// before returns whether the token sorts before the given application, i.e. whether the application belongs to a
+// later page
+func (t *listContinueToken) before(a *v1alpha1.Application) bool {
+	if t.Name != a.Name {
+		return t.Name < a.Name
+	}
+	return t.Namespace < a.Namespace
+}
+
+func encodeListContinueToken(token *listContinueToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling continue token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeListContinueToken(value string) (*listContinueToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding continue token: %w", err)
+	}
+	token := &listContinueToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("error unmarshaling continue token: %w", err)
+	}
+	if token.Name == "" {
+		return nil, errors.New("continue token does not contain an application name")
+	}
+	return token, nil
+}
+
+// getApplicationFilter returns the saved filter with the given name. A filter which restricts the list to projects is
+// only expanded for users who can get all of these projects, so that filters don't reveal projects to other teams.
+func (s *Server) getApplicationFilter(ctx context.Context, name string) (*settings.ApplicationFilter, error) {
+	filters, err := s.settingsMgr.GetApplicationFilters()
+	if err != nil {
+		return nil, fmt.Errorf("error getting application filters: %w", err)
+	}
+	for i := range filters {
+		if filters[i].Name != name {
+			continue
+		}
+		for _, project := range filters[i].Projects {
+			if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, project) {
+				return nil, argocommon.PermissionDeniedAPIError
+			}
+		}
+		return &filters[i], nil
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "application filter '%s' is not configured", name)
+}
+
+// applyApplicationFilter returns the applications matching all criteria of the saved filter
+func applyApplicationFilter(apps []*v1alpha1.Application, filter *settings.ApplicationFilter) ([]*v1alpha1.Application, error) {
+	selector, err := labels.Parse(filter.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the selector of application filter '%s': %w", filter.Name, err)
+	}
+	apps = argo.FilterByProjectsP(apps, filter.Projects)
+	apps = argo.FilterByRepoP(apps, filter.Repo)
+	items := make([]*v1alpha1.Application, 0, len(apps))
+	for _, a := range apps {
+		if !selector.Matches(labels.Set(a.Labels)) {
+			continue
+		}
+		if len(filter.HealthStatuses) > 0 && !slices.Contains(filter.HealthStatuses, string(a.Status.Health.Status)) {
+			continue
+		}
+		if len(filter.SyncStatuses) > 0 && !slices.Contains(filter.SyncStatuses, string(a.Status.Sync.Status)) {
+			continue
+		}
+		items = append(items, a)
+	}
+	return items, nil
+}
+
 // Create creates an application
 func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateRequest) (*v1alpha1.Application, error) {
 	if q.GetApplication() == nil {
@@ -352,7 +600,12 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 		return nil, err
 	}
 
-	err = s.validateAndNormalizeApp(ctx, a, proj, validate)
+	strict := true
+	if q.StrictValidation != nil {
+		strict = *q.StrictValidation
+	}
+
+	warnings, err := s.validateAndNormalizeAppWithWarnings(ctx, a, proj, validate, strict)
 	if err != nil {
 		return nil, fmt.Errorf("error while validating and normalizing app: %w", err)
 	}
@@ -372,6 +625,29 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 		a.Operation = nil
 	}
 
+	var created *v1alpha1.Application
+	if q.GetDryRun() {
+		created, err = s.dryRunCreate(ctx, a, appNs, q.GetUpsert(), warnings)
+	} else {
+		created, err = s.createApp(ctx, a, appNs, q.GetUpsert())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(warnings) > 0 {
+		// warnings don't fail the request, let the client know about them
+		md := metadata.MD{}
+		for _, warning := range warnings {
+			md.Append(createWarningHeader, fmt.Sprintf("%s: %s", warning.Type, warning.Message))
+		}
+		_ = grpc.SetHeader(ctx, md)
+	}
+	return created, nil
+}
+
+// createApp creates the validated and normalized application, or updates the existing application if upsert is set
+func (s *Server) createApp(ctx context.Context, a *v1alpha1.Application, appNs string, upsert bool) (*v1alpha1.Application, error) {
 	created, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Create(ctx, a, metav1.CreateOptions{})
 	if err == nil {
 		s.logAppEvent(ctx, created, argo.EventReasonResourceCreated, "created application")
@@ -388,16 +664,10 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 		return nil, status.Errorf(codes.Internal, "unable to check existing application details (%s): %v", appNs, err)
 	}
 
-	equalSpecs := reflect.DeepEqual(existing.Spec.Destination, a.Spec.Destination) &&
-		reflect.DeepEqual(existing.Spec, a.Spec) &&
-		reflect.DeepEqual(existing.Labels, a.Labels) &&
-		reflect.DeepEqual(existing.Annotations, a.Annotations) &&
-		reflect.DeepEqual(existing.Finalizers, a.Finalizers)
-
-	if equalSpecs {
+	if equalCreatedApplications(existing, a) {
 		return existing, nil
 	}
-	if q.Upsert == nil || !*q.Upsert {
+	if !upsert {
 		return nil, status.Errorf(codes.InvalidArgument, "existing application spec is different, use upsert flag to force update")
 	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns)); err != nil {
@@ -410,6 +680,41 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	return updated, nil
 }
 
+// equalCreatedApplications returns whether creating the new application would leave the existing one unchanged
+func equalCreatedApplications(existing *v1alpha1.Application, a *v1alpha1.Application) bool {
+	return reflect.DeepEqual(existing.Spec.Destination, a.Spec.Destination) &&
+		reflect.DeepEqual(existing.Spec, a.Spec) &&
+		reflect.DeepEqual(existing.Labels, a.Labels) &&
+		reflect.DeepEqual(existing.Annotations, a.Annotations) &&
+		reflect.DeepEqual(existing.Finalizers, a.Finalizers)
+}
+
+// dryRunCreate returns the validated and normalized application Create would persist, with the validation warnings as
+// conditions, after checking that an existing application would not make Create fail.
+func (s *Server) dryRunCreate(ctx context.Context, a *v1alpha1.Application, appNs string, upsert bool, warnings []v1alpha1.ApplicationCondition) (*v1alpha1.Application, error) {
+	existing, err := s.appLister.Applications(appNs).Get(a.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, status.Errorf(codes.Internal, "unable to check existing application details (%s): %v", appNs, err)
+	}
+	if err == nil && !equalCreatedApplications(existing, a) {
+		if !upsert {
+			return nil, status.Errorf(codes.InvalidArgument, "existing application spec is different, use upsert flag to force update")
+		}
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+	}
+
+	app := a.DeepCopy()
+	app.Namespace = appNs
+	now := metav1.Now()
+	for _, warning := range warnings {
+		warning.LastTransitionTime = &now
+		app.Status.Conditions = append(app.Status.Conditions, warning)
+	}
+	return app, nil
+}
+
 func (s *Server) queryRepoServer(ctx context.Context, proj *v1alpha1.AppProject, action func(
 	client apiclient.RepoServerServiceClient,
 	helmRepos []*v1alpha1.Repository,
@@ -485,8 +790,214 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, security.NamespaceNotPermittedError(a.Namespace)
 	}
 
+	if err := validateClusterLabels(q.ClusterLabels); err != nil {
+		return nil, err
+	}
+	switch q.GetOutputFormat() {
+	case "", manifestOutputFormatJSON, manifestOutputFormatYAML:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported output format %q, must be one of %q or %q", q.GetOutputFormat(), manifestOutputFormatJSON, manifestOutputFormatYAML)
+	}
+
+	manifestInfos, err := s.generateManifests(ctx, a, proj, q)
+	if err != nil {
+		return nil, err
+	}
+
+	redactionRules, err := s.settingsMgr.GetResourceRedactionRules()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource redaction rules: %w", err)
+	}
+	manifests := &apiclient.ManifestResponse{}
+	if q.GetWithLiveDiff() {
+		// the diffs are computed before the manifests are redacted, so that redacted values don't show up as changes
+		manifests.LiveDiffs, err = s.getManifestLiveDiffs(ctx, a, manifestInfos, redactionRules)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, manifestInfo := range manifestInfos {
+		for i, manifest := range manifestInfo.Manifests {
+			manifestInfo.Manifests[i], err = s.redactManifest(manifest, redactionRules)
+			if err != nil {
+				return nil, err
+			}
+			// the conversion happens after the secret data is hidden, the JSON manifests are returned unchanged
+			if q.GetOutputFormat() == manifestOutputFormatYAML {
+				data, err := yaml.JSONToYAML([]byte(manifestInfo.Manifests[i]))
+				if err != nil {
+					return nil, fmt.Errorf("error converting manifest to YAML: %w", err)
+				}
+				manifestInfo.Manifests[i] = string(data)
+			}
+		}
+		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+		manifests.GenerationSettings = append(manifests.GenerationSettings, manifestInfo.GenerationSettings...)
+	}
+
+	return manifests, nil
+}
+
+// getManifestLiveDiffs diffs each of the generated manifests against the cached live state of its resource. Secret data
+// is hidden and the resulting states are redacted like the manifests themselves.
+func (s *Server) getManifestLiveDiffs(ctx context.Context, a *v1alpha1.Application, manifestInfos []*apiclient.ManifestResponse, redactionRules []settings.ResourceRedactionRule) ([]*apiclient.ManifestLiveDiff, error) {
+	items := make([]*v1alpha1.ResourceDiff, 0)
+	err := s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &items)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting cached app managed resources: %w", err)
+	}
+	liveStates := make(map[kube.ResourceKey]string, len(items))
+	for _, item := range items {
+		liveStates[kube.NewResourceKey(item.Group, item.Kind, item.Namespace, item.Name)] = item.LiveState
+	}
+
+	var keys []kube.ResourceKey
+	var targetObjs, liveObjs []*unstructured.Unstructured
+	for _, manifestInfo := range manifestInfos {
+		for _, manifest := range manifestInfo.Manifests {
+			targetObj := &unstructured.Unstructured{}
+			if err := json.Unmarshal([]byte(manifest), targetObj); err != nil {
+				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			}
+			key := kube.GetResourceKey(targetObj)
+			liveState, ok := liveStates[key]
+			if !ok && key.Namespace == "" {
+				// manifests without a namespace are applied to the destination namespace, unless cluster scoped
+				namespacedKey := kube.NewResourceKey(key.Group, key.Kind, a.Spec.Destination.Namespace, key.Name)
+				if liveState, ok = liveStates[namespacedKey]; ok {
+					key = namespacedKey
+					targetObj.SetNamespace(key.Namespace)
+				}
+			}
+			var liveObj *unstructured.Unstructured
+			if liveState != "" && liveState != "null" {
+				liveObj = &unstructured.Unstructured{}
+				if err := json.Unmarshal([]byte(liveState), liveObj); err != nil {
+					return nil, fmt.Errorf("error unmarshaling live state of %s: %w", key.String(), err)
+				}
+			}
+			if key.Kind == kube.SecretKind && key.Group == "" {
+				targetObj, liveObj, err = diff.HideSecretData(targetObj, liveObj, s.settingsMgr.GetSensitiveAnnotations())
+				if err != nil {
+					return nil, fmt.Errorf("error hiding secret data: %w", err)
+				}
+			}
+			keys = append(keys, key)
+			targetObjs = append(targetObjs, targetObj)
+			liveObjs = append(liveObjs, liveObj)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	diffConfig, err := s.newDiffConfig(a)
+	if err != nil {
+		return nil, err
+	}
+	diffResults, err := argodiff.StateDiffs(liveObjs, targetObjs, diffConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error diffing manifests: %w", err)
+	}
+
+	liveDiffs := make([]*apiclient.ManifestLiveDiff, 0, len(keys))
+	for i, key := range keys {
+		diffRes := diffResults.Diffs[i]
+		liveDiff := &apiclient.ManifestLiveDiff{
+			Group:     key.Group,
+			Kind:      key.Kind,
+			Namespace: key.Namespace,
+			Name:      key.Name,
+			Modified:  diffRes.Modified,
+		}
+		if liveObjs[i] != nil {
+			liveDiff.NormalizedLiveState, err = s.redactManifest(string(diffRes.NormalizedLive), redactionRules)
+			if err != nil {
+				return nil, err
+			}
+		}
+		liveDiff.PredictedLiveState, err = s.redactManifest(string(diffRes.PredictedLive), redactionRules)
+		if err != nil {
+			return nil, err
+		}
+		liveDiffs = append(liveDiffs, liveDiff)
+	}
+	return liveDiffs, nil
+}
+
+// newDiffConfig returns the configuration the application controller diffs the resources of the application with, so
+// that the ignored differences of the application and the resource overrides and compare options are respected
+func (s *Server) newDiffConfig(a *v1alpha1.Application) (argodiff.DiffConfig, error) {
+	argoSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting ArgoCD settings: %w", err)
+	}
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+	compareOptions, err := s.settingsMgr.GetResourceCompareOptions()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource compare options: %w", err)
+	}
+	appLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key: %w", err)
+	}
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(a.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, normalizers.IgnoreNormalizerOpts{}).
+		WithTracking(appLabelKey, argoSettings.TrackingMethod).
+		WithNoCache().
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("error building diff config: %w", err)
+	}
+	return diffConfig, nil
+}
+
+// manifestGenerationSettings returns the settings of a manifest request that determine the generated manifests, so
+// that they can be returned to the user. Repository credentials are never part of the returned settings.
+func manifestGenerationSettings(req *apiclient.ManifestRequest) *apiclient.ManifestGenerationSettings {
+	settings := &apiclient.ManifestGenerationSettings{
+		Revision:           req.Revision,
+		ApplicationSource:  req.ApplicationSource,
+		AppLabelKey:        req.AppLabelKey,
+		Namespace:          req.Namespace,
+		KubeVersion:        req.KubeVersion,
+		ApiVersions:        req.ApiVersions,
+		TrackingMethod:     req.TrackingMethod,
+		InstallationID:     req.InstallationID,
+		KustomizeOptions:   req.KustomizeOptions,
+		EnabledSourceTypes: req.EnabledSourceTypes,
+		HelmOptions:        req.HelmOptions,
+		ClusterLabels:      req.ClusterLabels,
+	}
+	if req.Repo != nil {
+		settings.RepoURL = req.Repo.Repo
+	}
+	return settings
+}
+
+// validateClusterLabels validates the keys and values of the cluster label overrides of a manifest query
+func validateClusterLabels(clusterLabels map[string]string) error {
+	for _, key := range slices.Sorted(maps.Keys(clusterLabels)) {
+		if errs := k8svalidation.IsQualifiedName(key); len(errs) > 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid cluster label key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := k8svalidation.IsValidLabelValue(clusterLabels[key]); len(errs) > 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid value of cluster label %q: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// generateManifests generates the manifests of every source of the application using the revisions requested in the
+// query. The returned manifests are not redacted, callers must hide secret data before returning them to the user.
+func (s *Server) generateManifests(ctx context.Context, a *v1alpha1.Application, proj *v1alpha1.AppProject, q *application.ApplicationManifestQuery) ([]*apiclient.ManifestResponse, error) {
 	manifestInfos := make([]*apiclient.ManifestResponse, 0)
-	err = s.queryRepoServer(ctx, proj, func(
+	err := s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, ociRepos []*v1alpha1.Repository, ociCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
 	) error {
 		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
@@ -494,9 +1005,22 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("error getting app instance label key from settings: %w", err)
 		}
 
-		config, err := s.getApplicationClusterConfig(ctx, a)
+		cluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
 		if err != nil {
-			return fmt.Errorf("error getting application cluster config: %w", err)
+			return fmt.Errorf("error validating destination: %w", err)
+		}
+		config, err := cluster.RESTConfig()
+		if err != nil {
+			return fmt.Errorf("error getting cluster REST config: %w", err)
+		}
+
+		// the manifests are rendered with the labels of the destination cluster, unless overridden by the query
+		clusterLabels := maps.Clone(cluster.Labels)
+		if len(q.ClusterLabels) > 0 {
+			if clusterLabels == nil {
+				clusterLabels = make(map[string]string, len(q.ClusterLabels))
+			}
+			maps.Copy(clusterLabels, q.ClusterLabels)
 		}
 
 		serverVersion, err := s.kubectl.GetServerVersion(config)
@@ -534,95 +1058,530 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("failed to get ref sources: %w", err)
 		}
 
-		for _, source := range sources {
-			repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
-			if err != nil {
-				return fmt.Errorf("error getting repository: %w", err)
-			}
+		kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
+		if err != nil {
+			return fmt.Errorf("error getting kustomize settings: %w", err)
+		}
+		installationID, err := s.settingsMgr.GetInstallationID()
+		if err != nil {
+			return fmt.Errorf("error getting installation ID: %w", err)
+		}
+		trackingMethod, err := s.settingsMgr.GetTrackingMethod()
+		if err != nil {
+			return fmt.Errorf("error getting trackingMethod from settings: %w", err)
+		}
 
-			kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
-			if err != nil {
-				return fmt.Errorf("error getting kustomize settings: %w", err)
-			}
+		apiVersions := argo.APIResourcesToStrings(apiResources, true)
 
-			installationID, err := s.settingsMgr.GetInstallationID()
-			if err != nil {
-				return fmt.Errorf("error getting installation ID: %w", err)
-			}
-			trackingMethod, err := s.settingsMgr.GetTrackingMethod()
-			if err != nil {
-				return fmt.Errorf("error getting trackingMethod from settings: %w", err)
+		// the ref sources are resolved above, so the manifests of the sources can be generated independently
+		results := make([]*apiclient.ManifestResponse, len(sources))
+		g, gctx := errgroup.WithContext(ctx)
+		g.SetLimit(manifestGenerationParallelism)
+		for i, source := range sources {
+			// stop scheduling sources once the client disconnected or another source failed
+			if gctx.Err() != nil {
+				break
 			}
+			g.Go(func() error {
+				// the source may have waited for a free worker, during which the request could have been canceled
+				if err := gctx.Err(); err != nil {
+					return err
+				}
+				repo, err := s.db.GetRepository(gctx, source.RepoURL, proj.Name)
+				if err != nil {
+					return fmt.Errorf("error getting repository: %w", err)
+				}
+
+				repos := helmRepos
+				helmRepoCreds := helmCreds
+				// If the source is OCI, there is a potential for an OCI image to be a Helm chart and that said chart in
+				// turn would have OCI dependencies. To ensure that those dependencies can be resolved, add them to the repos
+				// list.
+				if source.IsOCI() {
+					repos = slices.Clone(helmRepos)
+					helmRepoCreds = slices.Clone(helmCreds)
+					repos = append(repos, ociRepos...)
+					helmRepoCreds = append(helmRepoCreds, ociCreds...)
+				}
 
-			repos := helmRepos
-			helmRepoCreds := helmCreds
-			// If the source is OCI, there is a potential for an OCI image to be a Helm chart and that said chart in
-			// turn would have OCI dependencies. To ensure that those dependencies can be resolved, add them to the repos
-			// list.
-			if source.IsOCI() {
-				repos = slices.Clone(helmRepos)
-				helmRepoCreds = slices.Clone(helmCreds)
-				repos = append(repos, ociRepos...)
-				helmRepoCreds = append(helmRepoCreds, ociCreds...)
-			}
-
-			manifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
-				Repo:                            repo,
-				Revision:                        source.TargetRevision,
-				AppLabelKey:                     appInstanceLabelKey,
-				AppName:                         a.InstanceName(s.ns),
-				Namespace:                       a.Spec.Destination.Namespace,
-				ApplicationSource:               &source,
-				Repos:                           repos,
-				KustomizeOptions:                kustomizeSettings,
-				KubeVersion:                     serverVersion,
-				ApiVersions:                     argo.APIResourcesToStrings(apiResources, true),
-				HelmRepoCreds:                   helmRepoCreds,
-				HelmOptions:                     helmOptions,
-				TrackingMethod:                  trackingMethod,
-				EnabledSourceTypes:              enableGenerateManifests,
-				ProjectName:                     proj.Name,
-				ProjectSourceRepos:              proj.Spec.SourceRepos,
-				HasMultipleSources:              a.Spec.HasMultipleSources(),
-				RefSources:                      refSources,
-				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
-				InstallationID:                  installationID,
+				manifestRequest := &apiclient.ManifestRequest{
+					Repo:                            repo,
+					Revision:                        source.TargetRevision,
+					AppLabelKey:                     appInstanceLabelKey,
+					AppName:                         a.InstanceName(s.ns),
+					Namespace:                       a.Spec.Destination.Namespace,
+					ApplicationSource:               &source,
+					Repos:                           repos,
+					KustomizeOptions:                kustomizeSettings,
+					KubeVersion:                     serverVersion,
+					ApiVersions:                     apiVersions,
+					HelmRepoCreds:                   helmRepoCreds,
+					HelmOptions:                     helmOptions,
+					TrackingMethod:                  trackingMethod,
+					EnabledSourceTypes:              enableGenerateManifests,
+					ProjectName:                     proj.Name,
+					ProjectSourceRepos:              proj.Spec.SourceRepos,
+					HasMultipleSources:              a.Spec.HasMultipleSources(),
+					RefSources:                      refSources,
+					AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
+					InstallationID:                  installationID,
+					ClusterLabels:                   clusterLabels,
+				}
+				manifestInfo, err := client.GenerateManifest(gctx, manifestRequest)
+				if err != nil {
+					return fmt.Errorf("error generating manifests for source %d of %d: %w", i+1, len(sources), err)
+				}
+				if q.GetIncludeGenerationSettings() {
+					manifestInfo.GenerationSettings = []*apiclient.ManifestGenerationSettings{manifestGenerationSettings(manifestRequest)}
+				}
+				// every source writes its own index, so the manifests keep the order of the sources
+				results[i] = manifestInfo
+				return nil
 			})
-			if err != nil {
-				return fmt.Errorf("error generating manifests: %w", err)
-			}
-			manifestInfos = append(manifestInfos, manifestInfo)
 		}
+		if err := g.Wait(); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("manifest generation canceled: %w", err)
+		}
+		manifestInfos = append(manifestInfos, results...)
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
+	return manifestInfos, nil
+}
 
-	manifests := &apiclient.ManifestResponse{}
-	for _, manifestInfo := range manifestInfos {
-		for i, manifest := range manifestInfo.Manifests {
-			obj := &unstructured.Unstructured{}
-			err = json.Unmarshal([]byte(manifest), obj)
+// GetPerSourceSyncStatus generates the manifests of every source of the application and attributes the sync status of
+// the managed resources to the source which generated them. For multi-source applications this reveals which source
+// causes the aggregated status to be OutOfSync.
+func (s *Server) GetPerSourceSyncStatus(ctx context.Context, q *application.PerSourceSyncStatusQuery) (*application.PerSourceSyncStatusResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+
+	manifestInfos, err := s.generateManifests(ctx, a.DeepCopy(), proj, &application.ApplicationManifestQuery{Name: q.Name})
+	if err != nil {
+		return nil, err
+	}
+	return perSourceSyncStatus(a, manifestInfos)
+}
+
+// perSourceSyncStatus attributes the statuses of the application resources to the sources, given the manifests
+// generated for each source in the order of the application sources
+func perSourceSyncStatus(a *v1alpha1.Application, manifestInfos []*apiclient.ManifestResponse) (*application.PerSourceSyncStatusResponse, error) {
+	statuses := make(map[kube.ResourceKey]v1alpha1.ResourceStatus)
+	for _, res := range a.Status.Resources {
+		statuses[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res
+	}
+	outOfSyncRef := func(res v1alpha1.ResourceStatus) *v1alpha1.ResourceRef {
+		return &v1alpha1.ResourceRef{Group: res.Group, Version: res.Version, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name}
+	}
+
+	attributed := make(map[kube.ResourceKey]bool)
+	sources := a.Spec.GetSources()
+	res := &application.PerSourceSyncStatusResponse{}
+	for i, manifestInfo := range manifestInfos {
+		if i >= len(sources) {
+			break
+		}
+		sourceStatus := &application.SourceSyncStatus{
+			SourceIndex: ptr.To(int32(i)),
+			RepoURL:     ptr.To(sources[i].RepoURL),
+			Path:        ptr.To(sources[i].Path),
+			Chart:       ptr.To(sources[i].Chart),
+			Status:      ptr.To(string(v1alpha1.SyncStatusCodeSynced)),
+		}
+		if a.Spec.HasMultipleSources() {
+			if i < len(a.Status.Sync.Revisions) {
+				sourceStatus.Revision = ptr.To(a.Status.Sync.Revisions[i])
+			}
+		} else {
+			sourceStatus.Revision = ptr.To(a.Status.Sync.Revision)
+		}
+		res.Sources = append(res.Sources, sourceStatus)
+
+		for _, manifest := range manifestInfo.Manifests {
+			obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
 			if err != nil {
 				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
 			}
-			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-				obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
-				if err != nil {
-					return nil, fmt.Errorf("error hiding secret data: %w", err)
+			key := kube.GetResourceKey(obj)
+			resStatus, ok := statuses[key]
+			if !ok && key.Namespace == "" {
+				// namespaced resources without a namespace in the manifest are deployed to the destination namespace
+				key.Namespace = a.Spec.Destination.Namespace
+				resStatus, ok = statuses[key]
+			}
+			if !ok || attributed[key] {
+				continue
+			}
+			attributed[key] = true
+			if resStatus.Status == v1alpha1.SyncStatusCodeOutOfSync {
+				sourceStatus.Status = ptr.To(string(v1alpha1.SyncStatusCodeOutOfSync))
+				sourceStatus.OutOfSyncResources = append(sourceStatus.OutOfSyncResources, outOfSyncRef(resStatus))
+			}
+		}
+	}
+
+	for _, resStatus := range a.Status.Resources {
+		key := kube.NewResourceKey(resStatus.Group, resStatus.Kind, resStatus.Namespace, resStatus.Name)
+		if !attributed[key] && resStatus.Status == v1alpha1.SyncStatusCodeOutOfSync {
+			res.UnattributedOutOfSyncResources = append(res.UnattributedOutOfSyncResources, outOfSyncRef(resStatus))
+		}
+	}
+	return res, nil
+}
+
+// DiffFromGeneratedTemplate compares an application generated by an ApplicationSet to the template of the set. Fields
+// which the template renders from generator parameters can not be compared and are skipped, as are the fields ignored
+// by the ignoreApplicationDifferences of the set, so any remaining difference is a modification of the application.
+func (s *Server) DiffFromGeneratedTemplate(ctx context.Context, q *application.ApplicationTemplateDiffQuery) (*application.ApplicationTemplateDiffResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	owner := metav1.GetControllerOf(a)
+	if owner == nil || owner.APIVersion != v1alpha1.SchemeGroupVersion.String() || owner.Kind != applicationType.ApplicationSetKind {
+		return &application.ApplicationTemplateDiffResponse{}, nil
+	}
+	appSet, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(a.Namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// the set is being deleted and the application is about to be orphaned or deleted
+			return &application.ApplicationTemplateDiffResponse{}, nil
+		}
+		return nil, fmt.Errorf("error getting ApplicationSet %s: %w", owner.Name, err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionGet, appSet.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	differences, err := templateDifferences(appSet, a)
+	if err != nil {
+		return nil, err
+	}
+	return &application.ApplicationTemplateDiffResponse{
+		ApplicationSetName: ptr.To(appSet.Name),
+		Differences:        differences,
+	}, nil
+}
+
+// templateDifferences returns the differences between the labels, annotations and spec of an application and the
+// template of the given ApplicationSet.
+func templateDifferences(appSet *v1alpha1.ApplicationSet, a *v1alpha1.Application) ([]*application.ApplicationTemplateFieldDifference, error) {
+	template := map[string]any{
+		"metadata": map[string]any{
+			"labels":      appSet.Spec.Template.Labels,
+			"annotations": appSet.Spec.Template.Annotations,
+		},
+		"spec": appSet.Spec.Template.Spec,
+	}
+	live := map[string]any{
+		"metadata": map[string]any{
+			"labels":      a.Labels,
+			"annotations": a.Annotations,
+		},
+		"spec": a.Spec,
+	}
+	templateObj, err := toJSONObject(template)
+	if err != nil {
+		return nil, fmt.Errorf("error converting ApplicationSet template: %w", err)
+	}
+	liveObj, err := toJSONObject(live)
+	if err != nil {
+		return nil, fmt.Errorf("error converting application: %w", err)
+	}
+
+	var ignoredPointers []string
+	for _, ignore := range appSet.Spec.IgnoreApplicationDifferences {
+		if ignore.Name == "" || ignore.Name == a.Name {
+			ignoredPointers = append(ignoredPointers, ignore.JSONPointers...)
+		}
+	}
+	ignored := func(path string) bool {
+		for _, pointer := range ignoredPointers {
+			if path == pointer || strings.HasPrefix(path, strings.TrimSuffix(pointer, "/")+"/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	var differences []*application.ApplicationTemplateFieldDifference
+	var compare func(path string, templateValue, liveValue any, onlyTemplateKeys bool) error
+	compare = func(path string, templateValue, liveValue any, onlyTemplateKeys bool) error {
+		if ignored(path) {
+			return nil
+		}
+		if str, ok := templateValue.(string); ok && strings.Contains(str, "{{") {
+			// rendered from the generator parameters
+			return nil
+		}
+		templateMap, templateIsMap := templateValue.(map[string]any)
+		liveMap, liveIsMap := liveValue.(map[string]any)
+		if templateIsMap && (liveIsMap || liveValue == nil) {
+			keys := make(map[string]bool)
+			for k := range templateMap {
+				keys[k] = true
+			}
+			if !onlyTemplateKeys {
+				for k := range liveMap {
+					keys[k] = true
 				}
-				data, err := json.Marshal(obj)
-				if err != nil {
-					return nil, fmt.Errorf("error marshaling manifest: %w", err)
+			}
+			for _, k := range slices.Sorted(maps.Keys(keys)) {
+				if strings.Contains(k, "{{") {
+					continue
+				}
+				keyPath := path + "/" + strings.ReplaceAll(strings.ReplaceAll(k, "~", "~0"), "/", "~1")
+				if err := compare(keyPath, templateMap[k], liveMap[k], false); err != nil {
+					return err
 				}
-				manifestInfo.Manifests[i] = string(data)
 			}
+			return nil
 		}
-		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+		templateList, templateIsList := templateValue.([]any)
+		liveList, liveIsList := liveValue.([]any)
+		if templateIsList && liveIsList && len(templateList) == len(liveList) {
+			for i := range templateList {
+				if err := compare(fmt.Sprintf("%s/%d", path, i), templateList[i], liveList[i], false); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if reflect.DeepEqual(templateValue, liveValue) {
+			return nil
+		}
+		difference := &application.ApplicationTemplateFieldDifference{Path: ptr.To(path)}
+		if difference.TemplateValue, err = marshalTemplateDiffValue(templateValue); err != nil {
+			return fmt.Errorf("error marshaling template value of %s: %w", path, err)
+		}
+		if difference.LiveValue, err = marshalTemplateDiffValue(liveValue); err != nil {
+			return fmt.Errorf("error marshaling application value of %s: %w", path, err)
+		}
+		differences = append(differences, difference)
+		return nil
 	}
 
-	return manifests, nil
+	templateMetadata, _ := templateObj["metadata"].(map[string]any)
+	liveMetadata, _ := liveObj["metadata"].(map[string]any)
+	// the application may have labels and annotations which are not managed by the template
+	for _, field := range []string{"labels", "annotations"} {
+		if templateMetadata[field] == nil {
+			continue
+		}
+		if err := compare("/metadata/"+field, templateMetadata[field], liveMetadata[field], true); err != nil {
+			return nil, err
+		}
+	}
+	if err := compare("/spec", templateObj["spec"], liveObj["spec"], false); err != nil {
+		return nil, err
+	}
+	return differences, nil
+}
+
+func marshalTemplateDiffValue(value any) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return ptr.To(string(data)), nil
+}
+
+func toJSONObject(obj any) (map[string]any, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var res map[string]any
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetRevisionsDiff generates the manifests of an application source at two revisions and returns the resources which
+// were added, removed or modified between them. By default the currently synced revision is compared with the source's
+// target revision, which previews what the next sync would change. Multi-source applications may compare several
+// sources at once by selecting them with their positions like GetManifests, the other sources use their target
+// revision on both sides.
+func (s *Server) GetRevisionsDiff(ctx context.Context, q *application.ApplicationRevisionsDiffQuery) (*application.ApplicationRevisionsDiffResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+
+	var sourcePositions []int64
+	var baseRevisions, targetRevisions []string
+	if len(q.SourcePositions) > 0 {
+		if !a.Spec.HasMultipleSources() {
+			return nil, status.Error(codes.InvalidArgument, "source positions are only supported for applications with multiple sources")
+		}
+		if len(q.BaseRevisions) != len(q.SourcePositions) || len(q.TargetRevisions) != len(q.SourcePositions) {
+			return nil, status.Error(codes.InvalidArgument, "a base and a target revision are required for every source position")
+		}
+		sourcePositions, baseRevisions, targetRevisions = q.SourcePositions, q.BaseRevisions, q.TargetRevisions
+	} else {
+		sourceIndex := int(q.GetSourceIndex())
+		if sourceIndex < 0 || sourceIndex >= len(a.Spec.GetSources()) {
+			return nil, status.Errorf(codes.InvalidArgument, "source index %d is out of range", sourceIndex)
+		}
+		sourcePositions = []int64{int64(sourceIndex + 1)}
+		baseRevisions = []string{q.GetBaseRevision()}
+		targetRevisions = []string{q.GetTargetRevision()}
+	}
+
+	baseRevisions, targetRevisions, items, err := s.diffRevisions(ctx, a, proj, sourcePositions, baseRevisions, targetRevisions)
+	if err != nil {
+		return nil, err
+	}
+	return &application.ApplicationRevisionsDiffResponse{
+		BaseRevision:    ptr.To(baseRevisions[0]),
+		TargetRevision:  ptr.To(targetRevisions[0]),
+		Items:           items,
+		BaseRevisions:   baseRevisions,
+		TargetRevisions: targetRevisions,
+	}, nil
+}
+
+// diffRevisions generates the manifests of the application with the sources at the given positions, starting at 1, at
+// the base and at the target revisions and returns the resolved revisions together with the resources which were added,
+// removed or modified between them. An empty base revision defaults to the revision the source is synced to, an empty
+// target revision to the source's target revision.
+func (s *Server) diffRevisions(ctx context.Context, a *v1alpha1.Application, proj *v1alpha1.AppProject, sourcePositions []int64, baseRevisions, targetRevisions []string) ([]string, []string, []*application.ManifestRevisionDiff, error) {
+	sources := a.Spec.GetSources()
+	baseRevisions = slices.Clone(baseRevisions)
+	targetRevisions = slices.Clone(targetRevisions)
+	for i, pos := range sourcePositions {
+		if pos <= 0 || pos > int64(len(sources)) {
+			return nil, nil, nil, status.Errorf(codes.InvalidArgument, "source position %d is out of range", pos)
+		}
+		if baseRevisions[i] == "" {
+			if !a.Spec.HasMultipleSources() {
+				baseRevisions[i] = a.Status.Sync.Revision
+			} else if int(pos) <= len(a.Status.Sync.Revisions) {
+				baseRevisions[i] = a.Status.Sync.Revisions[pos-1]
+			}
+		}
+		if baseRevisions[i] == "" {
+			return nil, nil, nil, status.Error(codes.InvalidArgument, "base revision is required because the application has not been synced")
+		}
+		if targetRevisions[i] == "" {
+			targetRevisions[i] = sources[pos-1].TargetRevision
+		}
+		if targetRevisions[i] == "" {
+			targetRevisions[i] = "HEAD"
+		}
+	}
+
+	manifestQuery := func(revisions []string) *application.ApplicationManifestQuery {
+		if a.Spec.HasMultipleSources() {
+			return &application.ApplicationManifestQuery{Name: ptr.To(a.Name), SourcePositions: sourcePositions, Revisions: revisions}
+		}
+		return &application.ApplicationManifestQuery{Name: ptr.To(a.Name), Revision: ptr.To(revisions[0])}
+	}
+	baseObjs, err := s.generateManifestObjects(ctx, a, proj, manifestQuery(baseRevisions))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error generating manifests for revisions %s: %w", strings.Join(baseRevisions, ", "), err)
+	}
+	targetObjs, err := s.generateManifestObjects(ctx, a, proj, manifestQuery(targetRevisions))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error generating manifests for revisions %s: %w", strings.Join(targetRevisions, ", "), err)
+	}
+	items, err := s.diffManifestObjects(baseObjs, targetObjs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return baseRevisions, targetRevisions, items, nil
+}
+
+// generateManifestObjects generates the manifests of the application for the query and returns them by resource key
+func (s *Server) generateManifestObjects(ctx context.Context, a *v1alpha1.Application, proj *v1alpha1.AppProject, q *application.ApplicationManifestQuery) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	manifestInfos, err := s.generateManifests(ctx, a.DeepCopy(), proj, q)
+	if err != nil {
+		return nil, err
+	}
+	objs := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, manifestInfo := range manifestInfos {
+		for _, manifest := range manifestInfo.Manifests {
+			obj := &unstructured.Unstructured{}
+			if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			}
+			objs[kube.GetResourceKey(obj)] = obj
+		}
+	}
+	return objs, nil
+}
+
+// diffManifestObjects returns the resources which were added, removed or modified between two sets of manifests,
+// sorted by resource key. The data of secrets is hidden.
+func (s *Server) diffManifestObjects(baseObjs, targetObjs map[kube.ResourceKey]*unstructured.Unstructured) ([]*application.ManifestRevisionDiff, error) {
+	keys := make([]kube.ResourceKey, 0, len(baseObjs)+len(targetObjs))
+	for key := range baseObjs {
+		keys = append(keys, key)
+	}
+	for key := range targetObjs {
+		if _, ok := baseObjs[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	var items []*application.ManifestRevisionDiff
+	for _, key := range keys {
+		baseObj, targetObj := baseObjs[key], targetObjs[key]
+		if baseObj != nil && targetObj != nil && reflect.DeepEqual(baseObj.Object, targetObj.Object) {
+			continue
+		}
+		if key.Kind == kube.SecretKind && key.Group == "" {
+			// hide both sides together so that changed values remain distinguishable
+			var err error
+			targetObj, baseObj, err = diff.HideSecretData(targetObj, baseObj, s.settingsMgr.GetSensitiveAnnotations())
+			if err != nil {
+				return nil, fmt.Errorf("error hiding secret data: %w", err)
+			}
+		}
+		item := &application.ManifestRevisionDiff{
+			Group:     ptr.To(key.Group),
+			Kind:      ptr.To(key.Kind),
+			Namespace: ptr.To(key.Namespace),
+			Name:      ptr.To(key.Name),
+		}
+		if baseObj != nil {
+			data, err := json.Marshal(baseObj)
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling manifest: %w", err)
+			}
+			item.BaseState = ptr.To(string(data))
+		}
+		if targetObj != nil {
+			data, err := json.Marshal(targetObj)
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling manifest: %w", err)
+			}
+			item.TargetState = ptr.To(string(data))
+		}
+		items = append(items, item)
+	}
+	return items, nil
 }
 
 func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_GetManifestsWithFilesServer) error {
@@ -729,22 +1688,14 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 		return err
 	}
 
+	redactionRules, err := s.settingsMgr.GetResourceRedactionRules()
+	if err != nil {
+		return fmt.Errorf("error getting resource redaction rules: %w", err)
+	}
 	for i, manifest := range manifestInfo.Manifests {
-		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(manifest), obj)
+		manifestInfo.Manifests[i], err = s.redactManifest(manifest, redactionRules)
 		if err != nil {
-			return fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
-		}
-		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-			obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
-			if err != nil {
-				return fmt.Errorf("error hiding secret data: %w", err)
-			}
-			data, err := json.Marshal(obj)
-			if err != nil {
-				return fmt.Errorf("error marshaling manifest: %w", err)
-			}
-			manifestInfo.Manifests[i] = string(data)
+			return err
 		}
 	}
 
@@ -752,6 +1703,70 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 	return nil
 }
 
+// UploadLocalManifests receives the manifests for a local sync of an application and stores them, so that a sync can
+// reference them in its manifestsRef instead of passing them inline, which may exceed the gRPC message size limit. The
+// stream starts with the query, followed by any number of chunks of manifests.
+func (s *Server) UploadLocalManifests(stream application.ApplicationService_UploadLocalManifestsServer) error {
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving query: %w", err)
+	}
+	query := req.GetQuery()
+	if query == nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: the stream must start with the query")
+	}
+
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionSync, query.GetProject(), query.GetAppNamespace(), query.GetName())
+	if err != nil {
+		return err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+		return err
+	}
+
+	var manifests []string
+	size := 0
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error receiving manifests: %w", err)
+		}
+		chunk := req.GetChunk()
+		if chunk == nil {
+			return status.Errorf(codes.InvalidArgument, "invalid request: the query must only be sent once")
+		}
+		// empty chunks are rejected so that the number of chunks is bounded by the size limit
+		if len(chunk.Manifests) == 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid request: chunks must contain manifests")
+		}
+		for _, manifest := range chunk.Manifests {
+			size += len(manifest)
+		}
+		if size > maxLocalManifestsSize {
+			return status.Errorf(codes.ResourceExhausted, "local manifests exceeded the max size of %d bytes", maxLocalManifestsSize)
+		}
+		manifests = append(manifests, chunk.Manifests...)
+	}
+	if len(manifests) == 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid request: no manifests were uploaded")
+	}
+
+	ref := uuid.NewString()
+	expiresAt, err := s.cache.SetLocalManifests(a.QualifiedName(), ref, manifests)
+	if err != nil {
+		return fmt.Errorf("error storing local manifests: %w", err)
+	}
+	return stream.SendAndClose(&application.LocalManifestsReference{
+		Ref:       ptr.To(ref),
+		ExpiresAt: &metav1.Time{Time: expiresAt},
+		Count:     ptr.To(int64(len(manifests))),
+	})
+}
+
 // Get returns an application by name
 func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1alpha1.Application, error) {
 	appName := q.GetName()
@@ -776,7 +1791,7 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 	s.inferResourcesStatusHealth(a)
 
 	if q.Refresh == nil {
-		return a, nil
+		return projectApplicationFields(a, q.Fields)
 	}
 
 	refreshType := v1alpha1.RefreshTypeNormal
@@ -798,8 +1813,14 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 	}
 
 	if refreshType == v1alpha1.RefreshTypeHard {
-		// force refresh cached application details
-		if err := s.queryRepoServer(ctx, proj, func(
+		// force refresh cached application details, without letting a slow repo server hold up the refreshed app
+		detailsCtx := ctx
+		if hardRefreshAppDetailsTimeout > 0 {
+			var cancel context.CancelFunc
+			detailsCtx, cancel = context.WithTimeout(ctx, hardRefreshAppDetailsTimeout)
+			defer cancel()
+		}
+		if err := s.queryRepoServer(detailsCtx, proj, func(
 			client apiclient.RepoServerServiceClient,
 			helmRepos []*v1alpha1.Repository,
 			_ []*v1alpha1.RepoCreds,
@@ -809,7 +1830,7 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 			enabledSourceTypes map[string]bool,
 		) error {
 			source := app.Spec.GetSource()
-			repo, err := s.db.GetRepository(ctx, a.Spec.GetSource().RepoURL, proj.Name)
+			repo, err := s.db.GetRepository(detailsCtx, a.Spec.GetSource().RepoURL, proj.Name)
 			if err != nil {
 				return fmt.Errorf("error getting repository: %w", err)
 			}
@@ -821,7 +1842,7 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 			if err != nil {
 				return fmt.Errorf("error getting trackingMethod from settings: %w", err)
 			}
-			_, err = client.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
+			_, err = client.GetAppDetails(detailsCtx, &apiclient.RepoServerAppDetailsQuery{
 				Repo:               repo,
 				Source:             &source,
 				AppName:            appName,
@@ -834,7 +1855,14 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 			})
 			return err
 		}); err != nil {
-			log.Warnf("Failed to force refresh application details: %v", err)
+			if ctx.Err() == nil && errors.Is(detailsCtx.Err(), context.DeadlineExceeded) {
+				msg := fmt.Sprintf("refreshing the details of application %s timed out after %s, the details may be outdated", appName, hardRefreshAppDetailsTimeout)
+				log.Warn(msg)
+				// the refreshed application is still returned, let the client know its details were not regenerated
+				_ = grpc.SetHeader(ctx, metadata.Pairs(hardRefreshWarningHeader, msg))
+			} else {
+				log.Warnf("Failed to force refresh application details: %v", err)
+			}
 		}
 	}
 
@@ -854,15 +1882,58 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 					annotations = make(map[string]string)
 				}
 				if _, ok := annotations[v1alpha1.AnnotationKeyRefresh]; !ok {
-					return event.Application.DeepCopy(), nil
+					return projectApplicationFields(event.Application.DeepCopy(), q.Fields)
 				}
 			}
 		}
 	}
 }
 
-// ListResourceEvents returns a list of event resources
+// projectApplicationFields returns an application with only the fields at the given dot separated paths set. Paths
+// which don't exist in the application are ignored. The application is returned unchanged if no paths are given.
+func projectApplicationFields(a *v1alpha1.Application, paths []string) (*v1alpha1.Application, error) {
+	if len(paths) == 0 {
+		return a, nil
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(a)
+	if err != nil {
+		return nil, fmt.Errorf("error converting application to unstructured: %w", err)
+	}
+	projected := map[string]any{}
+	for _, path := range paths {
+		fields := strings.Split(path, ".")
+		value, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+		if err != nil || !found {
+			// unknown paths, or paths into fields which are not objects, are ignored to stay forward compatible
+			continue
+		}
+		if err := unstructured.SetNestedField(projected, runtime.DeepCopyJSONValue(value), fields...); err != nil {
+			return nil, fmt.Errorf("error projecting field %s: %w", path, err)
+		}
+	}
+	res := &v1alpha1.Application{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(projected, res); err != nil {
+		return nil, fmt.Errorf("error converting projected application: %w", err)
+	}
+	res.TypeMeta = a.TypeMeta
+	return res, nil
+}
+
+// GetRBACName returns the name the RBAC enforcer checks application policies against. For applications outside of
+// the control plane's namespace it includes the application's namespace.
+func (s *Server) GetRBACName(ctx context.Context, q *application.ApplicationRBACNameQuery) (*application.ApplicationRBACNameResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &application.ApplicationRBACNameResponse{RbacName: ptr.To(a.RBACName(s.ns))}, nil
+}
+
+// ListResourceEvents returns a list of event resources
 func (s *Server) ListResourceEvents(ctx context.Context, q *application.ApplicationResourceEventsQuery) (*corev1.EventList, error) {
+	if q.GetLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
 	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
 		return nil, err
@@ -872,6 +1943,7 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 		kubeClientset kubernetes.Interface
 		fieldSelector string
 		namespace     string
+		external      bool
 	)
 	// There are two places where we get events. If we are getting application events, we query
 	// our own cluster. If it is events on a resource on an external cluster, then we query the
@@ -879,11 +1951,7 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 	if q.GetResourceName() == "" && q.GetResourceUID() == "" {
 		kubeClientset = s.kubeclientset
 		namespace = a.Namespace
-		fieldSelector = fields.SelectorFromSet(map[string]string{
-			"involvedObject.name":      a.Name,
-			"involvedObject.uid":       string(a.UID),
-			"involvedObject.namespace": a.Namespace,
-		}).String()
+		fieldSelector = applicationEventsFieldSelector(a)
 	} else {
 		tree, err := s.getAppResources(ctx, a)
 		if err != nil {
@@ -910,6 +1978,7 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 		if err != nil {
 			return nil, fmt.Errorf("error creating kube client: %w", err)
 		}
+		external = true
 		fieldSelector = fields.SelectorFromSet(map[string]string{
 			"involvedObject.name":      q.GetResourceName(),
 			"involvedObject.uid":       q.GetResourceUID(),
@@ -917,7 +1986,11 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 		}).String()
 	}
 	log.Infof("Querying for resource events with field selector: %s", fieldSelector)
-	opts := metav1.ListOptions{FieldSelector: fieldSelector}
+	opts := metav1.ListOptions{FieldSelector: fieldSelector, Limit: q.GetLimit(), Continue: q.GetContinue()}
+	if external && externalEventsListTimeout > 0 {
+		involvedObject := corev1.ObjectReference{Name: q.GetResourceName(), Namespace: namespace, UID: types.UID(q.GetResourceUID())}
+		return listExternalResourceEvents(ctx, kubeClientset.CoreV1().Events(namespace), opts, involvedObject)
+	}
 	list, err := kubeClientset.CoreV1().Events(namespace).List(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error listing resource events: %w", err)
@@ -925,6 +1998,112 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 	return list.DeepCopy(), nil
 }
 
+// applicationEventsFieldSelector returns the field selector of the events of the Application object itself
+func applicationEventsFieldSelector(a *v1alpha1.Application) string {
+	return fields.SelectorFromSet(map[string]string{
+		"involvedObject.name":      a.Name,
+		"involvedObject.uid":       string(a.UID),
+		"involvedObject.namespace": a.Namespace,
+	}).String()
+}
+
+// WatchApplicationEvents streams the events of the Application object itself, such as the progress messages of an
+// operation, as they are emitted. The events which already exist are sent first. Events of the application resources
+// are not included, since they are emitted on the destination cluster.
+func (s *Server) WatchApplicationEvents(q *application.ApplicationObjectEventsQuery, ws application.ApplicationService_WatchApplicationEventsServer) error {
+	ctx := ws.Context()
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return err
+	}
+
+	eventsIf := s.kubeclientset.CoreV1().Events(a.Namespace)
+	opts := metav1.ListOptions{FieldSelector: applicationEventsFieldSelector(a)}
+	list, err := eventsIf.List(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("error listing application events: %w", err)
+	}
+	for i := range list.Items {
+		if err := ws.Send(&list.Items[i]); err != nil {
+			return fmt.Errorf("error sending application event: %w", err)
+		}
+	}
+
+	opts.ResourceVersion = list.ResourceVersion
+	w, err := eventsIf.Watch(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("error watching application events: %w", err)
+	}
+	defer w.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			switch e.Type {
+			case watch.Error:
+				return fmt.Errorf("error watching application events: %w", apierrors.FromObject(e.Object))
+			case watch.Added, watch.Modified:
+				event, ok := e.Object.(*corev1.Event)
+				if !ok {
+					continue
+				}
+				if err := ws.Send(event); err != nil {
+					return fmt.Errorf("error sending application event: %w", err)
+				}
+			}
+		}
+	}
+}
+
+// listExternalResourceEvents lists the events of a resource on an external cluster page by page. If the cluster does
+// not respond within externalEventsListTimeout, the events listed so far are returned together with a warning event
+// explaining that the list is incomplete, so that a slow cluster does not block the caller. If the caller pages
+// through the events itself by setting a limit, only the requested page is listed and its continue token is returned.
+func listExternalResourceEvents(ctx context.Context, eventsIf typedcorev1.EventInterface, opts metav1.ListOptions, involvedObject corev1.ObjectReference) (*corev1.EventList, error) {
+	listCtx, cancel := context.WithTimeout(ctx, externalEventsListTimeout)
+	defer cancel()
+
+	res := &corev1.EventList{}
+	paged := opts.Limit > 0
+	if !paged {
+		opts.Limit = externalEventsListPageSize
+	}
+	for {
+		page, err := eventsIf.List(listCtx, opts)
+		if err != nil {
+			if ctx.Err() == nil && errors.Is(listCtx.Err(), context.DeadlineExceeded) {
+				log.Warnf("Timed out listing events of %s/%s after %s", involvedObject.Namespace, involvedObject.Name, externalEventsListTimeout)
+				now := metav1.Now()
+				res.Items = append(res.Items, corev1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: involvedObject.Name + ".events-list-timeout", Namespace: involvedObject.Namespace},
+					InvolvedObject: involvedObject,
+					Type:           corev1.EventTypeWarning,
+					Reason:         "EventsListTimeout",
+					Message:        fmt.Sprintf("Listing events from the destination cluster timed out after %s, the list may be incomplete", externalEventsListTimeout),
+					FirstTimestamp: now,
+					LastTimestamp:  now,
+					Count:          1,
+				})
+				return res, nil
+			}
+			return nil, fmt.Errorf("error listing resource events: %w", err)
+		}
+		res.Items = append(res.Items, page.Items...)
+		if paged {
+			res.Continue = page.Continue
+			return res, nil
+		}
+		if page.Continue == "" {
+			return res, nil
+		}
+		opts.Continue = page.Continue
+	}
+}
+
 // validateAndUpdateApp validates and updates the application. currentProject is the name of the project the app
 // currently is under. If not specified, we assume that the app is under the project specified in the app spec.
 func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *v1alpha1.Application, merge bool, validate bool, action string, currentProject string) (*v1alpha1.Application, error) {
@@ -1030,6 +2209,54 @@ func (s *Server) Update(ctx context.Context, q *application.ApplicationUpdateReq
 	return s.validateAndUpdateApp(ctx, q.Application, false, validate, rbac.ActionUpdate, q.GetProject())
 }
 
+// PreviewUpdate validates and normalizes the proposed application like Update does and returns the application as it
+// would be after the update, together with a merge patch from the current application, without persisting anything.
+func (s *Server) PreviewUpdate(ctx context.Context, q *application.ApplicationUpdateRequest) (*application.ApplicationUpdatePreviewResponse, error) {
+	if q.GetApplication() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application is nil in request")
+	}
+	proposed := q.GetApplication().DeepCopy()
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, proposed.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	current, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionUpdate, q.GetProject(), proposed.Namespace, proposed.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	validate := true
+	if q.Validate != nil {
+		validate = *q.Validate
+	}
+	if err := s.validateAndNormalizeApp(ctx, proposed, proj, validate); err != nil {
+		return nil, fmt.Errorf("error validating and normalizing app: %w", err)
+	}
+
+	// apply the same fields updateApp does
+	updated := current.DeepCopy()
+	updated.Spec = proposed.Spec
+	updated.Labels = proposed.Labels
+	updated.Annotations = proposed.Annotations
+	updated.Finalizers = proposed.Finalizers
+
+	currentBytes, err := json.Marshal(current)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling current application: %w", err)
+	}
+	updatedBytes, err := json.Marshal(updated)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling updated application: %w", err)
+	}
+	patch, err := jsonpatch.CreateMergePatch(currentBytes, updatedBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error creating merge patch: %w", err)
+	}
+	return &application.ApplicationUpdatePreviewResponse{
+		Application: updated,
+		Patch:       ptr.To(string(patch)),
+	}, nil
+}
+
 // UpdateSpec updates an application spec and filters out any invalid parameter overrides
 func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdateSpecRequest) (*v1alpha1.ApplicationSpec, error) {
 	if q.GetSpec() == nil {
@@ -1041,6 +2268,13 @@ func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdat
 	}
 
 	a.Spec = *q.GetSpec()
+	jsonApp, err := json.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling application: %w", err)
+	}
+	if err := enforceApplicationSchema(jsonApp); err != nil {
+		return nil, err
+	}
 	validate := true
 	if q.Validate != nil {
 		validate = *q.Validate
@@ -1052,143 +2286,456 @@ func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdat
 	return &a.Spec, nil
 }
 
-// Patch patches an application
-func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchRequest) (*v1alpha1.Application, error) {
-	app, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+// UpdateSyncPolicy replaces the sync policy of an application using a merge patch limited to spec.syncPolicy,
+// so concurrent changes to other parts of the spec are not overwritten.
+func (s *Server) UpdateSyncPolicy(ctx context.Context, q *application.ApplicationSyncPolicyUpdateRequest) (*application.ApplicationSyncPolicyResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionUpdate, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
 	if err != nil {
 		return nil, err
 	}
-
-	err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, app.RBACName(s.ns))
-	if err != nil {
-		return nil, err
+	syncPolicy := q.GetSyncPolicy()
+	if syncPolicy != nil && syncPolicy.IsZero() {
+		syncPolicy = nil
+	}
+	if err := validateSyncPolicy(syncPolicy); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sync policy: %v", err)
 	}
 
-	jsonApp, err := json.Marshal(app)
+	newApp := a.DeepCopy()
+	newApp.Spec.SyncPolicy = syncPolicy
+	jsonApp, err := json.Marshal(newApp)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling application: %w", err)
 	}
+	if err := enforceApplicationSchema(jsonApp); err != nil {
+		return nil, err
+	}
+	validate := true
+	if q.Validate != nil {
+		validate = *q.Validate
+	}
 
-	var patchApp []byte
+	s.projectLock.RLock(newApp.Spec.GetProject())
+	defer s.projectLock.RUnlock(newApp.Spec.GetProject())
 
-	switch q.GetPatchType() {
-	case "json", "":
-		patch, err := jsonpatch.DecodePatch([]byte(q.GetPatch()))
+	if err := s.validateAndNormalizeApp(ctx, newApp, proj, validate); err != nil {
+		return nil, fmt.Errorf("error validating and normalizing app: %w", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		patch, err := syncPolicyPatch(a, newApp.Spec.SyncPolicy)
 		if err != nil {
-			return nil, fmt.Errorf("error decoding json patch: %w", err)
+			return nil, err
 		}
-		patchApp, err = patch.Apply(jsonApp)
-		if err != nil {
-			return nil, fmt.Errorf("error applying patch: %w", err)
+		if patch == nil {
+			return &application.ApplicationSyncPolicyResponse{SyncPolicy: a.Spec.SyncPolicy}, nil
 		}
-	case "merge":
-		patchApp, err = jsonpatch.MergePatch(jsonApp, []byte(q.GetPatch()))
+		res, err := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Patch(ctx, a.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err == nil {
+			s.logAppEvent(ctx, res, argo.EventReasonResourceUpdated, "updated application sync policy")
+			s.waitSync(res)
+			return &application.ApplicationSyncPolicyResponse{SyncPolicy: res.Spec.SyncPolicy}, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error patching application sync policy: %w", err)
+		}
+
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Get(ctx, a.Name, metav1.GetOptions{})
 		if err != nil {
-			return nil, fmt.Errorf("error calculating merge patch: %w", err)
+			return nil, fmt.Errorf("error getting application: %w", err)
 		}
-	default:
-		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Patch type '%s' is not supported", q.GetPatchType()))
 	}
+	return nil, status.Errorf(codes.Internal, "Failed to update application. Too many conflicts")
+}
 
-	newApp := &v1alpha1.Application{}
-	err = json.Unmarshal(patchApp, newApp)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling patched app: %w", err)
+// validateSyncPolicy checks the parts of a sync policy that the CRD schema does not validate.
+func validateSyncPolicy(syncPolicy *v1alpha1.SyncPolicy) error {
+	if syncPolicy == nil {
+		return nil
 	}
-	return s.validateAndUpdateApp(ctx, newApp, false, true, rbac.ActionUpdate, q.GetProject())
+	for _, option := range syncPolicy.SyncOptions {
+		if !strings.Contains(option, "=") {
+			return fmt.Errorf("sync option %q must be in the form key=value", option)
+		}
+	}
+	if syncPolicy.Retry != nil {
+		if backoff := syncPolicy.Retry.Backoff; backoff != nil && backoff.Factor != nil && *backoff.Factor < 1 {
+			return fmt.Errorf("retry backoff factor must be at least 1, got %d", *backoff.Factor)
+		}
+		if _, err := syncPolicy.Retry.NextRetryAt(time.Now(), 0); err != nil {
+			return fmt.Errorf("invalid retry backoff: %w", err)
+		}
+	}
+	return nil
 }
 
-func (s *Server) getAppProject(ctx context.Context, a *v1alpha1.Application, logCtx *log.Entry) (*v1alpha1.AppProject, error) {
-	proj, err := argo.GetAppProject(ctx, a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db)
-	if err == nil {
-		return proj, nil
+// syncPolicyPatch returns a merge patch turning the sync policy of the given application into the desired one,
+// guarded by the resource version of the application. It returns nil if the sync policy is unchanged.
+func syncPolicyPatch(a *v1alpha1.Application, desired *v1alpha1.SyncPolicy) ([]byte, error) {
+	current, err := json.Marshal(map[string]any{"spec": map[string]any{"syncPolicy": a.Spec.SyncPolicy}})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling current sync policy: %w", err)
 	}
-
-	// If there's a permission issue or the app doesn't exist, return a vague error to avoid letting the user enumerate project names.
-	vagueError := status.Errorf(codes.InvalidArgument, "app is not allowed in project %q, or the project does not exist", a.Spec.Project)
-
-	if apierrors.IsNotFound(err) {
-		return nil, vagueError
+	updated, err := json.Marshal(map[string]any{"spec": map[string]any{"syncPolicy": desired}})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling desired sync policy: %w", err)
 	}
-
-	var applicationNotAllowedToUseProjectErr *argo.ErrApplicationNotAllowedToUseProject
-	if errors.As(err, &applicationNotAllowedToUseProjectErr) {
-		return nil, vagueError
+	patch, err := jsonpatch.CreateMergePatch(current, updated)
+	if err != nil {
+		return nil, fmt.Errorf("error creating sync policy patch: %w", err)
 	}
-
-	// Unknown error, log it but return the vague error to the user
-	logCtx.WithFields(map[string]any{
-		"project":                a.Spec.Project,
-		argocommon.SecurityField: argocommon.SecurityMedium,
-	}).Warnf("error getting app project: %s", err)
-	return nil, vagueError
+	patchMap := map[string]any{}
+	if err := json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, fmt.Errorf("error unmarshaling sync policy patch: %w", err)
+	}
+	if len(patchMap) == 0 {
+		return nil, nil
+	}
+	patchMap["metadata"] = map[string]any{"resourceVersion": a.ResourceVersion}
+	return json.Marshal(patchMap)
 }
 
-// Delete removes an application and all associated resources
-func (s *Server) Delete(ctx context.Context, q *application.ApplicationDeleteRequest) (*application.ApplicationResponse, error) {
-	appName := q.GetName()
-	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
-	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), appNs, appName, "")
+// UpdateApplicationsMetadata merges the given labels and annotations into every application matching the selector.
+// Applications the caller cannot see are skipped, applications the caller cannot update are reported as failed.
+func (s *Server) UpdateApplicationsMetadata(ctx context.Context, q *application.ApplicationsMetadataUpdateRequest) (*application.ApplicationsMetadataUpdateResponse, error) {
+	if q.GetSelector() == "" {
+		return nil, status.Error(codes.InvalidArgument, "selector is required")
+	}
+	if len(q.Labels) == 0 && len(q.Annotations) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one label or annotation is required")
+	}
+	selector, err := labels.Parse(q.GetSelector())
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "error parsing the selector: %v", err)
 	}
-
-	s.projectLock.RLock(a.Spec.Project)
-	defer s.projectLock.RUnlock(a.Spec.Project)
-
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionDelete, a.RBACName(s.ns)); err != nil {
-		return nil, err
+	var apps []*v1alpha1.Application
+	if q.GetAppNamespace() == "" {
+		apps, err = s.appLister.List(selector)
+	} else {
+		apps, err = s.appLister.Applications(q.GetAppNamespace()).List(selector)
 	}
-
-	if q.Cascade != nil && !*q.Cascade && q.GetPropagationPolicy() != "" {
-		return nil, status.Error(codes.InvalidArgument, "cannot set propagation policy when cascading is disabled")
+	if err != nil {
+		return nil, fmt.Errorf("error listing apps with selectors: %w", err)
 	}
+	apps = argo.FilterByProjectsP(apps, q.GetProjects())
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].QualifiedName() < apps[j].QualifiedName()
+	})
 
-	patchFinalizer := false
-	if q.Cascade == nil || *q.Cascade {
-		// validate the propgation policy
-		policyFinalizer := getPropagationPolicyFinalizer(q.GetPropagationPolicy())
-		if policyFinalizer == "" {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid propagation policy: %s", *q.PropagationPolicy)
+	res := &application.ApplicationsMetadataUpdateResponse{}
+	for _, a := range apps {
+		if !s.isNamespaceEnabled(a.Namespace) {
+			continue
 		}
-		if !a.IsFinalizerPresent(policyFinalizer) {
-			a.SetCascadedDeletion(policyFinalizer)
-			patchFinalizer = true
+		if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns)) {
+			continue
 		}
-	} else if a.CascadedDeletion() {
-		a.UnSetCascadedDeletion()
-		patchFinalizer = true
+		result := &application.ApplicationMetadataUpdateResult{
+			Name:         ptr.To(a.Name),
+			AppNamespace: ptr.To(a.Namespace),
+			Updated:      ptr.To(false),
+		}
+		res.Results = append(res.Results, result)
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns)); err != nil {
+			result.Error = ptr.To(err.Error())
+			continue
+		}
+		if err := s.updateAppMetadata(ctx, a, q.Labels, q.Annotations); err != nil {
+			result.Error = ptr.To(err.Error())
+			continue
+		}
+		result.Updated = ptr.To(true)
 	}
+	return res, nil
+}
 
-	if patchFinalizer {
-		// Although the cascaded deletion/propagation policy finalizer is not set when apps are created via
-		// API, they will often be set by the user as part of declarative config. As part of a delete
-		// request, we always calculate the patch to see if we need to set/unset the finalizer.
-		patch, err := json.Marshal(map[string]any{
-			"metadata": map[string]any{
-				"finalizers": a.Finalizers,
-			},
-		})
+// BatchGetWithTrees returns the requested applications together with their cached resource trees. Every application is
+// checked separately, so that applications which cannot be returned are reported as failed without failing the others.
+func (s *Server) BatchGetWithTrees(ctx context.Context, q *application.ApplicationsWithTreesQuery) (*application.ApplicationsWithTreesResponse, error) {
+	if len(q.Applications) > maxBatchGetWithTreesApplications {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d applications can be requested at once", maxBatchGetWithTreesApplications)
+	}
+	res := &application.ApplicationsWithTreesResponse{}
+	for _, item := range q.Applications {
+		result := &application.ApplicationWithTree{
+			Name:         ptr.To(item.GetName()),
+			AppNamespace: ptr.To(item.GetAppNamespace()),
+		}
+		res.Items = append(res.Items, result)
+
+		a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, item.GetProject(), item.GetAppNamespace(), item.GetName())
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling finalizers: %w", err)
+			result.Error = ptr.To(err.Error())
+			continue
 		}
-		_, err = s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Patch(ctx, a.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		// the informer's copy must not be modified
+		a = a.DeepCopy()
+		s.inferResourcesStatusHealth(a)
+		result.Application = a
+
+		tree, err := s.getAppResources(ctx, a)
 		if err != nil {
-			return nil, fmt.Errorf("error patching application with finalizers: %w", err)
+			result.Error = ptr.To(err.Error())
+			continue
 		}
+		result.Tree = tree
 	}
+	return res, nil
+}
 
-	err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Delete(ctx, appName, metav1.DeleteOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("error deleting application: %w", err)
+func (s *Server) updateAppMetadata(ctx context.Context, a *v1alpha1.Application, appLabels, appAnnotations map[string]string) error {
+	s.projectLock.RLock(a.Spec.GetProject())
+	defer s.projectLock.RUnlock(a.Spec.GetProject())
+
+	// updateApp modifies the application it is given, the informer's copy must not be modified
+	a = a.DeepCopy()
+	newApp := a.DeepCopy()
+	newApp.Labels = appLabels
+	newApp.Annotations = appAnnotations
+	if _, err := s.updateApp(ctx, a, newApp, true); err != nil {
+		return fmt.Errorf("error updating application: %w", err)
 	}
-	s.logAppEvent(ctx, a, argo.EventReasonResourceDeleted, "deleted application")
-	return &application.ApplicationResponse{}, nil
+	return nil
 }
 
-func (s *Server) isApplicationPermitted(selector labels.Selector, minVersion int, claims any, appName, appNs string, projects map[string]bool, a v1alpha1.Application) bool {
-	if len(projects) > 0 && !projects[a.Spec.GetProject()] {
-		return false
+// Patch patches an application
+func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchRequest) (*v1alpha1.Application, error) {
+	app, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, app.RBACName(s.ns))
+	if err != nil {
+		return nil, err
+	}
+
+	patchApp, err := applyApplicationPatch(app, q.GetPatchType(), q.GetPatch())
+	if err != nil {
+		return nil, err
+	}
+	if err := enforceApplicationSchema(patchApp); err != nil {
+		return nil, err
+	}
+
+	newApp := &v1alpha1.Application{}
+	err = json.Unmarshal(patchApp, newApp)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling patched app: %w", err)
+	}
+	return s.validateAndUpdateApp(ctx, newApp, false, true, rbac.ActionUpdate, q.GetProject())
+}
+
+// ValidatePatch applies the patch to the application like Patch does and returns the fields of the resulting application
+// which do not match the Application CRD schema, without persisting anything
+func (s *Server) ValidatePatch(ctx context.Context, q *application.ApplicationPatchRequest) (*application.ApplicationSchemaValidationResponse, error) {
+	app, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, app.RBACName(s.ns))
+	if err != nil {
+		return nil, err
+	}
+
+	patchApp, err := applyApplicationPatch(app, q.GetPatchType(), q.GetPatch())
+	if err != nil {
+		return nil, err
+	}
+	fieldErrs, err := validateApplicationSchema(patchApp)
+	if err != nil {
+		return nil, fmt.Errorf("error validating application schema: %w", err)
+	}
+	res := &application.ApplicationSchemaValidationResponse{}
+	for _, fieldErr := range fieldErrs {
+		res.Errors = append(res.Errors, &application.ApplicationSchemaFieldError{
+			Field:  ptr.To(fieldErr.Field),
+			Type:   ptr.To(fieldErr.Type.String()),
+			Detail: ptr.To(fieldErr.ErrorBody()),
+		})
+	}
+	return res, nil
+}
+
+// DryRunPatch applies the patch to the application like Patch does and returns the normalized result, along with the
+// findings which would make Patch reject it, without persisting anything. Since nothing is written, only get
+// privileges are required.
+func (s *Server) DryRunPatch(ctx context.Context, q *application.ApplicationPatchRequest) (*application.ApplicationDryRunPatchResponse, error) {
+	app, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	patchApp, err := applyApplicationPatch(app, q.GetPatchType(), q.GetPatch())
+	if err != nil {
+		return nil, err
+	}
+	fieldErrs, err := validateApplicationSchema(patchApp)
+	if err != nil {
+		return nil, fmt.Errorf("error validating application schema: %w", err)
+	}
+	newApp := &v1alpha1.Application{}
+	err = json.Unmarshal(patchApp, newApp)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error unmarshaling patched app: %v", err)
+	}
+
+	res := &application.ApplicationDryRunPatchResponse{Application: newApp}
+	invalidSpec := func(message string) {
+		res.Conditions = append(res.Conditions, &v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionInvalidSpecError, Message: message})
+	}
+	for _, fieldErr := range fieldErrs {
+		invalidSpec(fieldErr.Error())
+	}
+	if newApp.Spec.GetProject() != app.Spec.GetProject() {
+		if proj, err = s.getAppProject(ctx, newApp, log.WithFields(applog.GetAppLogFields(newApp))); err != nil {
+			invalidSpec(status.Convert(err).Message())
+		}
+	}
+	if _, err := argo.GetDestinationCluster(ctx, newApp.Spec.Destination, s.db); err != nil {
+		invalidSpec(fmt.Sprintf("application destination spec for %s is invalid: %s", newApp.Name, err.Error()))
+	}
+	if len(res.Conditions) == 0 {
+		conditions, err := argo.ValidateRepo(ctx, newApp, s.repoClientset, s.db, s.kubectl, proj, s.settingsMgr)
+		if err != nil {
+			return nil, fmt.Errorf("error validating the repo: %w", err)
+		}
+		permissionConditions, err := argo.ValidatePermissions(ctx, &newApp.Spec, proj, s.db)
+		if err != nil {
+			return nil, fmt.Errorf("error validating project permissions: %w", err)
+		}
+		for _, condition := range append(conditions, permissionConditions...) {
+			res.Conditions = append(res.Conditions, &condition)
+		}
+	}
+
+	newApp.Spec = *argo.NormalizeApplicationSpec(&newApp.Spec)
+	return res, nil
+}
+
+// applyApplicationPatch applies a json or merge patch to the application and returns the patched application as JSON
+func applyApplicationPatch(app *v1alpha1.Application, patchType string, patch string) ([]byte, error) {
+	jsonApp, err := json.Marshal(app)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling application: %w", err)
+	}
+
+	var patchApp []byte
+
+	switch patchType {
+	case "json", "":
+		jsonPatch, err := jsonpatch.DecodePatch([]byte(patch))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding json patch: %w", err)
+		}
+		patchApp, err = jsonPatch.Apply(jsonApp)
+		if err != nil {
+			return nil, fmt.Errorf("error applying patch: %w", err)
+		}
+	case "merge":
+		patchApp, err = jsonpatch.MergePatch(jsonApp, []byte(patch))
+		if err != nil {
+			return nil, fmt.Errorf("error calculating merge patch: %w", err)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Patch type '%s' is not supported", patchType))
+	}
+
+	return patchApp, nil
+}
+
+func (s *Server) getAppProject(ctx context.Context, a *v1alpha1.Application, logCtx *log.Entry) (*v1alpha1.AppProject, error) {
+	proj, err := argo.GetAppProject(ctx, a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db)
+	if err == nil {
+		return proj, nil
+	}
+
+	// If there's a permission issue or the app doesn't exist, return a vague error to avoid letting the user enumerate project names.
+	vagueError := status.Errorf(codes.InvalidArgument, "app is not allowed in project %q, or the project does not exist", a.Spec.Project)
+
+	if apierrors.IsNotFound(err) {
+		return nil, vagueError
+	}
+
+	var applicationNotAllowedToUseProjectErr *argo.ErrApplicationNotAllowedToUseProject
+	if errors.As(err, &applicationNotAllowedToUseProjectErr) {
+		return nil, vagueError
+	}
+
+	// Unknown error, log it but return the vague error to the user
+	logCtx.WithFields(map[string]any{
+		"project":                a.Spec.Project,
+		argocommon.SecurityField: argocommon.SecurityMedium,
+	}).Warnf("error getting app project: %s", err)
+	return nil, vagueError
+}
+
+// Delete removes an application and all associated resources
+func (s *Server) Delete(ctx context.Context, q *application.ApplicationDeleteRequest) (*application.ApplicationResponse, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	s.projectLock.RLock(a.Spec.Project)
+	defer s.projectLock.RUnlock(a.Spec.Project)
+
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionDelete, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	if q.Cascade != nil && !*q.Cascade && q.GetPropagationPolicy() != "" {
+		return nil, status.Error(codes.InvalidArgument, "cannot set propagation policy when cascading is disabled")
+	}
+
+	patchFinalizer := false
+	if q.Cascade == nil || *q.Cascade {
+		// validate the propgation policy
+		policyFinalizer := getPropagationPolicyFinalizer(q.GetPropagationPolicy())
+		if policyFinalizer == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid propagation policy: %s", *q.PropagationPolicy)
+		}
+		if !a.IsFinalizerPresent(policyFinalizer) {
+			a.SetCascadedDeletion(policyFinalizer)
+			patchFinalizer = true
+		}
+	} else if a.CascadedDeletion() {
+		a.UnSetCascadedDeletion()
+		patchFinalizer = true
+	}
+
+	if patchFinalizer {
+		// Although the cascaded deletion/propagation policy finalizer is not set when apps are created via
+		// API, they will often be set by the user as part of declarative config. As part of a delete
+		// request, we always calculate the patch to see if we need to set/unset the finalizer.
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{
+				"finalizers": a.Finalizers,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling finalizers: %w", err)
+		}
+		_, err = s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Patch(ctx, a.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error patching application with finalizers: %w", err)
+		}
+	}
+
+	err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Delete(ctx, appName, metav1.DeleteOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error deleting application: %w", err)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonResourceDeleted, "deleted application")
+	return &application.ApplicationResponse{}, nil
+}
+
+func (s *Server) isApplicationPermitted(selector labels.Selector, minVersion int, claims any, appName, appNs string, projects map[string]bool, a v1alpha1.Application) bool {
+	if len(projects) > 0 && !projects[a.Spec.GetProject()] {
+		return false
 	}
 
 	if appVersion, err := strconv.Atoi(a.ResourceVersion); err == nil && appVersion < minVersion {
@@ -1233,12 +2780,29 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 			minVersion = 0
 		}
 	}
+	if q.GetDebounceMilliseconds() < 0 {
+		return status.Errorf(codes.InvalidArgument, "debounce must not be negative")
+	}
+	debounce := time.Duration(q.GetDebounceMilliseconds()) * time.Millisecond
+	matchName, err := appNameMatcher(q)
+	if err != nil {
+		return err
+	}
 
+	isPermitted := func(a v1alpha1.Application) bool {
+		if matchName == nil {
+			return s.isApplicationPermitted(selector, minVersion, claims, appName, appNs, projects, a)
+		}
+		// names matched by prefix or regex are only restricted to a namespace if one was requested, like in List
+		if !matchName(a.Name) || (q.GetAppNamespace() != "" && a.Namespace != appNs) {
+			return false
+		}
+		return s.isApplicationPermitted(selector, minVersion, claims, "", appNs, projects, a)
+	}
 	// sendIfPermitted is a helper to send the application to the client's streaming channel if the
 	// caller has RBAC privileges permissions to view it
 	sendIfPermitted := func(a v1alpha1.Application, eventType watch.EventType) {
-		permitted := s.isApplicationPermitted(selector, minVersion, claims, appName, appNs, projects, a)
-		if !permitted {
+		if !isPermitted(a) {
 			return
 		}
 		s.inferResourcesStatusHealth(&a)
@@ -1271,19 +2835,99 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 	}
 	unsubscribe := s.appBroadcaster.Subscribe(events)
 	defer unsubscribe()
+
+	// with a debounce, events are buffered per application and only the latest one is sent when the window elapses
+	var flush <-chan time.Time
+	pending := make(map[string]*v1alpha1.ApplicationWatchEvent)
+	addPending := func(event *v1alpha1.ApplicationWatchEvent) {
+		if isPermitted(event.Application) {
+			pending[event.Application.QualifiedName()] = event
+		}
+	}
+	if debounce > 0 {
+		var stop func()
+		flush, stop = newWatchDebounceTicker(debounce)
+		defer stop()
+	}
 	for {
 		select {
 		case event := <-events:
-			sendIfPermitted(event.Application, event.Type)
+			if flush == nil {
+				sendIfPermitted(event.Application, event.Type)
+			} else {
+				addPending(event)
+			}
+		case <-flush:
+			// the events queued in the meantime are taken into account, so that the latest state is sent
+			for range len(events) {
+				addPending(<-events)
+			}
+			keys := make([]string, 0, len(pending))
+			for key := range pending {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				sendIfPermitted(pending[key].Application, pending[key].Type)
+			}
+			clear(pending)
 		case <-ws.Context().Done():
 			return nil
 		}
 	}
 }
 
+// newWatchDebounceTicker returns the channel on which Watch sends the debounced events, and a function to stop it
+var newWatchDebounceTicker = func(debounce time.Duration) (<-chan time.Time, func()) {
+	ticker := time.NewTicker(debounce)
+	return ticker.C, ticker.Stop
+}
+
+// parseApplicationTTL parses the value of the TTL annotation of an application, which must be a positive duration
+func parseApplicationTTL(value string) (time.Duration, error) {
+	ttl, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("ttl must be positive, got %s", value)
+	}
+	return ttl, nil
+}
+
+// GetTTL returns the TTL of an application set with the TTL annotation, and the time remaining until it expires
+func (s *Server) GetTTL(ctx context.Context, q *application.ApplicationTTLQuery) (*application.ApplicationTTLResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &application.ApplicationTTLResponse{}
+	value, ok := a.Annotations[argocommon.AnnotationKeyAppTTL]
+	if !ok {
+		return res, nil
+	}
+	ttl, err := parseApplicationTTL(value)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application %s has an invalid %s annotation: %v", a.Name, argocommon.AnnotationKeyAppTTL, err)
+	}
+	expiresAt := metav1.NewTime(a.CreationTimestamp.Add(ttl))
+	res.Ttl = ptr.To(ttl.String())
+	res.ExpiresAt = &expiresAt
+	res.RemainingSeconds = ptr.To(int64(max(time.Until(expiresAt.Time), 0).Seconds()))
+	return res, nil
+}
+
 func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Application, proj *v1alpha1.AppProject, validate bool) error {
+	_, err := s.validateAndNormalizeAppWithWarnings(ctx, app, proj, validate, true)
+	return err
+}
+
+// validateAndNormalizeAppWithWarnings validates and normalizes the application like validateAndNormalizeApp. Unless
+// strict is set, validation conditions which are not errors don't fail the validation and are returned as warnings.
+func (s *Server) validateAndNormalizeAppWithWarnings(ctx context.Context, app *v1alpha1.Application, proj *v1alpha1.AppProject, validate bool, strict bool) ([]v1alpha1.ApplicationCondition, error) {
 	if app.GetName() == "" {
-		return errors.New("resource name may not be empty")
+		return nil, errors.New("resource name may not be empty")
 	}
 
 	// ensure sources names are unique
@@ -1291,7 +2935,7 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		sourceNames := make(map[string]bool)
 		for _, source := range app.Spec.Sources {
 			if source.Name != "" && sourceNames[source.Name] {
-				return fmt.Errorf("application %s has duplicate source name: %s", app.Name, source.Name)
+				return nil, fmt.Errorf("application %s has duplicate source name: %s", app.Name, source.Name)
 			}
 			sourceNames[source.Name] = true
 		}
@@ -1301,7 +2945,7 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 	currApp, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, app.Name, metav1.GetOptions{})
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
-			return fmt.Errorf("error getting application by name: %w", err)
+			return nil, fmt.Errorf("error getting application by name: %w", err)
 		}
 		// Kubernetes go-client will return a pointer to a zero-value app instead of nil, even
 		// though the API response was NotFound. This behavior was confirmed via logs.
@@ -1311,61 +2955,224 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		// When changing projects, caller must have application create & update privileges in new project
 		// NOTE: the update check was already verified in the caller to this function
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, app.RBACName(s.ns)); err != nil {
-			return err
+			return nil, err
 		}
 		// They also need 'update' privileges in the old project
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, currApp.RBACName(s.ns)); err != nil {
-			return err
+			return nil, err
 		}
 		// Validate that the new project exists and the application is allowed to use it
 		newProj, err := s.getAppProject(ctx, app, log.WithFields(applog.GetAppLogFields(app)))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		proj = newProj
 	}
 
+	requiredLabels, err := s.settingsMgr.GetRequiredApplicationLabels(proj.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting required application labels: %w", err)
+	}
+	var missingLabels []string
+	for _, key := range requiredLabels {
+		if app.Labels[key] == "" {
+			missingLabels = append(missingLabels, key)
+		}
+	}
+	if len(missingLabels) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "application %s is missing labels required in project '%s': %s", app.Name, proj.Name, strings.Join(missingLabels, ", "))
+	}
+
+	if value, ok := app.Annotations[argocommon.AnnotationKeyAppTTL]; ok {
+		ttl, err := parseApplicationTTL(value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "application %s has an invalid %s annotation: %v", app.Name, argocommon.AnnotationKeyAppTTL, err)
+		}
+		app.Annotations[argocommon.AnnotationKeyAppTTL] = ttl.String()
+	}
+
 	if _, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db); err != nil {
-		return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
+		return nil, status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
 	}
 
-	var conditions []v1alpha1.ApplicationCondition
+	var warnings []v1alpha1.ApplicationCondition
 
 	if validate {
-		conditions := make([]v1alpha1.ApplicationCondition, 0)
-		condition, err := argo.ValidateRepo(ctx, app, s.repoClientset, s.db, s.kubectl, proj, s.settingsMgr)
+		conditions, err := argo.ValidateRepo(ctx, app, s.repoClientset, s.db, s.kubectl, proj, s.settingsMgr)
 		if err != nil {
-			return fmt.Errorf("error validating the repo: %w", err)
+			return nil, fmt.Errorf("error validating the repo: %w", err)
 		}
-		conditions = append(conditions, condition...)
-		if len(conditions) > 0 {
-			return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
+		failures, conditionWarnings := splitValidationConditions(conditions, strict)
+		if len(failures) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(failures))
 		}
+		warnings = append(warnings, conditionWarnings...)
 	}
 
-	conditions, err = argo.ValidatePermissions(ctx, &app.Spec, proj, s.db)
+	conditions, err := argo.ValidatePermissions(ctx, &app.Spec, proj, s.db)
 	if err != nil {
-		return fmt.Errorf("error validating project permissions: %w", err)
+		return nil, fmt.Errorf("error validating project permissions: %w", err)
+	}
+	failures, conditionWarnings := splitValidationConditions(conditions, strict)
+	if len(failures) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(failures))
 	}
-	if len(conditions) > 0 {
-		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
+	warnings = append(warnings, conditionWarnings...)
+
+	// the source is silently ignored if there are multiple sources. This is only a warning without strict validation,
+	// since strict validation has always accepted such applications.
+	if !strict && app.Spec.HasMultipleSources() && !app.Spec.Source.IsZero() {
+		warnings = append(warnings, v1alpha1.ApplicationCondition{
+			Type:    v1alpha1.ApplicationConditionIgnoredSourceWarning,
+			Message: "spec.source is ignored since spec.sources is set",
+		})
 	}
 
 	app.Spec = *argo.NormalizeApplicationSpec(&app.Spec)
-	return nil
+	return warnings, nil
 }
 
-func (s *Server) getApplicationClusterConfig(ctx context.Context, a *v1alpha1.Application) (*rest.Config, error) {
-	cluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
-	if err != nil {
-		return nil, fmt.Errorf("error validating destination: %w", err)
+// splitValidationConditions returns the validation conditions which fail the validation and the ones which are only
+// warnings. With strict validation, every condition fails the validation.
+func splitValidationConditions(conditions []v1alpha1.ApplicationCondition, strict bool) ([]v1alpha1.ApplicationCondition, []v1alpha1.ApplicationCondition) {
+	if strict {
+		return conditions, nil
 	}
-	config, err := cluster.RESTConfig()
-	if err != nil {
-		return nil, fmt.Errorf("error getting cluster REST config: %w", err)
+	var failures, warnings []v1alpha1.ApplicationCondition
+	for i := range conditions {
+		if conditions[i].IsError() {
+			failures = append(failures, conditions[i])
+		} else {
+			warnings = append(warnings, conditions[i])
+		}
 	}
-
-	return config, err
+	return failures, warnings
+}
+
+// PreviewProjectChange validates the application's current spec against another project without modifying the
+// application. Like validateAndNormalizeApp, it requires update privileges in the current project and create privileges
+// in the target project.
+func (s *Server) PreviewProjectChange(ctx context.Context, q *application.ApplicationProjectChangePreviewRequest) (*application.ApplicationProjectChangePreviewResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionUpdate, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	newApp := a.DeepCopy()
+	newApp.Spec.Project = q.GetTargetProject()
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, newApp.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	targetProj, err := s.getAppProject(ctx, newApp, log.WithFields(applog.GetAppLogFields(newApp)))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &application.ApplicationProjectChangePreviewResponse{}
+	var sources []v1alpha1.ApplicationSource
+	if newApp.Spec.SourceHydrator != nil {
+		sources = append(sources, newApp.Spec.SourceHydrator.GetDrySource())
+	} else {
+		sources = newApp.Spec.GetSources()
+	}
+	for _, source := range sources {
+		permitted, err := argo.GetPermittedRepos(targetProj, []*v1alpha1.Repository{{Repo: source.RepoURL}})
+		if err != nil {
+			return nil, fmt.Errorf("error getting permitted repos: %w", err)
+		}
+		if len(permitted) == 0 {
+			res.RejectedSources = append(res.RejectedSources, source.RepoURL)
+		}
+	}
+
+	conditions, err := argo.ValidatePermissions(ctx, &newApp.Spec, targetProj, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error validating project permissions: %w", err)
+	}
+	for i := range conditions {
+		res.Conditions = append(res.Conditions, &conditions[i])
+	}
+	res.Permitted = ptr.To(len(res.RejectedSources) == 0 && len(conditions) == 0)
+	return res, nil
+}
+
+// ListExcludedResources returns the resource exclusions and inclusions which apply to the destination cluster of the
+// application, and optionally the resources of its target manifests which they exclude. Excluded resources are not
+// watched, so they never show up in the resource tree.
+func (s *Server) ListExcludedResources(ctx context.Context, q *application.ApplicationExcludedResourcesQuery) (*application.ApplicationExcludedResourcesResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error validating destination: %w", err)
+	}
+	resourcesFilter, err := s.settingsMgr.GetResourcesFilter()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources filter: %w", err)
+	}
+
+	exclusions, inclusions := resourcesFilter.ClusterFilters(cluster.Server)
+	res := &application.ApplicationExcludedResourcesResponse{
+		Cluster:    ptr.To(cluster.Server),
+		Exclusions: toResourceFilterRules(exclusions),
+		Inclusions: toResourceFilterRules(inclusions),
+	}
+	if !q.GetCheckManifests() {
+		return res, nil
+	}
+
+	manifests, err := s.GetManifests(ctx, &application.ApplicationManifestQuery{
+		Name:         q.Name,
+		AppNamespace: q.AppNamespace,
+		Project:      q.Project,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting application manifests: %w", err)
+	}
+	for _, manifest := range manifests.Manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+		}
+		gvk := obj.GroupVersionKind()
+		if resourcesFilter.IsExcludedResource(gvk.Group, gvk.Kind, cluster.Server) {
+			res.ExcludedManifests = append(res.ExcludedManifests, &v1alpha1.ResourceRef{
+				Group:     gvk.Group,
+				Version:   gvk.Version,
+				Kind:      gvk.Kind,
+				Namespace: obj.GetNamespace(),
+				Name:      obj.GetName(),
+			})
+		}
+	}
+	return res, nil
+}
+
+func toResourceFilterRules(filteredResources []settings.FilteredResource) []*application.ResourceFilterRule {
+	var rules []*application.ResourceFilterRule
+	for _, filteredResource := range filteredResources {
+		rules = append(rules, &application.ResourceFilterRule{
+			ApiGroups: filteredResource.APIGroups,
+			Kinds:     filteredResource.Kinds,
+			Clusters:  filteredResource.Clusters,
+		})
+	}
+	return rules
+}
+
+func (s *Server) getApplicationClusterConfig(ctx context.Context, a *v1alpha1.Application) (*rest.Config, error) {
+	cluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error validating destination: %w", err)
+	}
+	config, err := cluster.RESTConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster REST config: %w", err)
+	}
+
+	return config, err
 }
 
 // getCachedAppState loads the cached state and trigger app refresh if cache is missing
@@ -1466,1301 +3273,5365 @@ func (s *Server) GetResource(ctx context.Context, q *application.ApplicationReso
 	return &application.ApplicationResourceResponse{Manifest: &manifest}, nil
 }
 
-func (s *Server) replaceSecretValues(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
-	if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-		_, obj, err := diff.HideSecretData(nil, obj, s.settingsMgr.GetSensitiveAnnotations())
-		if err != nil {
-			return nil, err
-		}
-		return obj, err
-	}
-	return obj, nil
-}
-
-// PatchResource patches a resource
-func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationResourcePatchRequest) (*application.ApplicationResourceResponse, error) {
-	resourceRequest := &application.ApplicationResourceRequest{
-		Name:         q.Name,
-		AppNamespace: q.AppNamespace,
-		Namespace:    q.Namespace,
-		ResourceName: q.ResourceName,
-		Kind:         q.Kind,
-		Version:      q.Version,
-		Group:        q.Group,
-		Project:      q.Project,
-	}
-	res, config, a, err := s.getAppLiveResource(ctx, rbac.ActionUpdate, resourceRequest)
+// RefreshResource re-reads a single resource from the cluster and returns its node with the current version and health,
+// so that a change made outside of Argo CD is visible without waiting for the application to be reconciled. The resource
+// tree is owned by the controller, so instead of updating it the application is annotated to be refreshed, which makes
+// the controller update the tree.
+func (s *Server) RefreshResource(ctx context.Context, q *application.ApplicationResourceRequest) (*v1alpha1.ResourceNode, error) {
+	res, config, a, err := s.getAppLiveResource(ctx, rbac.ActionSync, q)
 	if err != nil {
 		return nil, err
 	}
-
-	manifest, err := s.kubectl.PatchResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace, types.PatchType(q.GetPatchType()), []byte(q.GetPatch()))
+	if q.GetVersion() != "" {
+		res.Version = q.GetVersion()
+	}
+	obj, err := s.kubectl.GetResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace)
 	if err != nil {
-		// don't expose real error for secrets since it might contain secret data
-		if res.Kind == kube.SecretKind && res.Group == "" {
-			return nil, fmt.Errorf("failed to patch Secret %s/%s", res.Namespace, res.Name)
-		}
-		return nil, fmt.Errorf("error patching resource: %w", err)
+		return nil, fmt.Errorf("error getting resource: %w", err)
 	}
-	if manifest == nil {
-		return nil, errors.New("failed to patch resource: manifest was nil")
+	if obj == nil {
+		return nil, status.Errorf(codes.NotFound, "%s %s not found", res.Kind, res.Name)
 	}
-	manifest, err = s.replaceSecretValues(manifest)
+
+	overrides, err := s.settingsMgr.GetResourceOverrides()
 	if err != nil {
-		return nil, fmt.Errorf("error replacing secret values: %w", err)
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
 	}
-	data, err := json.Marshal(manifest.Object)
+	resHealth, err := health.GetResourceHealth(obj, lua.ResourceHealthOverrides(overrides))
 	if err != nil {
-		return nil, fmt.Errorf("erro marshaling manifest object: %w", err)
+		return nil, fmt.Errorf("error getting resource health: %w", err)
+	}
+	res.ResourceVersion = obj.GetResourceVersion()
+	res.Health = nil
+	if resHealth != nil {
+		res.Health = &v1alpha1.HealthStatus{Status: resHealth.Status, Message: resHealth.Message}
 	}
-	s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("patched resource %s/%s '%s'", q.GetGroup(), q.GetKind(), q.GetResourceName()))
-	m := string(data)
-	return &application.ApplicationResourceResponse{
-		Manifest: &m,
-	}, nil
-}
 
-// DeleteResource deletes a specified resource
-func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationResourceDeleteRequest) (*application.ApplicationResponse, error) {
-	resourceRequest := &application.ApplicationResourceRequest{
-		Name:         q.Name,
-		AppNamespace: q.AppNamespace,
-		Namespace:    q.Namespace,
-		ResourceName: q.ResourceName,
-		Kind:         q.Kind,
-		Version:      q.Version,
-		Group:        q.Group,
-		Project:      q.Project,
+	if _, err := argo.RefreshApp(s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace), a.Name, v1alpha1.RefreshTypeNormal, false); err != nil {
+		return nil, fmt.Errorf("error refreshing the app: %w", err)
 	}
-	res, config, a, err := s.getAppLiveResource(ctx, rbac.ActionDelete, resourceRequest)
+	return res, nil
+}
+
+// lastAppliedConfigSourceAnnotation is the source of last applied configurations read from the
+// last-applied-configuration annotation
+const lastAppliedConfigSourceAnnotation = "annotation"
+
+// GetLastAppliedConfig returns the configuration Argo CD last applied to a live resource, which is read from the
+// last-applied-configuration annotation written by client-side apply. Resources synced with server-side apply have no
+// such annotation, their managed fields only record which fields Argo CD owns but not their values, so NotFound is
+// returned for them. Secret data is redacted before it is returned.
+func (s *Server) GetLastAppliedConfig(ctx context.Context, q *application.ApplicationResourceRequest) (*application.LastAppliedConfigResponse, error) {
+	res, config, _, err := s.getAppLiveResource(ctx, rbac.ActionGet, q)
 	if err != nil {
 		return nil, err
 	}
-	var deleteOption metav1.DeleteOptions
-	switch {
-	case q.GetOrphan():
-		propagationPolicy := metav1.DeletePropagationOrphan
-		deleteOption = metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
-	case q.GetForce():
-		propagationPolicy := metav1.DeletePropagationBackground
-		zeroGracePeriod := int64(0)
-		deleteOption = metav1.DeleteOptions{PropagationPolicy: &propagationPolicy, GracePeriodSeconds: &zeroGracePeriod}
-	default:
-		propagationPolicy := metav1.DeletePropagationForeground
-		deleteOption = metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
+	if q.GetVersion() != "" {
+		res.Version = q.GetVersion()
 	}
-	err = s.kubectl.DeleteResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace, deleteOption)
+	obj, err := s.kubectl.GetResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace)
 	if err != nil {
-		return nil, fmt.Errorf("error deleting resource: %w", err)
+		return nil, fmt.Errorf("error getting resource: %w", err)
 	}
-	s.logAppEvent(ctx, a, argo.EventReasonResourceDeleted, fmt.Sprintf("deleted resource %s/%s '%s'", q.GetGroup(), q.GetKind(), q.GetResourceName()))
-	return &application.ApplicationResponse{}, nil
-}
-
-func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
-	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if obj == nil {
+		return nil, status.Errorf(codes.NotFound, "%s %s not found", res.Kind, res.Name)
+	}
+	obj, err = s.replaceSecretValues(obj)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error replacing secret values: %w", err)
 	}
 
-	return s.getAppResources(ctx, a)
-}
-
-func (s *Server) WatchResourceTree(q *application.ResourcesQuery, ws application.ApplicationService_WatchResourceTreeServer) error {
-	_, _, err := s.getApplicationEnforceRBACInformer(ws.Context(), rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	trackingMethod, err := s.settingsMgr.GetTrackingMethod()
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("error getting trackingMethod from settings: %w", err)
 	}
+	resp := &application.LastAppliedConfigResponse{TrackingMethod: ptr.To(trackingMethod)}
 
-	cacheKey := argo.AppInstanceName(q.GetApplicationName(), q.GetAppNamespace(), s.ns)
-	return s.cache.OnAppResourcesTreeChanged(ws.Context(), cacheKey, func() error {
-		var tree v1alpha1.ApplicationTree
-		err := s.cache.GetAppResourcesTree(cacheKey, &tree)
+	var trackingID string
+	if argo.IsOldTrackingMethod(trackingMethod) {
+		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
 		if err != nil {
-			return fmt.Errorf("error getting app resource tree: %w", err)
+			return nil, fmt.Errorf("error getting app instance label key from settings: %w", err)
 		}
-		return ws.Send(&tree)
-	})
+		trackingID = obj.GetLabels()[appInstanceLabelKey]
+	} else {
+		trackingID = obj.GetAnnotations()[argocommon.AnnotationKeyAppInstance]
+	}
+	if trackingID != "" {
+		resp.TrackingId = ptr.To(trackingID)
+	}
+
+	lastApplied, ok := obj.GetAnnotations()[corev1.LastAppliedConfigAnnotation]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s %s has no %s annotation. Resources synced with server-side apply don't record their last applied configuration, their managed fields only list the fields owned by %s", res.Kind, res.Name, corev1.LastAppliedConfigAnnotation, argocommon.ArgoCDSSAManager)
+	}
+	resp.LastAppliedConfig = ptr.To(lastApplied)
+	resp.Source = ptr.To(lastAppliedConfigSourceAnnotation)
+	return resp, nil
 }
 
-func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error) {
-	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+const (
+	// manualEditManagerPrefix is the prefix of the field managers of kubectl commands, such as kubectl-edit and
+	// kubectl-patch, which are the usual way to edit resources manually
+	manualEditManagerPrefix = "kubectl"
+	// clientSideApplyManager is the field manager of client-side apply, used by Argo CD syncs as well as kubectl apply
+	clientSideApplyManager = "kubectl-client-side-apply"
+)
+
+// ListManualEdits returns the resources of the application which were likely edited manually. It inspects the managed
+// fields of the live resources for entries of kubectl field managers other than the ones of Argo CD itself. Since
+// syncs using client-side apply share their field manager with kubectl apply, entries of that manager are only
+// reported for resources synced with server-side apply. Changes to subresources, such as the status updated by
+// controllers or the replicas scaled by an autoscaler, are ignored.
+func (s *Server) ListManualEdits(ctx context.Context, q *application.ResourcesQuery) (*application.ApplicationManualEditsResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
 	if err != nil {
 		return nil, err
 	}
-
-	source, err := getAppSourceBySourceIndexAndVersionId(a, q.SourceIndex, q.VersionId)
+	tree, err := s.getAppResources(ctx, a)
 	if err != nil {
-		return nil, fmt.Errorf("error getting app source by source index and version ID: %w", err)
+		return nil, fmt.Errorf("error getting app resources: %w", err)
 	}
-
-	repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+	config, err := s.getApplicationClusterConfig(ctx, a)
 	if err != nil {
-		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
 	}
-	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
-	if err != nil {
-		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	appServerSideApply := a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.SyncOptions.HasOption(common.SyncOptionServerSideApply)
+
+	res := &application.ApplicationManualEditsResponse{}
+	for _, node := range tree.Nodes {
+		// only resources managed by the application, not the ones created by controllers, are reverted by a sync
+		if len(node.ParentRefs) > 0 || node.UID == "" || !isMatchingResource(q, kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)) {
+			continue
+		}
+		ref := node.ResourceRef
+		obj, err := s.kubectl.GetResource(ctx, config, node.GroupKindVersion(), node.Name, node.Namespace)
+		if err == nil && obj == nil {
+			err = errors.New("resource not found")
+		}
+		if err != nil {
+			res.Items = append(res.Items, &application.ResourceManualEdits{Resource: &ref, Error: ptr.To(err.Error())})
+			continue
+		}
+		serverSideApply := appServerSideApply
+		if resourceSetsSyncOption(obj, "ServerSideApply") {
+			serverSideApply = resourceutil.HasAnnotationOption(obj, common.AnnotationSyncOptions, common.SyncOptionServerSideApply)
+		}
+		if edits := manualEdits(obj.GetManagedFields(), serverSideApply); len(edits) > 0 {
+			res.Items = append(res.Items, &application.ResourceManualEdits{Resource: &ref, Edits: edits})
+		}
 	}
-	defer utilio.Close(conn)
-	return repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
-		Repo:           repo,
-		Revision:       q.GetRevision(),
-		CheckSignature: len(proj.Spec.SignatureKeys) > 0,
-	})
+	return res, nil
 }
 
-// RevisionChartDetails returns the helm chart metadata, as fetched from the reposerver
-func (s *Server) RevisionChartDetails(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.ChartDetails, error) {
-	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
-	if err != nil {
-		return nil, err
+// manualEdits returns the managed fields entries of kubectl field managers which are not the ones of Argo CD syncs
+func manualEdits(entries []metav1.ManagedFieldsEntry, serverSideApply bool) []*application.ManualEdit {
+	var res []*application.ManualEdit
+	for _, entry := range entries {
+		if entry.Subresource != "" || !strings.HasPrefix(entry.Manager, manualEditManagerPrefix) {
+			continue
+		}
+		if entry.Manager == clientSideApplyManager && !serverSideApply {
+			continue
+		}
+		fieldCount := int64(0)
+		if entry.FieldsV1 != nil {
+			var fields map[string]any
+			if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err == nil {
+				fieldCount = countManagedFields(fields)
+			}
+		}
+		res = append(res, &application.ManualEdit{
+			Manager:    ptr.To(entry.Manager),
+			Operation:  ptr.To(string(entry.Operation)),
+			Time:       entry.Time,
+			FieldCount: ptr.To(fieldCount),
+		})
 	}
+	return res
+}
 
-	source, err := getAppSourceBySourceIndexAndVersionId(a, q.SourceIndex, q.VersionId)
+// countManagedFields returns the number of leaf fields of a FieldsV1 field set
+func countManagedFields(fields map[string]any) int64 {
+	count := int64(0)
+	for key, value := range fields {
+		if key == "." {
+			continue
+		}
+		children, ok := value.(map[string]any)
+		if !ok || len(children) == 0 || (len(children) == 1 && children["."] != nil) {
+			count++
+			continue
+		}
+		count += countManagedFields(children)
+	}
+	return count
+}
+
+// GetResourceTargetManifest returns the desired state of a single resource, as last compared by the application
+// controller, from the cached managed resources of the application.
+func (s *Server) GetResourceTargetManifest(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ApplicationResourceResponse, error) {
+	items, err := s.getManagedResources(ctx, &application.ResourcesQuery{
+		ApplicationName: q.Name,
+		AppNamespace:    q.AppNamespace,
+		Project:         q.Project,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("error getting app source by source index and version ID: %w", err)
+		return nil, err
+	}
+	var item *v1alpha1.ResourceDiff
+	for i := range items {
+		if items[i].Group == q.GetGroup() && items[i].Kind == q.GetKind() && items[i].Namespace == q.GetNamespace() && items[i].Name == q.GetResourceName() {
+			item = items[i]
+			break
+		}
+	}
+	if item == nil {
+		return nil, status.Errorf(codes.NotFound, "%s %s not found as part of application %s", q.GetKind(), q.GetResourceName(), q.GetName())
+	}
+	if item.TargetState == "" || item.TargetState == "null" {
+		// the resource is no longer part of the desired state and will be pruned
+		return nil, status.Errorf(codes.NotFound, "%s %s has no desired state", q.GetKind(), q.GetResourceName())
 	}
 
-	if source.Chart == "" {
-		return nil, fmt.Errorf("no chart found for application: %v", q.GetName())
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal([]byte(item.TargetState), obj); err != nil {
+		return nil, fmt.Errorf("error unmarshaling target state: %w", err)
 	}
-	repo, err := s.db.GetRepository(ctx, source.RepoURL, a.Spec.Project)
+	obj, err = s.replaceSecretValues(obj)
 	if err != nil {
-		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+		return nil, fmt.Errorf("error replacing secret values: %w", err)
 	}
-	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	data, err := json.Marshal(obj.Object)
 	if err != nil {
-		return nil, fmt.Errorf("error creating repo server client: %w", err)
+		return nil, fmt.Errorf("error marshaling manifest object: %w", err)
 	}
-	defer utilio.Close(conn)
-	return repoClient.GetRevisionChartDetails(ctx, &apiclient.RepoServerRevisionChartDetailsRequest{
-		Repo:     repo,
-		Name:     source.Chart,
-		Revision: q.GetRevision(),
-	})
+	return &application.ApplicationResourceResponse{Manifest: ptr.To(string(data))}, nil
 }
 
-func (s *Server) GetOCIMetadata(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.OCIMetadata, error) {
-	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
-	if err != nil {
-		return nil, err
+func (s *Server) replaceSecretValues(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+		_, obj, err := diff.HideSecretData(nil, obj, s.settingsMgr.GetSensitiveAnnotations())
+		if err != nil {
+			return nil, err
+		}
+		return obj, err
 	}
-
-	source, err := getAppSourceBySourceIndexAndVersionId(a, q.SourceIndex, q.VersionId)
+	redactionRules, err := s.settingsMgr.GetResourceRedactionRules()
 	if err != nil {
-		return nil, fmt.Errorf("error getting app source by source index and version ID: %w", err)
+		return nil, fmt.Errorf("error getting resource redaction rules: %w", err)
+	}
+	if hasRedactionRule(obj, redactionRules) {
+		obj = obj.DeepCopy()
+		redactData(obj, redactionRules)
 	}
+	return obj, nil
+}
 
-	repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+// redactManifest hides the data of a Secret manifest, and the values of the data keys of other manifests which match
+// the configured redaction rules
+func (s *Server) redactManifest(manifest string, redactionRules []settings.ResourceRedactionRule) (string, error) {
+	obj := &unstructured.Unstructured{}
+	err := json.Unmarshal([]byte(manifest), obj)
 	if err != nil {
-		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+		return "", fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
 	}
-	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	switch {
+	case obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "":
+		obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
+		if err != nil {
+			return "", fmt.Errorf("error hiding secret data: %w", err)
+		}
+	case hasRedactionRule(obj, redactionRules):
+		redactData(obj, redactionRules)
+	default:
+		return manifest, nil
+	}
+	data, err := json.Marshal(obj)
 	if err != nil {
-		return nil, fmt.Errorf("error creating repo server client: %w", err)
+		return "", fmt.Errorf("error marshaling manifest: %w", err)
 	}
-	defer utilio.Close(conn)
+	return string(data), nil
+}
 
-	return repoClient.GetOCIMetadata(ctx, &apiclient.RepoServerRevisionChartDetailsRequest{
-		Repo:     repo,
-		Name:     source.Chart,
-		Revision: q.GetRevision(),
+// redactedValue replaces the values of data keys matched by a redaction rule
+const redactedValue = "++++++++"
+
+// hasRedactionRule returns whether one of the redaction rules applies to the kind of the resource
+func hasRedactionRule(obj *unstructured.Unstructured, rules []settings.ResourceRedactionRule) bool {
+	gk := obj.GroupVersionKind().GroupKind()
+	return slices.ContainsFunc(rules, func(rule settings.ResourceRedactionRule) bool {
+		return rule.Group == gk.Group && rule.Kind == gk.Kind
 	})
 }
 
-// getAppSourceBySourceIndexAndVersionId returns the source for a specific source index and version ID. Source index and
-// version ID are optional. If the source index is not specified, it defaults to 0. If the version ID is not specified,
-// we use the source(s) currently configured for the app. If the version ID is specified, we find the source for that
-// version ID. If the version ID is not found, we return an error. If the source index is out of bounds for whichever
-// source we choose (configured sources or sources for a specific version), we return an error.
-func getAppSourceBySourceIndexAndVersionId(a *v1alpha1.Application, sourceIndexMaybe *int32, versionIdMaybe *int32) (v1alpha1.ApplicationSource, error) {
-	// Start with all the app's configured sources.
-	sources := a.Spec.GetSources()
+// redactData masks the values of the data, stringData and binaryData keys of the resource matched by the redaction
+// rules of its kind, including in the last applied configuration annotation which contains the same keys
+func redactData(obj *unstructured.Unstructured, rules []settings.ResourceRedactionRule) {
+	gk := obj.GroupVersionKind().GroupKind()
+	redactDataKeys(obj.Object, gk, rules)
 
-	// If the user specified a version, get the sources for that version. If the version is not found, return an error.
-	if versionIdMaybe != nil {
-		versionId := int64(*versionIdMaybe)
-		var err error
-		sources, err = getSourcesByVersionId(a, versionId)
+	annotations := obj.GetAnnotations()
+	lastApplied, ok := annotations[corev1.LastAppliedConfigAnnotation]
+	if !ok {
+		return
+	}
+	lastAppliedObj := map[string]any{}
+	if err := json.Unmarshal([]byte(lastApplied), &lastAppliedObj); err != nil {
+		// the values of a configuration which cannot be parsed cannot be masked, so all of it is
+		annotations[corev1.LastAppliedConfigAnnotation] = redactedValue
+	} else {
+		redactDataKeys(lastAppliedObj, gk, rules)
+		data, err := json.Marshal(lastAppliedObj)
 		if err != nil {
-			return v1alpha1.ApplicationSource{}, fmt.Errorf("error getting source by version ID: %w", err)
+			annotations[corev1.LastAppliedConfigAnnotation] = redactedValue
+		} else {
+			annotations[corev1.LastAppliedConfigAnnotation] = string(data)
 		}
 	}
+	obj.SetAnnotations(annotations)
+}
 
-	// Start by assuming we want the first source.
-	sourceIndex := 0
-
-	// If the user specified a source index, use that instead.
-	if sourceIndexMaybe != nil {
-		sourceIndex = int(*sourceIndexMaybe)
-		if sourceIndex >= len(sources) {
-			if len(sources) == 1 {
-				return v1alpha1.ApplicationSource{}, fmt.Errorf("source index %d not found because there is only 1 source", sourceIndex)
+// redactDataKeys masks the values of the data, stringData and binaryData keys of the resource content matched by the
+// redaction rules of the given kind
+func redactDataKeys(content map[string]any, gk schema.GroupKind, rules []settings.ResourceRedactionRule) {
+	for _, rule := range rules {
+		if rule.Group != gk.Group || rule.Kind != gk.Kind {
+			continue
+		}
+		for _, field := range []string{"data", "stringData", "binaryData"} {
+			data, ok := content[field].(map[string]any)
+			if !ok {
+				continue
+			}
+			for key := range data {
+				if glob.MatchStringInList(rule.Keys, key, glob.GLOB) {
+					data[key] = redactedValue
+				}
 			}
-			return v1alpha1.ApplicationSource{}, fmt.Errorf("source index %d not found because there are only %d sources", sourceIndex, len(sources))
 		}
 	}
+}
 
-	source := sources[sourceIndex]
+// PatchResource patches a resource
+func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationResourcePatchRequest) (*application.ApplicationResourceResponse, error) {
+	resourceRequest := &application.ApplicationResourceRequest{
+		Name:         q.Name,
+		AppNamespace: q.AppNamespace,
+		Namespace:    q.Namespace,
+		ResourceName: q.ResourceName,
+		Kind:         q.Kind,
+		Version:      q.Version,
+		Group:        q.Group,
+		Project:      q.Project,
+	}
+	res, config, a, err := s.getAppLiveResource(ctx, rbac.ActionUpdate, resourceRequest)
+	if err != nil {
+		return nil, err
+	}
 
-	return source, nil
+	m, err := s.patchLiveResource(ctx, config, res, types.PatchType(q.GetPatchType()), []byte(q.GetPatch()))
+	if err != nil {
+		return nil, err
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("patched resource %s/%s '%s'", q.GetGroup(), q.GetKind(), q.GetResourceName()))
+	return &application.ApplicationResourceResponse{
+		Manifest: &m,
+	}, nil
 }
 
-// getRevisionHistoryByVersionId returns the revision history for a specific version ID.
-// If the version ID is not found, it returns an empty revision history and false.
-func getRevisionHistoryByVersionId(histories v1alpha1.RevisionHistories, versionId int64) (v1alpha1.RevisionHistory, bool) {
-	for _, h := range histories {
-		if h.ID == versionId {
-			return h, true
+// patchLiveResource patches a live resource and returns the patched manifest with secret values masked
+func (s *Server) patchLiveResource(ctx context.Context, config *rest.Config, res *v1alpha1.ResourceNode, patchType types.PatchType, patch []byte) (string, error) {
+	manifest, err := s.kubectl.PatchResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace, patchType, patch)
+	if err != nil {
+		// don't expose real error for secrets since it might contain secret data
+		if res.Kind == kube.SecretKind && res.Group == "" {
+			return "", fmt.Errorf("failed to patch Secret %s/%s", res.Namespace, res.Name)
 		}
+		return "", fmt.Errorf("error patching resource: %w", err)
 	}
-	return v1alpha1.RevisionHistory{}, false
+	if manifest == nil {
+		return "", errors.New("failed to patch resource: manifest was nil")
+	}
+	manifest, err = s.replaceSecretValues(manifest)
+	if err != nil {
+		return "", fmt.Errorf("error replacing secret values: %w", err)
+	}
+	data, err := json.Marshal(manifest.Object)
+	if err != nil {
+		return "", fmt.Errorf("erro marshaling manifest object: %w", err)
+	}
+	return string(data), nil
 }
 
-// getSourcesByVersionId returns the sources for a specific version ID. If there is no history, it returns an error.
-// If the version ID is not found, it returns an error. If the version ID is found, and there are multiple sources,
-// it returns the sources for that version ID. If the version ID is found, and there is only one source, it returns
-// a slice with just the single source.
-func getSourcesByVersionId(a *v1alpha1.Application, versionId int64) ([]v1alpha1.ApplicationSource, error) {
-	if len(a.Status.History) == 0 {
-		return nil, fmt.Errorf("version ID %d not found because the app has no history", versionId)
+// PatchResources applies the same patch to every live resource of the application which matches the request, either
+// the explicitly listed resources or those with the requested group, kind and namespace. Each resource is patched
+// independently and the per-resource outcome is returned, so a single failure does not abort the remaining patches.
+func (s *Server) PatchResources(ctx context.Context, q *application.ApplicationResourcesPatchRequest) (*application.ApplicationResourcesPatchResponse, error) {
+	if len(q.Resources) == 0 && q.GetKind() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "either resources or a kind must be specified")
+	}
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	// like getAppLiveResource, fall back to the fine-grained update permission of each resource
+	fineGrainedInheritanceDisabled, err := s.settingsMgr.ApplicationFineGrainedRBACInheritanceDisabled()
+	if err != nil {
+		return nil, err
 	}
+	appUpdateAllowed := !fineGrainedInheritanceDisabled && s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns))
 
-	h, ok := getRevisionHistoryByVersionId(a.Status.History, versionId)
-	if !ok {
-		return nil, fmt.Errorf("revision history not found for version ID %d", versionId)
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app resources: %w", err)
+	}
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting destination cluster: %w", err)
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
 	}
 
-	if len(h.Sources) > 0 {
-		return h.Sources, nil
+	matches := func(node v1alpha1.ResourceNode) bool {
+		if len(q.Resources) == 0 {
+			return node.Group == q.GetGroup() && node.Kind == q.GetKind() && (q.GetNamespace() == "" || node.Namespace == q.GetNamespace())
+		}
+		for _, ref := range q.Resources {
+			if ref.Group == node.Group && ref.Kind == node.Kind && ref.Namespace == node.Namespace && ref.Name == node.Name {
+				return true
+			}
+		}
+		return false
 	}
 
-	return []v1alpha1.ApplicationSource{h.Source}, nil
-}
+	res := &application.ApplicationResourcesPatchResponse{}
+	patched := 0
+	for i := range tree.Nodes {
+		node := tree.Nodes[i]
+		if node.UID == "" || !matches(node) {
+			continue
+		}
+		result := &application.ResourcePatchResult{
+			Group:     ptr.To(node.Group),
+			Kind:      ptr.To(node.Kind),
+			Namespace: ptr.To(node.Namespace),
+			Name:      ptr.To(node.Name),
+			Patched:   ptr.To(false),
+		}
+		res.Results = append(res.Results, result)
 
-func isMatchingResource(q *application.ResourcesQuery, key kube.ResourceKey) bool {
-	return (q.GetName() == "" || q.GetName() == key.Name) &&
-		(q.GetNamespace() == "" || q.GetNamespace() == key.Namespace) &&
-		(q.GetGroup() == "" || q.GetGroup() == key.Group) &&
-		(q.GetKind() == "" || q.GetKind() == key.Kind)
+		if !appUpdateAllowed {
+			action := fmt.Sprintf("%s/%s/%s/%s/%s", rbac.ActionUpdate, node.Group, node.Kind, node.Namespace, node.Name)
+			if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, action, a.RBACName(s.ns)) {
+				result.Error = ptr.To(argocommon.PermissionDeniedAPIError.Error())
+				continue
+			}
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(node.GroupKindVersion())
+		obj.SetNamespace(node.Namespace)
+		obj.SetName(node.Name)
+		if err := s.verifyResourcePermitted(destCluster, proj, obj); err != nil {
+			result.Error = ptr.To(err.Error())
+			continue
+		}
+
+		manifest, err := s.patchLiveResource(ctx, config, &node, types.PatchType(q.GetPatchType()), []byte(q.GetPatch()))
+		if err != nil {
+			result.Error = ptr.To(err.Error())
+			continue
+		}
+		result.Patched = ptr.To(true)
+		result.Manifest = ptr.To(manifest)
+		patched++
+	}
+
+	s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("patched %d resources", patched))
+	return res, nil
 }
 
-func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQuery) (*application.ManagedResourcesResponse, error) {
-	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+// DeleteResource deletes a specified resource
+func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationResourceDeleteRequest) (*application.ApplicationResponse, error) {
+	resourceRequest := &application.ApplicationResourceRequest{
+		Name:         q.Name,
+		AppNamespace: q.AppNamespace,
+		Namespace:    q.Namespace,
+		ResourceName: q.ResourceName,
+		Kind:         q.Kind,
+		Version:      q.Version,
+		Group:        q.Group,
+		Project:      q.Project,
+	}
+	res, config, a, err := s.getAppLiveResource(ctx, rbac.ActionDelete, resourceRequest)
 	if err != nil {
 		return nil, err
 	}
-
-	items := make([]*v1alpha1.ResourceDiff, 0)
-	err = s.getCachedAppState(ctx, a, func() error {
-		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &items)
-	})
+	liveObj, err := s.kubectl.GetResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace)
 	if err != nil {
-		return nil, fmt.Errorf("error getting cached app managed resources: %w", err)
+		return nil, fmt.Errorf("error getting resource: %w", err)
 	}
-	res := &application.ManagedResourcesResponse{}
-	for i := range items {
-		item := items[i]
-		if !item.Hook && isMatchingResource(q, kube.ResourceKey{Name: item.Name, Namespace: item.Namespace, Kind: item.Kind, Group: item.Group}) {
-			res.Items = append(res.Items, item)
+	protectionAnnotation, err := s.settingsMgr.GetResourceDeletionProtectionAnnotation()
+	if err != nil {
+		return nil, fmt.Errorf("error getting the deletion protection annotation: %w", err)
+	}
+	if liveObj != nil && isResourceDeletionProtected(liveObj, protectionAnnotation) {
+		if !q.GetForce() {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s %s is protected from deletion by the %s annotation", res.Kind, res.Name, protectionAnnotation)
+		}
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+			return nil, err
 		}
 	}
+	var deleteOption metav1.DeleteOptions
+	switch {
+	case q.GetOrphan():
+		propagationPolicy := metav1.DeletePropagationOrphan
+		deleteOption = metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
+	case q.GetForce():
+		propagationPolicy := metav1.DeletePropagationBackground
+		zeroGracePeriod := int64(0)
+		deleteOption = metav1.DeleteOptions{PropagationPolicy: &propagationPolicy, GracePeriodSeconds: &zeroGracePeriod}
+	default:
+		propagationPolicy := metav1.DeletePropagationForeground
+		deleteOption = metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
+	}
+	err = s.kubectl.DeleteResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace, deleteOption)
+	if err != nil {
+		return nil, fmt.Errorf("error deleting resource: %w", err)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonResourceDeleted, fmt.Sprintf("deleted resource %s/%s '%s'", q.GetGroup(), q.GetKind(), q.GetResourceName()))
+	return &application.ApplicationResponse{}, nil
+}
 
-	return res, nil
+// isResourceDeletionProtected returns true if the given protection annotation is set to "true" on the resource
+func isResourceDeletionProtected(obj *unstructured.Unstructured, protectionAnnotation string) bool {
+	return protectionAnnotation != "" && obj.GetAnnotations()[protectionAnnotation] == "true"
 }
 
-func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
-	if q.PodName != nil {
-		podKind := "Pod"
-		q.Kind = &podKind
-		q.ResourceName = q.PodName
+func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if err != nil {
+		return nil, err
 	}
 
-	var sinceSeconds, tailLines *int64
-	if q.GetSinceSeconds() > 0 {
-		sinceSeconds = ptr.To(q.GetSinceSeconds())
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, err
 	}
-	if q.GetTailLines() > 0 {
-		tailLines = ptr.To(q.GetTailLines())
+	if q.GetCollapseReplicaSetHistory() {
+		collapseReplicaSetHistory(tree)
 	}
-	var untilTime *metav1.Time
-	if q.GetUntilTime() != "" {
-		val, err := time.Parse(time.RFC3339Nano, q.GetUntilTime())
-		if err != nil {
-			return fmt.Errorf("invalid untilTime parameter value: %w", err)
-		}
-		untilTimeVal := metav1.NewTime(val)
-		untilTime = &untilTimeVal
+	if len(q.HealthStatuses) > 0 {
+		filterTreeByHealth(tree, s.resourceHealthByKey(a), q.HealthStatuses)
 	}
-
-	literal := ""
-	inverse := false
-	if q.GetFilter() != "" {
-		literal = *q.Filter
-		if literal[0] == '!' {
-			literal = literal[1:]
-			inverse = true
+	if q.GetIncludeLinks() {
+		if err := s.addResourceTreeLinks(ctx, a, proj, tree); err != nil {
+			return nil, err
 		}
 	}
+	return tree, nil
+}
 
-	a, _, err := s.getApplicationEnforceRBACInformer(ws.Context(), rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+// resourceLinkErrorInfo is the name of the info items holding the errors evaluating the resource deep links of a node
+const resourceLinkErrorInfo = "Link Error"
+
+// addResourceTreeLinks resolves the resource deep links of every node of the tree and adds them to the external URLs
+// of the node, so that clients don't have to list the links of every node. Links are evaluated against the cached live
+// state of managed resources, other nodes only provide their reference. Errors evaluating the links of a node are added
+// to the info of the node rather than failing the whole tree.
+func (s *Server) addResourceTreeLinks(ctx context.Context, a *v1alpha1.Application, proj *v1alpha1.AppProject, tree *v1alpha1.ApplicationTree) error {
+	deepLinks, err := s.settingsMgr.GetDeepLinks(settings.ResourceDeepLinks)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to read resource deep links from configmap: %w", err)
 	}
-
-	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbac.ResourceLogs, rbac.ActionGet, a.RBACName(s.ns)); err != nil {
+	if len(deepLinks) == 0 {
+		return nil
+	}
+	appObj, err := kube.ToUnstructured(a)
+	if err != nil {
 		return err
 	}
-
-	tree, err := s.getAppResources(ws.Context(), a)
+	clstObj, projObj, err := s.getObjectsForDeepLinks(ctx, a, proj.DeepCopy())
 	if err != nil {
-		return fmt.Errorf("error getting app resource tree: %w", err)
+		return err
 	}
 
-	config, err := s.getApplicationClusterConfig(ws.Context(), a)
-	if err != nil {
-		return fmt.Errorf("error getting application cluster config: %w", err)
-	}
-
-	kubeClientset, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		return fmt.Errorf("error creating kube client: %w", err)
-	}
-
-	// from the tree find pods which match query of kind, group, and resource name
-	pods := getSelectedPods(tree.Nodes, q)
-	if len(pods) == 0 {
-		return nil
-	}
-
-	maxPodLogsToRender, err := s.settingsMgr.GetMaxPodLogsToRender()
+	managedResources := make([]*v1alpha1.ResourceDiff, 0)
+	err = s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &managedResources)
+	})
 	if err != nil {
-		return fmt.Errorf("error getting MaxPodLogsToRender config: %w", err)
+		return fmt.Errorf("error getting cached app managed resources: %w", err)
 	}
-
-	if int64(len(pods)) > maxPodLogsToRender {
-		return status.Error(codes.InvalidArgument, "max pods to view logs are reached. Please provide more granular query")
+	liveStates := make(map[kube.ResourceKey]string, len(managedResources))
+	for _, item := range managedResources {
+		liveStates[kube.NewResourceKey(item.Group, item.Kind, item.Namespace, item.Name)] = item.LiveState
 	}
 
-	var streams []chan logEntry
-
-	for _, pod := range pods {
-		stream, err := kubeClientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
-			Container:    q.GetContainer(),
-			Follow:       q.GetFollow(),
-			Timestamps:   true,
-			SinceSeconds: sinceSeconds,
-			SinceTime:    q.GetSinceTime(),
-			TailLines:    tailLines,
-			Previous:     q.GetPrevious(),
-		}).Stream(ws.Context())
-		podName := pod.Name
-		logStream := make(chan logEntry)
-		if err == nil {
-			defer utilio.Close(stream)
+	for i := range tree.Nodes {
+		node := &tree.Nodes[i]
+		obj, err := s.deepLinksResourceObject(node.ResourceRef, liveStates[kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)])
+		if err != nil {
+			node.Info = append(node.Info, v1alpha1.InfoItem{Name: resourceLinkErrorInfo, Value: err.Error()})
+			continue
 		}
-
-		streams = append(streams, logStream)
-		go func() {
-			// if k8s failed to start steaming logs (typically because Pod is not ready yet)
-			// then the error should be shown in the UI so that user know the reason
-			if err != nil {
-				logStream <- logEntry{line: err.Error()}
-			} else {
-				parseLogsStream(podName, stream, logStream)
-			}
-			close(logStream)
-		}()
-	}
-
-	logStream := mergeLogStreams(streams, time.Millisecond*100)
-	sentCount := int64(0)
-	done := make(chan error)
-	go func() {
-		for entry := range logStream {
-			if entry.err != nil {
-				done <- entry.err
-				return
-			}
-			if q.Filter != nil {
-				var lineContainsFilter bool
-				if q.GetMatchCase() {
-					lineContainsFilter = strings.Contains(entry.line, literal)
-				} else {
-					lineContainsFilter = strings.Contains(strings.ToLower(entry.line), strings.ToLower(literal))
-				}
-
-				if (inverse && lineContainsFilter) || (!inverse && !lineContainsFilter) {
-					continue
-				}
-			}
-			ts := metav1.NewTime(entry.timeStamp)
-			if untilTime != nil && entry.timeStamp.After(untilTime.Time) {
-				done <- ws.Send(&application.LogEntry{
-					Last:         ptr.To(true),
-					PodName:      &entry.podName,
-					Content:      &entry.line,
-					TimeStampStr: ptr.To(entry.timeStamp.Format(time.RFC3339Nano)),
-					TimeStamp:    &ts,
-				})
-				return
+		deepLinksObject := deeplinks.CreateDeepLinksObject(obj, appObj, clstObj, projObj)
+		links, errorList := deeplinks.EvaluateDeepLinksResponse(deepLinksObject, obj.GetName(), deepLinks)
+		for _, link := range links.Items {
+			if node.NetworkingInfo == nil {
+				node.NetworkingInfo = &v1alpha1.ResourceNetworkingInfo{}
 			}
-			sentCount++
-			if err := ws.Send(&application.LogEntry{
-				PodName:      &entry.podName,
-				Content:      &entry.line,
-				TimeStampStr: ptr.To(entry.timeStamp.Format(time.RFC3339Nano)),
-				TimeStamp:    &ts,
-				Last:         ptr.To(false),
-			}); err != nil {
-				done <- err
-				break
+			// the UI shows the title of external URLs of the form <title>|<url>
+			url := link.GetUrl()
+			if link.GetTitle() != "" {
+				url = link.GetTitle() + "|" + url
 			}
+			node.NetworkingInfo.ExternalURLs = append(node.NetworkingInfo.ExternalURLs, url)
+		}
+		for _, msg := range errorList {
+			node.Info = append(node.Info, v1alpha1.InfoItem{Name: resourceLinkErrorInfo, Value: msg})
 		}
-		now := time.Now()
-		nowTS := metav1.NewTime(now)
-		done <- ws.Send(&application.LogEntry{
-			Last:         ptr.To(true),
-			PodName:      ptr.To(""),
-			Content:      ptr.To(""),
-			TimeStampStr: ptr.To(now.Format(time.RFC3339Nano)),
-			TimeStamp:    &nowTS,
-		})
-	}()
-
-	select {
-	case err := <-done:
-		return err
-	case <-ws.Context().Done():
-		log.WithField("application", q.Name).Debug("k8s pod logs reader completed due to closed grpc context")
-		return nil
 	}
+	return nil
 }
 
-// from all of the treeNodes, get the pod who meets the criteria or whose parents meets the criteria
-func getSelectedPods(treeNodes []v1alpha1.ResourceNode, q *application.ApplicationPodLogsQuery) []v1alpha1.ResourceNode {
-	var pods []v1alpha1.ResourceNode
-	isTheOneMap := make(map[string]bool)
-	for _, treeNode := range treeNodes {
-		if treeNode.Kind == kube.PodKind && treeNode.Group == "" && treeNode.UID != "" {
-			if isTheSelectedOne(&treeNode, q, treeNodes, isTheOneMap) {
-				pods = append(pods, treeNode)
-			}
+// deepLinksResourceObject returns the object resource deep links of a tree node are evaluated against: its live state
+// with secret data hidden if known, otherwise an object holding only the reference of the node.
+func (s *Server) deepLinksResourceObject(ref v1alpha1.ResourceRef, liveState string) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if liveState != "" && liveState != "null" {
+		if err := json.Unmarshal([]byte(liveState), obj); err != nil {
+			return nil, fmt.Errorf("error unmarshaling live state: %w", err)
 		}
+		return s.replaceSecretValues(obj)
 	}
-	return pods
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Group: ref.Group, Version: ref.Version, Kind: ref.Kind})
+	obj.SetNamespace(ref.Namespace)
+	obj.SetName(ref.Name)
+	obj.SetUID(types.UID(ref.UID))
+	return obj, nil
 }
 
-// check is currentNode is matching with group, kind, and name, or if any of its parents matches
-func isTheSelectedOne(currentNode *v1alpha1.ResourceNode, q *application.ApplicationPodLogsQuery, resourceNodes []v1alpha1.ResourceNode, isTheOneMap map[string]bool) bool {
-	exist, value := isTheOneMap[currentNode.UID]
-	if exist {
-		return value
+// resourceHealthByKey returns the health of the managed resources of the application, regardless of whether it
+// is stored in the application status or in the resource tree.
+func (s *Server) resourceHealthByKey(a *v1alpha1.Application) map[kube.ResourceKey]*v1alpha1.HealthStatus {
+	app := a.DeepCopy()
+	s.inferResourcesStatusHealth(app)
+	healthByKey := make(map[kube.ResourceKey]*v1alpha1.HealthStatus, len(app.Status.Resources))
+	for _, res := range app.Status.Resources {
+		if res.Health != nil {
+			healthByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Health
+		}
 	}
+	return healthByKey
+}
 
-	if (q.GetResourceName() == "" || currentNode.Name == q.GetResourceName()) &&
-		(q.GetKind() == "" || currentNode.Kind == q.GetKind()) &&
-		(q.GetGroup() == "" || currentNode.Group == q.GetGroup()) &&
-		(q.GetNamespace() == "" || currentNode.Namespace == q.GetNamespace()) {
-		isTheOneMap[currentNode.UID] = true
-		return true
+// treeNodeHealth returns the health of a node of the resource tree, which is only stored in the application status for
+// managed resources.
+func treeNodeHealth(node v1alpha1.ResourceNode, healthByKey map[kube.ResourceKey]*v1alpha1.HealthStatus) *v1alpha1.HealthStatus {
+	if node.Health != nil {
+		return node.Health
 	}
+	return healthByKey[kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)]
+}
 
-	if len(currentNode.ParentRefs) == 0 {
-		isTheOneMap[currentNode.UID] = false
-		return false
+// filterTreeByHealth prunes the tree to the nodes with one of the given health statuses. The ancestors of matching
+// nodes are kept so the parent refs of the remaining nodes still resolve within the tree.
+func filterTreeByHealth(tree *v1alpha1.ApplicationTree, healthByKey map[kube.ResourceKey]*v1alpha1.HealthStatus, statuses []string) {
+	matches := func(node v1alpha1.ResourceNode) bool {
+		nodeHealth := treeNodeHealth(node, healthByKey)
+		return nodeHealth != nil && slices.Contains(statuses, string(nodeHealth.Status))
 	}
 
-	for _, parentResource := range currentNode.ParentRefs {
-		// look up parentResource from resourceNodes
-		// then check if the parent isTheSelectedOne
-		for _, resourceNode := range resourceNodes {
-			if resourceNode.Namespace == parentResource.Namespace &&
-				resourceNode.Name == parentResource.Name &&
-				resourceNode.Group == parentResource.Group &&
-				resourceNode.Kind == parentResource.Kind {
-				if isTheSelectedOne(&resourceNode, q, resourceNodes, isTheOneMap) {
-					isTheOneMap[currentNode.UID] = true
-					return true
-				}
+	nodesByKey := make(map[kube.ResourceKey]v1alpha1.ResourceNode, len(tree.Nodes))
+	for _, node := range tree.Nodes {
+		nodesByKey[kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)] = node
+	}
+	keep := make(map[kube.ResourceKey]bool)
+	var markWithAncestors func(node v1alpha1.ResourceNode)
+	markWithAncestors = func(node v1alpha1.ResourceNode) {
+		key := kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)
+		if keep[key] {
+			return
+		}
+		keep[key] = true
+		for _, parent := range node.ParentRefs {
+			if parentNode, ok := nodesByKey[kube.NewResourceKey(parent.Group, parent.Kind, parent.Namespace, parent.Name)]; ok {
+				markWithAncestors(parentNode)
 			}
 		}
 	}
+	for _, node := range tree.Nodes {
+		if matches(node) {
+			markWithAncestors(node)
+		}
+	}
 
-	isTheOneMap[currentNode.UID] = false
-	return false
+	nodes := make([]v1alpha1.ResourceNode, 0, len(keep))
+	for _, node := range tree.Nodes {
+		if keep[kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)] {
+			nodes = append(nodes, node)
+		}
+	}
+	tree.Nodes = nodes
+
+	orphanedNodes := make([]v1alpha1.ResourceNode, 0, len(tree.OrphanedNodes))
+	for _, node := range tree.OrphanedNodes {
+		if matches(node) {
+			orphanedNodes = append(orphanedNodes, node)
+		}
+	}
+	tree.OrphanedNodes = orphanedNodes
 }
 
-// Sync syncs an application to its target state
-func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncRequest) (*v1alpha1.Application, error) {
-	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, syncReq.GetProject(), syncReq.GetAppNamespace(), syncReq.GetName(), "")
+// GetResourceKindCounts returns the number of resources per group/kind of the cached resource tree of the application.
+// Orphaned resources are counted separately.
+func (s *Server) GetResourceKindCounts(ctx context.Context, q *application.ResourcesQuery) (*application.ApplicationResourceKindCountsResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
 	if err != nil {
 		return nil, err
 	}
 
-	s.inferResourcesStatusHealth(a)
-
-	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true)
+	tree, err := s.getAppResources(ctx, a)
 	if err != nil {
-		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
-	}
-	if !canSync {
-		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
-	}
-
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, a.RBACName(s.ns)); err != nil {
 		return nil, err
 	}
+	return &application.ApplicationResourceKindCountsResponse{
+		Counts:         countResourceKinds(tree.Nodes),
+		OrphanedCounts: countResourceKinds(tree.OrphanedNodes),
+	}, nil
+}
 
-	if syncReq.Manifests != nil {
-		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
-			return nil, err
-		}
-		if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() && !syncReq.GetDryRun() {
-			return nil, status.Error(codes.FailedPrecondition, "cannot use local sync when Automatic Sync Policy is enabled unless for dry run")
-		}
-	}
-	if a.DeletionTimestamp != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
-	}
-
-	revision, displayRevision, sourceRevisions, displayRevisions, err := s.resolveSourceRevisions(ctx, a, syncReq)
+// GetResourceSubtreeHealthCounts returns the number of resources per group/kind and health status of the resources under
+// the given resource of the cached resource tree of the application, e.g. the pods of a deployment.
+func (s *Server) GetResourceSubtreeHealthCounts(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ResourceSubtreeHealthCountsResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
 		return nil, err
 	}
 
-	var retry *v1alpha1.RetryStrategy
-	var syncOptions v1alpha1.SyncOptions
-	if a.Spec.SyncPolicy != nil {
-		syncOptions = a.Spec.SyncPolicy.SyncOptions
-		retry = a.Spec.SyncPolicy.Retry
-	}
-	if syncReq.RetryStrategy != nil {
-		retry = syncReq.RetryStrategy
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, err
 	}
-	if syncReq.SyncOptions != nil {
-		syncOptions = syncReq.SyncOptions.Items
+	rootKey := kube.NewResourceKey(q.GetGroup(), q.GetKind(), q.GetNamespace(), q.GetResourceName())
+	if !slices.ContainsFunc(tree.Nodes, func(node v1alpha1.ResourceNode) bool {
+		return kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name) == rootKey
+	}) {
+		return nil, status.Errorf(codes.NotFound, "resource %s not found in the resource tree of application %s", rootKey.String(), a.QualifiedName())
 	}
 
-	if syncOptions.HasOption(common.SyncOptionReplace) && !s.syncWithReplaceAllowed {
-		return nil, status.Error(codes.FailedPrecondition, "sync with replace was disabled on the API Server level via the server configuration")
+	subtree := resourceSubtree(tree.Nodes, rootKey)
+	healthByKey := s.resourceHealthByKey(a)
+	type healthKey struct {
+		gk     schema.GroupKind
+		health string
+	}
+	counts := make(map[healthKey]int64)
+	for _, node := range subtree {
+		key := healthKey{gk: schema.GroupKind{Group: node.Group, Kind: node.Kind}}
+		if nodeHealth := treeNodeHealth(node, healthByKey); nodeHealth != nil {
+			key.health = string(nodeHealth.Status)
+		}
+		counts[key]++
+	}
+	res := &application.ResourceSubtreeHealthCountsResponse{Total: ptr.To(int64(len(subtree)))}
+	for key, count := range counts {
+		res.Counts = append(res.Counts, &application.ResourceHealthCount{
+			Group:  ptr.To(key.gk.Group),
+			Kind:   ptr.To(key.gk.Kind),
+			Health: ptr.To(key.health),
+			Count:  ptr.To(count),
+		})
 	}
+	sort.Slice(res.Counts, func(i, j int) bool {
+		if res.Counts[i].GetGroup() != res.Counts[j].GetGroup() {
+			return res.Counts[i].GetGroup() < res.Counts[j].GetGroup()
+		}
+		if res.Counts[i].GetKind() != res.Counts[j].GetKind() {
+			return res.Counts[i].GetKind() < res.Counts[j].GetKind()
+		}
+		return res.Counts[i].GetHealth() < res.Counts[j].GetHealth()
+	})
+	return res, nil
+}
 
-	// We cannot use local manifests if we're only allowed to sync to signed commits
-	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
-		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
+// resourceSubtree returns the nodes below the root node, following the parent refs of the nodes. The root node itself
+// is not included.
+func resourceSubtree(nodes []v1alpha1.ResourceNode, root kube.ResourceKey) []v1alpha1.ResourceNode {
+	children := make(map[kube.ResourceKey][]v1alpha1.ResourceNode)
+	for _, node := range nodes {
+		for _, parent := range node.ParentRefs {
+			parentKey := kube.NewResourceKey(parent.Group, parent.Kind, parent.Namespace, parent.Name)
+			children[parentKey] = append(children[parentKey], node)
+		}
 	}
 
-	resources := []v1alpha1.SyncOperationResource{}
-	if syncReq.GetResources() != nil {
-		for _, r := range syncReq.GetResources() {
-			if r != nil {
-				resources = append(resources, *r)
+	var subtree []v1alpha1.ResourceNode
+	visited := map[kube.ResourceKey]bool{root: true}
+	queue := []kube.ResourceKey{root}
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		for _, child := range children[key] {
+			childKey := kube.NewResourceKey(child.Group, child.Kind, child.Namespace, child.Name)
+			if visited[childKey] {
+				continue
 			}
+			visited[childKey] = true
+			subtree = append(subtree, child)
+			queue = append(queue, childKey)
 		}
 	}
-	op := v1alpha1.Operation{
-		Sync: &v1alpha1.SyncOperation{
-			Revision:     revision,
-			Prune:        syncReq.GetPrune(),
-			DryRun:       syncReq.GetDryRun(),
-			SyncOptions:  syncOptions,
-			SyncStrategy: syncReq.Strategy,
-			Resources:    resources,
-			Manifests:    syncReq.Manifests,
-			Sources:      a.Spec.Sources,
-			Revisions:    sourceRevisions,
-		},
-		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
-		Info:        syncReq.Infos,
+	return subtree
+}
+
+// countResourceKinds returns the number of nodes per group/kind, sorted by group and kind
+func countResourceKinds(nodes []v1alpha1.ResourceNode) []*application.ResourceKindCount {
+	counts := make(map[schema.GroupKind]int64)
+	for _, node := range nodes {
+		counts[schema.GroupKind{Group: node.Group, Kind: node.Kind}]++
 	}
-	if retry != nil {
-		op.Retry = *retry
+	res := make([]*application.ResourceKindCount, 0, len(counts))
+	for gk, count := range counts {
+		res = append(res, &application.ResourceKindCount{Group: ptr.To(gk.Group), Kind: ptr.To(gk.Kind), Count: ptr.To(count)})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].GetGroup() != res[j].GetGroup() {
+			return res[i].GetGroup() < res[j].GetGroup()
+		}
+		return res[i].GetKind() < res[j].GetKind()
+	})
+	return res
+}
+
+// podSpecFields are the fields holding the pod spec of the workload kinds GetAppResourceRequests sums up
+var podSpecFields = map[schema.GroupKind][]string{
+	{Group: "apps", Kind: kube.DeploymentKind}:  {"spec", "template", "spec"},
+	{Group: "apps", Kind: kube.StatefulSetKind}: {"spec", "template", "spec"},
+	{Group: "apps", Kind: kube.DaemonSetKind}:   {"spec", "template", "spec"},
+	{Group: "apps", Kind: kube.ReplicaSetKind}:  {"spec", "template", "spec"},
+	{Kind: kube.PodKind}:                        {"spec"},
+}
+
+// resourceRequirementsTotals sums up the CPU and memory requests and limits of containers
+type resourceRequirementsTotals struct {
+	cpuRequests    resource.Quantity
+	cpuLimits      resource.Quantity
+	memoryRequests resource.Quantity
+	memoryLimits   resource.Quantity
+}
+
+func (t *resourceRequirementsTotals) addContainers(containers []corev1.Container, replicas int64) {
+	for _, c := range containers {
+		t.cpuRequests.Add(*resource.NewMilliQuantity(c.Resources.Requests.Cpu().MilliValue()*replicas, resource.DecimalSI))
+		t.cpuLimits.Add(*resource.NewMilliQuantity(c.Resources.Limits.Cpu().MilliValue()*replicas, resource.DecimalSI))
+		t.memoryRequests.Add(*resource.NewQuantity(c.Resources.Requests.Memory().Value()*replicas, resource.BinarySI))
+		t.memoryLimits.Add(*resource.NewQuantity(c.Resources.Limits.Memory().Value()*replicas, resource.BinarySI))
 	}
+}
 
-	appName := syncReq.GetName()
-	appNs := s.appNamespaceOrDefault(syncReq.GetAppNamespace())
-	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
-	a, err = argo.SetAppOperation(appIf, appName, &op)
+func (t *resourceRequirementsTotals) add(other resourceRequirementsTotals) {
+	t.cpuRequests.Add(other.cpuRequests)
+	t.cpuLimits.Add(other.cpuLimits)
+	t.memoryRequests.Add(other.memoryRequests)
+	t.memoryLimits.Add(other.memoryLimits)
+}
+
+// GetAppResourceRequests returns the CPU and memory requests and limits of the application workloads, multiplied by
+// their desired number of replicas. Workloads owned by another workload, such as the replica sets of a deployment, are
+// skipped so that every pod is only counted once.
+func (s *Server) GetAppResourceRequests(ctx context.Context, q *application.ResourcesQuery) (*application.ApplicationResourceRequestsResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
 	if err != nil {
-		return nil, fmt.Errorf("error setting app operation: %w", err)
+		return nil, err
+	}
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app resources: %w", err)
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+
+	var total resourceRequirementsTotals
+	res := &application.ApplicationResourceRequestsResponse{}
+	for i := range tree.Nodes {
+		node := tree.Nodes[i]
+		if _, ok := podSpecFields[schema.GroupKind{Group: node.Group, Kind: node.Kind}]; !ok || node.UID == "" || hasWorkloadParent(node) {
+			continue
+		}
+		result := &application.WorkloadResourceRequests{
+			Group:     ptr.To(node.Group),
+			Kind:      ptr.To(node.Kind),
+			Namespace: ptr.To(node.Namespace),
+			Name:      ptr.To(node.Name),
+			Replicas:  ptr.To(int64(0)),
+		}
+		res.Workloads = append(res.Workloads, result)
+
+		obj, err := s.kubectl.GetResource(ctx, config, node.GroupKindVersion(), node.Name, node.Namespace)
+		if err != nil {
+			result.Error = ptr.To(fmt.Sprintf("error getting resource: %v", err))
+			continue
+		}
+		if obj == nil {
+			result.Error = ptr.To("resource not found")
+			continue
+		}
+		replicas, totals, err := workloadResourceRequirements(obj)
+		if err != nil {
+			result.Error = ptr.To(err.Error())
+			continue
+		}
+		result.Replicas = ptr.To(replicas)
+		result.CpuRequests = ptr.To(totals.cpuRequests.String())
+		result.CpuLimits = ptr.To(totals.cpuLimits.String())
+		result.MemoryRequests = ptr.To(totals.memoryRequests.String())
+		result.MemoryLimits = ptr.To(totals.memoryLimits.String())
+		total.add(totals)
+	}
+	res.CpuRequests = ptr.To(total.cpuRequests.String())
+	res.CpuLimits = ptr.To(total.cpuLimits.String())
+	res.MemoryRequests = ptr.To(total.memoryRequests.String())
+	res.MemoryLimits = ptr.To(total.memoryLimits.String())
+	return res, nil
+}
+
+// hasWorkloadParent returns whether the node is owned by one of the workloads GetAppResourceRequests sums up
+func hasWorkloadParent(node v1alpha1.ResourceNode) bool {
+	for _, parent := range node.ParentRefs {
+		if _, ok := podSpecFields[schema.GroupKind{Group: parent.Group, Kind: parent.Kind}]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// workloadResourceRequirements returns the desired number of pods of a live workload and the resource requirements
+// of its containers multiplied by that number. Since a HorizontalPodAutoscaler scales a workload by updating its
+// replicas, the desired replicas already account for autoscaling.
+func workloadResourceRequirements(obj *unstructured.Unstructured) (int64, resourceRequirementsTotals, error) {
+	var totals resourceRequirementsTotals
+	var replicas int64
+	switch obj.GetKind() {
+	case kube.PodKind:
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		if phase == string(corev1.PodSucceeded) || phase == string(corev1.PodFailed) {
+			return 0, totals, nil
+		}
+		replicas = 1
+	case kube.DaemonSetKind:
+		replicas, _, _ = unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+	default:
+		specReplicas, found, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		if err != nil {
+			return 0, totals, fmt.Errorf("error reading replicas: %w", err)
+		}
+		replicas = 1
+		if found {
+			replicas = specReplicas
+		}
+	}
+
+	podSpecObj, found, err := unstructured.NestedMap(obj.Object, podSpecFields[obj.GroupVersionKind().GroupKind()]...)
+	if err != nil {
+		return 0, totals, fmt.Errorf("error reading pod spec: %w", err)
+	}
+	if !found {
+		return replicas, totals, nil
+	}
+	var podSpec corev1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podSpecObj, &podSpec); err != nil {
+		return 0, totals, fmt.Errorf("error converting pod spec: %w", err)
+	}
+	totals.addContainers(podSpec.Containers, replicas)
+	return replicas, totals, nil
+}
+
+// GetDeployedImageDigests returns the image digests the containers of the application pods are running. Unlike the
+// images of the pod spec, which may reference mutable tags, the image IDs of the container statuses are resolved by
+// the container runtime, so they identify exactly what is deployed.
+func (s *Server) GetDeployedImageDigests(ctx context.Context, q *application.ResourcesQuery) (*application.ApplicationImageDigestsResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if err != nil {
+		return nil, err
+	}
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app resources: %w", err)
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+
+	res := &application.ApplicationImageDigestsResponse{}
+	for _, node := range tree.Nodes {
+		if node.Group != "" || node.Kind != kube.PodKind || node.UID == "" {
+			continue
+		}
+		obj, err := s.kubectl.GetResource(ctx, config, node.GroupKindVersion(), node.Name, node.Namespace)
+		if err == nil && obj == nil {
+			err = errors.New("resource not found")
+		}
+		var pod corev1.Pod
+		if err == nil {
+			err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pod)
+		}
+		if err != nil {
+			if res.Errors == nil {
+				res.Errors = map[string]string{}
+			}
+			res.Errors[node.Namespace+"/"+node.Name] = err.Error()
+			continue
+		}
+		res.Items = append(res.Items, containerImageDigests(&pod)...)
+	}
+	return res, nil
+}
+
+// containerImageDigests returns the image digests of the init and regular containers of the pod, in the order of their
+// statuses. Containers which have not been started yet report no image ID, so their digest is left empty.
+func containerImageDigests(pod *corev1.Pod) []*application.ContainerImageDigest {
+	var res []*application.ContainerImageDigest
+	add := func(statuses []corev1.ContainerStatus, init bool) {
+		for _, cs := range statuses {
+			digest := ""
+			if i := strings.LastIndex(cs.ImageID, "@"); i >= 0 {
+				digest = cs.ImageID[i+1:]
+			}
+			res = append(res, &application.ContainerImageDigest{
+				Pod:       ptr.To(pod.Name),
+				Namespace: ptr.To(pod.Namespace),
+				Container: ptr.To(cs.Name),
+				Init:      ptr.To(init),
+				Image:     ptr.To(cs.Image),
+				ImageID:   ptr.To(cs.ImageID),
+				Digest:    ptr.To(digest),
+			})
+		}
+	}
+	add(pod.Status.InitContainerStatuses, true)
+	add(pod.Status.ContainerStatuses, false)
+	return res
+}
+
+func (s *Server) WatchResourceTree(q *application.ResourcesQuery, ws application.ApplicationService_WatchResourceTreeServer) error {
+	_, _, err := s.getApplicationEnforceRBACInformer(ws.Context(), rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if err != nil {
+		return err
+	}
+
+	// the tree last sent on this stream, the deltas are computed against it
+	var prev *v1alpha1.ApplicationTree
+	cacheKey := argo.AppInstanceName(q.GetApplicationName(), q.GetAppNamespace(), s.ns)
+	return s.cache.OnAppResourcesTreeChanged(ws.Context(), cacheKey, func() error {
+		var tree v1alpha1.ApplicationTree
+		err := s.cache.GetAppResourcesTree(cacheKey, &tree)
+		if err != nil {
+			return fmt.Errorf("error getting app resource tree: %w", err)
+		}
+		if q.GetCollapseReplicaSetHistory() {
+			collapseReplicaSetHistory(&tree)
+		}
+		if !q.GetDeltas() {
+			return ws.Send(&tree)
+		}
+		delta, changed := resourceTreeDelta(prev, &tree)
+		if !changed {
+			return nil
+		}
+		if err := ws.Send(delta); err != nil {
+			return err
+		}
+		prev = &tree
+		return nil
+	})
+}
+
+// resourceTreeDelta returns the changes needed to turn prev into tree, and whether there are any. Nodes and hosts
+// contain the entries which are new or changed, removed entries are listed explicitly. If prev is nil, the delta
+// contains the whole tree.
+func resourceTreeDelta(prev, tree *v1alpha1.ApplicationTree) (*v1alpha1.ApplicationTree, bool) {
+	if prev == nil {
+		prev = &v1alpha1.ApplicationTree{}
+	}
+	delta := &v1alpha1.ApplicationTree{ShardsCount: tree.ShardsCount}
+	delta.Nodes, delta.RemovedNodes = resourceNodesDelta(prev.Nodes, tree.Nodes)
+	delta.OrphanedNodes, delta.RemovedOrphanedNodes = resourceNodesDelta(prev.OrphanedNodes, tree.OrphanedNodes)
+	delta.Hosts, delta.RemovedHosts = hostsDelta(prev.Hosts, tree.Hosts)
+	changed := len(delta.Nodes) > 0 || len(delta.RemovedNodes) > 0 || len(delta.OrphanedNodes) > 0 ||
+		len(delta.RemovedOrphanedNodes) > 0 || len(delta.Hosts) > 0 || len(delta.RemovedHosts) > 0 ||
+		prev.ShardsCount != tree.ShardsCount
+	return delta, changed
+}
+
+// hostsDelta returns the hosts which are new or differ from the previous ones, and the names of the previous hosts
+// which no longer exist
+func hostsDelta(prev, hosts []v1alpha1.HostInfo) ([]v1alpha1.HostInfo, []string) {
+	prevByName := make(map[string]*v1alpha1.HostInfo, len(prev))
+	for i := range prev {
+		prevByName[prev[i].Name] = &prev[i]
+	}
+	var changed []v1alpha1.HostInfo
+	for i := range hosts {
+		if prevHost, ok := prevByName[hosts[i].Name]; !ok || !reflect.DeepEqual(prevHost, &hosts[i]) {
+			changed = append(changed, hosts[i])
+		}
+		delete(prevByName, hosts[i].Name)
+	}
+	var removed []string
+	for i := range prev {
+		if _, ok := prevByName[prev[i].Name]; ok {
+			removed = append(removed, prev[i].Name)
+		}
+	}
+	return changed, removed
+}
+
+// resourceNodesDelta returns the nodes which are new or differ from the previous ones, and the references of the
+// previous nodes which no longer exist
+func resourceNodesDelta(prev, nodes []v1alpha1.ResourceNode) ([]v1alpha1.ResourceNode, []v1alpha1.ResourceRef) {
+	prevByKey := make(map[kube.ResourceKey]*v1alpha1.ResourceNode, len(prev))
+	for i := range prev {
+		prevByKey[kube.NewResourceKey(prev[i].Group, prev[i].Kind, prev[i].Namespace, prev[i].Name)] = &prev[i]
+	}
+	var changed []v1alpha1.ResourceNode
+	for i := range nodes {
+		key := kube.NewResourceKey(nodes[i].Group, nodes[i].Kind, nodes[i].Namespace, nodes[i].Name)
+		if prevNode, ok := prevByKey[key]; !ok || !reflect.DeepEqual(prevNode, &nodes[i]) {
+			changed = append(changed, nodes[i])
+		}
+		delete(prevByKey, key)
+	}
+	var removed []v1alpha1.ResourceRef
+	for i := range prev {
+		if _, ok := prevByKey[kube.NewResourceKey(prev[i].Group, prev[i].Kind, prev[i].Namespace, prev[i].Name)]; ok {
+			removed = append(removed, prev[i].ResourceRef)
+		}
+	}
+	return changed, removed
+}
+
+// collapseReplicaSetHistory replaces ReplicaSets that no longer own any pods, i.e. the revision history kept by a
+// Deployment, with a single summary node per owner. The summary node has no UID and lists the collapsed ReplicaSets in
+// its info items, so clients can request the full tree to expand it.
+func collapseReplicaSetHistory(tree *v1alpha1.ApplicationTree) {
+	hasChildren := make(map[kube.ResourceKey]bool)
+	for _, node := range tree.Nodes {
+		for _, parent := range node.ParentRefs {
+			hasChildren[kube.NewResourceKey(parent.Group, parent.Kind, parent.Namespace, parent.Name)] = true
+		}
+	}
+
+	nodes := make([]v1alpha1.ResourceNode, 0, len(tree.Nodes))
+	summaries := make(map[kube.ResourceKey]*v1alpha1.ResourceNode)
+	var summaryKeys []kube.ResourceKey
+	for _, node := range tree.Nodes {
+		isHistory := node.Group == "apps" && node.Kind == kube.ReplicaSetKind && len(node.ParentRefs) > 0 &&
+			!hasChildren[kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)]
+		if !isHistory {
+			nodes = append(nodes, node)
+			continue
+		}
+		owner := node.ParentRefs[0]
+		ownerKey := kube.NewResourceKey(owner.Group, owner.Kind, owner.Namespace, owner.Name)
+		summary, ok := summaries[ownerKey]
+		if !ok {
+			summary = &v1alpha1.ResourceNode{
+				ResourceRef: v1alpha1.ResourceRef{
+					Group:     node.Group,
+					Kind:      node.Kind,
+					Version:   node.Version,
+					Namespace: node.Namespace,
+					Name:      owner.Name + "-revision-history",
+				},
+				ParentRefs: []v1alpha1.ResourceRef{owner},
+			}
+			summaries[ownerKey] = summary
+			summaryKeys = append(summaryKeys, ownerKey)
+		}
+		summary.Info = append(summary.Info, v1alpha1.InfoItem{Name: kube.ReplicaSetKind, Value: node.Name})
+	}
+	for _, key := range summaryKeys {
+		nodes = append(nodes, *summaries[key])
+	}
+	tree.Nodes = nodes
+}
+
+func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := getAppSourceBySourceIndexAndVersionId(a, q.SourceIndex, q.VersionId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app source by source index and version ID: %w", err)
+	}
+
+	repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	}
+	defer utilio.Close(conn)
+	return repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:           repo,
+		Revision:       q.GetRevision(),
+		CheckSignature: len(proj.Spec.SignatureKeys) > 0,
+	})
+}
+
+// GetDestinationInfo returns the server, name and Kubernetes version of the cluster the application is deployed to,
+// together with the destination namespace. Cluster credentials are never returned.
+func (s *Server) GetDestinationInfo(ctx context.Context, q *application.ApplicationDestinationInfoQuery) (*application.ApplicationDestinationInfoResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting destination cluster: %w", err)
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	serverVersion, err := s.kubectl.GetServerVersion(config)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server version: %w", err)
+	}
+	return &application.ApplicationDestinationInfoResponse{
+		Server:        ptr.To(destCluster.Server),
+		Name:          ptr.To(destCluster.Name),
+		Namespace:     ptr.To(a.Spec.Destination.Namespace),
+		ServerVersion: ptr.To(serverVersion),
+	}, nil
+}
+
+// GetFieldManager returns the field managers Argo CD uses when applying the resources of the application, so that other
+// controllers applying the same resources with server-side apply can be configured not to conflict with it.
+func (s *Server) GetFieldManager(ctx context.Context, q *application.ApplicationFieldManagerQuery) (*application.ApplicationFieldManagerResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	installationID, err := s.settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, fmt.Errorf("error getting installation ID: %w", err)
+	}
+	return &application.ApplicationFieldManagerResponse{
+		FieldManager:           ptr.To(argocommon.ArgoCDSSAManager),
+		ClientSideApplyManager: ptr.To(clientSideApplyManager),
+		ServerSideApply:        ptr.To(a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.SyncOptions.HasOption(common.SyncOptionServerSideApply)),
+		InstallationID:         ptr.To(installationID),
+	}, nil
+}
+
+// GetDeployedRevisionAuthor returns the commit metadata of the revision the application was last synced to, according to
+// its history, along with who deployed it and when. The repo server only reports the commit author, not the committer.
+func (s *Server) GetDeployedRevisionAuthor(ctx context.Context, q *application.DeployedRevisionAuthorQuery) (*application.DeployedRevisionAuthorResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	history, source, revision, err := getDeployedRevision(a, q.SourceIndex)
+	if err != nil {
+		return nil, err
+	}
+	if source.IsHelm() || source.IsOCI() {
+		return nil, status.Errorf(codes.InvalidArgument, "commit metadata is only available for git sources")
+	}
+
+	repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	}
+	defer utilio.Close(conn)
+	metadata, err := repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:           repo,
+		Revision:       revision,
+		CheckSignature: len(proj.Spec.SignatureKeys) > 0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting revision metadata: %w", err)
+	}
+
+	return &application.DeployedRevisionAuthorResponse{
+		Revision:   ptr.To(revision),
+		Author:     ptr.To(metadata.Author),
+		Date:       metadata.Date,
+		Message:    ptr.To(metadata.Message),
+		DeployedBy: ptr.To(history.InitiatedBy.Username),
+		DeployedAt: &history.DeployedAt,
+	}, nil
+}
+
+// getDeployedRevision returns the last history entry of the application, together with the source of the given index
+// and the revision that source was synced to
+func getDeployedRevision(a *v1alpha1.Application, sourceIndex *int32) (*v1alpha1.RevisionHistory, *v1alpha1.ApplicationSource, string, error) {
+	if len(a.Status.History) == 0 {
+		return nil, nil, "", status.Errorf(codes.FailedPrecondition, "application %s has not been synced yet", a.QualifiedName())
+	}
+
+	idx := int(ptr.Deref(sourceIndex, 0))
+	if idx < 0 {
+		return nil, nil, "", status.Errorf(codes.InvalidArgument, "source index must not be negative")
+	}
+	history := a.Status.History.LastRevisionHistory()
+	versionId := int32(history.ID)
+	source, err := getAppSourceBySourceIndexAndVersionId(a, sourceIndex, &versionId)
+	if err != nil {
+		return nil, nil, "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	revision := history.Revision
+	if len(history.Revisions) > 0 {
+		if idx >= len(history.Revisions) {
+			return nil, nil, "", status.Errorf(codes.InvalidArgument, "source index %d not found because history entry %d has only %d revisions", idx, history.ID, len(history.Revisions))
+		}
+		revision = history.Revisions[idx]
+	}
+	return &history, &source, revision, nil
+}
+
+// VerifyDeployedRevisionSignature verifies the GnuPG signature of the revision the application was last synced to, for
+// applications of projects which enforce signature verification. The revision is only considered verified if it has
+// a good signature made with one of the keys allowed by the project.
+func (s *Server) VerifyDeployedRevisionSignature(ctx context.Context, q *application.DeployedRevisionSignatureQuery) (*application.DeployedRevisionSignatureResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if len(proj.Spec.SignatureKeys) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "project %s does not enforce signature verification", proj.Name)
+	}
+	_, source, revision, err := getDeployedRevision(a, q.SourceIndex)
+	if err != nil {
+		return nil, err
+	}
+	if source.IsHelm() || source.IsOCI() {
+		return nil, status.Errorf(codes.InvalidArgument, "signature verification is only available for git sources")
+	}
+
+	repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	}
+	defer utilio.Close(conn)
+	metadata, err := repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:           repo,
+		Revision:       revision,
+		CheckSignature: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting revision metadata: %w", err)
+	}
+
+	result, keyID := parseSignatureInfo(metadata.SignatureInfo)
+	allowed := false
+	if keyID != "" {
+		for _, k := range proj.Spec.SignatureKeys {
+			if gpg.KeyID(k.KeyID) == keyID {
+				allowed = true
+				break
+			}
+		}
+	}
+	return &application.DeployedRevisionSignatureResponse{
+		Revision:   ptr.To(revision),
+		Result:     ptr.To(result),
+		KeyID:      ptr.To(keyID),
+		KeyAllowed: ptr.To(allowed),
+		Verified:   ptr.To(result == gpg.VerifyResultGood && allowed),
+		Message:    ptr.To(metadata.SignatureInfo),
+	}, nil
+}
+
+// unsignedRevisionSignatureInfo is the signature info the repo server reports for a revision without a signature
+const unsignedRevisionSignatureInfo = "Revision is not signed."
+
+// signatureInfoPattern matches the signature info the repo server reports for a signed revision, e.g.
+// "Good signature from RSA key 4AEE18F83AFDEB23"
+var signatureInfoPattern = regexp.MustCompile(`^(\w+) signature from .* key (\w+)$`)
+
+// parseSignatureInfo returns the verification result and signer key ID of the signature info of a revision. An unsigned
+// revision has the result "Unsigned", and info which cannot be parsed the result gpg.VerifyResultUnknown.
+func parseSignatureInfo(info string) (string, string) {
+	if info == unsignedRevisionSignatureInfo {
+		return "Unsigned", ""
+	}
+	m := signatureInfoPattern.FindStringSubmatch(info)
+	if m == nil {
+		return gpg.VerifyResultUnknown, ""
+	}
+	return m[1], gpg.KeyID(m[2])
+}
+
+// GetResolvedSourceParameters returns, per source generating manifests, the parameters it contributes after resolving
+// the value files it reads from ref sources. For Helm sources these are the values of the value files, overridden by
+// the parameters set in the source spec, the same way they are passed to helm template. Other source types only report
+// their type, since their parameters do not depend on other sources.
+func (s *Server) GetResolvedSourceParameters(ctx context.Context, q *application.ApplicationResolvedSourceParametersQuery) (*application.ApplicationResolvedSourceParametersResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	sources := a.Spec.GetSources()
+	refSources, err := argo.GetRefSources(ctx, sources, proj.Name, s.db.GetRepository, []string{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ref sources: %w", err)
+	}
+	kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting kustomize settings: %w", err)
+	}
+	trackingMethod, err := s.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, fmt.Errorf("error getting trackingMethod from settings: %w", err)
+	}
+
+	res := &application.ApplicationResolvedSourceParametersResponse{}
+	err = s.queryRepoServer(ctx, proj, func(
+		client apiclient.RepoServerServiceClient,
+		helmRepos []*v1alpha1.Repository,
+		_ []*v1alpha1.RepoCreds,
+		_ []*v1alpha1.Repository,
+		_ []*v1alpha1.RepoCreds,
+		helmOptions *v1alpha1.HelmOptions,
+		enabledSourceTypes map[string]bool,
+	) error {
+		for i := range sources {
+			source := sources[i]
+			if source.IsRef() {
+				continue
+			}
+			result := &application.ResolvedSourceParameters{
+				SourceIndex: ptr.To(int32(i)),
+				RepoURL:     ptr.To(source.RepoURL),
+				Path:        ptr.To(source.Path),
+				Chart:       ptr.To(source.Chart),
+				RefSources:  valueFilesRefSources(source),
+			}
+			res.Sources = append(res.Sources, result)
+
+			repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+			if err != nil {
+				return fmt.Errorf("error getting repository: %w", err)
+			}
+			details, err := client.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
+				Repo:               repo,
+				Source:             &source,
+				AppName:            a.Name,
+				KustomizeOptions:   kustomizeSettings,
+				Repos:              helmRepos,
+				TrackingMethod:     trackingMethod,
+				EnabledSourceTypes: enabledSourceTypes,
+				HelmOptions:        helmOptions,
+				RefSources:         refSources,
+			})
+			if err != nil {
+				result.Error = ptr.To(err.Error())
+				continue
+			}
+			result.Type = ptr.To(details.Type)
+			if details.Helm != nil {
+				result.Parameters = resolvedHelmParameters(details.Helm.Parameters, source.Helm)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListSourceRevisionHistory returns a page of the application's history, newest entry first. Each entry lists the
+// revision of every source, so that clients do not have to pair the parallel sources and revisions of multi-source
+// entries, nor tell them apart from single source entries.
+func (s *Server) ListSourceRevisionHistory(ctx context.Context, q *application.ApplicationSourceRevisionHistoryQuery) (*application.ApplicationSourceRevisionHistoryResponse, error) {
+	if q.GetLimit() < 0 || q.GetOffset() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	history := a.Status.History
+	res := &application.ApplicationSourceRevisionHistoryResponse{Total: ptr.To(int64(len(history)))}
+	for i := len(history) - 1 - int(q.GetOffset()); i >= 0; i-- {
+		if q.GetLimit() > 0 && int64(len(res.Items)) == q.GetLimit() {
+			break
+		}
+		h := history[i]
+		res.Items = append(res.Items, &application.SourceRevisionHistoryEntry{
+			Id:              ptr.To(h.ID),
+			DeployedAt:      h.DeployedAt.DeepCopy(),
+			DeployStartedAt: h.DeployStartedAt.DeepCopy(),
+			InitiatedBy:     h.InitiatedBy.DeepCopy(),
+			Sources:         historySourceRevisions(h),
+		})
+	}
+	return res, nil
+}
+
+// historySourceRevisions returns the revision record of each source of a history entry. Entries of multi-source
+// applications have parallel sources and revisions, those of single source applications a single source and revision.
+func historySourceRevisions(h v1alpha1.RevisionHistory) []*application.SourceRevision {
+	if len(h.Sources) == 0 {
+		return []*application.SourceRevision{sourceRevision(0, h.Source, h.Revision)}
+	}
+	revisions := make([]*application.SourceRevision, 0, len(h.Sources))
+	for i, source := range h.Sources {
+		var revision string
+		if i < len(h.Revisions) {
+			revision = h.Revisions[i]
+		}
+		revisions = append(revisions, sourceRevision(i, source, revision))
+	}
+	return revisions
+}
+
+func sourceRevision(sourceIndex int, source v1alpha1.ApplicationSource, revision string) *application.SourceRevision {
+	displayRevision := revision
+	// chart versions and tags are displayed as is, only commit SHAs are abbreviated
+	if !source.IsHelm() && git.IsCommitSHA(revision) {
+		displayRevision = revision[:7]
+	}
+	return &application.SourceRevision{
+		SourceIndex:     ptr.To(int32(sourceIndex)),
+		SourceName:      ptr.To(source.Name),
+		RepoURL:         ptr.To(source.RepoURL),
+		Path:            ptr.To(source.Path),
+		Chart:           ptr.To(source.Chart),
+		Revision:        ptr.To(revision),
+		DisplayRevision: ptr.To(displayRevision),
+	}
+}
+
+const (
+	revisionTypeCommitSHA        = "CommitSHA"
+	revisionTypeSemverVersion    = "SemverVersion"
+	revisionTypeSemverConstraint = "SemverConstraint"
+	revisionTypeDefault          = "DefaultRevision"
+	revisionTypeBranchOrTag      = "BranchOrTag"
+)
+
+// GetRevisionPinningStatus classifies the target revision of each source of the application as pinned (a commit SHA
+// or an exact semver version) or floating (a branch, HEAD, a tag like latest or a semver constraint).
+func (s *Server) GetRevisionPinningStatus(ctx context.Context, q *application.ApplicationRevisionPinningStatusQuery) (*application.ApplicationRevisionPinningStatusResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &application.ApplicationRevisionPinningStatusResponse{Pinned: ptr.To(true)}
+	for i, source := range a.Spec.GetSources() {
+		revisionType := classifyTargetRevision(source)
+		pinned := revisionType == revisionTypeCommitSHA || revisionType == revisionTypeSemverVersion
+		if !pinned {
+			res.Pinned = ptr.To(false)
+		}
+		res.Sources = append(res.Sources, &application.RevisionPinningStatus{
+			SourceIndex:    ptr.To(int32(i)),
+			SourceName:     ptr.To(source.Name),
+			RepoURL:        ptr.To(source.RepoURL),
+			Path:           ptr.To(source.Path),
+			Chart:          ptr.To(source.Chart),
+			TargetRevision: ptr.To(source.TargetRevision),
+			Pinned:         ptr.To(pinned),
+			RevisionType:   ptr.To(revisionType),
+		})
+	}
+	return res, nil
+}
+
+// classifyTargetRevision returns the kind of the target revision of the source. Commit SHAs only identify content in
+// Git repositories, Helm chart versions are pinned if they are exact semver versions.
+func classifyTargetRevision(source v1alpha1.ApplicationSource) string {
+	revision := source.TargetRevision
+	switch {
+	case revision == "" || strings.EqualFold(revision, "HEAD"):
+		return revisionTypeDefault
+	case !source.IsHelm() && (git.IsCommitSHA(revision) || git.IsTruncatedCommitSHA(revision)):
+		return revisionTypeCommitSHA
+	case versions.IsVersion(revision):
+		return revisionTypeSemverVersion
+	case versions.IsConstraint(revision):
+		return revisionTypeSemverConstraint
+	default:
+		return revisionTypeBranchOrTag
+	}
+}
+
+// GetKustomizeDetails returns, per Kustomize source of the application, the Kustomize configuration it is built with: the
+// version and build options resolved from the settings, the overrides of the source spec, and the images of the built
+// manifests as reported by the repo server.
+func (s *Server) GetKustomizeDetails(ctx context.Context, q *application.ApplicationKustomizeDetailsQuery) (*application.ApplicationKustomizeDetailsResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting kustomize settings: %w", err)
+	}
+	trackingMethod, err := s.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, fmt.Errorf("error getting trackingMethod from settings: %w", err)
+	}
+
+	res := &application.ApplicationKustomizeDetailsResponse{}
+	err = s.queryRepoServer(ctx, proj, func(
+		client apiclient.RepoServerServiceClient,
+		helmRepos []*v1alpha1.Repository,
+		_ []*v1alpha1.RepoCreds,
+		_ []*v1alpha1.Repository,
+		_ []*v1alpha1.RepoCreds,
+		helmOptions *v1alpha1.HelmOptions,
+		enabledSourceTypes map[string]bool,
+	) error {
+		for i, source := range a.Spec.GetSources() {
+			if source.IsRef() {
+				continue
+			}
+			repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+			if err != nil {
+				return fmt.Errorf("error getting repository: %w", err)
+			}
+			details, err := client.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
+				Repo:               repo,
+				Source:             &source,
+				AppName:            a.Name,
+				KustomizeOptions:   kustomizeSettings,
+				Repos:              helmRepos,
+				TrackingMethod:     trackingMethod,
+				EnabledSourceTypes: enabledSourceTypes,
+				HelmOptions:        helmOptions,
+			})
+			// a source which could not be built is only reported if it is known to be a Kustomize source
+			if (err == nil && details.Kustomize == nil) || (err != nil && source.Kustomize == nil) {
+				continue
+			}
+			result := kustomizeSourceDetails(i, source, kustomizeSettings, a.Spec.Destination.Namespace)
+			if err != nil {
+				result.Error = ptr.To(err.Error())
+			} else {
+				result.Images = details.Kustomize.Images
+			}
+			res.Sources = append(res.Sources, result)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// kustomizeSourceDetails returns the Kustomize configuration of a source resolved from the Kustomize settings, without
+// the images of its built manifests
+func kustomizeSourceDetails(sourceIndex int, source v1alpha1.ApplicationSource, kustomizeSettings *v1alpha1.KustomizeOptions, destNamespace string) *application.KustomizeSourceDetails {
+	result := &application.KustomizeSourceDetails{
+		SourceIndex:  ptr.To(int32(sourceIndex)),
+		RepoURL:      ptr.To(source.RepoURL),
+		Path:         ptr.To(source.Path),
+		Namespace:    ptr.To(destNamespace),
+		BuildOptions: ptr.To(kustomizeSettings.BuildOptions),
+	}
+	if binaryPath, err := settings.GetKustomizeBinaryPath(kustomizeSettings, source); err != nil {
+		result.Error = ptr.To(err.Error())
+	} else {
+		result.BinaryPath = ptr.To(binaryPath)
+	}
+	if k := source.Kustomize; k != nil {
+		result.Version = ptr.To(k.Version)
+		if k.Namespace != "" {
+			result.Namespace = ptr.To(k.Namespace)
+		}
+		result.NamePrefix = ptr.To(k.NamePrefix)
+		result.NameSuffix = ptr.To(k.NameSuffix)
+		result.Components = k.Components
+		for _, image := range k.Images {
+			result.ImageOverrides = append(result.ImageOverrides, string(image))
+		}
+	}
+	return result
+}
+
+// valueFilesRefSources returns the distinct ref sources the Helm value files of the source are read from
+func valueFilesRefSources(source v1alpha1.ApplicationSource) []string {
+	if source.Helm == nil {
+		return nil
+	}
+	var refs []string
+	for _, valueFile := range source.Helm.ValueFiles {
+		if !strings.HasPrefix(valueFile, "$") {
+			continue
+		}
+		ref, _, _ := strings.Cut(valueFile, "/")
+		if !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// resolvedHelmParameters returns the values of the resolved value files, overridden by the parameters of the Helm
+// source, sorted by name
+func resolvedHelmParameters(values []*v1alpha1.HelmParameter, helmSource *v1alpha1.ApplicationSourceHelm) []*application.ResolvedParameter {
+	params := map[string]*application.ResolvedParameter{}
+	for _, v := range values {
+		params[v.Name] = &application.ResolvedParameter{Name: ptr.To(v.Name), Value: ptr.To(v.Value), Origin: ptr.To("values")}
+	}
+	if helmSource != nil {
+		for _, p := range helmSource.Parameters {
+			params[p.Name] = &application.ResolvedParameter{Name: ptr.To(p.Name), Value: ptr.To(p.Value), Origin: ptr.To("parameter")}
+		}
+	}
+	res := slices.Collect(maps.Values(params))
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].GetName() < res[j].GetName()
+	})
+	return res
+}
+
+// RevisionChartDetails returns the helm chart metadata, as fetched from the reposerver
+func (s *Server) RevisionChartDetails(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.ChartDetails, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := getAppSourceBySourceIndexAndVersionId(a, q.SourceIndex, q.VersionId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app source by source index and version ID: %w", err)
+	}
+
+	if source.Chart == "" {
+		return nil, fmt.Errorf("no chart found for application: %v", q.GetName())
+	}
+	repo, err := s.db.GetRepository(ctx, source.RepoURL, a.Spec.Project)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	}
+	defer utilio.Close(conn)
+	return repoClient.GetRevisionChartDetails(ctx, &apiclient.RepoServerRevisionChartDetailsRequest{
+		Repo:     repo,
+		Name:     source.Chart,
+		Revision: q.GetRevision(),
+	})
+}
+
+func (s *Server) GetOCIMetadata(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.OCIMetadata, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := getAppSourceBySourceIndexAndVersionId(a, q.SourceIndex, q.VersionId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app source by source index and version ID: %w", err)
+	}
+
+	repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	}
+	defer utilio.Close(conn)
+
+	return repoClient.GetOCIMetadata(ctx, &apiclient.RepoServerRevisionChartDetailsRequest{
+		Repo:     repo,
+		Name:     source.Chart,
+		Revision: q.GetRevision(),
+	})
+}
+
+// getAppSourceBySourceIndexAndVersionId returns the source for a specific source index and version ID. Source index and
+// version ID are optional. If the source index is not specified, it defaults to 0. If the version ID is not specified,
+// we use the source(s) currently configured for the app. If the version ID is specified, we find the source for that
+// version ID. If the version ID is not found, we return an error. If the source index is out of bounds for whichever
+// source we choose (configured sources or sources for a specific version), we return an error.
+func getAppSourceBySourceIndexAndVersionId(a *v1alpha1.Application, sourceIndexMaybe *int32, versionIdMaybe *int32) (v1alpha1.ApplicationSource, error) {
+	// Start with all the app's configured sources.
+	sources := a.Spec.GetSources()
+
+	// If the user specified a version, get the sources for that version. If the version is not found, return an error.
+	if versionIdMaybe != nil {
+		versionId := int64(*versionIdMaybe)
+		var err error
+		sources, err = getSourcesByVersionId(a, versionId)
+		if err != nil {
+			return v1alpha1.ApplicationSource{}, fmt.Errorf("error getting source by version ID: %w", err)
+		}
+	}
+
+	// Start by assuming we want the first source.
+	sourceIndex := 0
+
+	// If the user specified a source index, use that instead.
+	if sourceIndexMaybe != nil {
+		sourceIndex = int(*sourceIndexMaybe)
+		if sourceIndex >= len(sources) {
+			if len(sources) == 1 {
+				return v1alpha1.ApplicationSource{}, fmt.Errorf("source index %d not found because there is only 1 source", sourceIndex)
+			}
+			return v1alpha1.ApplicationSource{}, fmt.Errorf("source index %d not found because there are only %d sources", sourceIndex, len(sources))
+		}
+	}
+
+	source := sources[sourceIndex]
+
+	return source, nil
+}
+
+// getRevisionHistoryByVersionId returns the revision history for a specific version ID.
+// If the version ID is not found, it returns an empty revision history and false.
+func getRevisionHistoryByVersionId(histories v1alpha1.RevisionHistories, versionId int64) (v1alpha1.RevisionHistory, bool) {
+	for _, h := range histories {
+		if h.ID == versionId {
+			return h, true
+		}
+	}
+	return v1alpha1.RevisionHistory{}, false
+}
+
+// getSourcesByVersionId returns the sources for a specific version ID. If there is no history, it returns an error.
+// If the version ID is not found, it returns an error. If the version ID is found, and there are multiple sources,
+// it returns the sources for that version ID. If the version ID is found, and there is only one source, it returns
+// a slice with just the single source.
+func getSourcesByVersionId(a *v1alpha1.Application, versionId int64) ([]v1alpha1.ApplicationSource, error) {
+	if len(a.Status.History) == 0 {
+		return nil, fmt.Errorf("version ID %d not found because the app has no history", versionId)
+	}
+
+	h, ok := getRevisionHistoryByVersionId(a.Status.History, versionId)
+	if !ok {
+		return nil, fmt.Errorf("revision history not found for version ID %d", versionId)
+	}
+
+	if len(h.Sources) > 0 {
+		return h.Sources, nil
+	}
+
+	return []v1alpha1.ApplicationSource{h.Source}, nil
+}
+
+func isMatchingResource(q *application.ResourcesQuery, key kube.ResourceKey) bool {
+	return (q.GetName() == "" || q.GetName() == key.Name) &&
+		(q.GetNamespace() == "" || q.GetNamespace() == key.Namespace) &&
+		(q.GetGroup() == "" || q.GetGroup() == key.Group) &&
+		(q.GetKind() == "" || q.GetKind() == key.Kind)
+}
+
+func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQuery) (*application.ManagedResourcesResponse, error) {
+	items, err := s.getManagedResources(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	res := &application.ManagedResourcesResponse{Items: items}
+	for _, item := range items {
+		if !item.Hook {
+			continue
+		}
+		hookTypes, err := resourceDiffHookTypes(item)
+		if err != nil {
+			return nil, err
+		}
+		res.Hooks = append(res.Hooks, &application.ManagedResourceHook{
+			Group:     ptr.To(item.Group),
+			Kind:      ptr.To(item.Kind),
+			Namespace: ptr.To(item.Namespace),
+			Name:      ptr.To(item.Name),
+			Types:     hookTypes,
+		})
+	}
+	return res, nil
+}
+
+// resourceDiffHookTypes returns the hook types of a hook, read from its target state or, if it has none, its live state
+func resourceDiffHookTypes(item *v1alpha1.ResourceDiff) ([]string, error) {
+	state := item.TargetState
+	if state == "" || state == "null" {
+		state = item.LiveState
+	}
+	if state == "" || state == "null" {
+		return nil, nil
+	}
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal([]byte(state), obj); err != nil {
+		return nil, fmt.Errorf("error unmarshaling state of hook %s: %w", item.FullName(), err)
+	}
+	var hookTypes []string
+	for _, hookType := range hook.Types(obj) {
+		hookTypes = append(hookTypes, string(hookType))
+	}
+	return hookTypes, nil
+}
+
+// StreamManagedResources returns the same resource diffs as ManagedResources, but sends them one at a time so that
+// clients can render the diffs of applications with many resources progressively.
+func (s *Server) StreamManagedResources(q *application.ResourcesQuery, ws application.ApplicationService_StreamManagedResourcesServer) error {
+	items, err := s.getManagedResources(ws.Context(), q)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := ws.Send(item); err != nil {
+			return fmt.Errorf("error sending managed resource: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetSyncWaves returns the application's managed resources grouped by the value of their sync-wave annotation, in the
+// order the waves are applied during a sync. Hooks are excluded since they run in their own sync phases.
+func (s *Server) GetSyncWaves(ctx context.Context, q *application.ResourcesQuery) (*application.ApplicationSyncWavesResponse, error) {
+	items, err := s.getManagedResources(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	resourcesByWave := make(map[int64][]*v1alpha1.ResourceRef)
+	for _, item := range items {
+		if item.Hook {
+			continue
+		}
+		state := item.TargetState
+		if state == "" || state == "null" {
+			// resources which are about to be pruned only have a live state
+			state = item.LiveState
+		}
+		obj := &unstructured.Unstructured{}
+		if state != "" && state != "null" {
+			if err := json.Unmarshal([]byte(state), obj); err != nil {
+				return nil, fmt.Errorf("error unmarshaling state of resource %s: %w", item.FullName(), err)
+			}
+		}
+		wave := int64(syncwaves.Wave(obj))
+		resourcesByWave[wave] = append(resourcesByWave[wave], &v1alpha1.ResourceRef{
+			Group:     item.Group,
+			Version:   obj.GroupVersionKind().Version,
+			Kind:      item.Kind,
+			Namespace: item.Namespace,
+			Name:      item.Name,
+		})
+	}
+
+	res := &application.ApplicationSyncWavesResponse{}
+	for wave, resources := range resourcesByWave {
+		res.Waves = append(res.Waves, &application.ApplicationSyncWave{Wave: ptr.To(wave), Resources: resources})
+	}
+	sort.Slice(res.Waves, func(i, j int) bool {
+		return res.Waves[i].GetWave() < res.Waves[j].GetWave()
+	})
+	return res, nil
+}
+
+// syncOptionImpact describes how the sync of a resource changes when a sync option is enabled or disabled
+type syncOptionImpact struct {
+	// enabledValue is the value of the option which enables the behavior
+	enabledValue string
+	// affects returns whether the sync of a resource which is applied, pruned, or neither because it is in sync,
+	// depends on the option
+	affects        func(applied, pruned bool) bool
+	enabledImpact  string
+	disabledImpact string
+}
+
+func appliedResource(applied, _ bool) bool {
+	return applied
+}
+
+// syncOptionImpacts are the application-level sync options whose impact on a sync can be previewed
+var syncOptionImpacts = map[string]syncOptionImpact{
+	"ServerSideApply": {
+		enabledValue:   "true",
+		affects:        appliedResource,
+		enabledImpact:  "applied with server-side apply instead of client-side apply",
+		disabledImpact: "applied with client-side apply instead of server-side apply",
+	},
+	"Replace": {
+		enabledValue:   "true",
+		affects:        appliedResource,
+		enabledImpact:  "replaced instead of applied",
+		disabledImpact: "applied instead of replaced",
+	},
+	"Validate": {
+		enabledValue:   "false",
+		affects:        appliedResource,
+		enabledImpact:  "applied without schema validation",
+		disabledImpact: "applied with schema validation",
+	},
+	"ApplyOutOfSyncOnly": {
+		enabledValue: "true",
+		affects: func(applied, pruned bool) bool {
+			return !applied && !pruned
+		},
+		enabledImpact:  "skipped since it is in sync",
+		disabledImpact: "applied although it is in sync",
+	},
+	"PruneLast": {
+		enabledValue: "true",
+		affects: func(_, pruned bool) bool {
+			return pruned
+		},
+		enabledImpact:  "pruned after all other resources are synced and healthy",
+		disabledImpact: "pruned in its sync wave instead of after all other resources",
+	},
+}
+
+// PreviewSyncOptionImpact describes how the sync of the application's managed resources would change if the given
+// sync option was set in the sync policy, based on the current diff between the target and the live state. Resources
+// which set the option in their own sync-options annotation are not affected.
+func (s *Server) PreviewSyncOptionImpact(ctx context.Context, q *application.ApplicationSyncOptionImpactRequest) (*application.ApplicationSyncOptionImpactResponse, error) {
+	key, value, ok := strings.Cut(q.GetSyncOption(), "=")
+	impact, supported := syncOptionImpacts[key]
+	if !ok || !supported {
+		return nil, status.Errorf(codes.InvalidArgument, "sync option %q is not supported, supported options are %s", q.GetSyncOption(), strings.Join(slices.Sorted(maps.Keys(syncOptionImpacts)), ", "))
+	}
+	if value != "true" && value != "false" {
+		return nil, status.Errorf(codes.InvalidArgument, "sync option %s must be either true or false", key)
+	}
+
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionSync, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	var syncOptions v1alpha1.SyncOptions
+	if a.Spec.SyncPolicy != nil {
+		syncOptions = a.Spec.SyncPolicy.SyncOptions
+	}
+	enabled := value == impact.enabledValue
+	res := &application.ApplicationSyncOptionImpactResponse{
+		Changed: ptr.To(enabled != syncOptions.HasOption(key+"="+impact.enabledValue)),
+	}
+	if !res.GetChanged() {
+		return res, nil
+	}
+
+	items, err := s.getManagedResources(ctx, &application.ResourcesQuery{
+		ApplicationName: q.Name,
+		AppNamespace:    q.AppNamespace,
+		Project:         q.Project,
+	})
+	if err != nil {
+		return nil, err
+	}
+	description := impact.disabledImpact
+	if enabled {
+		description = impact.enabledImpact
+	}
+	for _, item := range items {
+		hasTarget := item.TargetState != "" && item.TargetState != "null"
+		hasLive := item.LiveState != "" && item.LiveState != "null"
+		applied := hasTarget && (!hasLive || item.Modified)
+		pruned := !hasTarget && hasLive
+		if !impact.affects(applied, pruned) {
+			continue
+		}
+		state := item.TargetState
+		if !hasTarget {
+			state = item.LiveState
+		}
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(state), obj); err != nil {
+			return nil, fmt.Errorf("error unmarshaling state of resource %s: %w", item.FullName(), err)
+		}
+		if resourceSetsSyncOption(obj, key) {
+			continue
+		}
+		res.Resources = append(res.Resources, &application.SyncOptionResourceImpact{
+			Group:     ptr.To(item.Group),
+			Kind:      ptr.To(item.Kind),
+			Namespace: ptr.To(item.Namespace),
+			Name:      ptr.To(item.Name),
+			Impact:    ptr.To(description),
+		})
+	}
+	return res, nil
+}
+
+const (
+	syncOptionSourceApplication = "application"
+	syncOptionSourceRequest     = "request"
+)
+
+// resolveSyncOptions returns the sync options a sync of the application uses: the options of the sync request if set,
+// otherwise the options of the sync policy. The source is "request" or "application" accordingly.
+func resolveSyncOptions(a *v1alpha1.Application, requested *application.SyncOptions) (v1alpha1.SyncOptions, string) {
+	if requested != nil {
+		return requested.Items, syncOptionSourceRequest
+	}
+	if a.Spec.SyncPolicy != nil {
+		return a.Spec.SyncPolicy.SyncOptions, syncOptionSourceApplication
+	}
+	return nil, syncOptionSourceApplication
+}
+
+// syncOptionRejectionReason returns why the API server rejects syncs with the given options, or an empty string if it
+// allows them
+func (s *Server) syncOptionRejectionReason(syncOptions v1alpha1.SyncOptions) string {
+	if syncOptions.HasOption(common.SyncOptionReplace) && !s.syncWithReplaceAllowed {
+		return "sync with replace was disabled on the API Server level via the server configuration"
+	}
+	return ""
+}
+
+// GetResolvedSyncOptions returns the sync options a sync of the application would use, with the options the API server
+// would reject marked as not allowed. Sync options are only set by applications and sync requests, projects have none.
+func (s *Server) GetResolvedSyncOptions(ctx context.Context, q *application.ApplicationResolvedSyncOptionsQuery) (*application.ApplicationResolvedSyncOptionsResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	syncOptions, source := resolveSyncOptions(a, q.SyncOptions)
+	res := &application.ApplicationResolvedSyncOptionsResponse{Allowed: ptr.To(true)}
+	for _, option := range syncOptions {
+		name, value, _ := strings.Cut(option, "=")
+		reason := s.syncOptionRejectionReason(v1alpha1.SyncOptions{option})
+		resolved := &application.ResolvedSyncOption{
+			Option:  ptr.To(option),
+			Name:    ptr.To(name),
+			Value:   ptr.To(value),
+			Source:  ptr.To(source),
+			Allowed: ptr.To(reason == ""),
+		}
+		if reason != "" {
+			resolved.Reason = ptr.To(reason)
+			res.Allowed = ptr.To(false)
+		}
+		res.Options = append(res.Options, resolved)
+	}
+	return res, nil
+}
+
+// syncOptionPruneConfirm is the sync option of resources which are only pruned once the pruning is confirmed
+const syncOptionPruneConfirm = "Prune=confirm"
+
+// GetPrunePreview returns the live resources a sync with Prune=true would delete because they are no longer part of the
+// manifests generated for the revision to sync to. Resources which disable pruning with the Prune=false sync option are
+// reported as not pruned, and the ones requiring confirmation with a message saying so.
+func (s *Server) GetPrunePreview(ctx context.Context, q *application.ApplicationPrunePreviewQuery) (*application.ApplicationPrunePreviewResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionSync, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := s.GetManifests(ctx, &application.ApplicationManifestQuery{
+		Name:            q.Name,
+		AppNamespace:    q.AppNamespace,
+		Project:         q.Project,
+		Revision:        q.Revision,
+		SourcePositions: q.SourcePositions,
+		Revisions:       q.Revisions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error generating manifests: %w", err)
+	}
+	targets := make(map[kube.ResourceKey]bool, len(manifests.Manifests))
+	for i, manifest := range manifests.Manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest %d: %w", i, err)
+		}
+		gk := obj.GroupVersionKind().GroupKind()
+		targets[kube.NewResourceKey(gk.Group, gk.Kind, obj.GetNamespace(), obj.GetName())] = true
+		// the controller applies namespaced resources without a namespace to the destination namespace
+		if obj.GetNamespace() == "" {
+			targets[kube.NewResourceKey(gk.Group, gk.Kind, a.Spec.Destination.Namespace, obj.GetName())] = true
+		}
+	}
+
+	items, err := s.getManagedResources(ctx, &application.ResourcesQuery{
+		ApplicationName: q.Name,
+		AppNamespace:    q.AppNamespace,
+		Project:         q.Project,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := &application.ApplicationPrunePreviewResponse{}
+	for _, item := range items {
+		if item.LiveState == "" || item.LiveState == "null" || targets[kube.NewResourceKey(item.Group, item.Kind, item.Namespace, item.Name)] {
+			continue
+		}
+		live := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(item.LiveState), live); err != nil {
+			return nil, fmt.Errorf("error unmarshaling live state of resource %s: %w", item.FullName(), err)
+		}
+		candidate := &application.PruneCandidate{
+			Resource: &v1alpha1.ResourceRef{Group: item.Group, Kind: item.Kind, Namespace: item.Namespace, Name: item.Name},
+			Pruned:   ptr.To(true),
+		}
+		switch {
+		case resourceutil.HasAnnotationOption(live, common.AnnotationSyncOptions, common.SyncOptionDisablePrune):
+			candidate.Pruned = ptr.To(false)
+			candidate.Message = ptr.To("pruning is disabled by the " + common.SyncOptionDisablePrune + " sync option")
+		case resourceutil.HasAnnotationOption(live, common.AnnotationSyncOptions, syncOptionPruneConfirm):
+			candidate.Message = ptr.To("pruning requires confirmation due to the " + syncOptionPruneConfirm + " sync option")
+		}
+		res.Resources = append(res.Resources, candidate)
+	}
+	return res, nil
+}
+
+// resourceSetsSyncOption returns whether the sync-options annotation of the resource sets the option with the given key
+func resourceSetsSyncOption(obj *unstructured.Unstructured, key string) bool {
+	for _, option := range strings.Split(obj.GetAnnotations()[common.AnnotationSyncOptions], ",") {
+		if strings.HasPrefix(strings.TrimSpace(option), key+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSyncBlastRadius summarizes the changes a sync to the requested revision would make, without syncing. The manifests
+// generated for the revision are compared with the managed resources: resources without a live state would be added,
+// live resources missing from the manifests would be pruned unless they disable pruning, and the others would be
+// modified if they are out of sync. The cached diff of a resource is only used if its manifest is the one it was computed
+// for, otherwise the resource is counted as modified.
+func (s *Server) GetSyncBlastRadius(ctx context.Context, q *application.ApplicationSyncBlastRadiusQuery) (*application.ApplicationSyncBlastRadiusResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionSync, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := s.GetManifests(ctx, &application.ApplicationManifestQuery{
+		Name:            q.Name,
+		AppNamespace:    q.AppNamespace,
+		Project:         q.Project,
+		Revision:        q.Revision,
+		SourcePositions: q.SourcePositions,
+		Revisions:       q.Revisions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error generating manifests: %w", err)
+	}
+	items, err := s.getManagedResources(ctx, &application.ResourcesQuery{
+		ApplicationName: q.Name,
+		AppNamespace:    q.AppNamespace,
+		Project:         q.Project,
+	})
+	if err != nil {
+		return nil, err
+	}
+	managed := make(map[kube.ResourceKey]*v1alpha1.ResourceDiff, len(items))
+	for _, item := range items {
+		managed[kube.NewResourceKey(item.Group, item.Kind, item.Namespace, item.Name)] = item
+	}
+
+	radius := newBlastRadius()
+	targets := make(map[kube.ResourceKey]bool, len(manifests.Manifests))
+	for i, manifest := range manifests.Manifests {
+		target, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest %d: %w", i, err)
+		}
+		gk := target.GroupVersionKind().GroupKind()
+		key := kube.NewResourceKey(gk.Group, gk.Kind, target.GetNamespace(), target.GetName())
+		item, ok := managed[key]
+		// the controller applies namespaced resources without a namespace to the destination namespace
+		if !ok && target.GetNamespace() == "" {
+			key = kube.NewResourceKey(gk.Group, gk.Kind, a.Spec.Destination.Namespace, target.GetName())
+			item, ok = managed[key]
+		}
+		targets[key] = true
+		if !ok || item.LiveState == "" || item.LiveState == "null" {
+			radius.add(key, blastRadiusAdded)
+			continue
+		}
+		modified, err := isBlastRadiusModified(item, target)
+		if err != nil {
+			return nil, err
+		}
+		if modified {
+			radius.add(key, blastRadiusModified)
+		} else {
+			radius.res.Unchanged = ptr.To(radius.res.GetUnchanged() + 1)
+		}
+	}
+	for key, item := range managed {
+		if targets[key] || item.LiveState == "" || item.LiveState == "null" {
+			continue
+		}
+		live := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(item.LiveState), live); err != nil {
+			return nil, fmt.Errorf("error unmarshaling live state of resource %s: %w", item.FullName(), err)
+		}
+		if !resourceutil.HasAnnotationOption(live, common.AnnotationSyncOptions, common.SyncOptionDisablePrune) {
+			radius.add(key, blastRadiusDeleted)
+		}
+	}
+	return radius.response(), nil
+}
+
+// isBlastRadiusModified returns whether a sync would update a live resource to the given target. The cached diff of the
+// resource is only relevant if it was computed for the same target.
+func isBlastRadiusModified(item *v1alpha1.ResourceDiff, target *unstructured.Unstructured) (bool, error) {
+	if item.TargetState == "" || item.TargetState == "null" {
+		return true, nil
+	}
+	cachedTarget := &unstructured.Unstructured{}
+	if err := json.Unmarshal([]byte(item.TargetState), cachedTarget); err != nil {
+		return false, fmt.Errorf("error unmarshaling target state of resource %s: %w", item.FullName(), err)
+	}
+	if target.GetNamespace() == "" {
+		target = target.DeepCopy()
+		target.SetNamespace(cachedTarget.GetNamespace())
+	}
+	if !reflect.DeepEqual(target.Object, cachedTarget.Object) {
+		return true, nil
+	}
+	outOfSync, err := resourceDiffOutOfSync(item)
+	if err != nil {
+		return false, fmt.Errorf("error comparing the states of %s: %w", item.FullName(), err)
+	}
+	return outOfSync, nil
+}
+
+type blastRadiusChange int
+
+const (
+	blastRadiusAdded blastRadiusChange = iota
+	blastRadiusModified
+	blastRadiusDeleted
+)
+
+// blastRadius accumulates the changes of a sync into an ApplicationSyncBlastRadiusResponse
+type blastRadius struct {
+	res        *application.ApplicationSyncBlastRadiusResponse
+	namespaces map[string]bool
+	kinds      map[schema.GroupKind]*application.BlastRadiusKind
+}
+
+func newBlastRadius() *blastRadius {
+	return &blastRadius{
+		res: &application.ApplicationSyncBlastRadiusResponse{
+			Added:     ptr.To(int64(0)),
+			Modified:  ptr.To(int64(0)),
+			Deleted:   ptr.To(int64(0)),
+			Unchanged: ptr.To(int64(0)),
+		},
+		namespaces: map[string]bool{},
+		kinds:      map[schema.GroupKind]*application.BlastRadiusKind{},
+	}
+}
+
+func (r *blastRadius) add(key kube.ResourceKey, change blastRadiusChange) {
+	gk := schema.GroupKind{Group: key.Group, Kind: key.Kind}
+	kind, ok := r.kinds[gk]
+	if !ok {
+		kind = &application.BlastRadiusKind{
+			Group:    ptr.To(gk.Group),
+			Kind:     ptr.To(gk.Kind),
+			Added:    ptr.To(int64(0)),
+			Modified: ptr.To(int64(0)),
+			Deleted:  ptr.To(int64(0)),
+			Workload: ptr.To(isWorkloadKind(gk)),
+		}
+		r.kinds[gk] = kind
+	}
+	switch change {
+	case blastRadiusAdded:
+		r.res.Added = ptr.To(r.res.GetAdded() + 1)
+		kind.Added = ptr.To(kind.GetAdded() + 1)
+	case blastRadiusModified:
+		r.res.Modified = ptr.To(r.res.GetModified() + 1)
+		kind.Modified = ptr.To(kind.GetModified() + 1)
+	case blastRadiusDeleted:
+		r.res.Deleted = ptr.To(r.res.GetDeleted() + 1)
+		kind.Deleted = ptr.To(kind.GetDeleted() + 1)
+	}
+	if key.Namespace != "" {
+		r.namespaces[key.Namespace] = true
+	}
+}
+
+func (r *blastRadius) response() *application.ApplicationSyncBlastRadiusResponse {
+	r.res.Namespaces = slices.Sorted(maps.Keys(r.namespaces))
+	for _, kind := range r.kinds {
+		r.res.Kinds = append(r.res.Kinds, kind)
+	}
+	sort.Slice(r.res.Kinds, func(i, j int) bool {
+		if r.res.Kinds[i].GetGroup() != r.res.Kinds[j].GetGroup() {
+			return r.res.Kinds[i].GetGroup() < r.res.Kinds[j].GetGroup()
+		}
+		return r.res.Kinds[i].GetKind() < r.res.Kinds[j].GetKind()
+	})
+	return r.res
+}
+
+// isWorkloadKind returns whether resources of the kind run pods
+func isWorkloadKind(gk schema.GroupKind) bool {
+	if _, ok := podSpecFields[gk]; ok {
+		return true
+	}
+	return gk == schema.GroupKind{Group: "batch", Kind: kube.JobKind} || gk == schema.GroupKind{Group: "batch", Kind: "CronJob"}
+}
+
+// GetIgnoreDifferencesMatches returns, per managed resource, the ignore differences rules of the application and of the
+// system-level resource overrides which apply to it. This explains why a resource can be reported as synced although
+// its live state differs from the desired state.
+func (s *Server) GetIgnoreDifferencesMatches(ctx context.Context, q *application.ResourcesQuery) (*application.ApplicationIgnoreDifferencesMatchesResponse, error) {
+	items, err := s.getManagedResources(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.appLister.Applications(s.appNamespaceOrDefault(q.GetAppNamespace())).Get(q.GetApplicationName())
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	overrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+	overrideKeys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		overrideKeys = append(overrideKeys, key)
+	}
+	sort.Strings(overrideKeys)
+
+	res := &application.ApplicationIgnoreDifferencesMatchesResponse{}
+	for _, item := range items {
+		var rules []*application.IgnoreDifferencesRuleMatch
+		for i, ignore := range a.Spec.IgnoreDifferences {
+			if ignoreDifferencesMatch(ignore.Group, ignore.Kind, ignore.Namespace, ignore.Name, item) {
+				rules = append(rules, &application.IgnoreDifferencesRuleMatch{
+					Source:                ptr.To("application"),
+					Index:                 ptr.To(int64(i)),
+					JsonPointers:          ignore.JSONPointers,
+					JqPathExpressions:     ignore.JQPathExpressions,
+					ManagedFieldsManagers: ignore.ManagedFieldsManagers,
+				})
+			}
+		}
+		for _, key := range overrideKeys {
+			ignore := overrides[key].IgnoreDifferences
+			if len(ignore.JSONPointers) == 0 && len(ignore.JQPathExpressions) == 0 && len(ignore.ManagedFieldsManagers) == 0 {
+				continue
+			}
+			group, kind, err := normalizers.GetGroupKindForOverrideKey(key)
+			if err != nil || !ignoreDifferencesMatch(group, kind, "", "", item) {
+				continue
+			}
+			rules = append(rules, &application.IgnoreDifferencesRuleMatch{
+				Source:                ptr.To("system"),
+				OverrideKey:           ptr.To(key),
+				JsonPointers:          ignore.JSONPointers,
+				JqPathExpressions:     ignore.JQPathExpressions,
+				ManagedFieldsManagers: ignore.ManagedFieldsManagers,
+			})
+		}
+		if len(rules) == 0 {
+			continue
+		}
+		res.Items = append(res.Items, &application.ResourceIgnoreDifferencesMatch{
+			Resource: &v1alpha1.ResourceRef{Group: item.Group, Kind: item.Kind, Namespace: item.Namespace, Name: item.Name},
+			Rules:    rules,
+		})
+	}
+	return res, nil
+}
+
+// GetResourceDestinations returns, per managed resource, the cluster and namespace it is applied to. Resources which
+// set their own namespace are applied to that namespace rather than the destination namespace of the application, and
+// cluster-scoped resources to no namespace at all. Resources the project does not permit to manage are reported with
+// the reason, since syncing them fails.
+func (s *Server) GetResourceDestinations(ctx context.Context, q *application.ResourcesQuery) (*application.ApplicationResourceDestinationsResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.getManagedResources(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting destination cluster: %w", err)
+	}
+
+	res := &application.ApplicationResourceDestinationsResponse{
+		Server:    ptr.To(destCluster.Server),
+		Name:      ptr.To(destCluster.Name),
+		Namespace: ptr.To(a.Spec.Destination.Namespace),
+	}
+	for _, item := range items {
+		dest := &application.ResourceDestination{
+			Resource:                    &v1alpha1.ResourceRef{Group: item.Group, Kind: item.Kind, Namespace: item.Namespace, Name: item.Name},
+			Namespace:                   ptr.To(item.Namespace),
+			OutsideDestinationNamespace: ptr.To(item.Namespace != "" && item.Namespace != a.Spec.Destination.Namespace),
+			Permitted:                   ptr.To(true),
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(schema.GroupVersionKind{Group: item.Group, Kind: item.Kind})
+		obj.SetNamespace(item.Namespace)
+		obj.SetName(item.Name)
+		if err := s.verifyResourcePermitted(destCluster, proj, obj); err != nil {
+			dest.Permitted = ptr.To(false)
+			dest.Message = ptr.To(err.Error())
+		}
+		res.Items = append(res.Items, dest)
+	}
+	return res, nil
+}
+
+// GetEffectiveIgnoreDifferences returns the ignore differences rules the application controller applies when diffing
+// the application's resources: the rules of the application spec followed by the ignore differences of the system
+// level resource overrides, in the same form the diff normalizer merges them in. Projects do not define ignore
+// differences rules of their own.
+func (s *Server) GetEffectiveIgnoreDifferences(ctx context.Context, q *application.ApplicationEffectiveIgnoreDifferencesQuery) (*application.ApplicationEffectiveIgnoreDifferencesResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+	return effectiveIgnoreDifferences(a, overrides), nil
+}
+
+func effectiveIgnoreDifferences(a *v1alpha1.Application, overrides map[string]v1alpha1.ResourceOverride) *application.ApplicationEffectiveIgnoreDifferencesResponse {
+	res := &application.ApplicationEffectiveIgnoreDifferencesResponse{}
+	for i := range a.Spec.IgnoreDifferences {
+		res.Items = append(res.Items, &application.EffectiveIgnoreDifferencesRule{
+			Source: ptr.To("application"),
+			Rule:   &a.Spec.IgnoreDifferences[i],
+		})
+	}
+
+	overrideKeys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		overrideKeys = append(overrideKeys, key)
+	}
+	sort.Strings(overrideKeys)
+	for _, key := range overrideKeys {
+		ignore := overrides[key].IgnoreDifferences
+		if len(ignore.JSONPointers) == 0 && len(ignore.JQPathExpressions) == 0 && len(ignore.ManagedFieldsManagers) == 0 {
+			continue
+		}
+		group, kind, err := normalizers.GetGroupKindForOverrideKey(key)
+		if err != nil {
+			log.Warn(err)
+			continue
+		}
+		res.Items = append(res.Items, &application.EffectiveIgnoreDifferencesRule{
+			Source:      ptr.To("system"),
+			OverrideKey: ptr.To(key),
+			Rule: &v1alpha1.ResourceIgnoreDifferences{
+				Group:                 group,
+				Kind:                  kind,
+				JSONPointers:          ignore.JSONPointers,
+				JQPathExpressions:     ignore.JQPathExpressions,
+				ManagedFieldsManagers: ignore.ManagedFieldsManagers,
+			},
+		})
+	}
+	return res
+}
+
+// ignoreDifferencesMatch returns whether an ignore differences rule with the given selector applies to the resource,
+// using the same matching as the diff normalizer
+func ignoreDifferencesMatch(group, kind, namespace, name string, item *v1alpha1.ResourceDiff) bool {
+	return glob.Match(group, item.Group) &&
+		glob.Match(kind, item.Kind) &&
+		(name == "" || name == item.Name) &&
+		(namespace == "" || namespace == item.Namespace)
+}
+
+// getManagedResources returns the cached diffs of the application's managed resources which match the query, excluding
+// hooks unless the query includes them.
+func (s *Server) getManagedResources(ctx context.Context, q *application.ResourcesQuery) ([]*v1alpha1.ResourceDiff, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1alpha1.ResourceDiff, 0)
+	err = s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &items)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting cached app managed resources: %w", err)
+	}
+	var res []*v1alpha1.ResourceDiff
+	for i := range items {
+		item := items[i]
+		if (item.Hook && !q.GetIncludeHooks()) || !isMatchingResource(q, kube.ResourceKey{Name: item.Name, Namespace: item.Namespace, Kind: item.Kind, Group: item.Group}) {
+			continue
+		}
+		if q.GetOutOfSyncOnly() {
+			outOfSync, err := resourceDiffOutOfSync(item)
+			if err != nil {
+				return nil, fmt.Errorf("error comparing the states of %s: %w", item.FullName(), err)
+			}
+			if !outOfSync {
+				continue
+			}
+		}
+		res = append(res, item)
+	}
+
+	return res, nil
+}
+
+// resourceDiffOutOfSync returns whether a managed resource is missing, has to be pruned, or has a normalized live state
+// which differs from the live state predicted from its target state
+func resourceDiffOutOfSync(item *v1alpha1.ResourceDiff) (bool, error) {
+	if item.Modified || item.LiveState == "null" || item.TargetState == "null" {
+		return true, nil
+	}
+	if item.NormalizedLiveState == item.PredictedLiveState || item.NormalizedLiveState == "" || item.PredictedLiveState == "" {
+		return false, nil
+	}
+	var normalizedLive, predictedLive any
+	if err := json.Unmarshal([]byte(item.NormalizedLiveState), &normalizedLive); err != nil {
+		return false, fmt.Errorf("error unmarshaling normalized live state: %w", err)
+	}
+	if err := json.Unmarshal([]byte(item.PredictedLiveState), &predictedLive); err != nil {
+		return false, fmt.Errorf("error unmarshaling predicted live state: %w", err)
+	}
+	return !reflect.DeepEqual(normalizedLive, predictedLive), nil
+}
+
+// newLogFilter returns a function reporting whether a log line passes the filter of the query. The filter is a substring,
+// or a regular expression if filterIsRegex is set, and a leading '!' inverts it. All lines pass if no filter is set.
+func newLogFilter(q *application.ApplicationPodLogsQuery) (func(line string) bool, error) {
+	if q.Filter == nil {
+		return func(string) bool { return true }, nil
+	}
+	filter := q.GetFilter()
+	inverse := false
+	if filter != "" && filter[0] == '!' {
+		filter = filter[1:]
+		inverse = true
+	}
+
+	var contains func(line string) bool
+	switch {
+	case q.GetFilterIsRegex():
+		if !q.GetMatchCase() {
+			filter = "(?i)" + filter
+		}
+		re, err := regexp.Compile(filter)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter regular expression: %v", err)
+		}
+		contains = re.MatchString
+	case q.GetMatchCase():
+		contains = func(line string) bool {
+			return strings.Contains(line, filter)
+		}
+	default:
+		filter = strings.ToLower(filter)
+		contains = func(line string) bool {
+			return strings.Contains(strings.ToLower(line), filter)
+		}
+	}
+	return func(line string) bool {
+		return contains(line) != inverse
+	}, nil
+}
+
+func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
+	if q.PodName != nil {
+		podKind := "Pod"
+		q.Kind = &podKind
+		q.ResourceName = q.PodName
+	}
+
+	var sinceSeconds, tailLines *int64
+	if q.GetSinceSeconds() > 0 {
+		sinceSeconds = ptr.To(q.GetSinceSeconds())
+	}
+	if q.GetTailLines() > 0 {
+		tailLines = ptr.To(q.GetTailLines())
+	}
+	var untilTime *metav1.Time
+	if q.GetUntilTime() != "" {
+		val, err := time.Parse(time.RFC3339Nano, q.GetUntilTime())
+		if err != nil {
+			return fmt.Errorf("invalid untilTime parameter value: %w", err)
+		}
+		untilTimeVal := metav1.NewTime(val)
+		untilTime = &untilTimeVal
+	}
+
+	matchesFilter, err := newLogFilter(q)
+	if err != nil {
+		return err
+	}
+
+	a, _, err := s.getApplicationEnforceRBACInformer(ws.Context(), rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return err
+	}
+
+	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbac.ResourceLogs, rbac.ActionGet, a.RBACName(s.ns)); err != nil {
+		return err
+	}
+
+	tree, err := s.getAppResources(ws.Context(), a)
+	if err != nil {
+		return fmt.Errorf("error getting app resource tree: %w", err)
+	}
+
+	config, err := s.getApplicationClusterConfig(ws.Context(), a)
+	if err != nil {
+		return fmt.Errorf("error getting application cluster config: %w", err)
+	}
+
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("error creating kube client: %w", err)
+	}
+
+	// from the tree find pods which match query of kind, group, and resource name
+	pods := getSelectedPods(tree.Nodes, q)
+	if len(pods) == 0 {
+		return nil
+	}
+
+	maxPodLogsToRender, err := s.settingsMgr.GetMaxPodLogsToRender()
+	if err != nil {
+		return fmt.Errorf("error getting MaxPodLogsToRender config: %w", err)
+	}
+
+	if int64(len(pods)) > maxPodLogsToRender {
+		return status.Error(codes.InvalidArgument, "max pods to view logs are reached. Please provide more granular query")
+	}
+
+	var streams []chan logEntry
+
+	for _, pod := range pods {
+		stream, err := kubeClientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+			Container:    q.GetContainer(),
+			Follow:       q.GetFollow(),
+			Timestamps:   true,
+			SinceSeconds: sinceSeconds,
+			SinceTime:    q.GetSinceTime(),
+			TailLines:    tailLines,
+			Previous:     q.GetPrevious(),
+		}).Stream(ws.Context())
+		podName := pod.Name
+		logStream := make(chan logEntry)
+		if err == nil {
+			defer utilio.Close(stream)
+		}
+
+		streams = append(streams, logStream)
+		go func() {
+			// if k8s failed to start steaming logs (typically because Pod is not ready yet)
+			// then the error should be shown in the UI so that user know the reason
+			if err != nil {
+				logStream <- logEntry{line: err.Error()}
+			} else {
+				parseLogsStream(podName, stream, logStream)
+			}
+			close(logStream)
+		}()
+	}
+
+	logStream := mergeLogStreams(streams, time.Millisecond*100)
+	sentCount := int64(0)
+	done := make(chan error)
+	go func() {
+		for entry := range logStream {
+			if entry.err != nil {
+				done <- entry.err
+				return
+			}
+			if !matchesFilter(entry.line) {
+				continue
+			}
+			ts := metav1.NewTime(entry.timeStamp)
+			if untilTime != nil && entry.timeStamp.After(untilTime.Time) {
+				done <- ws.Send(&application.LogEntry{
+					Last:         ptr.To(true),
+					PodName:      &entry.podName,
+					Content:      &entry.line,
+					TimeStampStr: ptr.To(entry.timeStamp.Format(time.RFC3339Nano)),
+					TimeStamp:    &ts,
+				})
+				return
+			}
+			sentCount++
+			if err := ws.Send(&application.LogEntry{
+				PodName:      &entry.podName,
+				Content:      &entry.line,
+				TimeStampStr: ptr.To(entry.timeStamp.Format(time.RFC3339Nano)),
+				TimeStamp:    &ts,
+				Last:         ptr.To(false),
+			}); err != nil {
+				done <- err
+				break
+			}
+		}
+		now := time.Now()
+		nowTS := metav1.NewTime(now)
+		done <- ws.Send(&application.LogEntry{
+			Last:         ptr.To(true),
+			PodName:      ptr.To(""),
+			Content:      ptr.To(""),
+			TimeStampStr: ptr.To(now.Format(time.RFC3339Nano)),
+			TimeStamp:    &nowTS,
+		})
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ws.Context().Done():
+		log.WithField("application", q.Name).Debug("k8s pod logs reader completed due to closed grpc context")
+		return nil
+	}
+}
+
+// ArchiveLogs gathers the logs of the pods selected by the query between sinceTime and untilTime like PodLogs does, and
+// streams them to the object store configured in the logs archive settings. The key of the written object is returned.
+func (s *Server) ArchiveLogs(ctx context.Context, q *application.ApplicationPodLogsQuery) (*application.ApplicationLogsArchiveResponse, error) {
+	if q.GetSinceTime() == nil || q.GetUntilTime() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "sinceTime and untilTime are required")
+	}
+	untilTime, err := time.Parse(time.RFC3339Nano, q.GetUntilTime())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid untilTime parameter value: %v", err)
+	}
+	if !untilTime.After(q.GetSinceTime().Time) {
+		return nil, status.Errorf(codes.InvalidArgument, "untilTime must be after sinceTime")
+	}
+
+	archiveSettings, err := s.settingsMgr.GetLogsArchiveSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting logs archive settings: %w", err)
+	}
+	if !archiveSettings.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "archiving logs is not enabled")
+	}
+
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceLogs, rbac.ActionGet, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	uploader, err := newLogsArchiveUploader(archiveSettings)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("%s%s/%s/%s-%s.log", archiveSettings.Prefix, a.Namespace, a.Name,
+		q.GetSinceTime().UTC().Format("20060102T150405Z"), untilTime.UTC().Format("20060102T150405Z"))
+
+	logsQuery := &application.ApplicationPodLogsQuery{
+		Name:         q.Name,
+		AppNamespace: q.AppNamespace,
+		Project:      q.Project,
+		Namespace:    q.Namespace,
+		PodName:      q.PodName,
+		Container:    q.Container,
+		SinceTime:    q.SinceTime,
+		UntilTime:    q.UntilTime,
+		Filter:       q.Filter,
+		MatchCase:    q.MatchCase,
+		Kind:         q.Kind,
+		Group:        q.Group,
+		ResourceName: q.ResourceName,
+		Previous:     q.Previous,
+		Follow:       ptr.To(false),
+	}
+	reader, writer := io.Pipe()
+	stream := &logsArchiveStream{podLogsCollectorStream: podLogsCollectorStream{ctx: ctx}, w: writer}
+	go func() {
+		writer.CloseWithError(s.PodLogs(logsQuery, stream))
+	}()
+	_, err = uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      ptr.To(archiveSettings.Bucket),
+		Key:         ptr.To(key),
+		Body:        reader,
+		ContentType: ptr.To("text/plain"),
+	})
+	// unblock the log reader if the upload stopped early
+	_ = reader.CloseWithError(err)
+	if err != nil {
+		return nil, fmt.Errorf("error archiving logs: %w", err)
+	}
+
+	return &application.ApplicationLogsArchiveResponse{
+		Bucket:  ptr.To(archiveSettings.Bucket),
+		Key:     ptr.To(key),
+		Entries: ptr.To(stream.entries),
+	}, nil
+}
+
+// GetPodLogsSnapshot returns the most recent log lines of the pods selected by the query in a single response. At most
+// tailLines lines are returned, across all selected pods.
+func (s *Server) GetPodLogsSnapshot(ctx context.Context, q *application.ApplicationPodLogsQuery) (*application.ApplicationPodLogsSnapshotResponse, error) {
+	tailLines := q.GetTailLines()
+	if tailLines < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "tailLines must not be negative")
+	}
+	if tailLines == 0 {
+		tailLines = defaultPodLogsSnapshotLines
+	}
+	if tailLines > maxPodLogsSnapshotLines {
+		return nil, status.Errorf(codes.InvalidArgument, "tailLines must not be greater than %d", maxPodLogsSnapshotLines)
+	}
+
+	logsQuery := &application.ApplicationPodLogsQuery{
+		Name:         q.Name,
+		AppNamespace: q.AppNamespace,
+		Project:      q.Project,
+		Namespace:    q.Namespace,
+		PodName:      q.PodName,
+		Container:    q.Container,
+		SinceSeconds: q.SinceSeconds,
+		SinceTime:    q.SinceTime,
+		TailLines:    ptr.To(tailLines),
+		UntilTime:    q.UntilTime,
+		Filter:       q.Filter,
+		MatchCase:    q.MatchCase,
+		Kind:         q.Kind,
+		Group:        q.Group,
+		ResourceName: q.ResourceName,
+		Previous:     q.Previous,
+		Follow:       ptr.To(false),
+	}
+	stream := &logsSnapshotStream{podLogsCollectorStream: podLogsCollectorStream{ctx: ctx}, limit: int(tailLines)}
+	if err := s.PodLogs(logsQuery, stream); err != nil {
+		return nil, err
+	}
+
+	return &application.ApplicationPodLogsSnapshotResponse{
+		Entries:   stream.entries,
+		Truncated: ptr.To(stream.truncated),
+	}, nil
+}
+
+// from all of the treeNodes, get the pod who meets the criteria or whose parents meets the criteria
+func getSelectedPods(treeNodes []v1alpha1.ResourceNode, q *application.ApplicationPodLogsQuery) []v1alpha1.ResourceNode {
+	var pods []v1alpha1.ResourceNode
+	isTheOneMap := make(map[string]bool)
+	for _, treeNode := range treeNodes {
+		if treeNode.Kind == kube.PodKind && treeNode.Group == "" && treeNode.UID != "" {
+			if isTheSelectedOne(&treeNode, q, treeNodes, isTheOneMap) {
+				pods = append(pods, treeNode)
+			}
+		}
+	}
+	return pods
+}
+
+// check is currentNode is matching with group, kind, and name, or if any of its parents matches
+func isTheSelectedOne(currentNode *v1alpha1.ResourceNode, q *application.ApplicationPodLogsQuery, resourceNodes []v1alpha1.ResourceNode, isTheOneMap map[string]bool) bool {
+	exist, value := isTheOneMap[currentNode.UID]
+	if exist {
+		return value
+	}
+
+	if (q.GetResourceName() == "" || currentNode.Name == q.GetResourceName()) &&
+		(q.GetKind() == "" || currentNode.Kind == q.GetKind()) &&
+		(q.GetGroup() == "" || currentNode.Group == q.GetGroup()) &&
+		(q.GetNamespace() == "" || currentNode.Namespace == q.GetNamespace()) {
+		isTheOneMap[currentNode.UID] = true
+		return true
+	}
+
+	if len(currentNode.ParentRefs) == 0 {
+		isTheOneMap[currentNode.UID] = false
+		return false
+	}
+
+	for _, parentResource := range currentNode.ParentRefs {
+		// look up parentResource from resourceNodes
+		// then check if the parent isTheSelectedOne
+		for _, resourceNode := range resourceNodes {
+			if resourceNode.Namespace == parentResource.Namespace &&
+				resourceNode.Name == parentResource.Name &&
+				resourceNode.Group == parentResource.Group &&
+				resourceNode.Kind == parentResource.Kind {
+				if isTheSelectedOne(&resourceNode, q, resourceNodes, isTheOneMap) {
+					isTheOneMap[currentNode.UID] = true
+					return true
+				}
+			}
+		}
+	}
+
+	isTheOneMap[currentNode.UID] = false
+	return false
+}
+
+// Sync syncs an application to its target state
+func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncRequest) (*v1alpha1.Application, error) {
+	if syncReq.GetWaitTimeoutSeconds() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "waitTimeoutSeconds must not be negative")
+	}
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, syncReq.GetProject(), syncReq.GetAppNamespace(), syncReq.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	s.inferResourcesStatusHealth(a)
+
+	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true)
+	if err != nil {
+		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
+	}
+	if !canSync {
+		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
+	}
+
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	if syncReq.GetManifestsRef() != "" {
+		if syncReq.Manifests != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "manifests and manifestsRef cannot be combined")
+		}
+		// the referenced manifests are synced like inline ones, so they are subject to the same checks below
+		syncReq.Manifests, err = s.cache.GetLocalManifests(a.QualifiedName(), syncReq.GetManifestsRef())
+		if errors.Is(err, servercache.ErrCacheMiss) {
+			return nil, status.Errorf(codes.NotFound, "local manifests %q not found or expired", syncReq.GetManifestsRef())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting local manifests: %w", err)
+		}
+	}
+	if syncReq.Manifests != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+		if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() && !syncReq.GetDryRun() {
+			return nil, status.Error(codes.FailedPrecondition, "cannot use local sync when Automatic Sync Policy is enabled unless for dry run")
+		}
+	}
+	if syncReq.GetForce() {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+	}
+	if errs := k8svalidation.IsValidLabelValue(syncReq.GetCorrelationId()); len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid correlation ID %q: %s", syncReq.GetCorrelationId(), strings.Join(errs, "; "))
+	}
+	if a.DeletionTimestamp != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
+	}
+
+	// Serialize concurrent syncs of the same app handled by this server, so that a second sync is rejected before its
+	// revisions are resolved instead of failing later when its operation is set. The lock is released once the operation
+	// is set, so it is not held while waiting for the operation to complete.
+	appName := syncReq.GetName()
+	appNs := s.appNamespaceOrDefault(syncReq.GetAppNamespace())
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
+	qualifiedName := a.QualifiedName()
+	s.appOperationLock.Lock(qualifiedName)
+	locked := true
+	defer func() {
+		if locked {
+			s.appOperationLock.Unlock(qualifiedName)
+		}
+	}()
+	current, err := appIf.Get(ctx, appName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	operationRunning := current.Status.OperationState != nil && !current.Status.OperationState.Phase.Completed()
+	if current.Operation != nil || operationRunning {
+		if !syncReq.GetForce() {
+			return nil, argo.ErrAnotherOperationInProgress
+		}
+		if operationRunning {
+			// the controller is already applying the running operation, it has to be terminated before it is replaced
+			return nil, status.Errorf(codes.FailedPrecondition, "operation is already running; terminate it before forcing a sync")
+		}
+		// remove the pending operation, so that it is replaced by the one of this request
+		current.Operation = nil
+		if _, err := appIf.Update(ctx, current, metav1.UpdateOptions{}); err != nil {
+			return nil, fmt.Errorf("error removing the pending operation: %w", err)
+		}
+		log.WithFields(applog.GetAppLogFields(a)).Info("replacing the pending operation with a forced sync")
+	}
+
+	revision, displayRevision, sourceRevisions, displayRevisions, err := s.resolveSourceRevisions(ctx, a, syncReq)
+	if err != nil {
+		return nil, err
+	}
+
+	var retry *v1alpha1.RetryStrategy
+	if a.Spec.SyncPolicy != nil {
+		retry = a.Spec.SyncPolicy.Retry
+	}
+	if syncReq.RetryStrategy != nil {
+		retry = syncReq.RetryStrategy
+	}
+	syncOptions, _ := resolveSyncOptions(a, syncReq.SyncOptions)
+
+	if reason := s.syncOptionRejectionReason(syncOptions); reason != "" {
+		return nil, status.Error(codes.FailedPrecondition, reason)
+	}
+
+	// We cannot use local manifests if we're only allowed to sync to signed commits
+	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
+	}
+
+	if len(syncReq.SyncWaves) > 0 {
+		if len(syncReq.Resources) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "syncWaves and resources cannot be combined")
+		}
+		syncReq.Resources, err = s.syncWavesResources(ctx, a, syncReq)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var infos []*v1alpha1.Info
+	infos = append(infos, syncReq.Infos...)
+	// the operation ID identifies this sync in the operation state, which keeps the info of the operation
+	operationID := uuid.NewString()
+	infos = append(infos, &v1alpha1.Info{Name: argocommon.LabelKeyOperationID, Value: operationID})
+	if syncReq.GetCorrelationId() != "" {
+		infos = append(infos, &v1alpha1.Info{Name: argocommon.LabelKeyCorrelationID, Value: syncReq.GetCorrelationId()})
+	}
+	if syncReq.ExpectedResourceCount != nil {
+		warning, err := s.checkExpectedResourceCount(ctx, a, syncReq)
+		if err != nil {
+			return nil, err
+		}
+		if warning != "" {
+			log.WithFields(applog.GetAppLogFields(a)).Warn(warning)
+			infos = append(infos, &v1alpha1.Info{Name: "Warning", Value: warning})
+		}
+	}
+
+	if syncReq.GetValidateAdmission() {
+		validation, err := s.validateSyncAdmission(ctx, a, proj, syncReq)
+		if err != nil {
+			return nil, err
+		}
+		if !validation.GetAllowed() {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot sync: %s", admissionDenialsMessage(validation))
+		}
+	}
+
+	resources := syncOperationResources(syncReq)
+	op := v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{
+			Revision:     revision,
+			Prune:        syncReq.GetPrune(),
+			DryRun:       syncReq.GetDryRun(),
+			SyncOptions:  syncOptions,
+			SyncStrategy: syncReq.Strategy,
+			Resources:    resources,
+			Manifests:    syncReq.Manifests,
+			Sources:      a.Spec.Sources,
+			Revisions:    sourceRevisions,
+		},
+		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
+		Info:        infos,
+	}
+	if retry != nil {
+		op.Retry = *retry
+	}
+
+	var events chan *v1alpha1.ApplicationWatchEvent
+	if syncReq.GetWait() {
+		// subscribe before setting the operation to ensure we don't miss its completion
+		appNs := a.Namespace
+		events = make(chan *v1alpha1.ApplicationWatchEvent, watchAPIBufferSize)
+		unsubscribe := s.appBroadcaster.Subscribe(events, func(event *v1alpha1.ApplicationWatchEvent) bool {
+			return event.Application.Name == appName && event.Application.Namespace == appNs
+		})
+		defer unsubscribe()
+	}
+
+	a, err = argo.SetAppOperation(appIf, appName, &op)
+	s.appOperationLock.Unlock(qualifiedName)
+	locked = false
+	if err != nil {
+		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
 	partial := ""
 	if len(syncReq.Resources) > 0 {
 		partial = "partial "
 	}
-	var reason string
-	if a.Spec.HasMultipleSources() {
-		reason = fmt.Sprintf("initiated %ssync to %s", partial, strings.Join(displayRevisions, ","))
+	var reason string
+	if a.Spec.HasMultipleSources() {
+		reason = fmt.Sprintf("initiated %ssync to %s", partial, strings.Join(displayRevisions, ","))
+	} else {
+		reason = fmt.Sprintf("initiated %ssync to %s", partial, displayRevision)
+	}
+	if syncReq.Manifests != nil {
+		reason = fmt.Sprintf("initiated %ssync locally", partial)
+	}
+	eventLabels := map[string]string{argocommon.LabelKeyOperationID: operationID}
+	if syncReq.GetCorrelationId() != "" {
+		eventLabels[argocommon.LabelKeyCorrelationID] = syncReq.GetCorrelationId()
+	}
+	s.logAppEventWithLabels(ctx, a, argo.EventReasonOperationStarted, reason, eventLabels)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(operationIDHeader, operationID))
+	if syncReq.GetWait() {
+		timeout := defaultSyncWaitTimeout
+		if syncReq.GetWaitTimeoutSeconds() > 0 {
+			timeout = time.Duration(syncReq.GetWaitTimeoutSeconds()) * time.Second
+		}
+		return waitForSyncOperation(ctx, a, operationID, events, timeout)
+	}
+	return a, nil
+}
+
+// waitForSyncOperation returns the application once the operation with the given ID completed. If it doesn't complete
+// within the timeout, the latest application is returned with the operation still in progress and the syncWaitHeader set.
+func waitForSyncOperation(ctx context.Context, a *v1alpha1.Application, operationID string, events <-chan *v1alpha1.ApplicationWatchEvent, timeout time.Duration) (*v1alpha1.Application, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("error waiting for sync operation %s: %w", operationID, ctx.Err())
+		case <-timer.C:
+			msg := fmt.Sprintf("sync operation %s of application %s did not complete within %s", operationID, a.Name, timeout)
+			_ = grpc.SetHeader(ctx, metadata.Pairs(syncWaitHeader, msg))
+			return a, nil
+		case event := <-events:
+			if appVersion, err := strconv.Atoi(event.Application.ResourceVersion); err == nil {
+				if currentVersion, err := strconv.Atoi(a.ResourceVersion); err == nil && appVersion < currentVersion {
+					continue
+				}
+			}
+			a = event.Application.DeepCopy()
+			opState := a.Status.OperationState
+			if opState != nil && getOperationID(&opState.Operation) == operationID && opState.Phase.Completed() {
+				return a, nil
+			}
+		}
+	}
+}
+
+// getOperationID returns the ID of an operation started by the API server, or an empty string if it has none
+func getOperationID(op *v1alpha1.Operation) string {
+	if op == nil {
+		return ""
+	}
+	for _, info := range op.Info {
+		if info.Name == argocommon.LabelKeyOperationID {
+			return info.Value
+		}
+	}
+	return ""
+}
+
+// ValidateSync generates the manifests a sync with the given request would apply and submits them to the destination
+// cluster with a server-side apply dry-run. No operation is set; the response reports the resources which would be
+// denied by the API server or by admission webhooks.
+func (s *Server) ValidateSync(ctx context.Context, syncReq *application.ApplicationSyncRequest) (*application.ApplicationSyncValidationResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionSync, syncReq.GetProject(), syncReq.GetAppNamespace(), syncReq.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if syncReq.Manifests != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+	}
+	return s.validateSyncAdmission(ctx, a, proj, syncReq)
+}
+
+// validateSyncAdmission dry-run applies the manifests a sync would apply, limited to the requested resources in case of
+// a partial sync, using server-side apply against the destination cluster.
+func (s *Server) validateSyncAdmission(ctx context.Context, a *v1alpha1.Application, proj *v1alpha1.AppProject, syncReq *application.ApplicationSyncRequest) (*application.ApplicationSyncValidationResponse, error) {
+	manifests := syncReq.Manifests
+	if manifests == nil {
+		res, err := s.GetManifests(ctx, &application.ApplicationManifestQuery{
+			Name:            syncReq.Name,
+			AppNamespace:    syncReq.AppNamespace,
+			Project:         syncReq.Project,
+			Revision:        syncReq.Revision,
+			SourcePositions: syncReq.SourcePositions,
+			Revisions:       syncReq.Revisions,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating manifests to validate admission: %w", err)
+		}
+		manifests = res.Manifests
+	}
+
+	res := &application.ApplicationSyncValidationResponse{Allowed: ptr.To(true)}
+	if len(manifests) == 0 {
+		return res, nil
+	}
+	objs := make([]*unstructured.Unstructured, 0, len(manifests))
+	for i, manifest := range manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error unmarshaling manifest %d: %v", i, err)
+		}
+		objs = append(objs, obj)
+	}
+
+	cluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting destination cluster: %w", err)
+	}
+	if err := s.setDryRunNamespaces(cluster, a.Spec.Destination.Namespace, objs); err != nil {
+		return nil, err
+	}
+	if resources := syncOperationResources(syncReq); len(resources) > 0 {
+		objs = slices.DeleteFunc(objs, func(obj *unstructured.Unstructured) bool {
+			return !argo.ContainsSyncResource(obj.GetName(), obj.GetNamespace(), obj.GroupVersionKind(), resources)
+		})
+	}
+	if len(objs) == 0 {
+		return res, nil
+	}
+
+	results, allowed, err := s.dryRunApply(ctx, cluster, proj, objs)
+	if err != nil {
+		return nil, err
+	}
+	res.Results = results
+	res.Allowed = ptr.To(allowed)
+	return res, nil
+}
+
+// setDryRunNamespaces sets the given namespace on the namespaced objects without one and clears the namespace of the
+// cluster-scoped objects, the way a sync to the cluster would. Kinds the cluster doesn't know are considered namespaced.
+func (s *Server) setDryRunNamespaces(cluster *v1alpha1.Cluster, namespace string, objs []*unstructured.Unstructured) error {
+	clusterConfig, err := cluster.RawRestConfig()
+	if err != nil {
+		return fmt.Errorf("error getting cluster raw REST config: %w", err)
+	}
+	apiResources, err := s.kubectl.GetAPIResources(clusterConfig, false, kubecache.NewNoopSettings())
+	if err != nil {
+		return fmt.Errorf("error getting API resources: %w", err)
+	}
+	namespaced := make(map[schema.GroupKind]bool, len(apiResources))
+	for _, apiResource := range apiResources {
+		namespaced[apiResource.GroupKind] = apiResource.Meta.Namespaced
+	}
+	for _, obj := range objs {
+		if isNamespaced, ok := namespaced[obj.GroupVersionKind().GroupKind()]; ok && !isNamespaced {
+			obj.SetNamespace("")
+		} else if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+	}
+	return nil
+}
+
+// newServerSideDryRunner returns the runner dry-run applying resources to a cluster with server-side apply, and a
+// function to clean it up
+var newServerSideDryRunner = func(kubectl kube.Kubectl, config *rest.Config) (diff.ServerSideDryRunner, func(), error) {
+	openAPISchema, _, err := kubectl.LoadOpenAPISchema(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get OpenAPI schema: %w", err)
+	}
+	applier, cleanup, err := kubeutil.ManageServerSideDiffDryRuns(config, openAPISchema, func(_ string) (kube.CleanupFunc, error) {
+		return func() {}, nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating server-side dry run applier: %w", err)
+	}
+	return diff.NewK8sServerSideDryRunner(applier), cleanup, nil
+}
+
+// dryRunApply server-side applies the objects to the cluster in dry-run mode and returns the result per object, and
+// whether every object would be admitted. The objects are applied with the credentials of Argo CD, so nothing is applied
+// unless the project permits the application to manage every object, the same way as for resource actions.
+func (s *Server) dryRunApply(ctx context.Context, cluster *v1alpha1.Cluster, proj *v1alpha1.AppProject, objs []*unstructured.Unstructured) ([]*application.ResourceActionValidationResult, bool, error) {
+	for _, obj := range objs {
+		if err := s.verifyResourcePermitted(cluster, proj, obj); err != nil {
+			return nil, false, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+
+	clusterConfig, err := cluster.RawRestConfig()
+	if err != nil {
+		return nil, false, fmt.Errorf("error getting cluster raw REST config: %w", err)
+	}
+	dryRunner, cleanup, err := newServerSideDryRunner(s.kubectl, clusterConfig)
+	if err != nil {
+		return nil, false, err
+	}
+	defer cleanup()
+
+	results := make([]*application.ResourceActionValidationResult, 0, len(objs))
+	allowed := true
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		result := &application.ResourceActionValidationResult{
+			Group:     ptr.To(gvk.Group),
+			Version:   ptr.To(gvk.Version),
+			Kind:      ptr.To(gvk.Kind),
+			Namespace: ptr.To(obj.GetNamespace()),
+			Name:      ptr.To(obj.GetName()),
+			Operation: ptr.To("apply"),
+			Allowed:   ptr.To(true),
+			DryRun:    ptr.To(true),
+		}
+		results = append(results, result)
+		if _, err := dryRunner.Run(ctx, obj, argocommon.ArgoCDSSAManager); err != nil {
+			setResourceActionValidationError(result, err)
+			allowed = false
+		}
+	}
+	return results, allowed, nil
+}
+
+// ValidateAgainstCluster generates the manifests of the application and dry-run applies them with server-side apply to
+// the given cluster instead of the destination of the application, e.g. to check that a standby cluster could take
+// over. The cluster and namespace must be permitted destinations of the application's project, and since the dry-run is
+// made with the credentials of Argo CD, it requires the same permission as a sync.
+func (s *Server) ValidateAgainstCluster(ctx context.Context, q *application.ApplicationClusterValidationRequest) (*application.ApplicationClusterValidationResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionSync, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	destination := q.GetDestination()
+	if destination == nil || (destination.Server == "" && destination.Name == "") {
+		return nil, status.Error(codes.InvalidArgument, "destination server or name is required")
+	}
+	dest := *destination
+	if dest.Namespace == "" {
+		dest.Namespace = a.Spec.Destination.Namespace
+	}
+	cluster, err := argo.GetDestinationCluster(ctx, dest, s.db)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error getting destination cluster: %v", err)
+	}
+	permitted, err := proj.IsDestinationPermitted(cluster, dest.Namespace, func(project string) ([]*v1alpha1.Cluster, error) {
+		return s.db.GetProjectClusters(ctx, project)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error checking destination permissions: %w", err)
+	}
+	if !permitted {
+		return nil, status.Errorf(codes.PermissionDenied, "destination server '%s' and namespace '%s' do not match any of the allowed destinations in project '%s'", cluster.Server, dest.Namespace, proj.Name)
+	}
+
+	manifests, err := s.GetManifests(ctx, &application.ApplicationManifestQuery{
+		Name:            q.Name,
+		AppNamespace:    q.AppNamespace,
+		Project:         q.Project,
+		Revision:        q.Revision,
+		SourcePositions: q.SourcePositions,
+		Revisions:       q.Revisions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error generating manifests to validate against the cluster: %w", err)
+	}
+	objs := make([]*unstructured.Unstructured, 0, len(manifests.Manifests))
+	for i, manifest := range manifests.Manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest %d: %w", i, err)
+		}
+		objs = append(objs, obj)
+	}
+	if err := s.setDryRunNamespaces(cluster, dest.Namespace, objs); err != nil {
+		return nil, err
+	}
+
+	res := &application.ApplicationClusterValidationResponse{
+		Server:  ptr.To(cluster.Server),
+		Allowed: ptr.To(true),
+	}
+	if len(objs) == 0 {
+		return res, nil
+	}
+	results, allowed, err := s.dryRunApply(ctx, cluster, proj, objs)
+	if err != nil {
+		return nil, err
+	}
+	res.Results = results
+	res.Allowed = ptr.To(allowed)
+	return res, nil
+}
+
+// CheckSourcesPermitted returns whether each source of the application, or each of the given sources, is permitted by
+// the source repositories of the application's project, along with the pattern which permits or denies it.
+func (s *Server) CheckSourcesPermitted(ctx context.Context, q *application.ApplicationSourcesPermissionQuery) (*application.ApplicationSourcesPermissionResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []v1alpha1.ApplicationSource
+	switch {
+	case len(q.Sources) > 0:
+		for _, source := range q.Sources {
+			sources = append(sources, *source)
+		}
+	case a.Spec.HasMultipleSources():
+		sources = a.Spec.GetSources()
+	default:
+		sources = append(sources, a.Spec.GetSource())
+	}
+
+	res := &application.ApplicationSourcesPermissionResponse{Project: ptr.To(proj.Name), Allowed: ptr.To(true)}
+	for _, source := range sources {
+		permitted, pattern := proj.MatchSourceRepos(source)
+		item := &application.SourcePermission{RepoURL: ptr.To(source.RepoURL), Allowed: ptr.To(permitted)}
+		if pattern != "" {
+			item.Pattern = ptr.To(pattern)
+		}
+		if !permitted {
+			res.Allowed = ptr.To(false)
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res, nil
+}
+
+// admissionDenialsMessage summarizes the denied resources of a sync validation
+func admissionDenialsMessage(validation *application.ApplicationSyncValidationResponse) string {
+	var denials []string
+	for _, result := range validation.Results {
+		if !result.GetAllowed() {
+			denials = append(denials, fmt.Sprintf("%s/%s: %s", result.GetKind(), result.GetName(), result.GetMessage()))
+		}
+	}
+	return fmt.Sprintf("%d resources denied by admission: %s", len(denials), strings.Join(denials, "; "))
+}
+
+// syncOperationResources returns the resources of a partial sync request
+func syncOperationResources(syncReq *application.ApplicationSyncRequest) []v1alpha1.SyncOperationResource {
+	resources := []v1alpha1.SyncOperationResource{}
+	if syncReq.GetResources() != nil {
+		for _, r := range syncReq.GetResources() {
+			if r != nil {
+				resources = append(resources, *r)
+			}
+		}
+	}
+	return resources
+}
+
+// syncWavesResources returns the resources of the manifests the sync would apply whose sync-wave annotation is one of
+// the requested waves. Hooks are skipped, since they are not synced as part of a partial sync.
+func (s *Server) syncWavesResources(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) ([]*v1alpha1.SyncOperationResource, error) {
+	manifests := syncReq.Manifests
+	if manifests == nil {
+		res, err := s.GetManifests(ctx, &application.ApplicationManifestQuery{
+			Name:            syncReq.Name,
+			AppNamespace:    syncReq.AppNamespace,
+			Project:         syncReq.Project,
+			Revision:        syncReq.Revision,
+			SourcePositions: syncReq.SourcePositions,
+			Revisions:       syncReq.Revisions,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error generating manifests to select sync waves: %w", err)
+		}
+		manifests = res.Manifests
+	}
+
+	waves := make(map[int64]bool, len(syncReq.SyncWaves))
+	for _, wave := range syncReq.SyncWaves {
+		waves[wave] = false
+	}
+	var resources []*v1alpha1.SyncOperationResource
+	for i, manifest := range manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error unmarshaling manifest %d: %v", i, err)
+		}
+		if hook.IsHook(obj) {
+			continue
+		}
+		wave := int64(syncwaves.Wave(obj))
+		if _, ok := waves[wave]; !ok {
+			continue
+		}
+		waves[wave] = true
+		gvk := obj.GroupVersionKind()
+		resources = append(resources, &v1alpha1.SyncOperationResource{
+			Group:     gvk.Group,
+			Kind:      gvk.Kind,
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+		})
+	}
+
+	var missing []string
+	for _, wave := range syncReq.SyncWaves {
+		if !waves[wave] {
+			missing = append(missing, strconv.FormatInt(wave, 10))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "application %s has no resources in sync waves %s", a.Name, strings.Join(missing, ", "))
+	}
+	return resources, nil
+}
+
+// checkExpectedResourceCount generates the manifests the sync would apply and compares their number with the count
+// the caller expects. A mismatch outside of the requested tolerance is returned as a warning message, or as an error
+// if the caller asked to fail on mismatch.
+func (s *Server) checkExpectedResourceCount(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) (string, error) {
+	expected := syncReq.GetExpectedResourceCount()
+	if expected < 0 {
+		return "", status.Errorf(codes.InvalidArgument, "expected resource count must not be negative")
+	}
+	if syncReq.GetExpectedResourceCountTolerance() < 0 {
+		return "", status.Errorf(codes.InvalidArgument, "expected resource count tolerance must not be negative")
+	}
+
+	var actual int64
+	if syncReq.Manifests != nil {
+		actual = int64(len(syncReq.Manifests))
+	} else {
+		manifests, err := s.GetManifests(ctx, &application.ApplicationManifestQuery{
+			Name:            syncReq.Name,
+			AppNamespace:    syncReq.AppNamespace,
+			Project:         syncReq.Project,
+			Revision:        syncReq.Revision,
+			SourcePositions: syncReq.SourcePositions,
+			Revisions:       syncReq.Revisions,
+		})
+		if err != nil {
+			return "", fmt.Errorf("error generating manifests to verify resource count: %w", err)
+		}
+		actual = int64(len(manifests.Manifests))
+	}
+
+	if !resourceCountDiffers(expected, actual, syncReq.GetExpectedResourceCountTolerance()) {
+		return "", nil
+	}
+	msg := fmt.Sprintf("generated %d resources, but %d were expected", actual, expected)
+	if syncReq.GetFailOnResourceCountMismatch() {
+		return "", status.Errorf(codes.FailedPrecondition, "cannot sync: %s", msg)
+	}
+	return msg, nil
+}
+
+// resourceCountDiffers returns true if actual deviates from expected by more than tolerancePercent percent.
+func resourceCountDiffers(expected, actual, tolerancePercent int64) bool {
+	delta := actual - expected
+	if delta < 0 {
+		delta = -delta
+	}
+	return delta*100 > expected*tolerancePercent
+}
+
+func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) (string, string, []string, []string, error) {
+	if a.Spec.HasMultipleSources() {
+		numOfSources := int64(len(a.Spec.GetSources()))
+		sourceRevisions := make([]string, numOfSources)
+		displayRevisions := make([]string, numOfSources)
+
+		sources := a.Spec.GetSources()
+		for i, pos := range syncReq.SourcePositions {
+			if pos <= 0 || pos > numOfSources {
+				return "", "", nil, nil, errors.New("source position is out of range")
+			}
+			sources[pos-1].TargetRevision = syncReq.Revisions[i]
+		}
+		for index, source := range sources {
+			if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() && !syncReq.GetDryRun() {
+				if normalizeTargetRevision(&a.Spec.GetSources()[index], a.Spec.GetSources()[index].TargetRevision) != normalizeTargetRevision(&source, source.TargetRevision) {
+					return "", "", nil, nil, status.Errorf(codes.FailedPrecondition, "Cannot sync source %s to %s: auto-sync currently set to %s", source.RepoURL, source.TargetRevision, a.Spec.Sources[index].TargetRevision)
+				}
+			}
+			revision, displayRevision, err := s.resolveRevision(ctx, a, syncReq, index)
+			if err != nil {
+				return "", "", nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+			sourceRevisions[index] = revision
+			displayRevisions[index] = displayRevision
+		}
+		return "", "", sourceRevisions, displayRevisions, nil
+	}
+	source := a.Spec.GetSource()
+	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() && !syncReq.GetDryRun() {
+		if syncReq.GetRevision() != "" && syncReq.GetRevision() != normalizeTargetRevision(source, source.TargetRevision) {
+			return "", "", nil, nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.GetRevision(), source.TargetRevision)
+		}
+	}
+	revision, displayRevision, err := s.resolveRevision(ctx, a, syncReq, -1)
+	if err != nil {
+		return "", "", nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return revision, displayRevision, nil, nil, nil
+}
+
+// Rollback syncs the application to a deployment from its history. A preview initiates no operation but returns the
+// normalized diffs of the manifests of the deployment against the live state.
+func (s *Server) Rollback(ctx context.Context, rollbackReq *application.ApplicationRollbackRequest) (*application.ApplicationRollbackResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, rollbackReq.GetProject(), rollbackReq.GetAppNamespace(), rollbackReq.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	s.inferResourcesStatusHealth(a)
+
+	if a.DeletionTimestamp != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
+	}
+	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() {
+		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
+	}
+
+	deploymentInfo, err := getRollbackDeploymentInfo(a, rollbackReq.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if rollbackReq.GetPreview() {
+		previewDiffs, err := s.getRollbackPreviewDiffs(ctx, a, proj, deploymentInfo)
+		if err != nil {
+			return nil, err
+		}
+		return &application.ApplicationRollbackResponse{Application: *a, PreviewDiffs: previewDiffs}, nil
+	}
+
+	var syncOptions v1alpha1.SyncOptions
+	if a.Spec.SyncPolicy != nil {
+		syncOptions = a.Spec.SyncPolicy.SyncOptions
+	}
+
+	// Rollback is just a convenience around Sync
+	op := v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{
+			Revision:     deploymentInfo.Revision,
+			Revisions:    deploymentInfo.Revisions,
+			DryRun:       rollbackReq.GetDryRun(),
+			Prune:        rollbackReq.GetPrune(),
+			SyncOptions:  syncOptions,
+			SyncStrategy: &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{}},
+			Source:       &deploymentInfo.Source,
+			Sources:      deploymentInfo.Sources,
+		},
+		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
+	}
+	appName := rollbackReq.GetName()
+	appNs := s.appNamespaceOrDefault(rollbackReq.GetAppNamespace())
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
+	a, err = argo.SetAppOperation(appIf, appName, &op)
+	if err != nil {
+		return nil, fmt.Errorf("error setting app operation: %w", err)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.GetId()))
+	return &application.ApplicationRollbackResponse{Application: *a}, nil
+}
+
+// getRollbackDeploymentInfo returns the deployment with the given ID from the history of the application
+func getRollbackDeploymentInfo(a *v1alpha1.Application, id int64) (*v1alpha1.RevisionHistory, error) {
+	var deploymentInfo *v1alpha1.RevisionHistory
+	for _, info := range a.Status.History {
+		if info.ID == id {
+			deploymentInfo = &info
+			break
+		}
+	}
+	if deploymentInfo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.QualifiedName(), id)
+	}
+	if deploymentInfo.Source.IsZero() && deploymentInfo.Sources.IsZero() {
+		// Since source type was introduced to history starting with v0.12, and is now required for
+		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
+		// As multi source doesn't use app.Source, we need to check to the Sources length
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
+	}
+	return deploymentInfo, nil
+}
+
+// getRollbackPreviewDiffs renders the manifests of a deployment from the history of the application and diffs them
+// against the live state of the resources, showing what a rollback to the deployment would change
+func (s *Server) getRollbackPreviewDiffs(ctx context.Context, a *v1alpha1.Application, proj *v1alpha1.AppProject, deploymentInfo *v1alpha1.RevisionHistory) ([]*apiclient.ManifestLiveDiff, error) {
+	// the manifests are generated from the sources of the deployment at the revisions it deployed
+	previewApp := a.DeepCopy()
+	if deploymentInfo.Sources.IsZero() {
+		source := deploymentInfo.Source
+		source.TargetRevision = deploymentInfo.Revision
+		previewApp.Spec.Source = &source
+		previewApp.Spec.Sources = nil
+	} else {
+		previewApp.Spec.Source = nil
+		previewApp.Spec.Sources = make(v1alpha1.ApplicationSources, len(deploymentInfo.Sources))
+		for i, source := range deploymentInfo.Sources {
+			if i < len(deploymentInfo.Revisions) {
+				source.TargetRevision = deploymentInfo.Revisions[i]
+			}
+			previewApp.Spec.Sources[i] = source
+		}
+	}
+	manifestInfos, err := s.generateManifests(ctx, previewApp, proj, &application.ApplicationManifestQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	redactionRules, err := s.settingsMgr.GetResourceRedactionRules()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource redaction rules: %w", err)
+	}
+	return s.getManifestLiveDiffs(ctx, a, manifestInfos, redactionRules)
+}
+
+func (s *Server) ListLinks(ctx context.Context, req *application.ListAppLinksRequest) (*application.LinksResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, req.GetProject(), req.GetNamespace(), req.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := kube.ToUnstructured(a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+
+	deepLinks, err := s.settingsMgr.GetDeepLinks(settings.ApplicationDeepLinks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read application deep links from configmap: %w", err)
+	}
+
+	clstObj, _, err := s.getObjectsForDeepLinks(ctx, a, proj)
+	if err != nil {
+		return nil, err
+	}
+
+	deepLinksObject := deeplinks.CreateDeepLinksObject(nil, obj, clstObj, nil)
+
+	finalList, errorList := deeplinks.EvaluateDeepLinksResponse(deepLinksObject, obj.GetName(), deepLinks)
+	if len(errorList) > 0 {
+		log.Errorf("errorList while evaluating application deep links, %v", strings.Join(errorList, ", "))
+	}
+
+	return finalList, nil
+}
+
+func (s *Server) getObjectsForDeepLinks(ctx context.Context, app *v1alpha1.Application, proj *v1alpha1.AppProject) (cluster *unstructured.Unstructured, project *unstructured.Unstructured, err error) {
+	// sanitize project jwt tokens
+	proj.Status = v1alpha1.AppProjectStatus{}
+
+	project, err = kube.ToUnstructured(proj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	getProjectClusters := func(project string) ([]*v1alpha1.Cluster, error) {
+		return s.db.GetProjectClusters(ctx, project)
+	}
+
+	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db)
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).
+			WithFields(map[string]any{
+				"destination": app.Spec.Destination,
+			}).Warnf("cannot validate cluster, error=%v", err.Error())
+		return nil, nil, nil
+	}
+
+	permitted, err := proj.IsDestinationPermitted(destCluster, app.Spec.Destination.Namespace, getProjectClusters)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !permitted {
+		return nil, nil, errors.New("error getting destination cluster")
+	}
+	// sanitize cluster, remove cluster config creds and other unwanted fields
+	cluster, err = deeplinks.SanitizeCluster(destCluster)
+	return cluster, project, err
+}
+
+func (s *Server) ListResourceLinks(ctx context.Context, req *application.ApplicationResourceRequest) (*application.LinksResponse, error) {
+	obj, _, app, _, err := s.getUnstructuredLiveResourceOrApp(ctx, rbac.ActionGet, req)
+	if err != nil {
+		return nil, err
+	}
+	deepLinks, err := s.settingsMgr.GetDeepLinks(settings.ResourceDeepLinks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read application deep links from configmap: %w", err)
+	}
+
+	obj, err = s.replaceSecretValues(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error replacing secret values: %w", err)
+	}
+
+	appObj, err := kube.ToUnstructured(app)
+	if err != nil {
+		return nil, err
+	}
+
+	proj, err := s.getAppProject(ctx, app, log.WithFields(applog.GetAppLogFields(app)))
+	if err != nil {
+		return nil, err
+	}
+
+	clstObj, projObj, err := s.getObjectsForDeepLinks(ctx, app, proj)
+	if err != nil {
+		return nil, err
+	}
+
+	deepLinksObject := deeplinks.CreateDeepLinksObject(obj, appObj, clstObj, projObj)
+	finalList, errorList := deeplinks.EvaluateDeepLinksResponse(deepLinksObject, obj.GetName(), deepLinks)
+	if len(errorList) > 0 {
+		log.Errorf("errors while evaluating resource deep links, %v", strings.Join(errorList, ", "))
+	}
+
+	return finalList, nil
+}
+
+func getAmbiguousRevision(app *v1alpha1.Application, syncReq *application.ApplicationSyncRequest, sourceIndex int) string {
+	ambiguousRevision := ""
+	if app.Spec.HasMultipleSources() {
+		for i, pos := range syncReq.SourcePositions {
+			if pos == int64(sourceIndex+1) {
+				ambiguousRevision = syncReq.Revisions[i]
+			}
+		}
+		if ambiguousRevision == "" {
+			ambiguousRevision = app.Spec.Sources[sourceIndex].TargetRevision
+		}
+	} else {
+		ambiguousRevision = syncReq.GetRevision()
+		if ambiguousRevision == "" {
+			ambiguousRevision = app.Spec.GetSource().TargetRevision
+		}
+	}
+	return normalizeTargetRevision(app.Spec.GetSourcePtrByIndex(sourceIndex), ambiguousRevision)
+}
+
+// normalizeTargetRevision returns HEAD for an empty revision of a git source, which is how the repo server interprets
+// it. Helm and OCI sources are left untouched, since an empty revision has a different meaning for them.
+func normalizeTargetRevision(source *v1alpha1.ApplicationSource, revision string) string {
+	if revision != "" || source == nil || source.IsHelm() || source.IsOCI() {
+		return revision
+	}
+	return "HEAD"
+}
+
+// resolveRevision resolves the revision specified either in the sync request, or the
+// application source, into a concrete revision that will be used for a sync operation.
+func (s *Server) resolveRevision(ctx context.Context, app *v1alpha1.Application, syncReq *application.ApplicationSyncRequest, sourceIndex int) (string, string, error) {
+	if syncReq.Manifests != nil {
+		return "", "", nil
+	}
+
+	ambiguousRevision := getAmbiguousRevision(app, syncReq, sourceIndex)
+
+	repoURL := app.Spec.GetSource().RepoURL
+	if app.Spec.HasMultipleSources() {
+		repoURL = app.Spec.Sources[sourceIndex].RepoURL
+	}
+
+	repo, err := s.db.GetRepository(ctx, repoURL, app.Spec.Project)
+	if err != nil {
+		return "", "", fmt.Errorf("error getting repository by URL: %w", err)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return "", "", fmt.Errorf("error getting repo server client: %w", err)
+	}
+	defer utilio.Close(conn)
+
+	source := app.Spec.GetSourcePtrByIndex(sourceIndex)
+	if !source.IsHelm() {
+		if git.IsCommitSHA(ambiguousRevision) {
+			// If it's already a commit SHA, then no need to look it up
+			return ambiguousRevision, ambiguousRevision, nil
+		}
+	}
+
+	resolveRevisionResponse, err := repoClient.ResolveRevision(ctx, &apiclient.ResolveRevisionRequest{
+		Repo:              repo,
+		App:               app,
+		AmbiguousRevision: ambiguousRevision,
+		SourceIndex:       int64(sourceIndex),
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("error resolving repo revision: %w", err)
+	}
+	return resolveRevisionResponse.Revision, resolveRevisionResponse.AmbiguousRevision, nil
+}
+
+func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.OperationTerminateRequest) (*application.OperationTerminateResponse, error) {
+	appName := termOpReq.GetName()
+	appNs := s.appNamespaceOrDefault(termOpReq.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, termOpReq.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < 10; i++ {
+		if a.Operation == nil || a.Status.OperationState == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
+		}
+		if termOpReq.GetOperationId() != "" && termOpReq.GetOperationId() != getOperationID(&a.Status.OperationState.Operation) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Unable to terminate operation. The operation in progress is not operation %s", termOpReq.GetOperationId())
+		}
+		a.Status.OperationState.Phase = common.OperationTerminating
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			s.waitSync(updated)
+			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, "terminated running operation")
+			return &application.OperationTerminateResponse{}, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to set operation for app %q due to update conflict. retrying again...", *termOpReq.Name)
+		time.Sleep(100 * time.Millisecond)
+		_, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
+}
+
+func (s *Server) logAppEvent(ctx context.Context, a *v1alpha1.Application, reason string, action string) {
+	s.logAppEventWithLabels(ctx, a, reason, action, nil)
+}
+
+// logAppEventWithLabels logs an application event like logAppEvent, adding the given labels to the event labels derived
+// from the application and its project
+func (s *Server) logAppEventWithLabels(ctx context.Context, a *v1alpha1.Application, reason string, action string, extraLabels map[string]string) {
+	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	message := fmt.Sprintf("%s %s", user, action)
+	eventLabels := argo.GetAppEventLabels(ctx, a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db)
+	maps.Copy(eventLabels, extraLabels)
+	s.auditLogger.LogAppEvent(a, eventInfo, message, user, eventLabels)
+}
+
+func (s *Server) logResourceEvent(ctx context.Context, res *v1alpha1.ResourceNode, reason string, action string) {
+	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	message := fmt.Sprintf("%s %s", user, action)
+	s.auditLogger.LogResourceEvent(res, eventInfo, message, user)
+}
+
+func (s *Server) ListResourceActions(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ResourceActionsListResponse, error) {
+	obj, _, _, _, err := s.getUnstructuredLiveResourceOrApp(ctx, rbac.ActionGet, q)
+	if err != nil {
+		return nil, err
+	}
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+
+	availableActions, err := s.getAvailableActions(resourceOverrides, obj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting available actions: %w", err)
+	}
+	actionsPtr := []*v1alpha1.ResourceAction{}
+	for i := range availableActions {
+		actionsPtr = append(actionsPtr, &availableActions[i])
+	}
+
+	return &application.ResourceActionsListResponse{Actions: actionsPtr}, nil
+}
+
+func (s *Server) getUnstructuredLiveResourceOrApp(ctx context.Context, rbacRequest string, q *application.ApplicationResourceRequest) (obj *unstructured.Unstructured, res *v1alpha1.ResourceNode, app *v1alpha1.Application, config *rest.Config, err error) {
+	if q.GetKind() == applicationType.ApplicationKind && q.GetGroup() == applicationType.Group && q.GetName() == q.GetResourceName() {
+		app, _, err = s.getApplicationEnforceRBACInformer(ctx, rbacRequest, q.GetProject(), q.GetAppNamespace(), q.GetName())
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbacRequest, app.RBACName(s.ns))
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		config, err = s.getApplicationClusterConfig(ctx, app)
+		if err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("error getting application cluster config: %w", err)
+		}
+		obj, err = kube.ToUnstructured(app)
 	} else {
-		reason = fmt.Sprintf("initiated %ssync to %s", partial, displayRevision)
+		res, config, app, err = s.getAppLiveResource(ctx, rbacRequest, q)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		obj, err = s.kubectl.GetResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace)
 	}
-	if syncReq.Manifests != nil {
-		reason = fmt.Sprintf("initiated %ssync locally", partial)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("error getting resource: %w", err)
 	}
-	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, reason)
-	return a, nil
+	return
 }
 
-func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) (string, string, []string, []string, error) {
-	if a.Spec.HasMultipleSources() {
-		numOfSources := int64(len(a.Spec.GetSources()))
-		sourceRevisions := make([]string, numOfSources)
-		displayRevisions := make([]string, numOfSources)
+func (s *Server) getAvailableActions(resourceOverrides map[string]v1alpha1.ResourceOverride, obj *unstructured.Unstructured) ([]v1alpha1.ResourceAction, error) {
+	luaVM := lua.VM{
+		ResourceOverrides: resourceOverrides,
+	}
 
-		sources := a.Spec.GetSources()
-		for i, pos := range syncReq.SourcePositions {
-			if pos <= 0 || pos > numOfSources {
-				return "", "", nil, nil, errors.New("source position is out of range")
+	discoveryScripts, err := luaVM.GetResourceActionDiscovery(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Lua discovery script: %w", err)
+	}
+	if len(discoveryScripts) == 0 {
+		return []v1alpha1.ResourceAction{}, nil
+	}
+	availableActions, err := luaVM.ExecuteResourceActionDiscovery(obj, discoveryScripts)
+	if err != nil {
+		return nil, fmt.Errorf("error executing Lua discovery script: %w", err)
+	}
+	return availableActions, nil
+}
+
+// RunResourceAction runs a resource action on a live resource
+//
+// Deprecated: use RunResourceActionV2 instead. This version does not support resource action parameters but is
+// maintained for backward compatibility. It will be removed in a future release.
+func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceActionRunRequest) (*application.ApplicationResponse, error) {
+	log.WithFields(log.Fields{
+		"action":        q.Action,
+		"application":   q.Name,
+		"app-namespace": q.AppNamespace,
+		"project":       q.Project,
+		"user":          session.Username(ctx),
+	}).Warn("RunResourceAction was called. RunResourceAction is deprecated and will be removed in a future release. Use RunResourceActionV2 instead.")
+	qV2 := &application.ResourceActionRunRequestV2{
+		Name:         q.Name,
+		AppNamespace: q.AppNamespace,
+		Namespace:    q.Namespace,
+		ResourceName: q.ResourceName,
+		Kind:         q.Kind,
+		Version:      q.Version,
+		Group:        q.Group,
+		Project:      q.Project,
+	}
+	return s.RunResourceActionV2(ctx, qV2)
+}
+
+// resourceActionExecution is the outcome of evaluating a resource action against the live resource
+type resourceActionExecution struct {
+	liveObj    *unstructured.Unstructured
+	newObjects []lua.ImpactedResource
+	res        *v1alpha1.ResourceNode
+	app        *v1alpha1.Application
+	config     *rest.Config
+}
+
+// executeResourceAction enforces the action RBAC for the requested resource and evaluates the action's Lua script
+// against the live object, returning the objects the action would create or patch without applying them.
+func (s *Server) executeResourceAction(ctx context.Context, q *application.ResourceActionRunRequestV2) (*resourceActionExecution, error) {
+	resourceRequest := &application.ApplicationResourceRequest{
+		Name:         q.Name,
+		AppNamespace: q.AppNamespace,
+		Namespace:    q.Namespace,
+		ResourceName: q.ResourceName,
+		Kind:         q.Kind,
+		Version:      q.Version,
+		Group:        q.Group,
+		Project:      q.Project,
+	}
+	actionRequest := fmt.Sprintf("%s/%s/%s/%s", rbac.ActionAction, q.GetGroup(), q.GetKind(), q.GetAction())
+	liveObj, res, a, config, err := s.getUnstructuredLiveResourceOrApp(ctx, actionRequest, resourceRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+
+	luaVM := lua.VM{
+		ResourceOverrides: resourceOverrides,
+	}
+	action, err := luaVM.GetResourceAction(liveObj, q.GetAction())
+	if err != nil {
+		return nil, fmt.Errorf("error getting Lua resource action: %w", err)
+	}
+
+	newObjects, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, q.GetResourceActionParameters())
+	if err != nil {
+		return nil, fmt.Errorf("error executing Lua resource action: %w", err)
+	}
+	return &resourceActionExecution{liveObj: liveObj, newObjects: newObjects, res: res, app: a, config: config}, nil
+}
+
+func (s *Server) RunResourceActionV2(ctx context.Context, q *application.ResourceActionRunRequestV2) (*application.ApplicationResponse, error) {
+	execution, err := s.executeResourceAction(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	liveObj, newObjects, res, a, config := execution.liveObj, execution.newObjects, execution.res, execution.app, execution.config
+
+	liveObjBytes, err := json.Marshal(liveObj)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling live object: %w", err)
+	}
+
+	var app *v1alpha1.Application
+	// Only bother getting the app if we know we're going to need it for a resource permission check.
+	if len(newObjects) > 0 {
+		// No need for an RBAC check, we checked above that the user is allowed to run this action.
+		app, err = s.appLister.Applications(s.appNamespaceOrDefault(q.GetAppNamespace())).Get(q.GetName())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	proj, err := s.getAppProject(ctx, a, log.WithFields(applog.GetAppLogFields(a)))
+	if err != nil {
+		return nil, err
+	}
+
+	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	// First, make sure all the returned resources are permitted, for each operation.
+	// Also perform create with dry-runs for all create-operation resources.
+	// This is performed separately to reduce the risk of only some of the resources being successfully created later.
+	// TODO: when apply/delete operations would be supported for custom actions,
+	// the dry-run for relevant apply/delete operation would have to be invoked as well.
+	for _, impactedResource := range newObjects {
+		newObj := impactedResource.UnstructuredObj
+		err := s.verifyResourcePermitted(destCluster, proj, newObj)
+		if err != nil {
+			return nil, err
+		}
+		if impactedResource.K8SOperation == lua.CreateOperation {
+			createOptions := metav1.CreateOptions{DryRun: []string{"All"}}
+			_, err := s.kubectl.CreateResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), newObj, createOptions)
+			if err != nil {
+				return nil, err
 			}
-			sources[pos-1].TargetRevision = syncReq.Revisions[i]
 		}
-		for index, source := range sources {
-			if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() && !syncReq.GetDryRun() {
-				if text.FirstNonEmpty(a.Spec.GetSources()[index].TargetRevision, "HEAD") != text.FirstNonEmpty(source.TargetRevision, "HEAD") {
-					return "", "", nil, nil, status.Errorf(codes.FailedPrecondition, "Cannot sync source %s to %s: auto-sync currently set to %s", source.RepoURL, source.TargetRevision, a.Spec.Sources[index].TargetRevision)
-				}
+	}
+
+	// Now, perform the actual operations.
+	// The creation itself is not transactional.
+	// TODO: maybe create a k8s list representation of the resources,
+	// and invoke create on this list resource to make it semi-transactional (there is still patch operation that is separate,
+	// thus can fail separately from create).
+	for _, impactedResource := range newObjects {
+		newObj := impactedResource.UnstructuredObj
+		newObjBytes, err := json.Marshal(newObj)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling new object: %w", err)
+		}
+
+		switch impactedResource.K8SOperation {
+		// No default case since a not supported operation would have failed upon unmarshaling earlier
+		case lua.PatchOperation:
+			_, err := s.patchResource(ctx, config, liveObjBytes, newObjBytes, newObj)
+			if err != nil {
+				return nil, err
 			}
-			revision, displayRevision, err := s.resolveRevision(ctx, a, syncReq, index)
+		case lua.CreateOperation:
+			_, err := s.createResource(ctx, config, newObj)
 			if err != nil {
-				return "", "", nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+				return nil, err
 			}
-			sourceRevisions[index] = revision
-			displayRevisions[index] = displayRevision
 		}
-		return "", "", sourceRevisions, displayRevisions, nil
 	}
-	source := a.Spec.GetSource()
-	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() && !syncReq.GetDryRun() {
-		if syncReq.GetRevision() != "" && syncReq.GetRevision() != text.FirstNonEmpty(source.TargetRevision, "HEAD") {
-			return "", "", nil, nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.GetRevision(), source.TargetRevision)
+
+	if res == nil {
+		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
+	} else {
+		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s/%s", q.GetAction(), res.Group, res.Kind, res.Name))
+		s.logResourceEvent(ctx, res, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
+	}
+	return &application.ApplicationResponse{}, nil
+}
+
+// ValidateResourceAction evaluates a resource action without applying it and reports, per impacted resource, whether the
+// change would be accepted. Resources the action creates are submitted to the cluster with a server-side dry-run, so
+// validating and mutating admission webhooks (e.g. OPA Gatekeeper) are consulted and their denials are returned in a
+// structured form instead of failing the whole request. Actions which patch a resource are rejected as unimplemented,
+// since patches can not be dry-run yet.
+func (s *Server) ValidateResourceAction(ctx context.Context, q *application.ResourceActionRunRequestV2) (*application.ResourceActionValidationResponse, error) {
+	execution, err := s.executeResourceAction(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	// patches can not be submitted with a dry-run through the kubectl wrapper, so they can not be validated either
+	for _, impactedResource := range execution.newObjects {
+		if impactedResource.K8SOperation != lua.CreateOperation {
+			obj := impactedResource.UnstructuredObj
+			return nil, status.Errorf(codes.Unimplemented, "dry-run of %s operations is not supported: action %q would %s %s %s/%s", impactedResource.K8SOperation, q.GetAction(), impactedResource.K8SOperation, obj.GetKind(), obj.GetNamespace(), obj.GetName())
 		}
 	}
-	revision, displayRevision, err := s.resolveRevision(ctx, a, syncReq, -1)
+	newObjects, a, config := execution.newObjects, execution.app, execution.config
+
+	proj, err := s.getAppProject(ctx, a, log.WithFields(applog.GetAppLogFields(a)))
 	if err != nil {
-		return "", "", nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+		return nil, err
 	}
-	return revision, displayRevision, nil, nil, nil
-}
 
-func (s *Server) Rollback(ctx context.Context, rollbackReq *application.ApplicationRollbackRequest) (*v1alpha1.Application, error) {
-	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, rollbackReq.GetProject(), rollbackReq.GetAppNamespace(), rollbackReq.GetName(), "")
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
 	if err != nil {
 		return nil, err
 	}
 
-	s.inferResourcesStatusHealth(a)
+	res := &application.ResourceActionValidationResponse{Allowed: ptr.To(true)}
+	for _, impactedResource := range newObjects {
+		newObj := impactedResource.UnstructuredObj
+		gvk := newObj.GroupVersionKind()
+		result := &application.ResourceActionValidationResult{
+			Group:     ptr.To(gvk.Group),
+			Version:   ptr.To(gvk.Version),
+			Kind:      ptr.To(gvk.Kind),
+			Namespace: ptr.To(newObj.GetNamespace()),
+			Name:      ptr.To(newObj.GetName()),
+			Operation: ptr.To(string(impactedResource.K8SOperation)),
+			Allowed:   ptr.To(true),
+			DryRun:    ptr.To(false),
+		}
+		res.Results = append(res.Results, result)
 
-	if a.DeletionTimestamp != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
-	}
-	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled() {
-		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
-	}
+		if err := s.verifyResourcePermitted(destCluster, proj, newObj); err != nil {
+			result.Allowed = ptr.To(false)
+			result.Reason = ptr.To(string(metav1.StatusReasonForbidden))
+			result.Message = ptr.To(err.Error())
+			res.Allowed = ptr.To(false)
+			continue
+		}
 
-	var deploymentInfo *v1alpha1.RevisionHistory
-	for _, info := range a.Status.History {
-		if info.ID == rollbackReq.GetId() {
-			deploymentInfo = &info
-			break
+		createOptions := metav1.CreateOptions{DryRun: []string{"All"}}
+		_, err := s.kubectl.CreateResource(ctx, config, gvk, newObj.GetName(), newObj.GetNamespace(), newObj, createOptions)
+		result.DryRun = ptr.To(true)
+		if err != nil {
+			setResourceActionValidationError(result, err)
+			res.Allowed = ptr.To(false)
 		}
 	}
-	if deploymentInfo == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.QualifiedName(), rollbackReq.GetId())
-	}
-	if deploymentInfo.Source.IsZero() && deploymentInfo.Sources.IsZero() {
-		// Since source type was introduced to history starting with v0.12, and is now required for
-		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
-		// As multi source doesn't use app.Source, we need to check to the Sources length
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
-	}
+	return res, nil
+}
 
-	var syncOptions v1alpha1.SyncOptions
-	if a.Spec.SyncPolicy != nil {
-		syncOptions = a.Spec.SyncPolicy.SyncOptions
+// setResourceActionValidationError marks the validation result as denied and copies the reason, code and causes of a
+// Kubernetes API error, such as an admission webhook denial, into it.
+func setResourceActionValidationError(result *application.ResourceActionValidationResult, err error) {
+	result.Allowed = ptr.To(false)
+	result.Message = ptr.To(err.Error())
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) {
+		return
 	}
-
-	// Rollback is just a convenience around Sync
-	op := v1alpha1.Operation{
-		Sync: &v1alpha1.SyncOperation{
-			Revision:     deploymentInfo.Revision,
-			Revisions:    deploymentInfo.Revisions,
-			DryRun:       rollbackReq.GetDryRun(),
-			Prune:        rollbackReq.GetPrune(),
-			SyncOptions:  syncOptions,
-			SyncStrategy: &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
-			Sources:      deploymentInfo.Sources,
-		},
-		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
+	st := apiStatus.Status()
+	result.Reason = ptr.To(string(st.Reason))
+	result.Code = ptr.To(st.Code)
+	if st.Message != "" {
+		result.Message = ptr.To(st.Message)
 	}
-	appName := rollbackReq.GetName()
-	appNs := s.appNamespaceOrDefault(rollbackReq.GetAppNamespace())
-	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
-	a, err = argo.SetAppOperation(appIf, appName, &op)
-	if err != nil {
-		return nil, fmt.Errorf("error setting app operation: %w", err)
+	if st.Details == nil {
+		return
+	}
+	for _, cause := range st.Details.Causes {
+		result.Causes = append(result.Causes, &application.ResourceActionValidationCause{
+			Type:    ptr.To(string(cause.Type)),
+			Field:   ptr.To(cause.Field),
+			Message: ptr.To(cause.Message),
+		})
 	}
-	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.GetId()))
-	return a, nil
 }
 
-func (s *Server) ListLinks(ctx context.Context, req *application.ListAppLinksRequest) (*application.LinksResponse, error) {
-	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, req.GetProject(), req.GetNamespace(), req.GetName(), "")
+// restartableWorkloadKinds are the kinds that RestartAppWorkloads knows how to roll-restart
+var restartableWorkloadKinds = map[string]bool{
+	kube.DeploymentKind:  true,
+	kube.StatefulSetKind: true,
+	kube.DaemonSetKind:   true,
+}
+
+// RestartAppWorkloads roll-restarts every Deployment, StatefulSet and DaemonSet of the application by patching the
+// pod template with the same restartedAt annotation that `kubectl rollout restart` uses. Each workload is restarted
+// independently and the per-resource outcome is returned, so a single failure does not abort the remaining restarts.
+func (s *Server) RestartAppWorkloads(ctx context.Context, q *application.RestartAppWorkloadsRequest) (*application.RestartAppWorkloadsResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
 		return nil, err
 	}
 
-	obj, err := kube.ToUnstructured(a)
+	tree, err := s.getAppResources(ctx, a)
 	if err != nil {
-		return nil, fmt.Errorf("error getting application: %w", err)
+		return nil, fmt.Errorf("error getting app resources: %w", err)
 	}
 
-	deepLinks, err := s.settingsMgr.GetDeepLinks(settings.ApplicationDeepLinks)
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read application deep links from configmap: %w", err)
+		return nil, fmt.Errorf("error getting destination cluster: %w", err)
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
 	}
 
-	clstObj, _, err := s.getObjectsForDeepLinks(ctx, a, proj)
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]string{
+						"kubectl.kubernetes.io/restartedAt": time.Now().UTC().Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error marshaling restart patch: %w", err)
 	}
 
-	deepLinksObject := deeplinks.CreateDeepLinksObject(nil, obj, clstObj, nil)
+	res := &application.RestartAppWorkloadsResponse{}
+	restarted := 0
+	for i := range tree.Nodes {
+		node := tree.Nodes[i]
+		if node.Group != "apps" || !restartableWorkloadKinds[node.Kind] || node.UID == "" {
+			continue
+		}
+		result := &application.WorkloadRestartResult{
+			Group:     ptr.To(node.Group),
+			Kind:      ptr.To(node.Kind),
+			Namespace: ptr.To(node.Namespace),
+			Name:      ptr.To(node.Name),
+			Restarted: ptr.To(false),
+		}
+		res.Results = append(res.Results, result)
 
-	finalList, errorList := deeplinks.EvaluateDeepLinksResponse(deepLinksObject, obj.GetName(), deepLinks)
-	if len(errorList) > 0 {
-		log.Errorf("errorList while evaluating application deep links, %v", strings.Join(errorList, ", "))
+		actionRequest := fmt.Sprintf("%s/%s/%s/%s", rbac.ActionAction, node.Group, node.Kind, "restart")
+		if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, actionRequest, a.RBACName(s.ns)) {
+			result.Error = ptr.To(argocommon.PermissionDeniedAPIError.Error())
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(node.GroupKindVersion())
+		obj.SetNamespace(node.Namespace)
+		obj.SetName(node.Name)
+		if err := s.verifyResourcePermitted(destCluster, proj, obj); err != nil {
+			result.Error = ptr.To(err.Error())
+			continue
+		}
+
+		_, err = s.kubectl.PatchResource(ctx, config, node.GroupKindVersion(), node.Name, node.Namespace, types.StrategicMergePatchType, patch)
+		if err != nil {
+			result.Error = ptr.To(fmt.Sprintf("error patching resource: %v", err))
+			continue
+		}
+		result.Restarted = ptr.To(true)
+		restarted++
+		s.logResourceEvent(ctx, &node, argo.EventReasonResourceActionRan, "ran action restart")
 	}
 
-	return finalList, nil
+	s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, fmt.Sprintf("restarted %d workloads", restarted))
+	return res, nil
 }
 
-func (s *Server) getObjectsForDeepLinks(ctx context.Context, app *v1alpha1.Application, proj *v1alpha1.AppProject) (cluster *unstructured.Unstructured, project *unstructured.Unstructured, err error) {
-	// sanitize project jwt tokens
-	proj.Status = v1alpha1.AppProjectStatus{}
-
-	project, err = kube.ToUnstructured(proj)
+func (s *Server) patchResource(ctx context.Context, config *rest.Config, liveObjBytes, newObjBytes []byte, newObj *unstructured.Unstructured) (*application.ApplicationResponse, error) {
+	diffBytes, err := jsonpatch.CreateMergePatch(liveObjBytes, newObjBytes)
 	if err != nil {
-		return nil, nil, err
+		return nil, fmt.Errorf("error calculating merge patch: %w", err)
 	}
-
-	getProjectClusters := func(project string) ([]*v1alpha1.Cluster, error) {
-		return s.db.GetProjectClusters(ctx, project)
+	if string(diffBytes) == "{}" {
+		return &application.ApplicationResponse{}, nil
 	}
 
-	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db)
+	// The following logic detects if the resource action makes a modification to status and/or spec.
+	// If status was modified, we attempt to patch the status using status subresource, in case the
+	// CRD is configured using the status subresource feature. See:
+	// https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definitions/#status-subresource
+	// If status subresource is in use, the patch has to be split into two:
+	// * one to update spec (and other non-status fields)
+	// * the other to update only status.
+	nonStatusPatch, statusPatch, err := splitStatusPatch(diffBytes)
 	if err != nil {
-		log.WithFields(applog.GetAppLogFields(app)).
-			WithFields(map[string]any{
-				"destination": app.Spec.Destination,
-			}).Warnf("cannot validate cluster, error=%v", err.Error())
-		return nil, nil, nil
+		return nil, fmt.Errorf("error splitting status patch: %w", err)
+	}
+	if statusPatch != nil {
+		_, err = s.kubectl.PatchResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), types.MergePatchType, diffBytes, "status")
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error patching resource: %w", err)
+			}
+			// K8s API server returns 404 NotFound when the CRD does not support the status subresource
+			// if we get here, the CRD does not use the status subresource. We will fall back to a normal patch
+		} else {
+			// If we get here, the CRD does use the status subresource, so we must patch status and
+			// spec separately. update the diffBytes to the spec-only patch and fall through.
+			diffBytes = nonStatusPatch
+		}
+	}
+	if diffBytes != nil {
+		_, err = s.kubectl.PatchResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), types.MergePatchType, diffBytes)
+		if err != nil {
+			return nil, fmt.Errorf("error patching resource: %w", err)
+		}
 	}
+	return &application.ApplicationResponse{}, nil
+}
 
-	permitted, err := proj.IsDestinationPermitted(destCluster, app.Spec.Destination.Namespace, getProjectClusters)
+func (s *Server) verifyResourcePermitted(destCluster *v1alpha1.Cluster, proj *v1alpha1.AppProject, obj *unstructured.Unstructured) error {
+	permitted, err := proj.IsResourcePermitted(schema.GroupKind{Group: obj.GroupVersionKind().Group, Kind: obj.GroupVersionKind().Kind}, obj.GetNamespace(), destCluster, func(project string) ([]*v1alpha1.Cluster, error) {
+		clusters, err := s.db.GetProjectClusters(context.TODO(), project)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project clusters: %w", err)
+		}
+		return clusters, nil
+	})
 	if err != nil {
-		return nil, nil, err
+		return fmt.Errorf("error checking resource permissions: %w", err)
 	}
 	if !permitted {
-		return nil, nil, errors.New("error getting destination cluster")
+		return fmt.Errorf("application is not permitted to manage %s/%s/%s in %s", obj.GroupVersionKind().Group, obj.GroupVersionKind().Kind, obj.GetName(), obj.GetNamespace())
 	}
-	// sanitize cluster, remove cluster config creds and other unwanted fields
-	cluster, err = deeplinks.SanitizeCluster(destCluster)
-	return cluster, project, err
+
+	return nil
 }
 
-func (s *Server) ListResourceLinks(ctx context.Context, req *application.ApplicationResourceRequest) (*application.LinksResponse, error) {
-	obj, _, app, _, err := s.getUnstructuredLiveResourceOrApp(ctx, rbac.ActionGet, req)
+func (s *Server) createResource(ctx context.Context, config *rest.Config, newObj *unstructured.Unstructured) (*application.ApplicationResponse, error) {
+	_, err := s.kubectl.CreateResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), newObj, metav1.CreateOptions{})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error creating resource: %w", err)
 	}
-	deepLinks, err := s.settingsMgr.GetDeepLinks(settings.ResourceDeepLinks)
+	return &application.ApplicationResponse{}, nil
+}
+
+// splitStatusPatch splits a patch into two: one for a non-status patch, and the status-only patch.
+// Returns nil for either if the patch doesn't have modifications to non-status, or status, respectively.
+func splitStatusPatch(patch []byte) ([]byte, []byte, error) {
+	var obj map[string]any
+	err := json.Unmarshal(patch, &obj)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read application deep links from configmap: %w", err)
+		return nil, nil, err
+	}
+	var nonStatusPatch, statusPatch []byte
+	if statusVal, ok := obj["status"]; ok {
+		// calculate the status-only patch
+		statusObj := map[string]any{
+			"status": statusVal,
+		}
+		statusPatch, err = json.Marshal(statusObj)
+		if err != nil {
+			return nil, nil, err
+		}
+		// remove status, and calculate the non-status patch
+		delete(obj, "status")
+		if len(obj) > 0 {
+			nonStatusPatch, err = json.Marshal(obj)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	} else {
+		// status was not modified in patch
+		nonStatusPatch = patch
 	}
+	return nonStatusPatch, statusPatch, nil
+}
 
-	obj, err = s.replaceSecretValues(obj)
+func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.ApplicationSyncWindowsQuery) (*application.ApplicationSyncWindowsResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
 	if err != nil {
-		return nil, fmt.Errorf("error replacing secret values: %w", err)
+		return nil, err
 	}
 
-	appObj, err := kube.ToUnstructured(app)
+	windows := proj.Spec.SyncWindows.Matches(a)
+	sync, err := windows.CanSync(true)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
 	}
 
-	proj, err := s.getAppProject(ctx, app, log.WithFields(applog.GetAppLogFields(app)))
+	activeWindows, err := windows.Active()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
+	}
+	res := &application.ApplicationSyncWindowsResponse{
+		ActiveWindows:   convertSyncWindows(activeWindows),
+		AssignedWindows: convertSyncWindows(windows),
+		CanSync:         &sync,
 	}
 
-	clstObj, projObj, err := s.getObjectsForDeepLinks(ctx, app, proj)
+	return res, nil
+}
+
+// GetSyncDurations returns the time each resource of the last sync operation took to become healthy, based on the sync
+// result and the health transition time of the resources.
+func (s *Server) GetSyncDurations(ctx context.Context, q *application.ApplicationSyncDurationsQuery) (*application.ApplicationSyncDurationsResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
 	if err != nil {
 		return nil, err
 	}
+	s.inferResourcesStatusHealth(a)
 
-	deepLinksObject := deeplinks.CreateDeepLinksObject(obj, appObj, clstObj, projObj)
-	finalList, errorList := deeplinks.EvaluateDeepLinksResponse(deepLinksObject, obj.GetName(), deepLinks)
-	if len(errorList) > 0 {
-		log.Errorf("errors while evaluating resource deep links, %v", strings.Join(errorList, ", "))
+	res := &application.ApplicationSyncDurationsResponse{}
+	opState := a.Status.OperationState
+	if opState == nil || opState.SyncResult == nil {
+		return res, nil
 	}
-
-	return finalList, nil
+	res.StartedAt = &opState.StartedAt
+	res.FinishedAt = opState.FinishedAt
+	res.Resources = resourceSyncDurations(opState, a.Status.Resources, time.Now())
+	return res, nil
 }
 
-func getAmbiguousRevision(app *v1alpha1.Application, syncReq *application.ApplicationSyncRequest, sourceIndex int) string {
-	ambiguousRevision := ""
-	if app.Spec.HasMultipleSources() {
-		for i, pos := range syncReq.SourcePositions {
-			if pos == int64(sourceIndex+1) {
-				ambiguousRevision = syncReq.Revisions[i]
-			}
+// resourceSyncDurations returns, for every resource of the sync result, the time from the start of the operation until
+// the resource became healthy, longest first. Resources without health are considered ready once the operation
+// finished. Resources which are not ready yet are reported as pending with the time elapsed so far.
+func resourceSyncDurations(opState *v1alpha1.OperationState, resources []v1alpha1.ResourceStatus, now time.Time) []*application.ResourceSyncDuration {
+	healthByKey := make(map[kube.ResourceKey]*v1alpha1.HealthStatus, len(resources))
+	for _, res := range resources {
+		healthByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Health
+	}
+
+	startedAt := opState.StartedAt.Time
+	var durations []*application.ResourceSyncDuration
+	for _, result := range opState.SyncResult.Resources {
+		if result.HookType != "" {
+			continue
 		}
-		if ambiguousRevision == "" {
-			ambiguousRevision = app.Spec.Sources[sourceIndex].TargetRevision
+		resHealth := healthByKey[kube.NewResourceKey(result.Group, result.Kind, result.Namespace, result.Name)]
+		var readyAt *time.Time
+		switch {
+		case resHealth != nil && resHealth.Status == health.HealthStatusHealthy && resHealth.LastTransitionTime != nil:
+			readyAt = &resHealth.LastTransitionTime.Time
+		case (resHealth == nil || resHealth.Status == health.HealthStatusHealthy) && opState.FinishedAt != nil:
+			readyAt = &opState.FinishedAt.Time
 		}
-	} else {
-		ambiguousRevision = syncReq.GetRevision()
-		if ambiguousRevision == "" {
-			ambiguousRevision = app.Spec.GetSource().TargetRevision
+		duration := &application.ResourceSyncDuration{
+			Group:        ptr.To(result.Group),
+			Kind:         ptr.To(result.Kind),
+			Namespace:    ptr.To(result.Namespace),
+			Name:         ptr.To(result.Name),
+			SyncStatus:   ptr.To(string(result.Status)),
+			HealthStatus: ptr.To(""),
+			Pending:      ptr.To(readyAt == nil),
+		}
+		if resHealth != nil {
+			duration.HealthStatus = ptr.To(string(resHealth.Status))
 		}
+		end := now
+		if readyAt != nil {
+			end = *readyAt
+		}
+		// resources which were already healthy before the operation started took no time
+		duration.DurationSeconds = ptr.To(int64(max(end.Sub(startedAt), 0).Seconds()))
+		durations = append(durations, duration)
 	}
-	return ambiguousRevision
+	sort.SliceStable(durations, func(i, j int) bool {
+		return durations[i].GetDurationSeconds() > durations[j].GetDurationSeconds()
+	})
+	return durations
 }
 
-// resolveRevision resolves the revision specified either in the sync request, or the
-// application source, into a concrete revision that will be used for a sync operation.
-func (s *Server) resolveRevision(ctx context.Context, app *v1alpha1.Application, syncReq *application.ApplicationSyncRequest, sourceIndex int) (string, string, error) {
-	if syncReq.Manifests != nil {
-		return "", "", nil
-	}
-
-	ambiguousRevision := getAmbiguousRevision(app, syncReq, sourceIndex)
-
-	repoURL := app.Spec.GetSource().RepoURL
-	if app.Spec.HasMultipleSources() {
-		repoURL = app.Spec.Sources[sourceIndex].RepoURL
-	}
+// hookSyncPhases are the sync phases hooks run in, in the order they run in
+var hookSyncPhases = []common.SyncPhase{common.SyncPhasePreSync, common.SyncPhaseSync, common.SyncPhasePostSync, common.SyncPhaseSyncFail}
 
-	repo, err := s.db.GetRepository(ctx, repoURL, app.Spec.Project)
+// GetHookResults returns the outcome of the hooks executed by the last sync operation, grouped by the sync phase they
+// ran in. Unlike the hook definitions of the manifests, this shows which hooks actually ran and whether they failed.
+func (s *Server) GetHookResults(ctx context.Context, q *application.ApplicationHookResultsQuery) (*application.ApplicationHookResultsResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
-		return "", "", fmt.Errorf("error getting repository by URL: %w", err)
+		return nil, err
 	}
-	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
-	if err != nil {
-		return "", "", fmt.Errorf("error getting repo server client: %w", err)
+
+	res := &application.ApplicationHookResultsResponse{}
+	opState := a.Status.OperationState
+	if opState == nil || opState.SyncResult == nil {
+		return res, nil
 	}
-	defer utilio.Close(conn)
+	res.OperationPhase = ptr.To(string(opState.Phase))
+	res.Phases = hookPhaseResults(opState.SyncResult.Resources)
+	return res, nil
+}
 
-	source := app.Spec.GetSourcePtrByIndex(sourceIndex)
-	if !source.IsHelm() {
-		if git.IsCommitSHA(ambiguousRevision) {
-			// If it's already a commit SHA, then no need to look it up
-			return ambiguousRevision, ambiguousRevision, nil
+// hookPhaseResults groups the hook results of a sync result by sync phase, in the order the phases run in
+func hookPhaseResults(results v1alpha1.ResourceResults) []*application.HookPhaseResults {
+	hooksByPhase := map[common.SyncPhase][]*application.HookResult{}
+	for _, result := range results {
+		if result.HookType == "" {
+			continue
 		}
+		hooksByPhase[result.SyncPhase] = append(hooksByPhase[result.SyncPhase], &application.HookResult{
+			Group:     ptr.To(result.Group),
+			Kind:      ptr.To(result.Kind),
+			Namespace: ptr.To(result.Namespace),
+			Name:      ptr.To(result.Name),
+			HookType:  ptr.To(string(result.HookType)),
+			HookPhase: ptr.To(string(result.HookPhase)),
+			Message:   ptr.To(result.Message),
+		})
 	}
-
-	resolveRevisionResponse, err := repoClient.ResolveRevision(ctx, &apiclient.ResolveRevisionRequest{
-		Repo:              repo,
-		App:               app,
-		AmbiguousRevision: ambiguousRevision,
-		SourceIndex:       int64(sourceIndex),
-	})
-	if err != nil {
-		return "", "", fmt.Errorf("error resolving repo revision: %w", err)
+	var res []*application.HookPhaseResults
+	for _, phase := range hookSyncPhases {
+		if hooks, ok := hooksByPhase[phase]; ok {
+			res = append(res, &application.HookPhaseResults{Phase: ptr.To(string(phase)), Hooks: hooks})
+		}
 	}
-	return resolveRevisionResponse.Revision, resolveRevisionResponse.AmbiguousRevision, nil
+	return res
 }
 
-func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.OperationTerminateRequest) (*application.OperationTerminateResponse, error) {
-	appName := termOpReq.GetName()
-	appNs := s.appNamespaceOrDefault(termOpReq.GetAppNamespace())
-	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, termOpReq.GetProject(), appNs, appName, "")
+// ListProjectAppConditions returns the conditions of the applications of a project which the caller can get, grouped by
+// condition type. The applications are selected the same way List selects them.
+func (s *Server) ListProjectAppConditions(ctx context.Context, q *application.ProjectAppConditionsQuery) (*application.ProjectAppConditionsResponse, error) {
+	apps, err := s.List(ctx, &application.ApplicationQuery{
+		Projects:     []string{q.GetProject()},
+		Selector:     q.Selector,
+		AppNamespace: q.AppNamespace,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	for i := 0; i < 10; i++ {
-		if a.Operation == nil || a.Status.OperationState == nil {
-			return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
-		}
-		a.Status.OperationState.Phase = common.OperationTerminating
-		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
-		if err == nil {
-			s.waitSync(updated)
-			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, "terminated running operation")
-			return &application.OperationTerminateResponse{}, nil
-		}
-		if !apierrors.IsConflict(err) {
-			return nil, fmt.Errorf("error updating application: %w", err)
-		}
-		log.Warnf("failed to set operation for app %q due to update conflict. retrying again...", *termOpReq.Name)
-		time.Sleep(100 * time.Millisecond)
-		_, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("error getting application by name: %w", err)
+	groupsByType := make(map[v1alpha1.ApplicationConditionType]*application.ApplicationConditionGroup)
+	for _, a := range apps.Items {
+		for _, condition := range a.Status.Conditions {
+			group, ok := groupsByType[condition.Type]
+			if !ok {
+				group = &application.ApplicationConditionGroup{Type: ptr.To(condition.Type)}
+				groupsByType[condition.Type] = group
+			}
+			group.Applications = append(group.Applications, &application.ApplicationConditionRef{
+				Name:               ptr.To(a.Name),
+				AppNamespace:       ptr.To(a.Namespace),
+				Message:            ptr.To(condition.Message),
+				LastTransitionTime: condition.LastTransitionTime,
+			})
 		}
 	}
-	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
-}
 
-func (s *Server) logAppEvent(ctx context.Context, a *v1alpha1.Application, reason string, action string) {
-	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
-	user := session.Username(ctx)
-	if user == "" {
-		user = "Unknown user"
+	res := &application.ProjectAppConditionsResponse{}
+	for _, group := range groupsByType {
+		// an application can have several conditions of the same type
+		appNames := make(map[string]bool)
+		for _, ref := range group.Applications {
+			appNames[ref.GetAppNamespace()+"/"+ref.GetName()] = true
+		}
+		group.Count = ptr.To(int64(len(appNames)))
+		res.Groups = append(res.Groups, group)
 	}
-	message := fmt.Sprintf("%s %s", user, action)
-	eventLabels := argo.GetAppEventLabels(ctx, a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db)
-	s.auditLogger.LogAppEvent(a, eventInfo, message, user, eventLabels)
+	sort.Slice(res.Groups, func(i, j int) bool {
+		return res.Groups[i].GetType() < res.Groups[j].GetType()
+	})
+	return res, nil
 }
 
-func (s *Server) logResourceEvent(ctx context.Context, res *v1alpha1.ResourceNode, reason string, action string) {
-	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
-	user := session.Username(ctx)
-	if user == "" {
-		user = "Unknown user"
+// ListProjectSyncWindows returns every sync window of a project together with the applications of that project which
+// the window affects. Only applications the caller is allowed to get are included.
+func (s *Server) ListProjectSyncWindows(ctx context.Context, q *application.ProjectSyncWindowsQuery) (*application.ProjectSyncWindowsResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.GetProject()); err != nil {
+		return nil, err
 	}
-	message := fmt.Sprintf("%s %s", user, action)
-	s.auditLogger.LogResourceEvent(res, eventInfo, message, user)
-}
-
-func (s *Server) ListResourceActions(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ResourceActionsListResponse, error) {
-	obj, _, _, _, err := s.getUnstructuredLiveResourceOrApp(ctx, rbac.ActionGet, q)
+	proj, err := applisters.NewAppProjectLister(s.projInformer.GetIndexer()).AppProjects(s.ns).Get(q.GetProject())
 	if err != nil {
-		return nil, err
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "project %q not found", q.GetProject())
+		}
+		return nil, fmt.Errorf("error getting project: %w", err)
 	}
-	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+
+	apps, err := s.appLister.List(labels.Everything())
 	if err != nil {
-		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+		return nil, fmt.Errorf("error listing apps: %w", err)
 	}
+	apps = argo.FilterByProjectsP(apps, []string{proj.Name})
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].QualifiedName() < apps[j].QualifiedName()
+	})
 
-	availableActions, err := s.getAvailableActions(resourceOverrides, obj)
+	appsByWindow := make(map[*v1alpha1.SyncWindow][]string)
+	for _, a := range apps {
+		if !s.isNamespaceEnabled(a.Namespace) {
+			continue
+		}
+		if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns)) {
+			continue
+		}
+		if matched := proj.Spec.SyncWindows.Matches(a); matched != nil {
+			for _, w := range *matched {
+				appsByWindow[w] = append(appsByWindow[w], a.QualifiedName())
+			}
+		}
+	}
+
+	activeWindows, err := proj.Spec.SyncWindows.Active()
 	if err != nil {
-		return nil, fmt.Errorf("error getting available actions: %w", err)
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
 	}
-	actionsPtr := []*v1alpha1.ResourceAction{}
-	for i := range availableActions {
-		actionsPtr = append(actionsPtr, &availableActions[i])
+	active := make(map[*v1alpha1.SyncWindow]bool)
+	if activeWindows != nil {
+		for _, w := range *activeWindows {
+			active[w] = true
+		}
 	}
 
-	return &application.ResourceActionsListResponse{Actions: actionsPtr}, nil
+	res := &application.ProjectSyncWindowsResponse{}
+	for _, w := range proj.Spec.SyncWindows {
+		res.Windows = append(res.Windows, &application.ProjectSyncWindowApplications{
+			Window: &application.ApplicationSyncWindow{
+				Kind:       ptr.To(w.Kind),
+				Schedule:   ptr.To(w.Schedule),
+				Duration:   ptr.To(w.Duration),
+				ManualSync: ptr.To(w.ManualSync),
+			},
+			Active:       ptr.To(active[w]),
+			Applications: appsByWindow[w],
+		})
+	}
+	return res, nil
 }
 
-func (s *Server) getUnstructuredLiveResourceOrApp(ctx context.Context, rbacRequest string, q *application.ApplicationResourceRequest) (obj *unstructured.Unstructured, res *v1alpha1.ResourceNode, app *v1alpha1.Application, config *rest.Config, err error) {
-	if q.GetKind() == applicationType.ApplicationKind && q.GetGroup() == applicationType.Group && q.GetName() == q.GetResourceName() {
-		app, _, err = s.getApplicationEnforceRBACInformer(ctx, rbacRequest, q.GetProject(), q.GetAppNamespace(), q.GetName())
+// ListAppsBlockedBySyncWindow returns the applications visible to the caller which currently cannot be synced manually
+// because of their project's sync windows, together with the windows that block them and when each of them opens.
+func (s *Server) ListAppsBlockedBySyncWindow(ctx context.Context, q *application.ApplicationQuery) (*application.ApplicationsBlockedBySyncWindowResponse, error) {
+	appList, err := s.List(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	projLister := applisters.NewAppProjectLister(s.projInformer.GetIndexer()).AppProjects(s.ns)
+	res := &application.ApplicationsBlockedBySyncWindowResponse{}
+	for i := range appList.Items {
+		a := &appList.Items[i]
+		proj, err := projLister.Get(a.Spec.GetProject())
 		if err != nil {
-			return nil, nil, nil, nil, err
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error getting project: %w", err)
 		}
-		err = s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbacRequest, app.RBACName(s.ns))
+		windows, err := blockingSyncWindows(proj.Spec.SyncWindows.Matches(a))
 		if err != nil {
-			return nil, nil, nil, nil, err
+			return nil, fmt.Errorf("error evaluating sync windows of application %s: %w", a.QualifiedName(), err)
 		}
-		config, err = s.getApplicationClusterConfig(ctx, app)
-		if err != nil {
-			return nil, nil, nil, nil, fmt.Errorf("error getting application cluster config: %w", err)
+		if len(windows) == 0 {
+			continue
 		}
-		obj, err = kube.ToUnstructured(app)
-	} else {
-		res, config, app, err = s.getAppLiveResource(ctx, rbacRequest, q)
-		if err != nil {
-			return nil, nil, nil, nil, err
+		item := &application.ApplicationBlockedBySyncWindow{
+			Name:         ptr.To(a.Name),
+			AppNamespace: ptr.To(a.Namespace),
+			Project:      ptr.To(a.Spec.GetProject()),
 		}
-		obj, err = s.kubectl.GetResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace)
-	}
-	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("error getting resource: %w", err)
+		for _, w := range windows {
+			blocking := &application.BlockingSyncWindow{
+				Window: &application.ApplicationSyncWindow{
+					Kind:       ptr.To(w.Kind),
+					Schedule:   ptr.To(w.Schedule),
+					Duration:   ptr.To(w.Duration),
+					ManualSync: ptr.To(w.ManualSync),
+				},
+			}
+			nextChange, err := w.NextChange()
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating sync window: %w", err)
+			}
+			blocking.NextOpenTime = &metav1.Time{Time: nextChange}
+			item.Windows = append(item.Windows, blocking)
+		}
+		res.Items = append(res.Items, item)
 	}
-	return
+	return res, nil
 }
 
-func (s *Server) getAvailableActions(resourceOverrides map[string]v1alpha1.ResourceOverride, obj *unstructured.Unstructured) ([]v1alpha1.ResourceAction, error) {
-	luaVM := lua.VM{
-		ResourceOverrides: resourceOverrides,
-	}
-
-	discoveryScripts, err := luaVM.GetResourceActionDiscovery(obj)
-	if err != nil {
-		return nil, fmt.Errorf("error getting Lua discovery script: %w", err)
-	}
-	if len(discoveryScripts) == 0 {
-		return []v1alpha1.ResourceAction{}, nil
+// ListStaleApplications returns the applications visible to the caller whose last successful sync, as recorded in their
+// revision history, is older than the requested threshold. Applications which have never been synced successfully are
+// reported as stale too. The other fields of the query filter the applications like they do for List.
+func (s *Server) ListStaleApplications(ctx context.Context, q *application.ApplicationQuery) (*application.StaleApplicationsResponse, error) {
+	if q.GetStaleAfterSeconds() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "stale threshold must be positive")
 	}
-	availableActions, err := luaVM.ExecuteResourceActionDiscovery(obj, discoveryScripts)
+	appList, err := s.List(ctx, q)
 	if err != nil {
-		return nil, fmt.Errorf("error executing Lua discovery script: %w", err)
+		return nil, err
 	}
-	return availableActions, nil
-}
 
-// RunResourceAction runs a resource action on a live resource
-//
-// Deprecated: use RunResourceActionV2 instead. This version does not support resource action parameters but is
-// maintained for backward compatibility. It will be removed in a future release.
-func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceActionRunRequest) (*application.ApplicationResponse, error) {
-	log.WithFields(log.Fields{
-		"action":        q.Action,
-		"application":   q.Name,
-		"app-namespace": q.AppNamespace,
-		"project":       q.Project,
-		"user":          session.Username(ctx),
-	}).Warn("RunResourceAction was called. RunResourceAction is deprecated and will be removed in a future release. Use RunResourceActionV2 instead.")
-	qV2 := &application.ResourceActionRunRequestV2{
-		Name:         q.Name,
-		AppNamespace: q.AppNamespace,
-		Namespace:    q.Namespace,
-		ResourceName: q.ResourceName,
-		Kind:         q.Kind,
-		Version:      q.Version,
-		Group:        q.Group,
-		Project:      q.Project,
+	threshold := time.Duration(q.GetStaleAfterSeconds()) * time.Second
+	res := &application.StaleApplicationsResponse{}
+	for i := range appList.Items {
+		a := &appList.Items[i]
+		item := &application.StaleApplication{
+			Name:         ptr.To(a.Name),
+			AppNamespace: ptr.To(a.Namespace),
+			Project:      ptr.To(a.Spec.GetProject()),
+		}
+		if len(a.Status.History) > 0 {
+			history := a.Status.History.LastRevisionHistory()
+			if time.Since(history.DeployedAt.Time) < threshold {
+				continue
+			}
+			item.LastSyncedAt = history.DeployedAt.DeepCopy()
+			item.Revision = ptr.To(history.Revision)
+			if history.Revision == "" && len(history.Revisions) > 0 {
+				item.Revision = ptr.To(history.Revisions[0])
+			}
+		}
+		res.Items = append(res.Items, item)
 	}
-	return s.RunResourceActionV2(ctx, qV2)
-}
-
-func (s *Server) RunResourceActionV2(ctx context.Context, q *application.ResourceActionRunRequestV2) (*application.ApplicationResponse, error) {
-	resourceRequest := &application.ApplicationResourceRequest{
-		Name:         q.Name,
-		AppNamespace: q.AppNamespace,
-		Namespace:    q.Namespace,
-		ResourceName: q.ResourceName,
-		Kind:         q.Kind,
-		Version:      q.Version,
-		Group:        q.Group,
-		Project:      q.Project,
+	return res, nil
+}
+
+// ListAppsByDestinationNamespace returns the applications the caller can get whose destination resolves to the given
+// cluster and namespace, regardless of whether the destinations refer to the cluster by server URL or by name.
+// Applications whose destination cannot be resolved are skipped.
+func (s *Server) ListAppsByDestinationNamespace(ctx context.Context, q *application.ApplicationDestinationNamespaceQuery) (*v1alpha1.ApplicationList, error) {
+	if q.GetServer() == "" && q.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster server or name is required")
 	}
-	actionRequest := fmt.Sprintf("%s/%s/%s/%s", rbac.ActionAction, q.GetGroup(), q.GetKind(), q.GetAction())
-	liveObj, res, a, config, err := s.getUnstructuredLiveResourceOrApp(ctx, actionRequest, resourceRequest)
+	if q.GetNamespace() == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+	cluster, err := argo.GetDestinationCluster(ctx, v1alpha1.ApplicationDestination{Server: q.GetServer(), Name: q.GetName()}, s.db)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "error getting destination cluster: %v", err)
 	}
 
-	liveObjBytes, err := json.Marshal(liveObj)
+	appList, err := s.List(ctx, &application.ApplicationQuery{})
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling live object: %w", err)
+		return nil, err
+	}
+	// several applications usually share a destination, so each destination is resolved only once
+	servers := make(map[v1alpha1.ApplicationDestination]string)
+	items := make([]v1alpha1.Application, 0)
+	for _, a := range appList.Items {
+		if a.Spec.Destination.Namespace != q.GetNamespace() {
+			continue
+		}
+		dest := v1alpha1.ApplicationDestination{Server: a.Spec.Destination.Server, Name: a.Spec.Destination.Name}
+		server, ok := servers[dest]
+		if !ok {
+			destCluster, err := argo.GetDestinationCluster(ctx, dest, s.db)
+			if err != nil {
+				log.WithFields(applog.GetAppLogFields(&a)).Debugf("Skipping application with unresolvable destination: %v", err)
+			} else {
+				server = destCluster.Server
+			}
+			servers[dest] = server
+		}
+		if server == cluster.Server {
+			items = append(items, a)
+		}
 	}
+	return &v1alpha1.ApplicationList{
+		ListMeta: appList.ListMeta,
+		Items:    items,
+	}, nil
+}
 
-	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+// ListGroupedByProject lists the applications matching the query like List does and groups them by their project.
+// Pagination is not supported since a page would only hold a part of the applications of some projects.
+func (s *Server) ListGroupedByProject(ctx context.Context, q *application.ApplicationQuery) (*application.ApplicationsByProjectResponse, error) {
+	if q.GetLimit() > 0 || q.GetContinue() != "" {
+		return nil, status.Error(codes.InvalidArgument, "limit and continue are not supported when grouping by project")
+	}
+	appList, err := s.List(ctx, q)
 	if err != nil {
-		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+		return nil, err
 	}
 
-	luaVM := lua.VM{
-		ResourceOverrides: resourceOverrides,
+	appsByProject := make(map[string][]*v1alpha1.Application)
+	for i := range appList.Items {
+		a := &appList.Items[i]
+		appsByProject[a.Spec.GetProject()] = append(appsByProject[a.Spec.GetProject()], a)
 	}
-	action, err := luaVM.GetResourceAction(liveObj, q.GetAction())
-	if err != nil {
-		return nil, fmt.Errorf("error getting Lua resource action: %w", err)
+	res := &application.ApplicationsByProjectResponse{Count: ptr.To(int64(len(appList.Items)))}
+	for _, project := range slices.Sorted(maps.Keys(appsByProject)) {
+		// List already sorted the applications by name and namespace
+		res.Projects = append(res.Projects, &application.ProjectApplications{
+			Project: ptr.To(project),
+			Count:   ptr.To(int64(len(appsByProject[project]))),
+			Items:   appsByProject[project],
+		})
 	}
+	return res, nil
+}
 
-	newObjects, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, q.GetResourceActionParameters())
+// blockingSyncWindows returns the windows which prevent a manual sync of an application with the given matching
+// windows: the active deny windows which do not allow manual syncs or, if there are neither active deny nor active allow
+// windows, the inactive allow windows. It returns nil if the windows allow a manual sync.
+func blockingSyncWindows(windows *v1alpha1.SyncWindows) (v1alpha1.SyncWindows, error) {
+	canSync, err := windows.CanSync(true)
+	if err != nil || canSync {
+		return nil, err
+	}
+	active, err := windows.Active()
 	if err != nil {
-		return nil, fmt.Errorf("error executing Lua resource action: %w", err)
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
 	}
-
-	var app *v1alpha1.Application
-	// Only bother getting the app if we know we're going to need it for a resource permission check.
-	if len(newObjects) > 0 {
-		// No need for an RBAC check, we checked above that the user is allowed to run this action.
-		app, err = s.appLister.Applications(s.appNamespaceOrDefault(q.GetAppNamespace())).Get(q.GetName())
-		if err != nil {
-			return nil, err
+	var blocking v1alpha1.SyncWindows
+	if active != nil {
+		for _, w := range *active {
+			if w.Kind == "deny" && !w.ManualSync {
+				blocking = append(blocking, w)
+			}
 		}
 	}
-
-	proj, err := s.getAppProject(ctx, a, log.WithFields(applog.GetAppLogFields(a)))
+	if len(blocking) > 0 {
+		return blocking, nil
+	}
+	inactiveAllows, err := windows.InactiveAllows()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
+	}
+	if inactiveAllows != nil {
+		blocking = append(blocking, *inactiveAllows...)
 	}
+	return blocking, nil
+}
 
-	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db)
+// ListNotificationSubscriptions returns the notification subscriptions configured by the subscribe and subscriptions
+// annotations of the application and of its project, which the notifications controller merges. Subscriptions without a trigger are
+// returned as is, since the default triggers they resolve to are part of the notifications configuration.
+func (s *Server) ListNotificationSubscriptions(ctx context.Context, q *application.ApplicationNotificationSubscriptionsQuery) (*application.ApplicationNotificationSubscriptionsResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
 		return nil, err
 	}
+	res := &application.ApplicationNotificationSubscriptionsResponse{}
+	res.Items = append(res.Items, notificationSubscriptions(a.GetAnnotations(), applicationType.ApplicationKind)...)
+	res.Items = append(res.Items, notificationSubscriptions(proj.GetAnnotations(), applicationType.AppProjectKind)...)
+	return res, nil
+}
 
-	// First, make sure all the returned resources are permitted, for each operation.
-	// Also perform create with dry-runs for all create-operation resources.
-	// This is performed separately to reduce the risk of only some of the resources being successfully created later.
-	// TODO: when apply/delete operations would be supported for custom actions,
-	// the dry-run for relevant apply/delete operation would have to be invoked as well.
-	for _, impactedResource := range newObjects {
-		newObj := impactedResource.UnstructuredObj
-		err := s.verifyResourcePermitted(destCluster, proj, newObj)
-		if err != nil {
-			return nil, err
-		}
-		if impactedResource.K8SOperation == lua.CreateOperation {
-			createOptions := metav1.CreateOptions{DryRun: []string{"All"}}
-			_, err := s.kubectl.CreateResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), newObj, createOptions)
-			if err != nil {
-				return nil, err
-			}
-		}
-	}
-
-	// Now, perform the actual operations.
-	// The creation itself is not transactional.
-	// TODO: maybe create a k8s list representation of the resources,
-	// and invoke create on this list resource to make it semi-transactional (there is still patch operation that is separate,
-	// thus can fail separately from create).
-	for _, impactedResource := range newObjects {
-		newObj := impactedResource.UnstructuredObj
-		newObjBytes, err := json.Marshal(newObj)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling new object: %w", err)
-		}
+const (
+	// notificationSubscribeAnnotationPrefix is the prefix of the notifications.argoproj.io/subscribe.<trigger>.<service>
+	// and notifications.argoproj.io/subscribe.<service> annotations
+	notificationSubscribeAnnotationPrefix = "notifications.argoproj.io/subscribe."
+	// notificationSubscriptionsAnnotation is the annotation listing subscriptions as YAML
+	notificationSubscriptionsAnnotation = "notifications.argoproj.io/subscriptions"
+)
 
-		switch impactedResource.K8SOperation {
-		// No default case since a not supported operation would have failed upon unmarshaling earlier
-		case lua.PatchOperation:
-			_, err := s.patchResource(ctx, config, liveObjBytes, newObjBytes, newObj)
-			if err != nil {
-				return nil, err
+// notificationSubscriptions returns the subscriptions of the annotations the same way the notifications controller
+// parses them, grouping the recipients by trigger and service
+func notificationSubscriptions(annotations map[string]string, source string) []*application.NotificationSubscription {
+	var res []*application.NotificationSubscription
+	bySubscription := map[string]*application.NotificationSubscription{}
+	for trigger, destinations := range subscriptions.NewAnnotations(annotations).GetAll() {
+		for _, destination := range destinations {
+			key := trigger + "." + destination.Service
+			subscription, ok := bySubscription[key]
+			if !ok {
+				subscription = &application.NotificationSubscription{
+					Trigger:    ptr.To(trigger),
+					Service:    ptr.To(destination.Service),
+					Source:     ptr.To(source),
+					Annotation: ptr.To(notificationSubscriptionAnnotation(annotations, trigger, destination.Service)),
+				}
+				bySubscription[key] = subscription
+				res = append(res, subscription)
 			}
-		case lua.CreateOperation:
-			_, err := s.createResource(ctx, config, newObj)
-			if err != nil {
-				return nil, err
+			if destination.Recipient != "" && !slices.Contains(subscription.Recipients, destination.Recipient) {
+				subscription.Recipients = append(subscription.Recipients, destination.Recipient)
 			}
 		}
 	}
-
-	if res == nil {
-		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
-	} else {
-		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s/%s", q.GetAction(), res.Group, res.Kind, res.Name))
-		s.logResourceEvent(ctx, res, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
+	for _, subscription := range res {
+		slices.Sort(subscription.Recipients)
 	}
-	return &application.ApplicationResponse{}, nil
+	slices.SortFunc(res, func(a, b *application.NotificationSubscription) int {
+		return cmp.Or(strings.Compare(a.GetTrigger(), b.GetTrigger()), strings.Compare(a.GetService(), b.GetService()))
+	})
+	return res
 }
 
-func (s *Server) patchResource(ctx context.Context, config *rest.Config, liveObjBytes, newObjBytes []byte, newObj *unstructured.Unstructured) (*application.ApplicationResponse, error) {
-	diffBytes, err := jsonpatch.CreateMergePatch(liveObjBytes, newObjBytes)
-	if err != nil {
-		return nil, fmt.Errorf("error calculating merge patch: %w", err)
+// notificationSubscriptionAnnotation returns the annotation a subscription is configured by, which is the subscribe
+// annotation of the trigger and service if there is one and the subscriptions annotation otherwise
+func notificationSubscriptionAnnotation(annotations map[string]string, trigger string, service string) string {
+	key := notificationSubscribeAnnotationPrefix + service
+	if trigger != "" {
+		key = notificationSubscribeAnnotationPrefix + trigger + "." + service
 	}
-	if string(diffBytes) == "{}" {
-		return &application.ApplicationResponse{}, nil
+	if _, ok := annotations[key]; ok {
+		return key
 	}
+	return notificationSubscriptionsAnnotation
+}
 
-	// The following logic detects if the resource action makes a modification to status and/or spec.
-	// If status was modified, we attempt to patch the status using status subresource, in case the
-	// CRD is configured using the status subresource feature. See:
-	// https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definitions/#status-subresource
-	// If status subresource is in use, the patch has to be split into two:
-	// * one to update spec (and other non-status fields)
-	// * the other to update only status.
-	nonStatusPatch, statusPatch, err := splitStatusPatch(diffBytes)
+// GetStatusTransitions returns the status transitions of the application which can be derived from its status, in
+// chronological order: the successful syncs of its history, the start and end of its last operation, the last change of
+// its health status and the conditions it currently has. The application only records when its health last changed, so
+// earlier health transitions are not included.
+func (s *Server) GetStatusTransitions(ctx context.Context, q *application.ApplicationStatusTransitionsQuery) (*application.ApplicationStatusTransitionsResponse, error) {
+	if q.GetLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
-		return nil, fmt.Errorf("error splitting status patch: %w", err)
+		return nil, err
 	}
-	if statusPatch != nil {
-		_, err = s.kubectl.PatchResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), types.MergePatchType, diffBytes, "status")
-		if err != nil {
-			if !apierrors.IsNotFound(err) {
-				return nil, fmt.Errorf("error patching resource: %w", err)
+	// the informer's copy must not be modified
+	a = a.DeepCopy()
+	s.inferResourcesStatusHealth(a)
+
+	var transitions []*application.StatusTransition
+	for _, h := range a.Status.History {
+		revision := h.Revision
+		if revision == "" {
+			revision = strings.Join(h.Revisions, ",")
+		}
+		transitions = append(transitions, &application.StatusTransition{
+			Type:     ptr.To(statusTransitionTypeSync),
+			Status:   ptr.To(string(v1alpha1.SyncStatusCodeSynced)),
+			Time:     h.DeployedAt.DeepCopy(),
+			Message:  ptr.To(fmt.Sprintf("synced to history entry %d", h.ID)),
+			Revision: ptr.To(revision),
+		})
+	}
+	if op := a.Status.OperationState; op != nil {
+		var revision string
+		if op.SyncResult != nil {
+			revision = op.SyncResult.Revision
+			if revision == "" {
+				revision = strings.Join(op.SyncResult.Revisions, ",")
 			}
-			// K8s API server returns 404 NotFound when the CRD does not support the status subresource
-			// if we get here, the CRD does not use the status subresource. We will fall back to a normal patch
-		} else {
-			// If we get here, the CRD does use the status subresource, so we must patch status and
-			// spec separately. update the diffBytes to the spec-only patch and fall through.
-			diffBytes = nonStatusPatch
+		}
+		transitions = append(transitions, &application.StatusTransition{
+			Type:     ptr.To(statusTransitionTypeOperation),
+			Status:   ptr.To(string(common.OperationRunning)),
+			Time:     op.StartedAt.DeepCopy(),
+			Revision: ptr.To(revision),
+		})
+		if op.FinishedAt != nil {
+			transitions = append(transitions, &application.StatusTransition{
+				Type:     ptr.To(statusTransitionTypeOperation),
+				Status:   ptr.To(string(op.Phase)),
+				Time:     op.FinishedAt.DeepCopy(),
+				Message:  ptr.To(op.Message),
+				Revision: ptr.To(revision),
+			})
 		}
 	}
-	if diffBytes != nil {
-		_, err = s.kubectl.PatchResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), types.MergePatchType, diffBytes)
-		if err != nil {
-			return nil, fmt.Errorf("error patching resource: %w", err)
+	if a.Status.Health.LastTransitionTime != nil {
+		transitions = append(transitions, &application.StatusTransition{
+			Type:   ptr.To(statusTransitionTypeHealth),
+			Status: ptr.To(string(a.Status.Health.Status)),
+			Time:   a.Status.Health.LastTransitionTime.DeepCopy(),
+		})
+	}
+	for _, condition := range a.Status.Conditions {
+		if condition.LastTransitionTime == nil {
+			continue
 		}
+		transitions = append(transitions, &application.StatusTransition{
+			Type:    ptr.To(statusTransitionTypeCondition),
+			Status:  ptr.To(string(condition.Type)),
+			Time:    condition.LastTransitionTime.DeepCopy(),
+			Message: ptr.To(condition.Message),
+		})
 	}
-	return &application.ApplicationResponse{}, nil
-}
 
-func (s *Server) verifyResourcePermitted(destCluster *v1alpha1.Cluster, proj *v1alpha1.AppProject, obj *unstructured.Unstructured) error {
-	permitted, err := proj.IsResourcePermitted(schema.GroupKind{Group: obj.GroupVersionKind().Group, Kind: obj.GroupVersionKind().Kind}, obj.GetNamespace(), destCluster, func(project string) ([]*v1alpha1.Cluster, error) {
-		clusters, err := s.db.GetProjectClusters(context.TODO(), project)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get project clusters: %w", err)
-		}
-		return clusters, nil
+	sort.SliceStable(transitions, func(i, j int) bool {
+		return transitions[i].Time.Before(transitions[j].Time)
 	})
-	if err != nil {
-		return fmt.Errorf("error checking resource permissions: %w", err)
-	}
-	if !permitted {
-		return fmt.Errorf("application is not permitted to manage %s/%s/%s in %s", obj.GroupVersionKind().Group, obj.GroupVersionKind().Kind, obj.GetName(), obj.GetNamespace())
+	if limit := int(q.GetLimit()); limit > 0 && len(transitions) > limit {
+		transitions = transitions[len(transitions)-limit:]
 	}
-
-	return nil
+	return &application.ApplicationStatusTransitionsResponse{Items: transitions}, nil
 }
 
-func (s *Server) createResource(ctx context.Context, config *rest.Config, newObj *unstructured.Unstructured) (*application.ApplicationResponse, error) {
-	_, err := s.kubectl.CreateResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), newObj, metav1.CreateOptions{})
+// the types of the transitions returned by GetStatusTransitions
+const (
+	statusTransitionTypeSync      = "Sync"
+	statusTransitionTypeOperation = "Operation"
+	statusTransitionTypeHealth    = "Health"
+	statusTransitionTypeCondition = "Condition"
+)
+
+// GetStableHealth returns the application's health, but reports a Degraded application as Progressing until it has been
+// degraded for longer than the requested grace period. This avoids alerting on the short degradation which is common
+// during rollouts. The grace period is measured from the last transition of the application's health status.
+func (s *Server) GetStableHealth(ctx context.Context, q *application.ApplicationStableHealthQuery) (*application.ApplicationStableHealthResponse, error) {
+	if q.GetGracePeriodSeconds() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "grace period must not be negative")
+	}
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
-		return nil, fmt.Errorf("error creating resource: %w", err)
+		return nil, err
 	}
-	return &application.ApplicationResponse{}, nil
+	// the informer's copy must not be modified
+	a = a.DeepCopy()
+	s.inferResourcesStatusHealth(a)
+
+	observed := a.Status.Health.Status
+	lastTransitionTime := a.Status.Health.LastTransitionTime
+	res := &application.ApplicationStableHealthResponse{
+		Status:             ptr.To(string(observed)),
+		ObservedStatus:     ptr.To(string(observed)),
+		LastTransitionTime: lastTransitionTime,
+		InGracePeriod:      ptr.To(false),
+	}
+	gracePeriod := time.Duration(q.GetGracePeriodSeconds()) * time.Second
+	// without a transition time it is unknown how long the app has been degraded, so it is reported as is
+	if observed == health.HealthStatusDegraded && lastTransitionTime != nil && time.Since(lastTransitionTime.Time) < gracePeriod {
+		res.Status = ptr.To(string(health.HealthStatusProgressing))
+		res.InGracePeriod = ptr.To(true)
+	}
+
+	for _, resource := range a.Status.Resources {
+		if resource.Health != nil && resource.Health.Status == health.HealthStatusDegraded {
+			res.DegradedResources = append(res.DegradedResources, &v1alpha1.ResourceRef{
+				Group:     resource.Group,
+				Version:   resource.Version,
+				Kind:      resource.Kind,
+				Namespace: resource.Namespace,
+				Name:      resource.Name,
+			})
+		}
+	}
+	return res, nil
 }
 
-// splitStatusPatch splits a patch into two: one for a non-status patch, and the status-only patch.
-// Returns nil for either if the patch doesn't have modifications to non-status, or status, respectively.
-func splitStatusPatch(patch []byte) ([]byte, []byte, error) {
-	var obj map[string]any
-	err := json.Unmarshal(patch, &obj)
+// GetProgressingReason walks the resource tree of the application and returns the resources which are not healthy, with
+// their health messages, so users can tell which resources hold up a Progressing or Degraded application. The health
+// of managed resources is taken from the application status if the tree nodes don't hold it.
+func (s *Server) GetProgressingReason(ctx context.Context, q *application.ApplicationProgressingReasonQuery) (*application.ApplicationProgressingReasonResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	var nonStatusPatch, statusPatch []byte
-	if statusVal, ok := obj["status"]; ok {
-		// calculate the status-only patch
-		statusObj := map[string]any{
-			"status": statusVal,
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	healthByKey := s.resourceHealthByKey(a)
+
+	appHealth := a.Status.Health.Status
+	res := &application.ApplicationProgressingReasonResponse{
+		Status:  ptr.To(string(appHealth)),
+		Message: ptr.To(a.Status.Health.Message),
+	}
+	addUnhealthy := func(ref v1alpha1.ResourceRef, nodeHealth *v1alpha1.HealthStatus, parentRefs []v1alpha1.ResourceRef) {
+		if nodeHealth == nil || nodeHealth.Status == health.HealthStatusHealthy {
+			return
 		}
-		statusPatch, err = json.Marshal(statusObj)
-		if err != nil {
-			return nil, nil, err
+		unhealthy := &application.UnhealthyResource{
+			Group:            ptr.To(ref.Group),
+			Version:          ptr.To(ref.Version),
+			Kind:             ptr.To(ref.Kind),
+			Namespace:        ptr.To(ref.Namespace),
+			Name:             ptr.To(ref.Name),
+			Status:           ptr.To(string(nodeHealth.Status)),
+			Message:          ptr.To(nodeHealth.Message),
+			MatchesAppHealth: ptr.To(nodeHealth.Status == appHealth),
 		}
-		// remove status, and calculate the non-status patch
-		delete(obj, "status")
-		if len(obj) > 0 {
-			nonStatusPatch, err = json.Marshal(obj)
-			if err != nil {
-				return nil, nil, err
-			}
+		for i := range parentRefs {
+			unhealthy.ParentRefs = append(unhealthy.ParentRefs, &parentRefs[i])
 		}
-	} else {
-		// status was not modified in patch
-		nonStatusPatch = patch
+		res.Resources = append(res.Resources, unhealthy)
 	}
-	return nonStatusPatch, statusPatch, nil
+
+	inTree := make(map[kube.ResourceKey]bool, len(tree.Nodes))
+	for _, node := range tree.Nodes {
+		key := kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)
+		inTree[key] = true
+		nodeHealth := node.Health
+		if nodeHealth == nil {
+			nodeHealth = healthByKey[key]
+		}
+		addUnhealthy(node.ResourceRef, nodeHealth, node.ParentRefs)
+	}
+	// managed resources which don't exist yet are not part of the tree
+	for _, resource := range a.Status.Resources {
+		key := kube.NewResourceKey(resource.Group, resource.Kind, resource.Namespace, resource.Name)
+		if inTree[key] {
+			continue
+		}
+		ref := v1alpha1.ResourceRef{Group: resource.Group, Version: resource.Version, Kind: resource.Kind, Namespace: resource.Namespace, Name: resource.Name}
+		addUnhealthy(ref, healthByKey[key], nil)
+	}
+
+	sort.SliceStable(res.Resources, func(i, j int) bool {
+		return health.IsWorse(health.HealthStatusCode(res.Resources[j].GetStatus()), health.HealthStatusCode(res.Resources[i].GetStatus()))
+	})
+	return res, nil
 }
 
-func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.ApplicationSyncWindowsQuery) (*application.ApplicationSyncWindowsResponse, error) {
-	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+// GetNextReconcileTime estimates when the application controller will next reconcile an application from the time it
+// was last reconciled and the configured reconciliation period. The controller delays each reconciliation by a random
+// jitter, so the reconciliation happens between the returned earliest and latest times.
+func (s *Server) GetNextReconcileTime(ctx context.Context, q *application.ApplicationNextReconcileTimeQuery) (*application.ApplicationNextReconcileTimeResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
 		return nil, err
 	}
-
-	windows := proj.Spec.SyncWindows.Matches(a)
-	sync, err := windows.CanSync(true)
+	timeout, jitter, err := s.settingsMgr.GetReconciliationTimeout()
 	if err != nil {
-		return nil, fmt.Errorf("invalid sync windows: %w", err)
+		return nil, fmt.Errorf("error getting reconciliation timeout from settings: %w", err)
+	}
+	_, refreshRequested := a.GetAnnotations()[v1alpha1.AnnotationKeyRefresh]
+	res := &application.ApplicationNextReconcileTimeResponse{
+		ReconciledAt:                 a.Status.ReconciledAt,
+		ReconciliationTimeoutSeconds: ptr.To(int64(timeout.Seconds())),
+		ReconciliationJitterSeconds:  ptr.To(int64(jitter.Seconds())),
+		RefreshRequested:             ptr.To(refreshRequested),
+		AutoSyncEnabled:              ptr.To(a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.IsAutomatedSyncEnabled()),
+	}
+	switch {
+	case refreshRequested || a.Status.ReconciledAt == nil:
+		now := metav1.Now()
+		res.Earliest, res.Latest = &now, &now
+	case timeout > 0:
+		res.Earliest = ptr.To(metav1.NewTime(a.Status.ReconciledAt.Add(timeout)))
+		res.Latest = ptr.To(metav1.NewTime(a.Status.ReconciledAt.Add(timeout + jitter)))
 	}
+	return res, nil
+}
 
-	activeWindows, err := windows.Active()
+// GetLastOperationError returns the phase and message of the last operation of an application if it failed, along with
+// the first resource or hook which failed during the sync.
+func (s *Server) GetLastOperationError(ctx context.Context, q *application.ApplicationLastOperationErrorQuery) (*application.ApplicationLastOperationErrorResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
-		return nil, fmt.Errorf("invalid sync windows: %w", err)
+		return nil, err
 	}
-	res := &application.ApplicationSyncWindowsResponse{
-		ActiveWindows:   convertSyncWindows(activeWindows),
-		AssignedWindows: convertSyncWindows(windows),
-		CanSync:         &sync,
+	opState := a.Status.OperationState
+	if opState == nil || (opState.Phase != common.OperationFailed && opState.Phase != common.OperationError) {
+		return &application.ApplicationLastOperationErrorResponse{Failed: ptr.To(false)}, nil
 	}
 
+	res := &application.ApplicationLastOperationErrorResponse{
+		Failed:     ptr.To(true),
+		Phase:      ptr.To(string(opState.Phase)),
+		Message:    ptr.To(opState.Message),
+		FinishedAt: opState.FinishedAt,
+	}
+	if opState.SyncResult != nil {
+		for _, result := range opState.SyncResult.Resources {
+			if result.Status != common.ResultCodeSyncFailed && result.HookPhase != common.OperationFailed && result.HookPhase != common.OperationError {
+				continue
+			}
+			res.FailedResource = &application.FailedResource{
+				Group:     ptr.To(result.Group),
+				Version:   ptr.To(result.Version),
+				Kind:      ptr.To(result.Kind),
+				Namespace: ptr.To(result.Namespace),
+				Name:      ptr.To(result.Name),
+				Status:    ptr.To(string(result.Status)),
+				HookPhase: ptr.To(string(result.HookPhase)),
+				Message:   ptr.To(result.Message),
+			}
+			break
+		}
+	}
 	return res, nil
 }
 
@@ -14,27 +14,36 @@ import (
 
 	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/argoproj/pkg/v2/sync"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager/s3manageriface"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	appsv1 "k8s.io/api/apps/v1"
 	k8sbatchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	kubetesting "k8s.io/client-go/testing"
 	k8scache "k8s.io/client-go/tools/cache"
@@ -56,7 +65,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/db"
-	"github.com/argoproj/argo-cd/v3/util/grpc"
+	grpc_util "github.com/argoproj/argo-cd/v3/util/grpc"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
@@ -615,6 +624,27 @@ func (t *TestServerStream) ServerStream() TestServerStream {
 	return TestServerStream{}
 }
 
+// TestLocalManifestsUploadServer reuses the stream methods of TestPodLogsServer
+type TestLocalManifestsUploadServer struct {
+	TestPodLogsServer
+	requests []*application.LocalManifestsUploadRequest
+	response *application.LocalManifestsReference
+}
+
+func (t *TestLocalManifestsUploadServer) SendAndClose(res *application.LocalManifestsReference) error {
+	t.response = res
+	return nil
+}
+
+func (t *TestLocalManifestsUploadServer) Recv() (*application.LocalManifestsUploadRequest, error) {
+	if len(t.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := t.requests[0]
+	t.requests = t.requests[1:]
+	return req, nil
+}
+
 type TestResourceTreeServer struct {
 	ctx context.Context
 }
@@ -645,6 +675,64 @@ func (t *TestResourceTreeServer) RecvMsg(_ any) error {
 	return nil
 }
 
+// TestApplicationEventsServer reuses the stream methods of TestPodLogsServer
+type TestApplicationEventsServer struct {
+	TestPodLogsServer
+	events chan *corev1.Event
+}
+
+func (t *TestApplicationEventsServer) Send(event *corev1.Event) error {
+	t.events <- event
+	return nil
+}
+
+type TestManagedResourcesServer struct {
+	ctx   context.Context
+	items []*v1alpha1.ResourceDiff
+}
+
+func (t *TestManagedResourcesServer) Send(item *v1alpha1.ResourceDiff) error {
+	t.items = append(t.items, item)
+	return nil
+}
+
+func (t *TestManagedResourcesServer) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (t *TestManagedResourcesServer) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (t *TestManagedResourcesServer) SetTrailer(metadata.MD) {}
+
+func (t *TestManagedResourcesServer) Context() context.Context {
+	return t.ctx
+}
+
+func (t *TestManagedResourcesServer) SendMsg(_ any) error {
+	return nil
+}
+
+func (t *TestManagedResourcesServer) RecvMsg(_ any) error {
+	return nil
+}
+
+// TestWatchServer reuses the stream methods of TestPodLogsServer and queues the events it is sent
+type TestWatchServer struct {
+	TestPodLogsServer
+	events chan *v1alpha1.ApplicationWatchEvent
+}
+
+func newTestWatchServer(ctx context.Context) *TestWatchServer {
+	return &TestWatchServer{TestPodLogsServer: TestPodLogsServer{ctx: ctx}, events: make(chan *v1alpha1.ApplicationWatchEvent, 10)}
+}
+
+func (t *TestWatchServer) Send(event *v1alpha1.ApplicationWatchEvent) error {
+	t.events <- event
+	return nil
+}
+
 type TestPodLogsServer struct {
 	ctx context.Context
 }
@@ -1223,6 +1311,81 @@ func testListAppsWithLabels(t *testing.T, appQuery application.ApplicationQuery,
 	}
 }
 
+func TestListAppsWithSavedFilter(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	argoCM := map[string]string{"server.application.filters": "- name: degraded\n  projects: [test-project1]\n  selector: team=payments\n  healthStatuses: [Degraded]\n"}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, argoCM, newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "App1"
+		app.Spec.Project = "test-project1"
+		app.SetLabels(map[string]string{"team": "payments"})
+		app.Status.Health.Status = health.HealthStatusDegraded
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "App2"
+		app.Spec.Project = "test-project1"
+		app.SetLabels(map[string]string{"team": "payments"})
+		app.Status.Health.Status = health.HealthStatusHealthy
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "App3"
+		app.Spec.Project = "test-project2"
+		app.SetLabels(map[string]string{"team": "payments"})
+		app.Status.Health.Status = health.HealthStatusDegraded
+	}))
+
+	t.Run("Expanded", func(t *testing.T) {
+		appList, err := appServer.List(t.Context(), &application.ApplicationQuery{SavedFilter: ptr.To("degraded")})
+		require.NoError(t, err)
+		require.Len(t, appList.Items, 1)
+		assert.Equal(t, "App1", appList.Items[0].Name)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := appServer.List(t.Context(), &application.ApplicationQuery{SavedFilter: ptr.To("unknown")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("NoProjectAccess", func(t *testing.T) {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, */*, allow
+`)
+		_, err := appServer.List(ctx, &application.ApplicationQuery{SavedFilter: ptr.To("degraded")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error())
+	})
+}
+
+func TestListAppsWithAnnotationSelector(t *testing.T) {
+	appServer := newTestAppServer(t, newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "App1"
+		app.SetLabels(map[string]string{"tier": "backend"})
+		app.SetAnnotations(map[string]string{"example.com/owner": "payments"})
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "App2"
+		app.SetAnnotations(map[string]string{"example.com/owner": "payments"})
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "App3"
+		app.SetLabels(map[string]string{"tier": "backend"})
+	}))
+
+	appList, err := appServer.List(t.Context(), &application.ApplicationQuery{AnnotationSelector: ptr.To("example.com/owner=payments")})
+	require.NoError(t, err)
+	require.Len(t, appList.Items, 2)
+	assert.Equal(t, "App1", appList.Items[0].Name)
+	assert.Equal(t, "App2", appList.Items[1].Name)
+
+	appList, err = appServer.List(t.Context(), &application.ApplicationQuery{Selector: ptr.To("tier=backend"), AnnotationSelector: ptr.To("!example.com/owner")})
+	require.NoError(t, err)
+	require.Len(t, appList.Items, 1)
+	assert.Equal(t, "App3", appList.Items[0].Name)
+
+	_, err = appServer.List(t.Context(), &application.ApplicationQuery{AnnotationSelector: ptr.To("=payments")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestListAppWithProjects(t *testing.T) {
 	appServer := newTestAppServer(t, newTestApp(func(app *v1alpha1.Application) {
 		app.Name = "App1"
@@ -1292,6 +1455,137 @@ func TestListApps(t *testing.T) {
 	assert.Equal(t, []string{"abc", "bcd", "def"}, names)
 }
 
+func TestListAppsPaginated(t *testing.T) {
+	var objects []runtime.Object
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		objects = append(objects, newTestApp(func(app *v1alpha1.Application) {
+			app.Name = name
+		}))
+	}
+	listNames := func(list *v1alpha1.ApplicationList) []string {
+		var names []string
+		for i := range list.Items {
+			names = append(names, list.Items[i].Name)
+		}
+		return names
+	}
+
+	t.Run("Pages", func(t *testing.T) {
+		appServer := newTestAppServer(t, objects...)
+
+		res, err := appServer.List(t.Context(), &application.ApplicationQuery{Limit: ptr.To(int64(2))})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, listNames(res))
+		require.NotEmpty(t, res.ListMeta.Continue)
+		assert.Equal(t, int64(3), *res.ListMeta.RemainingItemCount)
+
+		res, err = appServer.List(t.Context(), &application.ApplicationQuery{Limit: ptr.To(int64(2)), Continue: ptr.To(res.ListMeta.Continue)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c", "d"}, listNames(res))
+
+		res, err = appServer.List(t.Context(), &application.ApplicationQuery{Limit: ptr.To(int64(2)), Continue: ptr.To(res.ListMeta.Continue)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"e"}, listNames(res))
+		assert.Empty(t, res.ListMeta.Continue)
+	})
+
+	t.Run("LimitLargerThanResult", func(t *testing.T) {
+		appServer := newTestAppServer(t, objects...)
+
+		res, err := appServer.List(t.Context(), &application.ApplicationQuery{Limit: ptr.To(int64(10))})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, listNames(res))
+		assert.Empty(t, res.ListMeta.Continue)
+		assert.Nil(t, res.ListMeta.RemainingItemCount)
+	})
+
+	t.Run("BoundaryOnHiddenApp", func(t *testing.T) {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer := newTestAppServer(t, objects...)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/*, allow
+p, test-user, applications, get, default/c, deny
+`)
+
+		res, err := appServer.List(ctx, &application.ApplicationQuery{Limit: ptr.To(int64(2))})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, listNames(res))
+
+		res, err = appServer.List(ctx, &application.ApplicationQuery{Limit: ptr.To(int64(2)), Continue: ptr.To(res.ListMeta.Continue)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"d", "e"}, listNames(res))
+		assert.Empty(t, res.ListMeta.Continue)
+	})
+
+	t.Run("StaleToken", func(t *testing.T) {
+		appServer := newTestAppServer(t, objects...)
+		token, err := encodeListContinueToken(&listContinueToken{Name: "b", Namespace: testNamespace, ResourceVersion: "outdated"})
+		require.NoError(t, err)
+
+		res, err := appServer.List(t.Context(), &application.ApplicationQuery{Limit: ptr.To(int64(2)), Continue: ptr.To(token)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c", "d"}, listNames(res))
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		appServer := newTestAppServer(t, objects...)
+
+		_, err := appServer.List(t.Context(), &application.ApplicationQuery{Continue: ptr.To("not a token")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListAppsNameMatchMode(t *testing.T) {
+	var objects []runtime.Object
+	for _, name := range []string{"team-a-frontend", "team-a-backend", "team-b-api"} {
+		objects = append(objects, newTestApp(func(app *v1alpha1.Application) {
+			app.Name = name
+		}))
+	}
+	appServer := newTestAppServer(t, objects...)
+
+	listNames := func(t *testing.T, name, mode string) []string {
+		t.Helper()
+		res, err := appServer.List(t.Context(), &application.ApplicationQuery{Name: ptr.To(name), NameMatchMode: ptr.To(mode)})
+		require.NoError(t, err)
+		var names []string
+		for i := range res.Items {
+			names = append(names, res.Items[i].Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"team-a-backend"}, listNames(t, "team-a-backend", ""))
+	assert.Empty(t, listNames(t, "team-a", nameMatchModeExact))
+	assert.Equal(t, []string{"team-a-backend", "team-a-frontend"}, listNames(t, "team-a-", nameMatchModePrefix))
+	assert.Equal(t, []string{"team-a-backend", "team-a-frontend"}, listNames(t, "^team-a-.*", nameMatchModeRegex))
+	assert.Equal(t, []string{"team-b-api"}, listNames(t, "api$", nameMatchModeRegex))
+
+	_, err := appServer.List(t.Context(), &application.ApplicationQuery{Name: ptr.To("team-("), NameMatchMode: ptr.To(nameMatchModeRegex)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "invalid name regular expression")
+
+	_, err = appServer.List(t.Context(), &application.ApplicationQuery{Name: ptr.To("team"), NameMatchMode: ptr.To("glob")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	t.Run("Watch", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		// the initial snapshot is sent before the canceled context ends the watch
+		cancel()
+		stream := newTestWatchServer(ctx)
+		err := appServer.Watch(&application.ApplicationQuery{Name: ptr.To("^team-a-"), NameMatchMode: ptr.To(nameMatchModeRegex)}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.events, 2)
+		assert.Equal(t, "team-a-backend", (<-stream.events).Application.Name)
+		assert.Equal(t, "team-a-frontend", (<-stream.events).Application.Name)
+
+		err = appServer.Watch(&application.ApplicationQuery{Name: ptr.To("team-("), NameMatchMode: ptr.To(nameMatchModeRegex)}, newTestWatchServer(t.Context()))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestCoupleAppsListApps(t *testing.T) {
 	var objects []runtime.Object
 	ctx := t.Context()
@@ -1478,6 +1772,256 @@ func TestCreateApp(t *testing.T) {
 	assert.Equal(t, "default", app.Spec.Project)
 }
 
+// testServerTransportStream records the headers set by a unary method
+type testServerTransportStream struct {
+	header metadata.MD
+}
+
+func (s *testServerTransportStream) Method() string {
+	return ""
+}
+
+func (s *testServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *testServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *testServerTransportStream) SetTrailer(metadata.MD) error {
+	return nil
+}
+
+func TestCreateAppWithoutStrictValidation(t *testing.T) {
+	newAppWithIgnoredSource := func() *v1alpha1.Application {
+		return newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Sources = v1alpha1.ApplicationSources{*app.Spec.Source.DeepCopy()}
+		})
+	}
+	expectedWarning := "IgnoredSourceWarning: spec.source is ignored since spec.sources is set"
+
+	t.Run("StrictValidation", func(t *testing.T) {
+		appServer := newTestAppServer(t)
+		stream := &testServerTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+
+		_, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: newAppWithIgnoredSource()})
+		require.NoError(t, err)
+		assert.Empty(t, stream.header.Get(createWarningHeader))
+	})
+
+	t.Run("WarningHeader", func(t *testing.T) {
+		appServer := newTestAppServer(t)
+		stream := &testServerTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+
+		app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: newAppWithIgnoredSource(), StrictValidation: ptr.To(false)})
+		require.NoError(t, err)
+		assert.Equal(t, "test-app", app.Name)
+		assert.Equal(t, []string{expectedWarning}, stream.header.Get(createWarningHeader))
+	})
+
+	t.Run("NoWarningHeaderIfCreateFails", func(t *testing.T) {
+		appServer := newTestAppServer(t, newTestApp())
+		stream := &testServerTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+
+		_, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: newAppWithIgnoredSource(), StrictValidation: ptr.To(false)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Empty(t, stream.header.Get(createWarningHeader))
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		appServer := newTestAppServer(t)
+
+		app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: newAppWithIgnoredSource(), StrictValidation: ptr.To(false), DryRun: ptr.To(true)})
+		require.NoError(t, err)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionIgnoredSourceWarning, app.Status.Conditions[0].Type)
+	})
+}
+
+func TestCreateAppDryRun(t *testing.T) {
+	t.Run("NotPersisted", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Spec.Project = ""
+		appServer := newTestAppServer(t)
+
+		app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp, DryRun: ptr.To(true)})
+		require.NoError(t, err)
+		assert.Equal(t, "default", app.Spec.Project)
+		assert.Equal(t, testNamespace, app.Namespace)
+
+		_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("ExistingApplication", func(t *testing.T) {
+		existing := newTestApp()
+		appServer := newTestAppServer(t, existing)
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Source.Path = "other"
+		})
+
+		_, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp, DryRun: ptr.To(true)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp, DryRun: ptr.To(true), Upsert: ptr.To(true)})
+		require.NoError(t, err)
+		assert.Equal(t, "other", app.Spec.Source.Path)
+		stored, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), existing.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, existing.Spec.Source.Path, stored.Spec.Source.Path)
+	})
+
+	t.Run("CreateNotPermitted", func(t *testing.T) {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer := newTestAppServer(t)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/*, allow
+`)
+
+		_, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: newTestApp(), DryRun: ptr.To(true)})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error())
+	})
+}
+
+func TestSplitValidationConditions(t *testing.T) {
+	conditions := []v1alpha1.ApplicationCondition{
+		{Type: v1alpha1.ApplicationConditionInvalidSpecError, Message: "repo not permitted"},
+		{Type: "DeprecationWarning", Message: "field is deprecated"},
+	}
+
+	failures, warnings := splitValidationConditions(conditions, true)
+	assert.Equal(t, conditions, failures)
+	assert.Empty(t, warnings)
+
+	failures, warnings = splitValidationConditions(conditions, false)
+	assert.Equal(t, conditions[:1], failures)
+	assert.Equal(t, conditions[1:], warnings)
+
+	failures, warnings = splitValidationConditions(conditions[1:], false)
+	assert.Empty(t, failures)
+	assert.Len(t, warnings, 1)
+}
+
+func TestCreateAppWithRequiredLabels(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	argoCM := map[string]string{"application.requiredLabels": "'*': [team]\ndefault: [cost-center]\n"}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, argoCM)
+
+	t.Run("MissingLabels", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Labels = map[string]string{"team": "payments"}
+		})
+		_, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "missing labels required in project 'default': cost-center")
+	})
+
+	t.Run("AllLabels", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Labels = map[string]string{"team": "payments", "cost-center": "1234"}
+		})
+		app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp})
+		require.NoError(t, err)
+		assert.Equal(t, "1234", app.Labels["cost-center"])
+	})
+}
+
+func TestListExcludedResources(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	argoCM := map[string]string{
+		"resource.exclusions": "- apiGroups: [cilium.io]\n  kinds: [CiliumIdentity]\n- apiGroups: [batch]\n  clusters: [https://other-cluster]\n",
+		"resource.inclusions": "- apiGroups: ['*']\n  clusters: ['https://cluster-*']\n",
+	}
+	testApp := newTestApp()
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, argoCM, testApp)
+
+	res, err := appServer.ListExcludedResources(t.Context(), &application.ApplicationExcludedResourcesQuery{Name: &testApp.Name, CheckManifests: ptr.To(true)})
+	require.NoError(t, err)
+	assert.Equal(t, testApp.Spec.Destination.Server, res.GetCluster())
+	var excludedGroups []string
+	for _, rule := range res.Exclusions {
+		excludedGroups = append(excludedGroups, rule.ApiGroups...)
+	}
+	assert.Contains(t, excludedGroups, "cilium.io")
+	assert.NotContains(t, excludedGroups, "batch")
+	assert.Contains(t, excludedGroups, "events.k8s.io")
+	require.Len(t, res.Inclusions, 1)
+	assert.Equal(t, []string{"*"}, res.Inclusions[0].ApiGroups)
+	assert.Empty(t, res.ExcludedManifests)
+
+	_, err = appServer.ListExcludedResources(t.Context(), &application.ApplicationExcludedResourcesQuery{Name: ptr.To("does-not-exist")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCreateAppWithTTL(t *testing.T) {
+	appServer := newTestAppServer(t)
+
+	t.Run("InvalidTTL", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Annotations = map[string]string{common.AnnotationKeyAppTTL: "-1h"}
+		})
+		_, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		testApp.Annotations[common.AnnotationKeyAppTTL] = "three days"
+		_, err = appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ValidTTL", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Annotations = map[string]string{common.AnnotationKeyAppTTL: " 72h "}
+		})
+		app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp})
+		require.NoError(t, err)
+		assert.Equal(t, "72h0m0s", app.Annotations[common.AnnotationKeyAppTTL])
+	})
+}
+
+func TestGetTTL(t *testing.T) {
+	withoutTTL := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "without-ttl"
+	})
+	withTTL := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "with-ttl"
+		app.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+		app.Annotations = map[string]string{common.AnnotationKeyAppTTL: "3h"}
+	})
+	expired := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "expired"
+		app.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		app.Annotations = map[string]string{common.AnnotationKeyAppTTL: "1h"}
+	})
+	appServer := newTestAppServer(t, withoutTTL, withTTL, expired)
+
+	res, err := appServer.GetTTL(t.Context(), &application.ApplicationTTLQuery{Name: &withoutTTL.Name})
+	require.NoError(t, err)
+	assert.Nil(t, res.Ttl)
+
+	res, err = appServer.GetTTL(t.Context(), &application.ApplicationTTLQuery{Name: &withTTL.Name})
+	require.NoError(t, err)
+	assert.Equal(t, "3h0m0s", res.GetTtl())
+	assert.InDelta(t, 2*time.Hour.Seconds(), float64(res.GetRemainingSeconds()), 60)
+
+	res, err = appServer.GetTTL(t.Context(), &application.ApplicationTTLQuery{Name: &expired.Name})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), res.GetRemainingSeconds())
+}
+
 func TestCreateAppWithDestName(t *testing.T) {
 	appServer := newTestAppServer(t)
 	testApp := newTestAppWithDestName()
@@ -1607,6 +2151,41 @@ func TestCreateAppUpsert(t *testing.T) {
 	})
 }
 
+func TestPreviewUpdate(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = "default"
+	})
+	appServer := newTestAppServer(t, testApp)
+
+	t.Run("Changed", func(t *testing.T) {
+		proposed := newTestApp()
+		proposed.Spec.Source.TargetRevision = "v2"
+		res, err := appServer.PreviewUpdate(t.Context(), &application.ApplicationUpdateRequest{Application: proposed})
+		require.NoError(t, err)
+		// the proposed spec is normalized like it would be by Update
+		assert.Equal(t, "default", res.Application.Spec.Project)
+		assert.Equal(t, "v2", res.Application.Spec.Source.TargetRevision)
+		assert.JSONEq(t, `{"spec":{"source":{"targetRevision":"v2"}}}`, res.GetPatch())
+
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testApp.Namespace).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "HEAD", app.Spec.Source.TargetRevision)
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		res, err := appServer.PreviewUpdate(t.Context(), &application.ApplicationUpdateRequest{Application: newTestApp()})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{}`, res.GetPatch())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		proposed := newTestApp()
+		proposed.Spec.Destination.Server = "https://invalid-cluster"
+		_, err := appServer.PreviewUpdate(t.Context(), &application.ApplicationUpdateRequest{Application: proposed})
+		require.ErrorContains(t, err, "application destination spec for test-app is invalid")
+	})
+}
+
 func TestUpdateApp(t *testing.T) {
 	t.Parallel()
 	t.Run("Same spec", func(t *testing.T) {
@@ -1734,9 +2313,150 @@ func TestUpdateApp(t *testing.T) {
 	})
 }
 
-func TestUpdateAppSpec(t *testing.T) {
-	testApp := newTestApp()
-	appServer := newTestAppServer(t, testApp)
+func TestUpdateApplicationsMetadata(t *testing.T) {
+	newApp := func(name string, appLabels map[string]string) *v1alpha1.Application {
+		return newTestApp(func(app *v1alpha1.Application) {
+			app.Name = name
+			app.Labels = appLabels
+		})
+	}
+	frontend := newApp("frontend", map[string]string{"tier": "web"})
+	backend := newApp("backend", map[string]string{"tier": "web", "team": "old"})
+	dataApp := newApp("db", map[string]string{"tier": "data"})
+
+	t.Run("MergesIntoMatchingApps", func(t *testing.T) {
+		appServer := newTestAppServer(t, frontend, backend, dataApp)
+
+		res, err := appServer.UpdateApplicationsMetadata(t.Context(), &application.ApplicationsMetadataUpdateRequest{
+			Selector:    ptr.To("tier=web"),
+			Labels:      map[string]string{"team": "payments"},
+			Annotations: map[string]string{"owner": "payments@example.com"},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 2)
+		assert.Equal(t, "backend", res.Results[0].GetName())
+		assert.Equal(t, "frontend", res.Results[1].GetName())
+
+		for _, name := range []string{"frontend", "backend"} {
+			app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, "web", app.Labels["tier"])
+			assert.Equal(t, "payments", app.Labels["team"])
+			assert.Equal(t, "payments@example.com", app.Annotations["owner"])
+		}
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), "db", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, app.Labels, "team")
+	})
+
+	t.Run("UpdateNotPermitted", func(t *testing.T) {
+		ctx := t.Context()
+		//nolint:staticcheck
+		ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer := newTestAppServer(t, frontend, backend, dataApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/*, allow
+p, test-user, applications, update, default/frontend, allow
+`)
+
+		res, err := appServer.UpdateApplicationsMetadata(ctx, &application.ApplicationsMetadataUpdateRequest{
+			Selector: ptr.To("tier=web"),
+			Labels:   map[string]string{"team": "payments"},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 2)
+		assert.False(t, res.Results[0].GetUpdated())
+		assert.NotEmpty(t, res.Results[0].GetError())
+		assert.True(t, res.Results[1].GetUpdated())
+	})
+
+	t.Run("SelectorRequired", func(t *testing.T) {
+		appServer := newTestAppServer(t, frontend)
+
+		_, err := appServer.UpdateApplicationsMetadata(t.Context(), &application.ApplicationsMetadataUpdateRequest{
+			Labels: map[string]string{"team": "payments"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestUpdateSyncPolicy(t *testing.T) {
+	t.Run("EnableAutomatedSync", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.UpdateSyncPolicy(t.Context(), &application.ApplicationSyncPolicyUpdateRequest{
+			Name: &testApp.Name,
+			SyncPolicy: &v1alpha1.SyncPolicy{
+				Automated:   &v1alpha1.SyncPolicyAutomated{Prune: true, SelfHeal: true},
+				SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"},
+			},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res.GetSyncPolicy().Automated)
+		assert.True(t, res.GetSyncPolicy().Automated.SelfHeal)
+
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Spec.SyncPolicy)
+		assert.True(t, app.Spec.SyncPolicy.Automated.Prune)
+		assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=true"}, app.Spec.SyncPolicy.SyncOptions)
+		assert.Equal(t, testApp.Spec.GetSource(), app.Spec.GetSource())
+		assert.Equal(t, testApp.Spec.Destination, app.Spec.Destination)
+	})
+
+	t.Run("ClearSyncPolicy", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}}
+		})
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.UpdateSyncPolicy(t.Context(), &application.ApplicationSyncPolicyUpdateRequest{
+			Name: &testApp.Name,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, res.GetSyncPolicy())
+
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Spec.SyncPolicy)
+	})
+
+	t.Run("InvalidRetryBackoff", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.UpdateSyncPolicy(t.Context(), &application.ApplicationSyncPolicyUpdateRequest{
+			Name: &testApp.Name,
+			SyncPolicy: &v1alpha1.SyncPolicy{
+				Retry: &v1alpha1.RetryStrategy{Limit: 3, Backoff: &v1alpha1.Backoff{Duration: "soon"}},
+			},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("UpdateNotPermitted", func(t *testing.T) {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/*, allow
+`)
+
+		_, err := appServer.UpdateSyncPolicy(ctx, &application.ApplicationSyncPolicyUpdateRequest{
+			Name:       &testApp.Name,
+			SyncPolicy: &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}},
+		})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error())
+	})
+}
+
+func TestUpdateAppSpec(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
 	testApp.Spec.Project = ""
 	spec, err := appServer.UpdateSpec(t.Context(), &application.ApplicationUpdateSpecRequest{
 		Name: &testApp.Name,
@@ -1749,6 +2469,30 @@ func TestUpdateAppSpec(t *testing.T) {
 	assert.Equal(t, "default", app.Spec.Project)
 }
 
+func TestGetAppFields(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+		app.Status.Health.Status = health.HealthStatusDegraded
+	})
+	appServer := newTestAppServer(t, testApp)
+
+	app, err := appServer.Get(t.Context(), &application.ApplicationQuery{
+		Name:   &testApp.Name,
+		Fields: []string{"metadata.name", "status.sync.status", "status.unknown", "spec.source.repoURL.invalid"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, testApp.Name, app.Name)
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, app.Status.Sync.Status)
+	assert.Empty(t, app.Namespace)
+	assert.Empty(t, app.Status.Health.Status)
+	assert.Nil(t, app.Spec.Source)
+
+	app, err = appServer.Get(t.Context(), &application.ApplicationQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusDegraded, app.Status.Health.Status)
+	assert.NotNil(t, app.Spec.Source)
+}
+
 func TestDeleteApp(t *testing.T) {
 	ctx := t.Context()
 	appServer := newTestAppServer(t)
@@ -1932,6 +2676,80 @@ p, test-user, applications, delete/fake.io/PodTest/*, default/test-app, deny
 	})
 }
 
+func TestDeleteResourceDeletionProtection(t *testing.T) {
+	newDeployment := func(protected string) *unstructured.Unstructured {
+		return kube.MustToUnstructured(&appsv1.Deployment{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "guestbook",
+				Namespace:   test.FakeDestNamespace,
+				Annotations: map[string]string{"example.com/protected": protected},
+			},
+		})
+	}
+	newServer := func(t *testing.T, argoCM map[string]string, objects ...runtime.Object) *Server {
+		t.Helper()
+		return newTestAppServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:admin")
+		}, argoCM, objects...)
+	}
+	argoCM := map[string]string{"resource.deletionProtectionAnnotation": "example.com/protected"}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Group: "apps", Kind: "Deployment", Version: "v1", Name: "guestbook", Namespace: test.FakeDestNamespace},
+		}
+	})
+	newRequest := func(force bool) *application.ApplicationResourceDeleteRequest {
+		return &application.ApplicationResourceDeleteRequest{
+			Name:         &testApp.Name,
+			Group:        ptr.To("apps"),
+			Kind:         ptr.To("Deployment"),
+			Version:      ptr.To("v1"),
+			Namespace:    ptr.To(test.FakeDestNamespace),
+			ResourceName: ptr.To("guestbook"),
+			Force:        ptr.To(force),
+		}
+	}
+
+	t.Run("Protected", func(t *testing.T) {
+		appServer := newServer(t, argoCM, testApp, newDeployment("true"))
+		_, err := appServer.DeleteResource(t.Context(), newRequest(false))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("ForceWithOverride", func(t *testing.T) {
+		appServer := newServer(t, argoCM, testApp, newDeployment("true"))
+		_, err := appServer.DeleteResource(t.Context(), newRequest(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("ForceWithoutOverride", func(t *testing.T) {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer := newServer(t, argoCM, testApp, newDeployment("true"))
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+p, test-user, applications, delete/*, default/test-app, allow
+`)
+		_, err := appServer.DeleteResource(ctx, newRequest(true))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("NotProtected", func(t *testing.T) {
+		appServer := newServer(t, argoCM, testApp, newDeployment("false"))
+		_, err := appServer.DeleteResource(t.Context(), newRequest(false))
+		require.NoError(t, err)
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		appServer := newServer(t, map[string]string{}, testApp, newDeployment("true"))
+		_, err := appServer.DeleteResource(t.Context(), newRequest(false))
+		require.NoError(t, err)
+	})
+}
+
 func TestPatchResourcesRBAC(t *testing.T) {
 	ctx := t.Context()
 	//nolint:staticcheck
@@ -2025,6 +2843,345 @@ p, test-user, applications, update/fake.io/PodTest/*, default/test-app, deny
 	})
 }
 
+func TestPatchResources(t *testing.T) {
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook"},
+			{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "other"},
+			{Version: "v1", Kind: "Service", Namespace: testNamespace, Name: "guestbook"},
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+	_ = appServer.enf.SetBuiltinPolicy(fmt.Sprintf(`
+p, test-user, applications, get, default/test-app, allow
+p, test-user, applications, update/apps/Deployment/%s/guestbook, default/test-app, allow
+`, testNamespace))
+
+	t.Run("NoSelector", func(t *testing.T) {
+		_, err := appServer.PatchResources(ctx, &application.ApplicationResourcesPatchRequest{Name: &testApp.Name, Patch: ptr.To("{}")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("ByKind", func(t *testing.T) {
+		res, err := appServer.PatchResources(ctx, &application.ApplicationResourcesPatchRequest{
+			Name:      &testApp.Name,
+			Group:     ptr.To("apps"),
+			Kind:      ptr.To("Deployment"),
+			Patch:     ptr.To(`{"metadata":{"labels":{"foo":"bar"}}}`),
+			PatchType: ptr.To("application/merge-patch+json"),
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 2)
+		results := map[string]*application.ResourcePatchResult{}
+		for _, r := range res.Results {
+			results[r.GetName()] = r
+		}
+		// the fake kubectl returns no manifest, so the permitted resource fails after the patch call
+		assert.False(t, results["guestbook"].GetPatched())
+		assert.Contains(t, results["guestbook"].GetError(), "manifest was nil")
+		assert.False(t, results["other"].GetPatched())
+		assert.Equal(t, common.PermissionDeniedAPIError.Error(), results["other"].GetError())
+	})
+	t.Run("ByRef", func(t *testing.T) {
+		res, err := appServer.PatchResources(ctx, &application.ApplicationResourcesPatchRequest{
+			Name:      &testApp.Name,
+			Resources: []*v1alpha1.ResourceRef{{Version: "v1", Kind: "Service", Namespace: testNamespace, Name: "guestbook"}},
+			Patch:     ptr.To("{}"),
+			PatchType: ptr.To("application/merge-patch+json"),
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 1)
+		assert.Equal(t, "Service", res.Results[0].GetKind())
+		assert.Equal(t, common.PermissionDeniedAPIError.Error(), res.Results[0].GetError())
+	})
+}
+
+func TestSyncConcurrently(t *testing.T) {
+	appServer := newTestAppServer(t)
+	testApp := newTestApp()
+	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+
+	const syncs = 5
+	errs := make(chan error, syncs)
+	for range syncs {
+		go func() {
+			_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &app.Name})
+			errs <- err
+		}()
+	}
+
+	succeeded := 0
+	for range syncs {
+		err := <-errs
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.Equal(t, argo.ErrAnotherOperationInProgress, err)
+	}
+	assert.Equal(t, 1, succeeded)
+}
+
+func TestSyncDuringOperation(t *testing.T) {
+	setRunningOperation := func(t *testing.T, appServer *Server, name string) {
+		t.Helper()
+		appIf := appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns)
+		app, err := appIf.Get(t.Context(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		app.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationRunning, Operation: *app.Operation}
+		_, err = appIf.Update(t.Context(), app, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	t.Run("Rejected", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		setRunningOperation(t, appServer, testApp.Name)
+
+		_, err = appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name})
+		assert.Equal(t, argo.ErrAnotherOperationInProgress, err)
+	})
+
+	t.Run("ForcedPending", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+
+		app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, Prune: ptr.To(true), Force: ptr.To(true)})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.True(t, app.Operation.Sync.Prune)
+	})
+
+	t.Run("ForcedRunning", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		setRunningOperation(t, appServer, testApp.Name)
+
+		_, err = appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, Prune: ptr.To(true), Force: ptr.To(true)})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		// the running operation is left untouched
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.False(t, app.Operation.Sync.Prune)
+		require.NotNil(t, app.Status.OperationState)
+		assert.Equal(t, synccommon.OperationRunning, app.Status.OperationState.Phase)
+	})
+
+	t.Run("ForceRequiresOverride", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		ctx := t.Context()
+		//nolint:staticcheck
+		ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/*, allow
+p, test-user, applications, sync, default/*, allow
+`)
+
+		_, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name, Force: ptr.To(true)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSyncOperationID(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	firstID := getOperationID(app.Operation)
+	require.NotEmpty(t, firstID)
+
+	// the forced sync replaces the operation started by the first sync
+	app, err = appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, Force: ptr.To(true)})
+	require.NoError(t, err)
+	secondID := getOperationID(app.Operation)
+	require.NotEmpty(t, secondID)
+	assert.NotEqual(t, firstID, secondID)
+
+	app.Status.OperationState = &v1alpha1.OperationState{Operation: *app.Operation, Phase: synccommon.OperationRunning}
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Update(t.Context(), app, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	_, err = appServer.TerminateOperation(t.Context(), &application.OperationTerminateRequest{Name: &testApp.Name, OperationId: &firstID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestSyncWait(t *testing.T) {
+	t.Run("Completed", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		appServer.appBroadcaster = &broadcasterHandler{}
+
+		type result struct {
+			app *v1alpha1.Application
+			err error
+		}
+		done := make(chan result, 1)
+		go func() {
+			app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, Wait: ptr.To(true)})
+			done <- result{app, err}
+		}()
+
+		var started *v1alpha1.Application
+		require.Eventually(t, func() bool {
+			app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			started = app
+			return app.Operation != nil
+		}, 5*time.Second, 10*time.Millisecond)
+
+		running := started.DeepCopy()
+		running.Status.OperationState = &v1alpha1.OperationState{Operation: *started.Operation, Phase: synccommon.OperationRunning}
+		appServer.appBroadcaster.OnUpdate(started, running)
+		completed := started.DeepCopy()
+		completed.Operation = nil
+		completed.Status.OperationState = &v1alpha1.OperationState{Operation: *started.Operation, Phase: synccommon.OperationSucceeded}
+		appServer.appBroadcaster.OnUpdate(running, completed)
+
+		res := <-done
+		require.NoError(t, res.err)
+		require.NotNil(t, res.app.Status.OperationState)
+		assert.Equal(t, synccommon.OperationSucceeded, res.app.Status.OperationState.Phase)
+	})
+
+	t.Run("ConcurrentSync", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		appServer.appBroadcaster = &broadcasterHandler{}
+
+		waitDone := make(chan error, 1)
+		go func() {
+			_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, Wait: ptr.To(true)})
+			waitDone <- err
+		}()
+
+		var started *v1alpha1.Application
+		require.Eventually(t, func() bool {
+			app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			started = app
+			return app.Operation != nil
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// the waiting sync doesn't hold the app's operation lock, so the second sync is rejected without blocking
+		syncDone := make(chan error, 1)
+		go func() {
+			_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name})
+			syncDone <- err
+		}()
+		select {
+		case err := <-syncDone:
+			assert.Equal(t, argo.ErrAnotherOperationInProgress, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("second sync blocked by the waiting sync")
+		}
+
+		completed := started.DeepCopy()
+		completed.Operation = nil
+		completed.Status.OperationState = &v1alpha1.OperationState{Operation: *started.Operation, Phase: synccommon.OperationSucceeded}
+		appServer.appBroadcaster.OnUpdate(started, completed)
+		require.NoError(t, <-waitDone)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		appServer.appBroadcaster = &broadcasterHandler{}
+
+		app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, Wait: ptr.To(true), WaitTimeoutSeconds: ptr.To(int64(1))})
+		require.NoError(t, err)
+		// the in-progress application is returned
+		assert.NotNil(t, app.Operation)
+		assert.Nil(t, app.Status.OperationState)
+	})
+
+	t.Run("NegativeTimeout", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, Wait: ptr.To(true), WaitTimeoutSeconds: ptr.To(int64(-1))})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestCheckSourcesPermitted(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"https://github.com/argoproj/*", "!https://github.com/argoproj/forbidden.git"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = proj.Name
+	})
+	appServer := newTestAppServer(t, testApp, proj)
+
+	t.Run("ApplicationSources", func(t *testing.T) {
+		res, err := appServer.CheckSourcesPermitted(t.Context(), &application.ApplicationSourcesPermissionQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, "restricted", res.GetProject())
+		assert.True(t, res.GetAllowed())
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "https://github.com/argoproj/argocd-example-apps.git", res.Items[0].GetRepoURL())
+		assert.Equal(t, "https://github.com/argoproj/*", res.Items[0].GetPattern())
+	})
+
+	t.Run("GivenSources", func(t *testing.T) {
+		res, err := appServer.CheckSourcesPermitted(t.Context(), &application.ApplicationSourcesPermissionQuery{
+			Name: &testApp.Name,
+			Sources: []*v1alpha1.ApplicationSource{
+				{RepoURL: "https://github.com/argoproj/forbidden.git"},
+				{RepoURL: "https://gitlab.com/other/repo.git"},
+			},
+		})
+		require.NoError(t, err)
+		assert.False(t, res.GetAllowed())
+		require.Len(t, res.Items, 2)
+		assert.False(t, res.Items[0].GetAllowed())
+		assert.Equal(t, "!https://github.com/argoproj/forbidden.git", res.Items[0].GetPattern())
+		assert.False(t, res.Items[1].GetAllowed())
+		assert.Empty(t, res.Items[1].GetPattern())
+	})
+}
+
+func TestSyncWithCorrelationID(t *testing.T) {
+	t.Run("StoredOnOperation", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, CorrelationId: ptr.To("pipeline-run-42")})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Contains(t, app.Operation.Info, &v1alpha1.Info{Name: common.LabelKeyCorrelationID, Value: "pipeline-run-42"})
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		for _, id := range []string{"not valid", strings.Repeat("a", 64), "-leading-dash"} {
+			_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, CorrelationId: ptr.To(id)})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), id)
+		}
+	})
+}
+
 func TestSyncAndTerminate(t *testing.T) {
 	ctx := t.Context()
 	appServer := newTestAppServer(t)
@@ -2117,130 +3274,2832 @@ func TestSyncGit(t *testing.T) {
 	assert.Equal(t, "Unknown user initiated sync locally", events.Items[1].Message)
 }
 
-func TestRollbackApp(t *testing.T) {
-	testApp := newTestApp()
-	testApp.Status.History = []v1alpha1.RevisionHistory{{
-		ID:       1,
-		Revision: "abc",
-		Source:   *testApp.Spec.Source.DeepCopy(),
-	}}
-	appServer := newTestAppServer(t, testApp)
+func TestSyncWithLocalManifestsRef(t *testing.T) {
+	manifests := []string{
+		`{"apiVersion":"v1","kind":"ServiceAccount","metadata":{"name":"test","namespace":"test"}}`,
+		`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test","namespace":"test"}}`,
+	}
+	upload := func(t *testing.T, appServer *Server, appName string) string {
+		t.Helper()
+		stream := &TestLocalManifestsUploadServer{TestPodLogsServer: TestPodLogsServer{ctx: t.Context()}, requests: []*application.LocalManifestsUploadRequest{
+			{Part: &application.LocalManifestsUploadRequest_Query{Query: &application.LocalManifestsUploadQuery{Name: ptr.To(appName)}}},
+			{Part: &application.LocalManifestsUploadRequest_Chunk{Chunk: &application.LocalManifestsChunk{Manifests: manifests[:1]}}},
+			{Part: &application.LocalManifestsUploadRequest_Chunk{Chunk: &application.LocalManifestsChunk{Manifests: manifests[1:]}}},
+		}}
+		require.NoError(t, appServer.UploadLocalManifests(stream))
+		require.NotNil(t, stream.response)
+		assert.Equal(t, int64(2), stream.response.GetCount())
+		assert.True(t, stream.response.ExpiresAt.After(time.Now()))
+		return stream.response.GetRef()
+	}
 
-	updatedApp, err := appServer.Rollback(t.Context(), &application.ApplicationRollbackRequest{
-		Name: &testApp.Name,
-		Id:   ptr.To(int64(1)),
+	t.Run("Synced", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		ref := upload(t, appServer, testApp.Name)
+
+		app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, ManifestsRef: ptr.To(ref)})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Equal(t, manifests, app.Operation.Sync.Manifests)
 	})
+	t.Run("OtherApplication", func(t *testing.T) {
+		testApp := newTestApp()
+		otherApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Name = "other-app"
+		})
+		appServer := newTestAppServer(t, testApp, otherApp)
+		ref := upload(t, appServer, otherApp.Name)
 
-	require.NoError(t, err)
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, ManifestsRef: ptr.To(ref)})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("CombinedWithManifests", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		ref := upload(t, appServer, testApp.Name)
 
-	assert.NotNil(t, updatedApp.Operation)
-	assert.NotNil(t, updatedApp.Operation.Sync)
-	assert.NotNil(t, updatedApp.Operation.Sync.Source)
-	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, ManifestsRef: ptr.To(ref), Manifests: manifests})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("AutomatedSync", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}}
+		})
+		appServer := newTestAppServer(t, testApp)
+		ref := upload(t, appServer, testApp.Name)
+
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, ManifestsRef: ptr.To(ref)})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("SignatureKeys", func(t *testing.T) {
+		signedProj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "proj-signed", Namespace: "default"},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:   []string{"*"},
+				Destinations:  []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SignatureKeys: []v1alpha1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}},
+			},
+		}
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Project = "proj-signed"
+		})
+		appServer := newTestAppServer(t, signedProj, testApp)
+		ref := upload(t, appServer, testApp.Name)
+
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, ManifestsRef: ptr.To(ref)})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("UploadRequiresOverride", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		stream := &TestLocalManifestsUploadServer{TestPodLogsServer: TestPodLogsServer{ctx: t.Context()}, requests: []*application.LocalManifestsUploadRequest{
+			{Part: &application.LocalManifestsUploadRequest_Chunk{Chunk: &application.LocalManifestsChunk{Manifests: manifests}}},
+		}}
+		err := appServer.UploadLocalManifests(stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		f := func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("")
+		}
+		appServer = newTestAppServerWithEnforcerConfigure(t, f, map[string]string{}, testApp)
+		stream = &TestLocalManifestsUploadServer{TestPodLogsServer: TestPodLogsServer{ctx: t.Context()}, requests: []*application.LocalManifestsUploadRequest{
+			{Part: &application.LocalManifestsUploadRequest_Query{Query: &application.LocalManifestsUploadQuery{Name: ptr.To(testApp.Name)}}},
+		}}
+		err = appServer.UploadLocalManifests(stream)
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error())
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		originalMaxLocalManifestsSize := maxLocalManifestsSize
+		maxLocalManifestsSize = len(manifests[0]) + 1
+		t.Cleanup(func() {
+			maxLocalManifestsSize = originalMaxLocalManifestsSize
+		})
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		stream := &TestLocalManifestsUploadServer{TestPodLogsServer: TestPodLogsServer{ctx: t.Context()}, requests: []*application.LocalManifestsUploadRequest{
+			{Part: &application.LocalManifestsUploadRequest_Query{Query: &application.LocalManifestsUploadQuery{Name: ptr.To(testApp.Name)}}},
+			{Part: &application.LocalManifestsUploadRequest_Chunk{Chunk: &application.LocalManifestsChunk{Manifests: manifests[:1]}}},
+			{Part: &application.LocalManifestsUploadRequest_Chunk{Chunk: &application.LocalManifestsChunk{Manifests: manifests[1:]}}},
+		}}
+		err := appServer.UploadLocalManifests(stream)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Nil(t, stream.response)
+
+		stream = &TestLocalManifestsUploadServer{TestPodLogsServer: TestPodLogsServer{ctx: t.Context()}, requests: []*application.LocalManifestsUploadRequest{
+			{Part: &application.LocalManifestsUploadRequest_Query{Query: &application.LocalManifestsUploadQuery{Name: ptr.To(testApp.Name)}}},
+			{Part: &application.LocalManifestsUploadRequest_Chunk{Chunk: &application.LocalManifestsChunk{}}},
+		}}
+		err = appServer.UploadLocalManifests(stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
 
-func TestUpdateAppProject(t *testing.T) {
+func TestSyncExpectedResourceCount(t *testing.T) {
+	t.Run("MismatchRecordedAsWarning", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{
+			Name:                  &testApp.Name,
+			ExpectedResourceCount: ptr.To(int64(3)),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Contains(t, app.Operation.Info, &v1alpha1.Info{Name: "Warning", Value: "generated 0 resources, but 3 were expected"})
+	})
+
+	t.Run("MismatchFailsSync", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{
+			Name:                        &testApp.Name,
+			ExpectedResourceCount:       ptr.To(int64(3)),
+			FailOnResourceCountMismatch: ptr.To(true),
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("MatchingCount", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{
+			Name:                        &testApp.Name,
+			ExpectedResourceCount:       ptr.To(int64(0)),
+			FailOnResourceCountMismatch: ptr.To(true),
+		})
+		require.NoError(t, err)
+		for _, info := range app.Operation.Info {
+			assert.NotEqual(t, "Warning", info.Name)
+		}
+	})
+}
+
+func TestGetResolvedSyncOptions(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true", "Replace=true"}}
+	})
+	appServer := newTestAppServer(t, testApp)
+
+	t.Run("ReplaceAllowed", func(t *testing.T) {
+		res, err := appServer.GetResolvedSyncOptions(t.Context(), &application.ApplicationResolvedSyncOptionsQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.True(t, res.GetAllowed())
+		require.Len(t, res.Options, 2)
+		assert.Equal(t, "CreateNamespace", res.Options[0].GetName())
+		assert.Equal(t, "true", res.Options[0].GetValue())
+		assert.Equal(t, "application", res.Options[0].GetSource())
+	})
+	t.Run("ReplaceDisabled", func(t *testing.T) {
+		appServer.syncWithReplaceAllowed = false
+		defer func() { appServer.syncWithReplaceAllowed = true }()
+
+		res, err := appServer.GetResolvedSyncOptions(t.Context(), &application.ApplicationResolvedSyncOptionsQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.False(t, res.GetAllowed())
+		require.Len(t, res.Options, 2)
+		assert.True(t, res.Options[0].GetAllowed())
+		assert.False(t, res.Options[1].GetAllowed())
+		assert.NotEmpty(t, res.Options[1].GetReason())
+	})
+	t.Run("RequestOptions", func(t *testing.T) {
+		res, err := appServer.GetResolvedSyncOptions(t.Context(), &application.ApplicationResolvedSyncOptionsQuery{
+			Name:        &testApp.Name,
+			SyncOptions: &application.SyncOptions{Items: []string{"ServerSideApply=true"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Options, 1)
+		assert.Equal(t, "ServerSideApply", res.Options[0].GetName())
+		assert.Equal(t, "request", res.Options[0].GetSource())
+	})
+}
+
+func TestGetSyncBlastRadius(t *testing.T) {
 	testApp := newTestApp()
-	ctx := t.Context()
-	//nolint:staticcheck
-	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
 	appServer := newTestAppServer(t, testApp)
-	appServer.enf.SetDefaultRole("")
+	unchanged := `{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook","namespace":"fake-dest-ns"}}`
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{Manifests: []string{
+		unchanged,
+		`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"replicas":3}}`,
+		`{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"migrate","namespace":"jobs"}}`,
+		`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"fake-dest-ns"}}`,
+	}}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		// in sync, and the generated manifest is the one the cached diff was computed for
+		{Kind: "Service", Namespace: test.FakeDestNamespace, Name: "guestbook", LiveState: unchanged, TargetState: unchanged},
+		// in sync according to the cached diff, but the generated manifest changed
+		{Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "guestbook", LiveState: `{"apiVersion":"apps/v1","kind":"Deployment"}`, TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"fake-dest-ns"},"spec":{"replicas":1}}`},
+		{Group: "batch", Kind: "Job", Namespace: "jobs", Name: "migrate", LiveState: "null", TargetState: "{}"},
+		{Kind: "Secret", Namespace: "legacy", Name: "obsolete", LiveState: `{"apiVersion":"v1","kind":"Secret"}`, TargetState: "null"},
+		{Kind: "Secret", Namespace: "legacy", Name: "kept", LiveState: `{"apiVersion":"v1","kind":"Secret","metadata":{"annotations":{"argocd.argoproj.io/sync-options":"Prune=false"}}}`, TargetState: "null"},
+	})
+	require.NoError(t, err)
 
-	t.Run("update without changing project", func(t *testing.T) {
-		_ = appServer.enf.SetBuiltinPolicy(`p, admin, applications, update, default/test-app, allow`)
-		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+	res, err := appServer.GetSyncBlastRadius(t.Context(), &application.ApplicationSyncBlastRadiusQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), res.GetAdded())
+	assert.Equal(t, int64(1), res.GetModified())
+	assert.Equal(t, int64(1), res.GetDeleted())
+	assert.Equal(t, int64(1), res.GetUnchanged())
+	assert.Equal(t, []string{test.FakeDestNamespace, "jobs", "legacy"}, res.Namespaces)
+
+	kinds := map[string]*application.BlastRadiusKind{}
+	for _, kind := range res.Kinds {
+		kinds[kind.GetKind()] = kind
+	}
+	require.Len(t, kinds, 4)
+	assert.Equal(t, int64(1), kinds["Deployment"].GetModified())
+	assert.True(t, kinds["Deployment"].GetWorkload())
+	assert.Equal(t, int64(1), kinds["Job"].GetAdded())
+	assert.True(t, kinds["Job"].GetWorkload())
+	assert.Equal(t, int64(1), kinds["ConfigMap"].GetAdded())
+	assert.False(t, kinds["ConfigMap"].GetWorkload())
+	assert.Equal(t, int64(1), kinds["Secret"].GetDeleted())
+}
+
+func TestGetPrunePreview(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{Manifests: []string{
+		`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "guestbook"}}`,
+	}}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: testApp.Spec.Destination.Namespace, Name: "guestbook", LiveState: `{"apiVersion": "apps/v1", "kind": "Deployment"}`},
+		{Kind: "ConfigMap", Namespace: testNamespace, Name: "obsolete", LiveState: `{"apiVersion": "v1", "kind": "ConfigMap"}`},
+		{Kind: "Secret", Namespace: testNamespace, Name: "kept", LiveState: `{"apiVersion": "v1", "kind": "Secret", "metadata": {"annotations": {"argocd.argoproj.io/sync-options": "Prune=false"}}}`},
+		{Kind: "PersistentVolumeClaim", Namespace: testNamespace, Name: "data", LiveState: `{"apiVersion": "v1", "kind": "PersistentVolumeClaim", "metadata": {"annotations": {"argocd.argoproj.io/sync-options": "Prune=confirm"}}}`},
+		{Kind: "Service", Namespace: testNamespace, Name: "missing", LiveState: "null"},
+	})
+	require.NoError(t, err)
+
+	res, err := appServer.GetPrunePreview(t.Context(), &application.ApplicationPrunePreviewQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Resources, 3)
+	assert.Equal(t, "obsolete", res.Resources[0].Resource.Name)
+	assert.True(t, res.Resources[0].GetPruned())
+	assert.Empty(t, res.Resources[0].GetMessage())
+	assert.Equal(t, "kept", res.Resources[1].Resource.Name)
+	assert.False(t, res.Resources[1].GetPruned())
+	assert.Equal(t, "data", res.Resources[2].Resource.Name)
+	assert.True(t, res.Resources[2].GetPruned())
+	assert.Contains(t, res.Resources[2].GetMessage(), "requires confirmation")
+}
+
+func TestSyncWaves(t *testing.T) {
+	manifests := []string{
+		`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "config", "annotations": {"argocd.argoproj.io/sync-wave": "-1"}}}`,
+		`{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "guestbook", "namespace": "test"}}`,
+		`{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "guestbook", "annotations": {"argocd.argoproj.io/sync-wave": "1"}}}`,
+		`{"apiVersion": "batch/v1", "kind": "Job", "metadata": {"name": "migrate", "annotations": {"argocd.argoproj.io/hook": "Sync"}}}`,
+	}
+
+	t.Run("SelectedWaves", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{
+			Name:      &testApp.Name,
+			Manifests: manifests,
+			SyncWaves: []int64{-1, 0},
+		})
 		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Equal(t, []v1alpha1.SyncOperationResource{
+			{Kind: "ConfigMap", Name: "config"},
+			{Group: "apps", Kind: "Deployment", Name: "guestbook", Namespace: "test"},
+		}, app.Operation.Sync.Resources)
 	})
 
-	t.Run("cannot update to another project", func(t *testing.T) {
-		testApp.Spec.Project = "my-proj"
-		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+	t.Run("UnknownWave", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{
+			Name:      &testApp.Name,
+			Manifests: manifests,
+			SyncWaves: []int64{0, 2},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.ErrorContains(t, err, "no resources in sync waves 2")
+	})
+
+	t.Run("CombinedWithResources", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{
+			Name:      &testApp.Name,
+			Resources: []*v1alpha1.SyncOperationResource{{Kind: "Service", Name: "guestbook"}},
+			SyncWaves: []int64{0},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestResourceCountDiffers(t *testing.T) {
+	assert.False(t, resourceCountDiffers(10, 10, 0))
+	assert.True(t, resourceCountDiffers(10, 9, 0))
+	assert.False(t, resourceCountDiffers(10, 9, 10))
+	assert.True(t, resourceCountDiffers(10, 8, 10))
+	assert.True(t, resourceCountDiffers(10, 12, 10))
+	assert.True(t, resourceCountDiffers(0, 1, 50))
+}
+
+func TestValidateSync(t *testing.T) {
+	t.Run("NoManifests", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		app, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, ValidateAdmission: ptr.To(true)})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+
+	t.Run("InvalidManifest", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.ValidateSync(t.Context(), &application.ApplicationSyncRequest{Name: &testApp.Name, Manifests: []string{"{"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("PartialSyncExcludesResources", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.ValidateSync(t.Context(), &application.ApplicationSyncRequest{
+			Name:      &testApp.Name,
+			Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"}}`},
+			Resources: []*v1alpha1.SyncOperationResource{{Group: "apps", Kind: "Deployment", Name: "guestbook"}},
+		})
+		require.NoError(t, err)
+		assert.True(t, res.GetAllowed())
+		assert.Empty(t, res.Results)
+	})
+
+	t.Run("ResourceNotPermitted", func(t *testing.T) {
+		runner := &fakeServerSideDryRunner{}
+		withFakeServerSideDryRunner(t, runner)
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		// the default project doesn't permit cluster-scoped resources
+		_, err := appServer.ValidateSync(t.Context(), &application.ApplicationSyncRequest{
+			Name: &testApp.Name,
+			Manifests: []string{
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"}}`,
+				`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"other"}}`,
+			},
+		})
 		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, runner.objs)
 	})
 
-	t.Run("cannot change projects without create privileges", func(t *testing.T) {
-		_ = appServer.enf.SetBuiltinPolicy(`
-p, admin, applications, update, default/test-app, allow
-p, admin, applications, update, my-proj/test-app, allow
-`)
-		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
-		statusErr := grpc.UnwrapGRPCStatus(err)
-		assert.NotNil(t, statusErr)
-		assert.Equal(t, codes.PermissionDenied, statusErr.Code())
+	t.Run("Namespaces", func(t *testing.T) {
+		runner := &fakeServerSideDryRunner{errors: map[string]error{"config": stderrors.New("denied by policy")}}
+		withFakeServerSideDryRunner(t, runner)
+		clusterProj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: testNamespace},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:              []string{"*"},
+				Destinations:             []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				ClusterResourceWhitelist: []metav1.GroupKind{{Group: "*", Kind: "*"}},
+			},
+		}
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Project = "cluster"
+		})
+		appServer := newTestAppServer(t, testApp, clusterProj)
+		appServer.kubectl = &kubetest.MockKubectlCmd{APIResources: []kube.APIResourceInfo{
+			{GroupKind: schema.GroupKind{Kind: "ConfigMap"}, Meta: metav1.APIResource{Namespaced: true}},
+			{GroupKind: schema.GroupKind{Kind: "Namespace"}, Meta: metav1.APIResource{Namespaced: false}},
+		}}
+
+		res, err := appServer.ValidateSync(t.Context(), &application.ApplicationSyncRequest{
+			Name: &testApp.Name,
+			Manifests: []string{
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"}}`,
+				`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"other","namespace":"ignored"}}`,
+			},
+		})
+		require.NoError(t, err)
+		assert.False(t, res.GetAllowed())
+		require.Len(t, runner.objs, 2)
+		// only the namespaced resource is defaulted to the destination namespace
+		assert.Equal(t, test.FakeDestNamespace, runner.objs[0].GetNamespace())
+		assert.Empty(t, runner.objs[1].GetNamespace())
+		require.Len(t, res.Results, 2)
+		assert.False(t, res.Results[0].GetAllowed())
+		assert.True(t, res.Results[1].GetAllowed())
 	})
+}
 
-	t.Run("cannot change projects without update privileges in new project", func(t *testing.T) {
-		_ = appServer.enf.SetBuiltinPolicy(`
-p, admin, applications, update, default/test-app, allow
-p, admin, applications, create, my-proj/test-app, allow
-`)
-		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+// fakeServerSideDryRunner records the objects it dry-runs, and fails those with a name it has an error for
+type fakeServerSideDryRunner struct {
+	objs   []*unstructured.Unstructured
+	errors map[string]error
+}
+
+func (r *fakeServerSideDryRunner) Run(_ context.Context, obj *unstructured.Unstructured, _ string) (string, error) {
+	r.objs = append(r.objs, obj)
+	return "", r.errors[obj.GetName()]
+}
+
+func withFakeServerSideDryRunner(t *testing.T, runner *fakeServerSideDryRunner) {
+	t.Helper()
+	original := newServerSideDryRunner
+	newServerSideDryRunner = func(_ kube.Kubectl, _ *rest.Config) (diff.ServerSideDryRunner, func(), error) {
+		return runner, func() {}, nil
+	}
+	t.Cleanup(func() {
+		newServerSideDryRunner = original
+	})
+}
+
+func TestValidateAgainstCluster(t *testing.T) {
+	restrictedProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "https://cluster-api.example.com", Namespace: "prod"}},
+		},
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = "restricted"
+	})
+	appServer := newTestAppServer(t, testApp, restrictedProj)
+
+	t.Run("DestinationRequired", func(t *testing.T) {
+		_, err := appServer.ValidateAgainstCluster(t.Context(), &application.ApplicationClusterValidationRequest{Name: &testApp.Name})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("UnknownCluster", func(t *testing.T) {
+		_, err := appServer.ValidateAgainstCluster(t.Context(), &application.ApplicationClusterValidationRequest{
+			Name:        &testApp.Name,
+			Destination: &v1alpha1.ApplicationDestination{Name: "standby", Namespace: "prod"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DestinationNotPermitted", func(t *testing.T) {
+		_, err := appServer.ValidateAgainstCluster(t.Context(), &application.ApplicationClusterValidationRequest{
+			Name:        &testApp.Name,
+			Destination: &v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "staging"},
+		})
 		assert.Equal(t, codes.PermissionDenied, status.Code(err))
 	})
 
-	t.Run("cannot change projects without update privileges in old project", func(t *testing.T) {
+	withManifests := func(manifests ...string) {
+		mockRepoServiceClient := mocks.RepoServerServiceClient{}
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{Manifests: manifests}, nil)
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+	}
+
+	t.Run("Validated", func(t *testing.T) {
+		runner := &fakeServerSideDryRunner{}
+		withFakeServerSideDryRunner(t, runner)
+		withManifests(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"}}`)
+
+		res, err := appServer.ValidateAgainstCluster(t.Context(), &application.ApplicationClusterValidationRequest{
+			Name:        &testApp.Name,
+			Destination: &v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "prod"},
+		})
+		require.NoError(t, err)
+		assert.True(t, res.GetAllowed())
+		assert.Equal(t, "https://cluster-api.example.com", res.GetServer())
+		require.Len(t, res.Results, 1)
+		assert.Equal(t, "prod", res.Results[0].GetNamespace())
+		require.Len(t, runner.objs, 1)
+		assert.Equal(t, "prod", runner.objs[0].GetNamespace())
+	})
+
+	t.Run("ResourceNotPermitted", func(t *testing.T) {
+		runner := &fakeServerSideDryRunner{}
+		withFakeServerSideDryRunner(t, runner)
+		// the project doesn't permit cluster-scoped resources
+		withManifests(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"other"}}`)
+
+		_, err := appServer.ValidateAgainstCluster(t.Context(), &application.ApplicationClusterValidationRequest{
+			Name:        &testApp.Name,
+			Destination: &v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "prod"},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, runner.objs)
+	})
+
+	t.Run("SyncPermissionRequired", func(t *testing.T) {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer.enf.SetDefaultRole("")
 		_ = appServer.enf.SetBuiltinPolicy(`
-p, admin, applications, create, my-proj/test-app, allow
-p, admin, applications, update, my-proj/test-app, allow
+p, test-user, applications, get, restricted/*, allow
 `)
-		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
-		statusErr := grpc.UnwrapGRPCStatus(err)
-		assert.NotNil(t, statusErr)
-		assert.Equal(t, codes.PermissionDenied, statusErr.Code())
+
+		_, err := appServer.ValidateAgainstCluster(ctx, &application.ApplicationClusterValidationRequest{
+			Name:        &testApp.Name,
+			Destination: &v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "prod"},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestAdmissionDenialsMessage(t *testing.T) {
+	msg := admissionDenialsMessage(&application.ApplicationSyncValidationResponse{
+		Allowed: ptr.To(false),
+		Results: []*application.ResourceActionValidationResult{
+			{Kind: ptr.To("Deployment"), Name: ptr.To("guestbook"), Allowed: ptr.To(false), Message: ptr.To("denied by policy")},
+			{Kind: ptr.To("Service"), Name: ptr.To("guestbook"), Allowed: ptr.To(true)},
+			{Kind: ptr.To("ConfigMap"), Name: ptr.To("config"), Allowed: ptr.To(false), Message: ptr.To("invalid")},
+		},
+	})
+	assert.Equal(t, "2 resources denied by admission: Deployment/guestbook: denied by policy; ConfigMap/config: invalid", msg)
+}
+
+func TestResourceTreeDelta(t *testing.T) {
+	node := func(name, health string) v1alpha1.ResourceNode {
+		return v1alpha1.ResourceNode{
+			ResourceRef: v1alpha1.ResourceRef{Kind: "Pod", Version: "v1", Namespace: "ns", Name: name, UID: name},
+			Health:      &v1alpha1.HealthStatus{Status: health},
+		}
+	}
+	prev := &v1alpha1.ApplicationTree{
+		Nodes: []v1alpha1.ResourceNode{node("pod-1", "Healthy"), node("pod-2", "Healthy")},
+		Hosts: []v1alpha1.HostInfo{{Name: "node-1"}, {Name: "node-2"}},
+	}
+
+	t.Run("Initial", func(t *testing.T) {
+		delta, changed := resourceTreeDelta(nil, prev)
+		assert.True(t, changed)
+		assert.Len(t, delta.Nodes, 2)
+		assert.Empty(t, delta.RemovedNodes)
+		assert.Len(t, delta.Hosts, 2)
+		assert.Empty(t, delta.RemovedHosts)
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		_, changed := resourceTreeDelta(prev, &v1alpha1.ApplicationTree{
+			Nodes: []v1alpha1.ResourceNode{node("pod-1", "Healthy"), node("pod-2", "Healthy")},
+			Hosts: []v1alpha1.HostInfo{{Name: "node-1"}, {Name: "node-2"}},
+		})
+		assert.False(t, changed)
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		tree := &v1alpha1.ApplicationTree{
+			Nodes: []v1alpha1.ResourceNode{node("pod-1", "Degraded"), node("pod-3", "Healthy")},
+			Hosts: []v1alpha1.HostInfo{{Name: "node-1"}, {Name: "node-3"}},
+		}
+		delta, changed := resourceTreeDelta(prev, tree)
+		assert.True(t, changed)
+		require.Len(t, delta.Nodes, 2)
+		assert.Equal(t, "pod-1", delta.Nodes[0].Name)
+		assert.Equal(t, "pod-3", delta.Nodes[1].Name)
+		require.Len(t, delta.RemovedNodes, 1)
+		assert.Equal(t, "pod-2", delta.RemovedNodes[0].Name)
+		require.Len(t, delta.Hosts, 1)
+		assert.Equal(t, "node-3", delta.Hosts[0].Name)
+		assert.Equal(t, []string{"node-2"}, delta.RemovedHosts)
+	})
+
+	t.Run("AllHostsRemoved", func(t *testing.T) {
+		delta, changed := resourceTreeDelta(prev, &v1alpha1.ApplicationTree{
+			Nodes: []v1alpha1.ResourceNode{node("pod-1", "Healthy"), node("pod-2", "Healthy")},
+		})
+		assert.True(t, changed)
+		assert.Empty(t, delta.Nodes)
+		assert.Empty(t, delta.Hosts)
+		assert.Equal(t, []string{"node-1", "node-2"}, delta.RemovedHosts)
 	})
+}
+
+func TestResourceTreeIncludeLinks(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServerWithEnforcerConfigure(t, func(_ *rbac.Enforcer) {}, map[string]string{
+		"resource.links": `
+- url: https://logs.example.com/{{.resource.metadata.namespace}}/{{.resource.metadata.name}}
+  title: logs
+- url: https://teams.example.com/{{.resource.metadata.labels.team}}
+  title: team
+  if: resource.kind == "Deployment"
+- url: https://example.com
+  title: invalid
+  if: resource.kind == "Deployment" && resource.metadata.name
+`,
+	}, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{{
+		Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook",
+		LiveState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"default","labels":{"team":"payments"}}}`,
+	}})
+	require.NoError(t, err)
+	deploymentRef := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook", UID: "1"}
+	podRef := v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "guestbook-1-a", UID: "2"}
+	err = appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{ResourceRef: deploymentRef},
+		{ResourceRef: podRef, ParentRefs: []v1alpha1.ResourceRef{deploymentRef}},
+	}})
+	require.NoError(t, err)
+
+	res, err := appServer.ResourceTree(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	for _, node := range res.Nodes {
+		assert.Nil(t, node.NetworkingInfo)
+		assert.Empty(t, node.Info)
+	}
+
+	res, err = appServer.ResourceTree(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name, IncludeLinks: ptr.To(true)})
+	require.NoError(t, err)
+	require.Len(t, res.Nodes, 2)
+
+	assert.Equal(t, deploymentRef, res.Nodes[0].ResourceRef)
+	require.NotNil(t, res.Nodes[0].NetworkingInfo)
+	assert.Equal(t, []string{"logs|https://logs.example.com/default/guestbook", "team|https://teams.example.com/payments"}, res.Nodes[0].NetworkingInfo.ExternalURLs)
+	require.Len(t, res.Nodes[0].Info, 1)
+	assert.Equal(t, resourceLinkErrorInfo, res.Nodes[0].Info[0].Name)
+
+	assert.Equal(t, podRef, res.Nodes[1].ResourceRef)
+	require.NotNil(t, res.Nodes[1].NetworkingInfo)
+	assert.Equal(t, []string{"logs|https://logs.example.com/default/guestbook-1-a"}, res.Nodes[1].NetworkingInfo.ExternalURLs)
+	assert.Empty(t, res.Nodes[1].Info)
+
+	_, err = appServer.ResourceTree(t.Context(), &application.ResourcesQuery{ApplicationName: ptr.To("does-not-exist"), IncludeLinks: ptr.To(true)})
+	require.Error(t, err)
+}
+
+func TestCollapseReplicaSetHistory(t *testing.T) {
+	deployment := v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Version: "v1", Namespace: "ns", Name: "guestbook", UID: "1"}
+	replicaSet := func(name string) v1alpha1.ResourceNode {
+		return v1alpha1.ResourceNode{
+			ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Version: "v1", Namespace: "ns", Name: name, UID: name},
+			ParentRefs:  []v1alpha1.ResourceRef{deployment},
+		}
+	}
+	tree := &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{ResourceRef: deployment},
+		replicaSet("guestbook-1"),
+		replicaSet("guestbook-2"),
+		replicaSet("guestbook-3"),
+		{
+			ResourceRef: v1alpha1.ResourceRef{Kind: "Pod", Version: "v1", Namespace: "ns", Name: "guestbook-3-abcde", UID: "4"},
+			ParentRefs:  []v1alpha1.ResourceRef{{Group: "apps", Kind: "ReplicaSet", Namespace: "ns", Name: "guestbook-3", UID: "guestbook-3"}},
+		},
+	}}
+
+	collapseReplicaSetHistory(tree)
+
+	require.Len(t, tree.Nodes, 4)
+	assert.Equal(t, "guestbook", tree.Nodes[0].Name)
+	assert.Equal(t, "guestbook-3", tree.Nodes[1].Name)
+	assert.Equal(t, "guestbook-3-abcde", tree.Nodes[2].Name)
+	summary := tree.Nodes[3]
+	assert.Equal(t, "guestbook-revision-history", summary.Name)
+	assert.Empty(t, summary.UID)
+	assert.Equal(t, []v1alpha1.ResourceRef{deployment}, summary.ParentRefs)
+	assert.Equal(t, []v1alpha1.InfoItem{{Name: "ReplicaSet", Value: "guestbook-1"}, {Name: "ReplicaSet", Value: "guestbook-2"}}, summary.Info)
+}
+
+func TestFilterTreeByHealth(t *testing.T) {
+	deployment := v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Version: "v1", Namespace: "ns", Name: "guestbook", UID: "1"}
+	replicaSet := v1alpha1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Version: "v1", Namespace: "ns", Name: "guestbook-1", UID: "2"}
+	service := v1alpha1.ResourceRef{Kind: "Service", Version: "v1", Namespace: "ns", Name: "guestbook", UID: "3"}
+	tree := &v1alpha1.ApplicationTree{
+		Nodes: []v1alpha1.ResourceNode{
+			{ResourceRef: deployment},
+			{ResourceRef: replicaSet, ParentRefs: []v1alpha1.ResourceRef{deployment}, Health: &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy}},
+			{
+				ResourceRef: v1alpha1.ResourceRef{Kind: "Pod", Version: "v1", Namespace: "ns", Name: "guestbook-1-abcde", UID: "4"},
+				ParentRefs:  []v1alpha1.ResourceRef{replicaSet},
+				Health:      &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded},
+			},
+			{ResourceRef: service},
+		},
+		OrphanedNodes: []v1alpha1.ResourceNode{
+			{ResourceRef: v1alpha1.ResourceRef{Kind: "ConfigMap", Version: "v1", Namespace: "ns", Name: "leftover"}},
+		},
+	}
+	healthByKey := map[kube.ResourceKey]*v1alpha1.HealthStatus{
+		kube.NewResourceKey("apps", "Deployment", "ns", "guestbook"): {Status: health.HealthStatusProgressing},
+		kube.NewResourceKey("", "Service", "ns", "guestbook"):        {Status: health.HealthStatusHealthy},
+	}
+
+	filterTreeByHealth(tree, healthByKey, []string{string(health.HealthStatusDegraded)})
+
+	require.Len(t, tree.Nodes, 3)
+	assert.Equal(t, "Deployment", tree.Nodes[0].Kind)
+	assert.Equal(t, "ReplicaSet", tree.Nodes[1].Kind)
+	assert.Equal(t, "Pod", tree.Nodes[2].Kind)
+	assert.Equal(t, []v1alpha1.ResourceRef{replicaSet}, tree.Nodes[2].ParentRefs)
+	assert.Empty(t, tree.OrphanedNodes)
+
+	filterTreeByHealth(tree, healthByKey, []string{string(health.HealthStatusProgressing)})
+
+	require.Len(t, tree.Nodes, 1)
+	assert.Equal(t, "Deployment", tree.Nodes[0].Kind)
+}
+
+func TestRollbackApp(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Status.History = []v1alpha1.RevisionHistory{{
+		ID:       1,
+		Revision: "abc",
+		Source:   *testApp.Spec.Source.DeepCopy(),
+	}}
+	appServer := newTestAppServer(t, testApp)
+
+	updatedApp, err := appServer.Rollback(t.Context(), &application.ApplicationRollbackRequest{
+		Name: &testApp.Name,
+		Id:   ptr.To(int64(1)),
+	})
+
+	require.NoError(t, err)
+
+	assert.NotNil(t, updatedApp.Operation)
+	assert.NotNil(t, updatedApp.Operation.Sync)
+	assert.NotNil(t, updatedApp.Operation.Sync.Source)
+	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
+}
+
+func TestUpdateAppProject(t *testing.T) {
+	testApp := newTestApp()
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+
+	t.Run("update without changing project", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`p, admin, applications, update, default/test-app, allow`)
+		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		require.NoError(t, err)
+	})
+
+	t.Run("cannot update to another project", func(t *testing.T) {
+		testApp.Spec.Project = "my-proj"
+		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("cannot change projects without create privileges", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, admin, applications, update, default/test-app, allow
+p, admin, applications, update, my-proj/test-app, allow
+`)
+		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		statusErr := grpc_util.UnwrapGRPCStatus(err)
+		assert.NotNil(t, statusErr)
+		assert.Equal(t, codes.PermissionDenied, statusErr.Code())
+	})
+
+	t.Run("cannot change projects without update privileges in new project", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, admin, applications, update, default/test-app, allow
+p, admin, applications, create, my-proj/test-app, allow
+`)
+		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("cannot change projects without update privileges in old project", func(t *testing.T) {
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, admin, applications, create, my-proj/test-app, allow
+p, admin, applications, update, my-proj/test-app, allow
+`)
+		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		statusErr := grpc_util.UnwrapGRPCStatus(err)
+		assert.NotNil(t, statusErr)
+		assert.Equal(t, codes.PermissionDenied, statusErr.Code())
+	})
+
+	t.Run("can update project with proper permissions", func(t *testing.T) {
+		// Verify can update project with proper permissions
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, admin, applications, update, default/test-app, allow
+p, admin, applications, create, my-proj/test-app, allow
+p, admin, applications, update, my-proj/test-app, allow
+`)
+		updatedApp, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		require.NoError(t, err)
+		assert.Equal(t, "my-proj", updatedApp.Spec.Project)
+	})
+}
+
+func TestAppJsonPatch(t *testing.T) {
+	testApp := newTestAppWithAnnotations()
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+
+	app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: ptr.To("garbage")})
+	require.Error(t, err)
+	assert.Nil(t, app)
+
+	app, err = appServer.Patch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: ptr.To("[]")})
+	require.NoError(t, err)
+	assert.NotNil(t, app)
+
+	app, err = appServer.Patch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: ptr.To(`[{"op": "replace", "path": "/spec/source/path", "value": "foo"}]`)})
+	require.NoError(t, err)
+	assert.Equal(t, "foo", app.Spec.Source.Path)
+
+	app, err = appServer.Patch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: ptr.To(`[{"op": "remove", "path": "/metadata/annotations/test.annotation"}]`)})
+	require.NoError(t, err)
+	assert.NotContains(t, app.Annotations, "test.annotation")
+}
+
+func TestAppMergePatch(t *testing.T) {
+	testApp := newTestApp()
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+
+	app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{
+		Name: &testApp.Name, Patch: ptr.To(`{"spec": { "source": { "path": "foo" } }}`), PatchType: ptr.To("merge"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "foo", app.Spec.Source.Path)
+}
+
+func TestAppPatchSchemaValidation(t *testing.T) {
+	testApp := newTestApp()
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+
+	_, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{
+		Name: &testApp.Name, Patch: ptr.To(`{"spec": { "project": 1 }}`), PatchType: ptr.To("merge"),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "spec.project")
+}
+
+func TestDryRunPatch(t *testing.T) {
+	testApp := newTestApp()
+	//nolint:staticcheck
+	ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+	_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/*, allow
+`)
+
+	t.Run("Valid", func(t *testing.T) {
+		res, err := appServer.DryRunPatch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: ptr.To(`{"spec": { "source": { "path": "foo" } }}`), PatchType: ptr.To("merge"),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.Conditions)
+		assert.Equal(t, "foo", res.Application.Spec.Source.Path)
+
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, testApp.Spec.Source.Path, app.Spec.Source.Path)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		res, err := appServer.DryRunPatch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: ptr.To(`{"spec": { "destination": { "server": "https://unknown.example.com" } }}`), PatchType: ptr.To("merge"),
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, res.Conditions[0].Type)
+		assert.Contains(t, res.Conditions[0].Message, "application destination spec")
+	})
+
+	t.Run("InvalidPatch", func(t *testing.T) {
+		_, err := appServer.DryRunPatch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: ptr.To("garbage")})
+		require.Error(t, err)
+	})
+}
+
+func TestValidatePatch(t *testing.T) {
+	testApp := newTestApp()
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+
+	t.Run("Valid", func(t *testing.T) {
+		res, err := appServer.ValidatePatch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: ptr.To(`{"spec": { "source": { "path": "foo" } }}`), PatchType: ptr.To("merge"),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.Errors)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		res, err := appServer.ValidatePatch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: ptr.To(`[{"op": "remove", "path": "/spec/destination"}, {"op": "replace", "path": "/spec/source/repoURL", "value": true}]`),
+		})
+		require.NoError(t, err)
+		var fields []string
+		for _, fieldErr := range res.Errors {
+			fields = append(fields, fieldErr.GetField())
+		}
+		assert.ElementsMatch(t, []string{"spec.destination", "spec.source.repoURL"}, fields)
+
+		app, err := appServer.Get(ctx, &application.ApplicationQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, testApp.Spec.Source.RepoURL, app.Spec.Source.RepoURL)
+	})
+}
+
+func TestResourceSyncDurations(t *testing.T) {
+	startedAt := time.Date(2024, 1, 2, 3, 4, 0, 0, time.UTC)
+	healthyAt := metav1.NewTime(startedAt.Add(90 * time.Second))
+	alreadyHealthyAt := metav1.NewTime(startedAt.Add(-time.Hour))
+	opState := &v1alpha1.OperationState{
+		StartedAt:  metav1.NewTime(startedAt),
+		FinishedAt: ptr.To(metav1.NewTime(startedAt.Add(10 * time.Second))),
+		SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{
+			{Group: "apps", Kind: "Deployment", Namespace: "ns", Name: "guestbook", Status: synccommon.ResultCodeSynced},
+			{Kind: "ConfigMap", Namespace: "ns", Name: "config", Status: synccommon.ResultCodeSynced},
+			{Kind: "Service", Namespace: "ns", Name: "guestbook", Status: synccommon.ResultCodeSynced},
+			{Group: "apps", Kind: "StatefulSet", Namespace: "ns", Name: "db", Status: synccommon.ResultCodeSynced},
+			{Group: "batch", Kind: "Job", Namespace: "ns", Name: "migrate", HookType: synccommon.HookTypePreSync},
+		}},
+	}
+	resources := []v1alpha1.ResourceStatus{
+		{Group: "apps", Kind: "Deployment", Namespace: "ns", Name: "guestbook", Health: &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy, LastTransitionTime: &healthyAt}},
+		{Kind: "ConfigMap", Namespace: "ns", Name: "config"},
+		{Kind: "Service", Namespace: "ns", Name: "guestbook", Health: &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy, LastTransitionTime: &alreadyHealthyAt}},
+		{Group: "apps", Kind: "StatefulSet", Namespace: "ns", Name: "db", Health: &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing}},
+	}
+
+	durations := resourceSyncDurations(opState, resources, startedAt.Add(5*time.Minute))
+
+	require.Len(t, durations, 4)
+	assert.Equal(t, "db", durations[0].GetName())
+	assert.True(t, durations[0].GetPending())
+	assert.Equal(t, int64(300), durations[0].GetDurationSeconds())
+	assert.Equal(t, string(health.HealthStatusProgressing), durations[0].GetHealthStatus())
+	assert.Equal(t, "Deployment", durations[1].GetKind())
+	assert.False(t, durations[1].GetPending())
+	assert.Equal(t, int64(90), durations[1].GetDurationSeconds())
+	assert.Equal(t, "ConfigMap", durations[2].GetKind())
+	assert.Equal(t, int64(10), durations[2].GetDurationSeconds())
+	assert.Empty(t, durations[2].GetHealthStatus())
+	assert.Equal(t, "Service", durations[3].GetKind())
+	assert.Equal(t, int64(0), durations[3].GetDurationSeconds())
+}
+
+func TestGetSyncDurations(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	res, err := appServer.GetSyncDurations(t.Context(), &application.ApplicationSyncDurationsQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Empty(t, res.Resources)
+
+	_, err = appServer.GetSyncDurations(t.Context(), &application.ApplicationSyncDurationsQuery{Name: ptr.To("does-not-exist")})
+	assert.Error(t, err)
+}
+
+func TestGetRBACName(t *testing.T) {
+	t.Run("ControlPlaneNamespace", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.GetRBACName(t.Context(), &application.ApplicationRBACNameQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, "default/test-app", res.GetRbacName())
+	})
+	t.Run("AppInAnyNamespace", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Namespace = "demo"
+		})
+		appServer := newTestAppServer(t, testApp)
+		appServer.enabledNamespaces = []string{"demo"}
+
+		res, err := appServer.GetRBACName(t.Context(), &application.ApplicationRBACNameQuery{Name: &testApp.Name, AppNamespace: ptr.To("demo")})
+		require.NoError(t, err)
+		assert.Equal(t, "default/demo/test-app", res.GetRbacName())
+	})
+}
+
+func TestPreviewProjectChange(t *testing.T) {
+	restrictedProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"https://github.com/other/*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "other"}},
+		},
+	}
+
+	t.Run("Permitted", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.PreviewProjectChange(t.Context(), &application.ApplicationProjectChangePreviewRequest{
+			Name:          &testApp.Name,
+			TargetProject: ptr.To("my-proj"),
+		})
+		require.NoError(t, err)
+		assert.True(t, res.GetPermitted())
+		assert.Empty(t, res.RejectedSources)
+		assert.Empty(t, res.Conditions)
+	})
+	t.Run("Rejected", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp, restrictedProj)
+
+		res, err := appServer.PreviewProjectChange(t.Context(), &application.ApplicationProjectChangePreviewRequest{
+			Name:          &testApp.Name,
+			TargetProject: ptr.To("restricted"),
+		})
+		require.NoError(t, err)
+		assert.False(t, res.GetPermitted())
+		assert.Equal(t, []string{testApp.Spec.Source.RepoURL}, res.RejectedSources)
+		assert.Len(t, res.Conditions, 2)
+
+		// the application itself is not modified
+		app, err := appServer.Get(t.Context(), &application.ApplicationQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, "default", app.Spec.Project)
+	})
+	t.Run("NoCreateInTargetProject", func(t *testing.T) {
+		ctx := t.Context()
+		//nolint:staticcheck
+		ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, update, default/*, allow
+`)
+
+		_, err := appServer.PreviewProjectChange(ctx, &application.ApplicationProjectChangePreviewRequest{
+			Name:          &testApp.Name,
+			TargetProject: ptr.To("my-proj"),
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestGetManifestsGenerationSettings(t *testing.T) {
+	t.Run("Excluded", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		manifests, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Empty(t, manifests.GenerationSettings)
+	})
+	t.Run("Included", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		manifests, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{
+			Name:                      &testApp.Name,
+			IncludeGenerationSettings: ptr.To(true),
+		})
+		require.NoError(t, err)
+		require.Len(t, manifests.GenerationSettings, 1)
+		settings := manifests.GenerationSettings[0]
+		assert.Equal(t, testApp.Spec.Source.RepoURL, settings.RepoURL)
+		assert.Equal(t, testApp.Spec.Source.RepoURL, settings.ApplicationSource.RepoURL)
+		assert.Equal(t, testApp.Spec.Destination.Namespace, settings.Namespace)
+	})
+}
+
+func TestGetManifestsClusterLabels(t *testing.T) {
+	t.Run("Override", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		manifests, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{
+			Name:                      &testApp.Name,
+			IncludeGenerationSettings: ptr.To(true),
+			ClusterLabels:             map[string]string{"topology.kubernetes.io/region": "eu-west-1"},
+		})
+		require.NoError(t, err)
+		require.Len(t, manifests.GenerationSettings, 1)
+		assert.Equal(t, "eu-west-1", manifests.GenerationSettings[0].ClusterLabels["topology.kubernetes.io/region"])
+	})
+	t.Run("InvalidKey", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{
+			Name:          &testApp.Name,
+			ClusterLabels: map[string]string{"invalid key!": "value"},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("InvalidValue", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{
+			Name:          &testApp.Name,
+			ClusterLabels: map[string]string{"env": "not a valid value"},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetManifestsOutputFormat(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	configMap := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"fake-dest-ns"},"data":{"b":"2","a":"1"}}`
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(func(_ context.Context, _ *apiclient.ManifestRequest, _ ...grpc.CallOption) *apiclient.ManifestResponse {
+		return &apiclient.ManifestResponse{Manifests: []string{
+			configMap,
+			`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"creds","namespace":"fake-dest-ns"},"data":{"password":"aHVudGVyMg=="}}`,
+		}}
+	}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+	t.Run("JSON", func(t *testing.T) {
+		manifests, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		require.Len(t, manifests.Manifests, 2)
+		assert.Equal(t, configMap, manifests.Manifests[0])
+	})
+	t.Run("YAML", func(t *testing.T) {
+		manifests, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name, OutputFormat: ptr.To("yaml")})
+		require.NoError(t, err)
+		require.Len(t, manifests.Manifests, 2)
+		assert.Contains(t, manifests.Manifests[0], "kind: ConfigMap\n")
+		obj := &unstructured.Unstructured{}
+		require.NoError(t, yaml.Unmarshal([]byte(manifests.Manifests[1]), obj))
+		assert.Equal(t, "Secret", obj.GetKind())
+		assert.NotContains(t, manifests.Manifests[1], "aHVudGVyMg==")
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name, OutputFormat: ptr.To("toml")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetManifestsMultipleSources(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		repoURL := app.Spec.Source.RepoURL
+		app.Spec.Source = nil
+		app.Spec.Sources = v1alpha1.ApplicationSources{
+			{RepoURL: repoURL, Path: "first"},
+			{RepoURL: repoURL, Path: "second"},
+			{RepoURL: repoURL, Path: "third"},
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+	forPath := func(path string) any {
+		return mock.MatchedBy(func(req *apiclient.ManifestRequest) bool {
+			return req.ApplicationSource.Path == path
+		})
+	}
+
+	t.Run("Ordered", func(t *testing.T) {
+		mockRepoServiceClient := mocks.RepoServerServiceClient{}
+		// the first source finishes last, its manifests must still come first
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, forPath("first")).After(50*time.Millisecond).Return(&apiclient.ManifestResponse{Manifests: []string{"first"}}, nil)
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, forPath("second")).Return(&apiclient.ManifestResponse{Manifests: []string{"second"}}, nil)
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, forPath("third")).Return(&apiclient.ManifestResponse{Manifests: []string{"third"}}, nil)
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+		manifestInfos, err := appServer.generateManifests(t.Context(), testApp, &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}}, &application.ApplicationManifestQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		require.Len(t, manifestInfos, 3)
+		assert.Equal(t, []string{"first"}, manifestInfos[0].Manifests)
+		assert.Equal(t, []string{"second"}, manifestInfos[1].Manifests)
+		assert.Equal(t, []string{"third"}, manifestInfos[2].Manifests)
+	})
+	t.Run("Error", func(t *testing.T) {
+		mockRepoServiceClient := mocks.RepoServerServiceClient{}
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, forPath("second")).Return(nil, stderrors.New("rendering failed"))
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{}, nil)
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+		_, err := appServer.generateManifests(t.Context(), testApp, &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}}, &application.ApplicationManifestQuery{Name: &testApp.Name})
+		require.ErrorContains(t, err, "error generating manifests for source 2 of 3: rendering failed")
+	})
+	t.Run("ClientDisconnected", func(t *testing.T) {
+		parallelism := manifestGenerationParallelism
+		manifestGenerationParallelism = 1
+		t.Cleanup(func() {
+			manifestGenerationParallelism = parallelism
+		})
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+		mockRepoServiceClient := mocks.RepoServerServiceClient{}
+		// the client disconnects while the manifests of the first source are generated
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, forPath("first")).Run(func(args mock.Arguments) {
+			cancel()
+			assert.ErrorIs(t, args.Get(0).(context.Context).Err(), context.Canceled)
+		}).Return(&apiclient.ManifestResponse{}, nil)
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{}, nil)
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+		_, err := appServer.generateManifests(ctx, testApp, &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}}, &application.ApplicationManifestQuery{Name: &testApp.Name})
+		require.ErrorIs(t, err, context.Canceled)
+		mockRepoServiceClient.AssertNumberOfCalls(t, "GenerateManifest", 1)
+	})
+}
+
+func TestGetManifestsWithLiveDiff(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{Manifests: []string{
+		`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"replicas":3}}`,
+		`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"creds","namespace":"fake-dest-ns"},"data":{"password":"bmV3"}}`,
+		`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"fake-dest-ns"}}`,
+	}}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "guestbook", LiveState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"fake-dest-ns"},"spec":{"replicas":1}}`},
+		{Kind: "Secret", Namespace: test.FakeDestNamespace, Name: "creds", LiveState: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"creds","namespace":"fake-dest-ns"},"data":{"password":"b2xk"}}`},
+	})
+	require.NoError(t, err)
+
+	t.Run("Excluded", func(t *testing.T) {
+		manifests, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Empty(t, manifests.LiveDiffs)
+	})
+	t.Run("Included", func(t *testing.T) {
+		manifests, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name, WithLiveDiff: ptr.To(true)})
+		require.NoError(t, err)
+		require.Len(t, manifests.LiveDiffs, len(manifests.Manifests))
+
+		deployment := manifests.LiveDiffs[0]
+		assert.Equal(t, "Deployment", deployment.Kind)
+		assert.Equal(t, test.FakeDestNamespace, deployment.Namespace)
+		assert.True(t, deployment.Modified)
+		assert.Contains(t, deployment.NormalizedLiveState, `"replicas":1`)
+		assert.Contains(t, deployment.PredictedLiveState, `"replicas":3`)
+
+		secret := manifests.LiveDiffs[1]
+		assert.True(t, secret.Modified)
+		assert.NotContains(t, secret.NormalizedLiveState, "b2xk")
+		assert.NotContains(t, secret.PredictedLiveState, "bmV3")
+
+		configMap := manifests.LiveDiffs[2]
+		assert.Equal(t, "ConfigMap", configMap.Kind)
+		assert.Empty(t, configMap.NormalizedLiveState)
+		assert.NotEmpty(t, configMap.PredictedLiveState)
+	})
+	t.Run("IgnoreDifferences", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.IgnoreDifferences = []v1alpha1.ResourceIgnoreDifferences{{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}}}
+		})
+		appServer := newTestAppServer(t, testApp)
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+
+		manifests, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{Name: &testApp.Name, WithLiveDiff: ptr.To(true)})
+		require.NoError(t, err)
+		require.Len(t, manifests.LiveDiffs, len(manifests.Manifests))
+
+		assert.False(t, manifests.LiveDiffs[0].Modified)
+	})
+}
+
+func TestRollbackPreview(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.History = v1alpha1.RevisionHistories{{
+			ID:       1,
+			Revision: "abc123",
+			Source:   *app.Spec.Source.DeepCopy(),
+		}}
+	})
+	appServer := newTestAppServer(t, testApp)
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.MatchedBy(func(req *apiclient.ManifestRequest) bool {
+		return req.Revision == "abc123"
+	})).Return(&apiclient.ManifestResponse{Manifests: []string{
+		`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook"},"spec":{"replicas":1}}`,
+		`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"creds","namespace":"fake-dest-ns"},"data":{"password":"b2xk"}}`,
+	}}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "guestbook", LiveState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"fake-dest-ns"},"spec":{"replicas":3}}`},
+		{Kind: "Secret", Namespace: test.FakeDestNamespace, Name: "creds", LiveState: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"creds","namespace":"fake-dest-ns"},"data":{"password":"bmV3"}}`},
+	})
+	require.NoError(t, err)
+
+	res, err := appServer.Rollback(t.Context(), &application.ApplicationRollbackRequest{Name: &testApp.Name, Id: ptr.To(int64(1)), Preview: ptr.To(true)})
+	require.NoError(t, err)
+	assert.Equal(t, testApp.Name, res.Name)
+	assert.Nil(t, res.Operation)
+	require.Len(t, res.PreviewDiffs, 2)
+	assert.True(t, res.PreviewDiffs[0].Modified)
+	assert.Contains(t, res.PreviewDiffs[0].NormalizedLiveState, `"replicas":3`)
+	assert.Contains(t, res.PreviewDiffs[0].PredictedLiveState, `"replicas":1`)
+	assert.True(t, res.PreviewDiffs[1].Modified)
+	assert.NotContains(t, res.PreviewDiffs[1].NormalizedLiveState, "bmV3")
+	assert.NotContains(t, res.PreviewDiffs[1].PredictedLiveState, "b2xk")
+
+	// no operation is initiated
+	app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+
+	_, err = appServer.Rollback(t.Context(), &application.ApplicationRollbackRequest{Name: &testApp.Name, Id: ptr.To(int64(2)), Preview: ptr.To(true)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	t.Run("SyncNotPermitted", func(t *testing.T) {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/*, allow
+`)
+
+		_, err := appServer.Rollback(ctx, &application.ApplicationRollbackRequest{Name: &testApp.Name, Id: ptr.To(int64(1)), Preview: ptr.To(true)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestRedactionRules(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	argoCM := map[string]string{"resource.redactionRules": "- kind: ConfigMap\n  keys: ['*password*', token]\n"}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, argoCM)
+
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "config", "namespace": "default"},
+		"data":       map[string]any{"db.password": "hunter2", "token": "abc", "url": "https://example.com"},
+		"binaryData": map[string]any{"token": "YWJj"},
+	}}
+
+	t.Run("GetResource", func(t *testing.T) {
+		obj, err := appServer.replaceSecretValues(configMap)
+		require.NoError(t, err)
+		data, _, _ := unstructured.NestedStringMap(obj.Object, "data")
+		assert.Equal(t, map[string]string{"db.password": redactedValue, "token": redactedValue, "url": "https://example.com"}, data)
+		binaryData, _, _ := unstructured.NestedStringMap(obj.Object, "binaryData")
+		assert.Equal(t, map[string]string{"token": redactedValue}, binaryData)
+		// the original object must not be modified
+		assert.Equal(t, "hunter2", configMap.Object["data"].(map[string]any)["db.password"])
+	})
+	t.Run("Manifest", func(t *testing.T) {
+		rules, err := appServer.settingsMgr.GetResourceRedactionRules()
+		require.NoError(t, err)
+		data, err := configMap.MarshalJSON()
+		require.NoError(t, err)
+		manifest, err := appServer.redactManifest(string(data), rules)
+		require.NoError(t, err)
+		assert.NotContains(t, manifest, "hunter2")
+		assert.Contains(t, manifest, "https://example.com")
+	})
+	t.Run("UnmatchedKind", func(t *testing.T) {
+		deployment := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "app", "namespace": "default"},
+			"data":       map[string]any{"token": "abc"},
+		}}
+		obj, err := appServer.replaceSecretValues(deployment)
+		require.NoError(t, err)
+		assert.Equal(t, "abc", obj.Object["data"].(map[string]any)["token"])
+	})
+	t.Run("LastAppliedConfiguration", func(t *testing.T) {
+		lastApplied, err := configMap.MarshalJSON()
+		require.NoError(t, err)
+		withAnnotation := configMap.DeepCopy()
+		withAnnotation.SetAnnotations(map[string]string{corev1.LastAppliedConfigAnnotation: string(lastApplied)})
+
+		obj, err := appServer.replaceSecretValues(withAnnotation)
+		require.NoError(t, err)
+		annotation := obj.GetAnnotations()[corev1.LastAppliedConfigAnnotation]
+		assert.NotContains(t, annotation, "hunter2")
+		assert.NotContains(t, annotation, `"abc"`)
+		assert.Contains(t, annotation, "https://example.com")
+		// the original object must not be modified
+		assert.Equal(t, string(lastApplied), withAnnotation.GetAnnotations()[corev1.LastAppliedConfigAnnotation])
+
+		withAnnotation.SetAnnotations(map[string]string{corev1.LastAppliedConfigAnnotation: "{"})
+		obj, err = appServer.replaceSecretValues(withAnnotation)
+		require.NoError(t, err)
+		assert.Equal(t, redactedValue, obj.GetAnnotations()[corev1.LastAppliedConfigAnnotation])
+	})
+}
+
+func TestManifestGenerationSettings(t *testing.T) {
+	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git", Username: "user", Password: "secret"}
+	req := &apiclient.ManifestRequest{
+		Repo:              repo,
+		Repos:             []*v1alpha1.Repository{repo},
+		HelmRepoCreds:     []*v1alpha1.RepoCreds{{URL: "https://charts.example.com", Password: "secret"}},
+		Revision:          "HEAD",
+		ApplicationSource: &v1alpha1.ApplicationSource{RepoURL: repo.Repo, Path: "guestbook"},
+		Namespace:         "default",
+		TrackingMethod:    "annotation",
+		ClusterLabels:     map[string]string{"env": "prod"},
+	}
+
+	settings := manifestGenerationSettings(req)
+
+	assert.Equal(t, repo.Repo, settings.RepoURL)
+	assert.Equal(t, "HEAD", settings.Revision)
+	assert.Equal(t, "guestbook", settings.ApplicationSource.Path)
+	assert.Equal(t, "default", settings.Namespace)
+	assert.Equal(t, "annotation", settings.TrackingMethod)
+	assert.Equal(t, map[string]string{"env": "prod"}, settings.ClusterLabels)
+	data, err := settings.Marshal()
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+}
+
+func TestStreamManagedResources(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook"},
+		{Kind: "Service", Namespace: testNamespace, Name: "guestbook"},
+		{Group: "batch", Kind: "Job", Namespace: testNamespace, Name: "pre-sync", Hook: true},
+	})
+	require.NoError(t, err)
+
+	t.Run("All", func(t *testing.T) {
+		stream := &TestManagedResourcesServer{ctx: t.Context()}
+		err := appServer.StreamManagedResources(&application.ResourcesQuery{ApplicationName: &testApp.Name}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.items, 2)
+		assert.Equal(t, "Deployment", stream.items[0].Kind)
+		assert.Equal(t, "Service", stream.items[1].Kind)
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		stream := &TestManagedResourcesServer{ctx: t.Context()}
+		err := appServer.StreamManagedResources(&application.ResourcesQuery{ApplicationName: &testApp.Name, Kind: ptr.To("Service")}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.items, 1)
+		assert.Equal(t, "Service", stream.items[0].Kind)
+	})
+}
+
+func TestManagedResourcesIncludeHooks(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook"},
+		{Group: "batch", Kind: "Job", Namespace: testNamespace, Name: "migrate", Hook: true, TargetState: `{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"migrate","annotations":{"argocd.argoproj.io/hook":"PreSync,PostSync"}}}`},
+	})
+	require.NoError(t, err)
+
+	t.Run("Excluded", func(t *testing.T) {
+		res, err := appServer.ManagedResources(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Empty(t, res.Hooks)
+	})
+	t.Run("Included", func(t *testing.T) {
+		res, err := appServer.ManagedResources(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name, IncludeHooks: ptr.To(true)})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.True(t, res.Items[1].Hook)
+		require.Len(t, res.Hooks, 1)
+		assert.Equal(t, "migrate", res.Hooks[0].GetName())
+		assert.ElementsMatch(t, []string{"PreSync", "PostSync"}, res.Hooks[0].Types)
+	})
+}
+
+func TestManagedResourcesOutOfSyncOnly(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "in-sync", LiveState: "{}", TargetState: "{}", NormalizedLiveState: `{"a":1,"b":2}`, PredictedLiveState: `{"b":2,"a":1}`},
+		{Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "modified", LiveState: "{}", TargetState: "{}", Modified: true},
+		{Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "differs", LiveState: "{}", TargetState: "{}", NormalizedLiveState: `{"replicas":1}`, PredictedLiveState: `{"replicas":3}`},
+		{Kind: "Service", Namespace: testNamespace, Name: "missing", LiveState: "null", TargetState: "{}"},
+		{Kind: "ConfigMap", Namespace: testNamespace, Name: "extraneous", LiveState: "{}", TargetState: "null"},
+	})
+	require.NoError(t, err)
+
+	res, err := appServer.ManagedResources(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name, OutOfSyncOnly: ptr.To(true)})
+	require.NoError(t, err)
+	var names []string
+	for _, item := range res.Items {
+		names = append(names, item.Name)
+	}
+	assert.Equal(t, []string{"modified", "differs", "missing", "extraneous"}, names)
+
+	res, err = appServer.ManagedResources(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	assert.Len(t, res.Items, 5)
+}
+
+func TestGetStableHealth(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.Health = v1alpha1.AppHealthStatus{
+			Status:             health.HealthStatusDegraded,
+			LastTransitionTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+		}
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook", Health: &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded}},
+			{Version: "v1", Kind: "Service", Namespace: testNamespace, Name: "guestbook", Health: &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy}},
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+
+	t.Run("WithinGracePeriod", func(t *testing.T) {
+		res, err := appServer.GetStableHealth(t.Context(), &application.ApplicationStableHealthQuery{Name: &testApp.Name, GracePeriodSeconds: ptr.To(int64(300))})
+		require.NoError(t, err)
+		assert.Equal(t, string(health.HealthStatusProgressing), res.GetStatus())
+		assert.Equal(t, string(health.HealthStatusDegraded), res.GetObservedStatus())
+		assert.True(t, res.GetInGracePeriod())
+		require.Len(t, res.DegradedResources, 1)
+		assert.Equal(t, "Deployment", res.DegradedResources[0].Kind)
+	})
+	t.Run("GracePeriodExceeded", func(t *testing.T) {
+		res, err := appServer.GetStableHealth(t.Context(), &application.ApplicationStableHealthQuery{Name: &testApp.Name, GracePeriodSeconds: ptr.To(int64(30))})
+		require.NoError(t, err)
+		assert.Equal(t, string(health.HealthStatusDegraded), res.GetStatus())
+		assert.False(t, res.GetInGracePeriod())
+	})
+	t.Run("NegativeGracePeriod", func(t *testing.T) {
+		_, err := appServer.GetStableHealth(t.Context(), &application.ApplicationStableHealthQuery{Name: &testApp.Name, GracePeriodSeconds: ptr.To(int64(-1))})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetProgressingReason(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.Health = v1alpha1.AppHealthStatus{Status: health.HealthStatusProgressing}
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook", Health: &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for rollout to finish"}},
+			{Version: "v1", Kind: "Service", Namespace: testNamespace, Name: "guestbook", Health: &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy}},
+			{Version: "v1", Kind: "ConfigMap", Namespace: testNamespace, Name: "settings", Health: &v1alpha1.HealthStatus{Status: health.HealthStatusMissing}},
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	deploymentRef := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook"}
+	err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{ResourceRef: deploymentRef},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Service", Namespace: testNamespace, Name: "guestbook"}},
+		{
+			ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "guestbook-1-a"},
+			ParentRefs:  []v1alpha1.ResourceRef{deploymentRef},
+			Health:      &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded, Message: "CrashLoopBackOff"},
+		},
+	}})
+	require.NoError(t, err)
+
+	res, err := appServer.GetProgressingReason(t.Context(), &application.ApplicationProgressingReasonQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, string(health.HealthStatusProgressing), res.GetStatus())
+	require.Len(t, res.Resources, 3)
+	assert.Equal(t, "Pod", res.Resources[0].GetKind())
+	assert.Equal(t, "CrashLoopBackOff", res.Resources[0].GetMessage())
+	assert.Equal(t, []*v1alpha1.ResourceRef{&deploymentRef}, res.Resources[0].ParentRefs)
+	assert.Equal(t, "ConfigMap", res.Resources[1].GetKind())
+	assert.Equal(t, string(health.HealthStatusMissing), res.Resources[1].GetStatus())
+	assert.Equal(t, "Deployment", res.Resources[2].GetKind())
+	assert.Equal(t, "Waiting for rollout to finish", res.Resources[2].GetMessage())
+	assert.True(t, res.Resources[2].GetMatchesAppHealth())
+}
+
+func TestGetNextReconcileTime(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	reconciledAt := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	reconciledApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "reconciled"
+		app.Status.ReconciledAt = &reconciledAt
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}}
+	})
+	refreshedApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "refreshed"
+		app.Status.ReconciledAt = &reconciledAt
+		app.SetAnnotations(map[string]string{v1alpha1.AnnotationKeyRefresh: string(v1alpha1.RefreshTypeNormal)})
+	})
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, map[string]string{
+		"timeout.reconciliation":        "3m",
+		"timeout.reconciliation.jitter": "30s",
+	}, reconciledApp, refreshedApp)
+
+	res, err := appServer.GetNextReconcileTime(t.Context(), &application.ApplicationNextReconcileTimeQuery{Name: ptr.To("reconciled")})
+	require.NoError(t, err)
+	assert.Equal(t, int64(180), res.GetReconciliationTimeoutSeconds())
+	assert.Equal(t, int64(30), res.GetReconciliationJitterSeconds())
+	assert.True(t, res.GetAutoSyncEnabled())
+	assert.False(t, res.GetRefreshRequested())
+	require.NotNil(t, res.Earliest)
+	assert.Equal(t, reconciledAt.Add(3*time.Minute), res.Earliest.Time)
+	assert.Equal(t, reconciledAt.Add(3*time.Minute+30*time.Second), res.Latest.Time)
+
+	res, err = appServer.GetNextReconcileTime(t.Context(), &application.ApplicationNextReconcileTimeQuery{Name: ptr.To("refreshed")})
+	require.NoError(t, err)
+	assert.True(t, res.GetRefreshRequested())
+	assert.False(t, res.GetAutoSyncEnabled())
+	require.NotNil(t, res.Earliest)
+	assert.False(t, res.Earliest.Before(&reconciledAt))
+}
+
+func TestGetLastOperationError(t *testing.T) {
+	finishedAt := metav1.Now()
+	failedApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "failed"
+		app.Status.OperationState = &v1alpha1.OperationState{
+			Phase:      synccommon.OperationFailed,
+			Message:    "one or more objects failed to apply",
+			FinishedAt: &finishedAt,
+			SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{
+				{Kind: "ConfigMap", Namespace: testNamespace, Name: "config", Status: synccommon.ResultCodeSynced},
+				{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook", Status: synccommon.ResultCodeSyncFailed, Message: "field is immutable"},
+				{Kind: "Service", Namespace: testNamespace, Name: "guestbook", Status: synccommon.ResultCodeSyncFailed, Message: "invalid port"},
+			}},
+		}
+	})
+	succeededApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "succeeded"
+		app.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded}
+	})
+	appServer := newTestAppServer(t, failedApp, succeededApp)
+
+	res, err := appServer.GetLastOperationError(t.Context(), &application.ApplicationLastOperationErrorQuery{Name: ptr.To("failed")})
+	require.NoError(t, err)
+	assert.True(t, res.GetFailed())
+	assert.Equal(t, "Failed", res.GetPhase())
+	assert.Equal(t, "one or more objects failed to apply", res.GetMessage())
+	require.NotNil(t, res.FailedResource)
+	assert.Equal(t, "Deployment", res.FailedResource.GetKind())
+	assert.Equal(t, "guestbook", res.FailedResource.GetName())
+	assert.Equal(t, "field is immutable", res.FailedResource.GetMessage())
+
+	res, err = appServer.GetLastOperationError(t.Context(), &application.ApplicationLastOperationErrorQuery{Name: ptr.To("succeeded")})
+	require.NoError(t, err)
+	assert.False(t, res.GetFailed())
+	assert.Nil(t, res.FailedResource)
+}
+
+func TestListNotificationSubscriptions(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "proj-notified",
+			Namespace:   "default",
+			Annotations: map[string]string{"notifications.argoproj.io/subscribe.on-health-degraded.pagerduty": "platform-oncall"},
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = "proj-notified"
+		app.Annotations = map[string]string{
+			"notifications.argoproj.io/subscribe.on-sync-failed.slack": "team-a; team-b;",
+			"notifications.argoproj.io/subscribe.email":                "ops@example.com",
+			"notifications.argoproj.io/subscriptions": `- trigger: [on-sync-succeeded]
+  destinations:
+  - service: slack
+    recipients: [team-c]`,
+			"unrelated": "value",
+		}
+	})
+	appServer := newTestAppServer(t, proj, testApp)
+
+	res, err := appServer.ListNotificationSubscriptions(t.Context(), &application.ApplicationNotificationSubscriptionsQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 4)
+
+	assert.Empty(t, res.Items[0].GetTrigger())
+	assert.Equal(t, "email", res.Items[0].GetService())
+	assert.Equal(t, []string{"ops@example.com"}, res.Items[0].Recipients)
+	assert.Equal(t, "Application", res.Items[0].GetSource())
+
+	assert.Equal(t, "on-sync-failed", res.Items[1].GetTrigger())
+	assert.Equal(t, "slack", res.Items[1].GetService())
+	assert.Equal(t, []string{"team-a", "team-b"}, res.Items[1].Recipients)
+	assert.Equal(t, "notifications.argoproj.io/subscribe.on-sync-failed.slack", res.Items[1].GetAnnotation())
+
+	assert.Equal(t, "on-sync-succeeded", res.Items[2].GetTrigger())
+	assert.Equal(t, "slack", res.Items[2].GetService())
+	assert.Equal(t, []string{"team-c"}, res.Items[2].Recipients)
+	assert.Equal(t, "notifications.argoproj.io/subscriptions", res.Items[2].GetAnnotation())
+
+	assert.Equal(t, "on-health-degraded", res.Items[3].GetTrigger())
+	assert.Equal(t, "pagerduty", res.Items[3].GetService())
+	assert.Equal(t, "AppProject", res.Items[3].GetSource())
+}
+
+func TestGetStatusTransitions(t *testing.T) {
+	now := time.Now()
+	at := func(minutesAgo int) metav1.Time {
+		return metav1.NewTime(now.Add(-time.Duration(minutesAgo) * time.Minute).Truncate(time.Second))
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.History = v1alpha1.RevisionHistories{
+			{ID: 1, Revision: "aaa", DeployedAt: at(60)},
+			{ID: 2, Revision: "bbb", DeployedAt: at(30)},
+		}
+		app.Status.OperationState = &v1alpha1.OperationState{
+			Phase:      synccommon.OperationFailed,
+			Message:    "one or more objects failed to apply",
+			StartedAt:  at(10),
+			FinishedAt: ptr.To(at(9)),
+			SyncResult: &v1alpha1.SyncOperationResult{Revision: "ccc"},
+		}
+		app.Status.Health = v1alpha1.AppHealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: ptr.To(at(8))}
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{
+			{Type: v1alpha1.ApplicationConditionSyncError, Message: "failed to sync", LastTransitionTime: ptr.To(at(5))},
+			{Type: v1alpha1.ApplicationConditionComparisonError, Message: "without time"},
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+
+	t.Run("All", func(t *testing.T) {
+		res, err := appServer.GetStatusTransitions(t.Context(), &application.ApplicationStatusTransitionsQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		var transitions []string
+		for _, item := range res.Items {
+			transitions = append(transitions, item.GetType()+"/"+item.GetStatus())
+		}
+		assert.Equal(t, []string{
+			"Sync/Synced",
+			"Sync/Synced",
+			"Operation/Running",
+			"Operation/Failed",
+			"Health/Degraded",
+			"Condition/SyncError",
+		}, transitions)
+		assert.Equal(t, "bbb", res.Items[1].GetRevision())
+		assert.Equal(t, "ccc", res.Items[3].GetRevision())
+		assert.Equal(t, "one or more objects failed to apply", res.Items[3].GetMessage())
+	})
+	t.Run("Limit", func(t *testing.T) {
+		res, err := appServer.GetStatusTransitions(t.Context(), &application.ApplicationStatusTransitionsQuery{Name: &testApp.Name, Limit: ptr.To(int64(2))})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, "Health", res.Items[0].GetType())
+		assert.Equal(t, "Condition", res.Items[1].GetType())
+	})
+}
+
+func TestGetDestinationInfo(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	res, err := appServer.GetDestinationInfo(t.Context(), &application.ApplicationDestinationInfoQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, "https://cluster-api.example.com", res.GetServer())
+	assert.Equal(t, "fake-cluster", res.GetName())
+	assert.Equal(t, test.FakeDestNamespace, res.GetNamespace())
+
+	_, err = appServer.GetDestinationInfo(t.Context(), &application.ApplicationDestinationInfoQuery{Name: ptr.To("unknown")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetFieldManager(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	testApp := newTestApp()
+	ssaApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "ssa-app"
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"ServerSideApply=true"}}
+	})
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, map[string]string{"installationID": "prod-east"}, testApp, ssaApp)
+
+	res, err := appServer.GetFieldManager(t.Context(), &application.ApplicationFieldManagerQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, common.ArgoCDSSAManager, res.GetFieldManager())
+	assert.Equal(t, "kubectl-client-side-apply", res.GetClientSideApplyManager())
+	assert.False(t, res.GetServerSideApply())
+	assert.Equal(t, "prod-east", res.GetInstallationID())
+
+	res, err = appServer.GetFieldManager(t.Context(), &application.ApplicationFieldManagerQuery{Name: &ssaApp.Name})
+	require.NoError(t, err)
+	assert.True(t, res.GetServerSideApply())
+
+	_, err = appServer.GetFieldManager(t.Context(), &application.ApplicationFieldManagerQuery{Name: ptr.To("unknown")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetDeployedRevisionAuthor(t *testing.T) {
+	t.Run("NotSynced", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.GetDeployedRevisionAuthor(t.Context(), &application.DeployedRevisionAuthorQuery{Name: &testApp.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("LastDeployedRevision", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Status.History = v1alpha1.RevisionHistories{
+				{ID: 1, Revision: "abc", Source: *app.Spec.Source.DeepCopy()},
+				{ID: 2, Revision: "def", Source: *app.Spec.Source.DeepCopy(), InitiatedBy: v1alpha1.OperationInitiator{Username: "alice"}},
+			}
+		})
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.GetDeployedRevisionAuthor(t.Context(), &application.DeployedRevisionAuthorQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, "def", res.GetRevision())
+		assert.Equal(t, "alice", res.GetDeployedBy())
+	})
+	t.Run("SourceIndexOutOfRange", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Status.History = v1alpha1.RevisionHistories{{ID: 1, Revision: "abc", Source: *app.Spec.Source.DeepCopy()}}
+		})
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.GetDeployedRevisionAuthor(t.Context(), &application.DeployedRevisionAuthorQuery{Name: &testApp.Name, SourceIndex: ptr.To(int32(1))})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("NegativeSourceIndex", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Status.History = v1alpha1.RevisionHistories{{ID: 1, Revision: "abc", Source: *app.Spec.Source.DeepCopy()}}
+		})
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.GetDeployedRevisionAuthor(t.Context(), &application.DeployedRevisionAuthorQuery{Name: &testApp.Name, SourceIndex: ptr.To(int32(-1))})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestVerifyDeployedRevisionSignature(t *testing.T) {
+	signedProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-signed", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:   []string{"*"},
+			Destinations:  []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SignatureKeys: []v1alpha1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}},
+		},
+	}
+	t.Run("NotEnforced", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.VerifyDeployedRevisionSignature(t.Context(), &application.DeployedRevisionSignatureQuery{Name: &testApp.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("NotSynced", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Project = "proj-signed"
+		})
+		appServer := newTestAppServer(t, signedProj, testApp)
+
+		_, err := appServer.VerifyDeployedRevisionSignature(t.Context(), &application.DeployedRevisionSignatureQuery{Name: &testApp.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("Unverified", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Project = "proj-signed"
+			app.Status.History = v1alpha1.RevisionHistories{{ID: 1, Revision: "abc", Source: *app.Spec.Source.DeepCopy()}}
+		})
+		appServer := newTestAppServer(t, signedProj, testApp)
+
+		res, err := appServer.VerifyDeployedRevisionSignature(t.Context(), &application.DeployedRevisionSignatureQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, "abc", res.GetRevision())
+		assert.Equal(t, "Unknown", res.GetResult())
+		assert.False(t, res.GetVerified())
+	})
+	t.Run("SourceIndexOutOfRange", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Project = "proj-signed"
+			source := *app.Spec.Source.DeepCopy()
+			// the history entry records fewer revisions than sources
+			app.Status.History = v1alpha1.RevisionHistories{{ID: 1, Revisions: []string{"abc"}, Sources: v1alpha1.ApplicationSources{source, source}}}
+		})
+		appServer := newTestAppServer(t, signedProj, testApp)
+
+		for _, sourceIndex := range []int32{-1, 1, 2} {
+			_, err := appServer.VerifyDeployedRevisionSignature(t.Context(), &application.DeployedRevisionSignatureQuery{Name: &testApp.Name, SourceIndex: ptr.To(sourceIndex)})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), "source index %d", sourceIndex)
+		}
+	})
+}
+
+func TestGetResolvedSourceParameters(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		repoURL := app.Spec.Source.RepoURL
+		app.Spec.Source = nil
+		app.Spec.Sources = v1alpha1.ApplicationSources{
+			{RepoURL: repoURL, Ref: "values"},
+			{RepoURL: repoURL, Path: "chart", Helm: &v1alpha1.ApplicationSourceHelm{
+				ValueFiles: []string{"$values/envs/prod.yaml", "values.yaml"},
+				Parameters: []v1alpha1.HelmParameter{{Name: "replicas", Value: "5"}},
+			}},
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+
+	var detailsQuery *apiclient.RepoServerAppDetailsQuery
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GetAppDetails", mock.Anything, mock.MatchedBy(func(q *apiclient.RepoServerAppDetailsQuery) bool {
+		detailsQuery = q
+		return true
+	})).Return(&apiclient.RepoAppDetailsResponse{Type: "Helm", Helm: &apiclient.HelmAppSpec{Parameters: []*v1alpha1.HelmParameter{
+		{Name: "replicas", Value: "3"},
+		{Name: "image.tag", Value: "v2"},
+	}}}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+	res, err := appServer.GetResolvedSourceParameters(t.Context(), &application.ApplicationResolvedSourceParametersQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.NotNil(t, detailsQuery)
+	assert.Contains(t, detailsQuery.RefSources, "$values")
+
+	require.Len(t, res.Sources, 1)
+	source := res.Sources[0]
+	assert.Equal(t, int32(1), source.GetSourceIndex())
+	assert.Equal(t, "Helm", source.GetType())
+	assert.Equal(t, []string{"$values"}, source.RefSources)
+	require.Len(t, source.Parameters, 2)
+	assert.Equal(t, "image.tag", source.Parameters[0].GetName())
+	assert.Equal(t, "values", source.Parameters[0].GetOrigin())
+	assert.Equal(t, "replicas", source.Parameters[1].GetName())
+	assert.Equal(t, "5", source.Parameters[1].GetValue())
+	assert.Equal(t, "parameter", source.Parameters[1].GetOrigin())
+}
+
+func TestGetKustomizeDetails(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		repoURL := app.Spec.Source.RepoURL
+		app.Spec.Source = nil
+		app.Spec.Sources = v1alpha1.ApplicationSources{
+			{RepoURL: repoURL, Path: "overlays/prod", Kustomize: &v1alpha1.ApplicationSourceKustomize{
+				Version:    "v5.0.0",
+				NamePrefix: "prod-",
+				Images:     v1alpha1.KustomizeImages{"nginx=nginx:1.25"},
+			}},
+			{RepoURL: repoURL, Path: "chart"},
+		}
+	})
+	argoCM := map[string]string{
+		"kustomize.buildOptions":        "--enable-helm",
+		"kustomize.path.v5.0.0":         "/custom-tools/kustomize_5",
+		"kustomize.buildOptions.v5.0.0": "--load-restrictor LoadRestrictionsNone",
+	}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, argoCM, testApp)
+
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GetAppDetails", mock.Anything, mock.MatchedBy(func(q *apiclient.RepoServerAppDetailsQuery) bool {
+		return q.Source.Path == "overlays/prod"
+	})).Return(&apiclient.RepoAppDetailsResponse{Type: "Kustomize", Kustomize: &apiclient.KustomizeAppSpec{Images: []string{"nginx:1.25"}}}, nil)
+	mockRepoServiceClient.On("GetAppDetails", mock.Anything, mock.Anything).Return(&apiclient.RepoAppDetailsResponse{Type: "Helm", Helm: &apiclient.HelmAppSpec{}}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+	res, err := appServer.GetKustomizeDetails(t.Context(), &application.ApplicationKustomizeDetailsQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Sources, 1)
+	source := res.Sources[0]
+	assert.Equal(t, int32(0), source.GetSourceIndex())
+	assert.Equal(t, "v5.0.0", source.GetVersion())
+	assert.Equal(t, "/custom-tools/kustomize_5", source.GetBinaryPath())
+	assert.Equal(t, "--enable-helm", source.GetBuildOptions())
+	assert.Equal(t, test.FakeDestNamespace, source.GetNamespace())
+	assert.Equal(t, "prod-", source.GetNamePrefix())
+	assert.Equal(t, []string{"nginx=nginx:1.25"}, source.ImageOverrides)
+	assert.Equal(t, []string{"nginx:1.25"}, source.Images)
+	assert.Empty(t, source.GetError())
+}
+
+func TestListSourceRevisionHistory(t *testing.T) {
+	sha := "a4c96e0cd6cc7b1e8e6d4a7ba3e2d5c5e2a5f8e1"
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		source := *app.Spec.Source.DeepCopy()
+		chart := v1alpha1.ApplicationSource{RepoURL: "https://charts.example.com", Chart: "redis", Name: "cache"}
+		app.Status.History = v1alpha1.RevisionHistories{
+			{ID: 1, Revision: sha, Source: source},
+			{ID: 2, Sources: v1alpha1.ApplicationSources{source, chart}, Revisions: []string{sha, "17.3.2"}},
+			{ID: 3, Sources: v1alpha1.ApplicationSources{source, chart}, Revisions: []string{"v1.2.0", "17.4.0"}, InitiatedBy: v1alpha1.OperationInitiator{Automated: true}},
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+
+	t.Run("All", func(t *testing.T) {
+		res, err := appServer.ListSourceRevisionHistory(t.Context(), &application.ApplicationSourceRevisionHistoryQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), res.GetTotal())
+		require.Len(t, res.Items, 3)
+		assert.Equal(t, int64(3), res.Items[0].GetId())
+		assert.True(t, res.Items[0].InitiatedBy.Automated)
+
+		single := res.Items[2]
+		require.Len(t, single.Sources, 1)
+		assert.Equal(t, sha, single.Sources[0].GetRevision())
+		assert.Equal(t, sha[:7], single.Sources[0].GetDisplayRevision())
+
+		multi := res.Items[1]
+		require.Len(t, multi.Sources, 2)
+		assert.Equal(t, int32(1), multi.Sources[1].GetSourceIndex())
+		assert.Equal(t, "cache", multi.Sources[1].GetSourceName())
+		assert.Equal(t, "redis", multi.Sources[1].GetChart())
+		assert.Equal(t, "17.3.2", multi.Sources[1].GetDisplayRevision())
+	})
+	t.Run("Paginated", func(t *testing.T) {
+		res, err := appServer.ListSourceRevisionHistory(t.Context(), &application.ApplicationSourceRevisionHistoryQuery{Name: &testApp.Name, Offset: ptr.To(int64(1)), Limit: ptr.To(int64(1))})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), res.GetTotal())
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, int64(2), res.Items[0].GetId())
+
+		res, err = appServer.ListSourceRevisionHistory(t.Context(), &application.ApplicationSourceRevisionHistoryQuery{Name: &testApp.Name, Offset: ptr.To(int64(5))})
+		require.NoError(t, err)
+		assert.Empty(t, res.Items)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := appServer.ListSourceRevisionHistory(t.Context(), &application.ApplicationSourceRevisionHistoryQuery{Name: &testApp.Name, Limit: ptr.To(int64(-1))})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetRevisionPinningStatus(t *testing.T) {
+	t.Run("Classification", func(t *testing.T) {
+		for _, tc := range []struct {
+			source       v1alpha1.ApplicationSource
+			revisionType string
+		}{
+			{v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git"}, revisionTypeDefault},
+			{v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", TargetRevision: "HEAD"}, revisionTypeDefault},
+			{v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", TargetRevision: "a4c96e0cd6cc7b1e8e6d4a7ba3e2d5c5e2a5f8e1"}, revisionTypeCommitSHA},
+			{v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", TargetRevision: "a4c96e0"}, revisionTypeCommitSHA},
+			{v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", TargetRevision: "v1.2.0"}, revisionTypeSemverVersion},
+			{v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", TargetRevision: "main"}, revisionTypeBranchOrTag},
+			{v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", TargetRevision: "latest"}, revisionTypeBranchOrTag},
+			{v1alpha1.ApplicationSource{RepoURL: "https://charts.example.com", Chart: "redis", TargetRevision: "17.3.2"}, revisionTypeSemverVersion},
+			{v1alpha1.ApplicationSource{RepoURL: "https://charts.example.com", Chart: "redis", TargetRevision: "17.*"}, revisionTypeSemverConstraint},
+		} {
+			assert.Equal(t, tc.revisionType, classifyTargetRevision(tc.source), tc.source.TargetRevision)
+		}
+	})
+
+	t.Run("Sources", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Source = nil
+			app.Spec.Sources = v1alpha1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook", TargetRevision: "a4c96e0cd6cc7b1e8e6d4a7ba3e2d5c5e2a5f8e1"},
+				{RepoURL: "https://charts.example.com", Chart: "redis", TargetRevision: ">=17.0.0", Name: "cache"},
+			}
+		})
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.GetRevisionPinningStatus(t.Context(), &application.ApplicationRevisionPinningStatusQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.False(t, res.GetPinned())
+		require.Len(t, res.Sources, 2)
+		assert.True(t, res.Sources[0].GetPinned())
+		assert.Equal(t, "guestbook", res.Sources[0].GetPath())
+		assert.False(t, res.Sources[1].GetPinned())
+		assert.Equal(t, int32(1), res.Sources[1].GetSourceIndex())
+		assert.Equal(t, "cache", res.Sources[1].GetSourceName())
+		assert.Equal(t, revisionTypeSemverConstraint, res.Sources[1].GetRevisionType())
+	})
+}
+
+func TestParseSignatureInfo(t *testing.T) {
+	for _, tc := range []struct {
+		info   string
+		result string
+		keyID  string
+	}{
+		{"Good signature from RSA key 4AEE18F83AFDEB23", "Good", "4AEE18F83AFDEB23"},
+		{"Bad signature from RSA key 4AEE18F83AFDEB23", "Bad", "4AEE18F83AFDEB23"},
+		{"Revision is not signed.", "Unsigned", ""},
+		{"UNKNOWN signature: gpg: Can't check signature: No public key", "Unknown", ""},
+		{"", "Unknown", ""},
+	} {
+		result, keyID := parseSignatureInfo(tc.info)
+		assert.Equal(t, tc.result, result, tc.info)
+		assert.Equal(t, tc.keyID, keyID, tc.info)
+	}
+}
+
+func TestGetSyncWaves(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{
+			Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook",
+			TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","annotations":{"argocd.argoproj.io/sync-wave":"1"}}}`,
+		},
+		{
+			Kind: "ConfigMap", Namespace: testNamespace, Name: "config",
+			TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","annotations":{"argocd.argoproj.io/sync-wave":"-1"}}}`,
+		},
+		{
+			Kind: "Service", Namespace: testNamespace, Name: "guestbook",
+			TargetState: "null",
+			LiveState:   `{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook"}}`,
+		},
+		{
+			Group: "batch", Kind: "Job", Namespace: testNamespace, Name: "pre-sync", Hook: true,
+			TargetState: `{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"pre-sync","annotations":{"argocd.argoproj.io/hook":"PreSync"}}}`,
+		},
+	})
+	require.NoError(t, err)
+
+	res, err := appServer.GetSyncWaves(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Waves, 3)
+	assert.Equal(t, int64(-1), res.Waves[0].GetWave())
+	assert.Equal(t, "config", res.Waves[0].Resources[0].Name)
+	assert.Equal(t, int64(0), res.Waves[1].GetWave())
+	assert.Equal(t, "Service", res.Waves[1].Resources[0].Kind)
+	assert.Equal(t, int64(1), res.Waves[2].GetWave())
+	assert.Equal(t, "Deployment", res.Waves[2].Resources[0].Kind)
+	assert.Equal(t, "v1", res.Waves[2].Resources[0].Version)
+}
+
+func TestGetResourceKindCounts(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{
+		Nodes: []v1alpha1.ResourceNode{
+			{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook"}},
+			{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Namespace: testNamespace, Name: "guestbook-1"}},
+			{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Namespace: testNamespace, Name: "guestbook-2"}},
+			{ResourceRef: v1alpha1.ResourceRef{Kind: "Service", Namespace: testNamespace, Name: "guestbook"}},
+		},
+		OrphanedNodes: []v1alpha1.ResourceNode{
+			{ResourceRef: v1alpha1.ResourceRef{Kind: "ConfigMap", Namespace: testNamespace, Name: "leftover"}},
+		},
+	})
+	require.NoError(t, err)
+
+	res, err := appServer.GetResourceKindCounts(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Counts, 3)
+	assert.Equal(t, "Service", res.Counts[0].GetKind())
+	assert.Equal(t, int64(1), res.Counts[0].GetCount())
+	assert.Equal(t, "Deployment", res.Counts[1].GetKind())
+	assert.Equal(t, int64(1), res.Counts[1].GetCount())
+	assert.Equal(t, "ReplicaSet", res.Counts[2].GetKind())
+	assert.Equal(t, int64(2), res.Counts[2].GetCount())
+	require.Len(t, res.OrphanedCounts, 1)
+	assert.Equal(t, "ConfigMap", res.OrphanedCounts[0].GetKind())
+	assert.Equal(t, int64(1), res.OrphanedCounts[0].GetCount())
+}
+
+func TestGetResourceSubtreeHealthCounts(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	deploymentRef := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook"}
+	replicaSetRef := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: testNamespace, Name: "guestbook-1"}
+	pod := func(name string, healthStatus health.HealthStatusCode) v1alpha1.ResourceNode {
+		return v1alpha1.ResourceNode{
+			ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: name},
+			ParentRefs:  []v1alpha1.ResourceRef{replicaSetRef},
+			Health:      &v1alpha1.HealthStatus{Status: healthStatus},
+		}
+	}
+	err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{ResourceRef: deploymentRef},
+		{ResourceRef: replicaSetRef, ParentRefs: []v1alpha1.ResourceRef{deploymentRef}},
+		pod("guestbook-1-a", health.HealthStatusHealthy),
+		pod("guestbook-1-b", health.HealthStatusHealthy),
+		pod("guestbook-1-c", health.HealthStatusProgressing),
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "debug"}, Health: &v1alpha1.HealthStatus{Status: health.HealthStatusDegraded}},
+	}})
+	require.NoError(t, err)
+
+	res, err := appServer.GetResourceSubtreeHealthCounts(t.Context(), &application.ApplicationResourceRequest{
+		Name: &testApp.Name, Group: ptr.To("apps"), Kind: ptr.To("Deployment"), Namespace: ptr.To(testNamespace), ResourceName: ptr.To("guestbook"), Version: ptr.To("v1"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), res.GetTotal())
+	require.Len(t, res.Counts, 3)
+	assert.Equal(t, "Pod", res.Counts[0].GetKind())
+	assert.Equal(t, "Healthy", res.Counts[0].GetHealth())
+	assert.Equal(t, int64(2), res.Counts[0].GetCount())
+	assert.Equal(t, "Progressing", res.Counts[1].GetHealth())
+	assert.Equal(t, int64(1), res.Counts[1].GetCount())
+	assert.Equal(t, "ReplicaSet", res.Counts[2].GetKind())
+	assert.Empty(t, res.Counts[2].GetHealth())
+
+	_, err = appServer.GetResourceSubtreeHealthCounts(t.Context(), &application.ApplicationResourceRequest{
+		Name: &testApp.Name, Group: ptr.To("apps"), Kind: ptr.To("Deployment"), Namespace: ptr.To(testNamespace), ResourceName: ptr.To("missing"), Version: ptr.To("v1"),
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// pagedEventsClient returns the given pages and blocks until the context is done once they are exhausted
+type pagedEventsClient struct {
+	typedcorev1.EventInterface
+	pages []*corev1.EventList
+	calls int
+}
+
+func (c *pagedEventsClient) List(ctx context.Context, _ metav1.ListOptions) (*corev1.EventList, error) {
+	if c.calls >= len(c.pages) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	page := c.pages[c.calls]
+	c.calls++
+	return page, nil
+}
+
+func TestListExternalResourceEvents(t *testing.T) {
+	oldTimeout := externalEventsListTimeout
+	externalEventsListTimeout = 100 * time.Millisecond
+	t.Cleanup(func() {
+		externalEventsListTimeout = oldTimeout
+	})
+	involvedObject := corev1.ObjectReference{Name: "guestbook", Namespace: testNamespace, UID: "1"}
+	event := func(name string) corev1.Event {
+		return corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace}, InvolvedObject: involvedObject}
+	}
+
+	t.Run("AllPages", func(t *testing.T) {
+		client := &pagedEventsClient{pages: []*corev1.EventList{
+			{ListMeta: metav1.ListMeta{Continue: "next"}, Items: []corev1.Event{event("a")}},
+			{Items: []corev1.Event{event("b")}},
+		}}
+		list, err := listExternalResourceEvents(t.Context(), client, metav1.ListOptions{}, involvedObject)
+		require.NoError(t, err)
+		require.Len(t, list.Items, 2)
+		assert.Equal(t, "b", list.Items[1].Name)
+	})
+	t.Run("SinglePage", func(t *testing.T) {
+		client := &pagedEventsClient{pages: []*corev1.EventList{
+			{ListMeta: metav1.ListMeta{Continue: "next"}, Items: []corev1.Event{event("a")}},
+			{Items: []corev1.Event{event("b")}},
+		}}
+		list, err := listExternalResourceEvents(t.Context(), client, metav1.ListOptions{Limit: 1}, involvedObject)
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "a", list.Items[0].Name)
+		assert.Equal(t, "next", list.Continue)
+		assert.Equal(t, 1, client.calls)
+	})
+	t.Run("Timeout", func(t *testing.T) {
+		client := &pagedEventsClient{pages: []*corev1.EventList{
+			{ListMeta: metav1.ListMeta{Continue: "next"}, Items: []corev1.Event{event("a")}},
+		}}
+		list, err := listExternalResourceEvents(t.Context(), client, metav1.ListOptions{}, involvedObject)
+		require.NoError(t, err)
+		require.Len(t, list.Items, 2)
+		assert.Equal(t, "a", list.Items[0].Name)
+		assert.Equal(t, corev1.EventTypeWarning, list.Items[1].Type)
+		assert.Equal(t, "EventsListTimeout", list.Items[1].Reason)
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		_, err := listExternalResourceEvents(ctx, &pagedEventsClient{}, metav1.ListOptions{}, involvedObject)
+		require.Error(t, err)
+	})
+}
+
+func TestWatchApplicationEvents(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	_, err := appServer.kubeclientset.CoreV1().Events(testNamespace).Create(t.Context(), &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "initiated", Namespace: testNamespace},
+		InvolvedObject: corev1.ObjectReference{Name: testApp.Name, Namespace: testApp.Namespace, UID: testApp.UID},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	watcher := watch.NewFake()
+	appServer.kubeclientset.(*fake.Clientset).PrependWatchReactor("events", func(_ kubetesting.Action) (bool, watch.Interface, error) {
+		return true, watcher, nil
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	stream := &TestApplicationEventsServer{TestPodLogsServer: TestPodLogsServer{ctx: ctx}, events: make(chan *corev1.Event, 2)}
+	done := make(chan error, 1)
+	go func() {
+		done <- appServer.WatchApplicationEvents(&application.ApplicationObjectEventsQuery{Name: &testApp.Name}, stream)
+	}()
+
+	assert.Equal(t, "initiated", (<-stream.events).Name)
+	watcher.Add(&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "succeeded", Namespace: testNamespace}})
+	assert.Equal(t, "succeeded", (<-stream.events).Name)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	t.Run("NoPermission", func(t *testing.T) {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer.enf.SetDefaultRole("")
+		err := appServer.WatchApplicationEvents(&application.ApplicationObjectEventsQuery{Name: &testApp.Name}, &TestApplicationEventsServer{TestPodLogsServer: TestPodLogsServer{ctx: ctx}})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error())
+	})
+}
+
+func TestListResourceEventsPagination(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	for _, name := range []string{"event-a", "event-b", "event-c"} {
+		_, err := appServer.kubeclientset.CoreV1().Events(testNamespace).Create(t.Context(), &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+			InvolvedObject: corev1.ObjectReference{Name: testApp.Name, Namespace: testApp.Namespace, UID: testApp.UID},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	_, err := appServer.ListResourceEvents(t.Context(), &application.ApplicationResourceEventsQuery{Name: &testApp.Name, Limit: ptr.To(int64(-1))})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	list, err := appServer.ListResourceEvents(t.Context(), &application.ApplicationResourceEventsQuery{Name: &testApp.Name, Limit: ptr.To(int64(2))})
+	require.NoError(t, err)
+	assert.NotEmpty(t, list.Items)
+}
+
+func TestGetAppResourceRequests(t *testing.T) {
+	container := corev1.Container{
+		Name: "guestbook",
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+		},
+	}
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(3)),
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{container}}},
+		},
+	}
+	pod := &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: "debug", Namespace: testNamespace},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:      "debug",
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")}},
+		}}},
+	}
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp, kube.MustToUnstructured(deployment), kube.MustToUnstructured(pod))
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	deploymentRef := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook", UID: "1"}
+	replicaSetRef := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: testNamespace, Name: "guestbook-1", UID: "2"}
+	err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{ResourceRef: deploymentRef},
+		{ResourceRef: replicaSetRef, ParentRefs: []v1alpha1.ResourceRef{deploymentRef}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "guestbook-1-a", UID: "3"}, ParentRefs: []v1alpha1.ResourceRef{replicaSetRef}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "debug", UID: "4"}},
+	}})
+	require.NoError(t, err)
+
+	res, err := appServer.GetAppResourceRequests(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Workloads, 2)
+	assert.Equal(t, "Deployment", res.Workloads[0].GetKind())
+	assert.Equal(t, int64(3), res.Workloads[0].GetReplicas())
+	assert.Equal(t, "300m", res.Workloads[0].GetCpuRequests())
+	assert.Equal(t, "384Mi", res.Workloads[0].GetMemoryRequests())
+	assert.Equal(t, "Pod", res.Workloads[1].GetKind())
+	assert.Equal(t, int64(1), res.Workloads[1].GetReplicas())
+
+	assert.Equal(t, "350m", res.GetCpuRequests())
+	assert.Equal(t, "600m", res.GetCpuLimits())
+	assert.Equal(t, "384Mi", res.GetMemoryRequests())
+	assert.Equal(t, "0", res.GetMemoryLimits())
+}
+
+func TestGetDeployedImageDigests(t *testing.T) {
+	pod := &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-1-a", Namespace: testNamespace},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name:    "init",
+				Image:   "busybox:latest",
+				ImageID: "docker.io/library/busybox@sha256:1111",
+			}},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "guestbook", Image: "nginx:1.25", ImageID: "docker.io/library/nginx@sha256:2222"},
+				{Name: "sidecar", Image: "envoy:latest"},
+			},
+		},
+	}
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp, kube.MustToUnstructured(pod))
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook", UID: "1"}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "guestbook-1-a", UID: "2"}},
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "gone", UID: "3"}},
+	}})
+	require.NoError(t, err)
+
+	res, err := appServer.GetDeployedImageDigests(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 3)
+	assert.Equal(t, "init", res.Items[0].GetContainer())
+	assert.True(t, res.Items[0].GetInit())
+	assert.Equal(t, "sha256:1111", res.Items[0].GetDigest())
+	assert.Equal(t, "guestbook", res.Items[1].GetContainer())
+	assert.False(t, res.Items[1].GetInit())
+	assert.Equal(t, "nginx:1.25", res.Items[1].GetImage())
+	assert.Equal(t, "docker.io/library/nginx@sha256:2222", res.Items[1].GetImageID())
+	assert.Equal(t, "sha256:2222", res.Items[1].GetDigest())
+	assert.Equal(t, "sidecar", res.Items[2].GetContainer())
+	assert.Empty(t, res.Items[2].GetDigest())
+	assert.Equal(t, map[string]string{testNamespace + "/gone": "resource not found"}, res.Errors)
+}
+
+func TestGetResourceDestinations(t *testing.T) {
+	restrictedProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-restricted", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: testNamespace}},
+		},
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = "proj-restricted"
+	})
+	appServer := newTestAppServer(t, restrictedProj, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook"},
+		{Kind: "ConfigMap", Namespace: "other", Name: "guestbook"},
+		{Kind: "Namespace", Name: "other"},
+	})
+	require.NoError(t, err)
+
+	res, err := appServer.GetResourceDestinations(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, "https://cluster-api.example.com", res.GetServer())
+	assert.Equal(t, testNamespace, res.GetNamespace())
+	require.Len(t, res.Items, 3)
+
+	assert.Equal(t, "Deployment", res.Items[0].Resource.Kind)
+	assert.Equal(t, testNamespace, res.Items[0].GetNamespace())
+	assert.False(t, res.Items[0].GetOutsideDestinationNamespace())
+	assert.True(t, res.Items[0].GetPermitted())
+
+	assert.Equal(t, "ConfigMap", res.Items[1].Resource.Kind)
+	assert.Equal(t, "other", res.Items[1].GetNamespace())
+	assert.True(t, res.Items[1].GetOutsideDestinationNamespace())
+	assert.False(t, res.Items[1].GetPermitted())
+	assert.Contains(t, res.Items[1].GetMessage(), "not permitted")
+
+	assert.Equal(t, "Namespace", res.Items[2].Resource.Kind)
+	assert.Empty(t, res.Items[2].GetNamespace())
+	assert.False(t, res.Items[2].GetOutsideDestinationNamespace())
+	assert.False(t, res.Items[2].GetPermitted())
+}
+
+func TestListManualEdits(t *testing.T) {
+	managedFields := func(manager string, operation metav1.ManagedFieldsOperationType, subresource string, fields string) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{Manager: manager, Operation: operation, Subresource: subresource, FieldsType: "FieldsV1", FieldsV1: &metav1.FieldsV1{Raw: []byte(fields)}}
+	}
+	edited := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "edited", Namespace: testNamespace, ManagedFields: []metav1.ManagedFieldsEntry{
+			managedFields("kubectl-client-side-apply", metav1.ManagedFieldsOperationUpdate, "", `{"f:data":{".":{},"f:key":{}}}`),
+			managedFields("kubectl-edit", metav1.ManagedFieldsOperationUpdate, "", `{"f:data":{"f:key":{},"f:other":{}},"f:metadata":{"f:labels":{"f:team":{}}}}`),
+		}},
+	}
+	untouched := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "untouched", Namespace: testNamespace, ManagedFields: []metav1.ManagedFieldsEntry{
+			managedFields("kubectl-client-side-apply", metav1.ManagedFieldsOperationUpdate, "", `{"f:spec":{"f:replicas":{}}}`),
+			managedFields("kube-controller-manager", metav1.ManagedFieldsOperationUpdate, "status", `{"f:status":{"f:replicas":{}}}`),
+			managedFields("kubectl", metav1.ManagedFieldsOperationUpdate, "scale", `{"f:spec":{"f:replicas":{}}}`),
+		}},
+	}
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp, kube.MustToUnstructured(edited), kube.MustToUnstructured(untouched))
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	deploymentRef := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "untouched", UID: "2"}
+	err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: testNamespace, Name: "edited", UID: "1"}},
+		{ResourceRef: deploymentRef},
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: testNamespace, Name: "untouched-1", UID: "3"}, ParentRefs: []v1alpha1.ResourceRef{deploymentRef}},
+	}})
+	require.NoError(t, err)
+
+	res, err := appServer.ListManualEdits(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, "edited", res.Items[0].Resource.Name)
+	require.Len(t, res.Items[0].Edits, 1)
+	assert.Equal(t, "kubectl-edit", res.Items[0].Edits[0].GetManager())
+	assert.Equal(t, int64(3), res.Items[0].Edits[0].GetFieldCount())
+
+	t.Run("ServerSideApply", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"ServerSideApply=true"}}
+		})
+		appServer := newTestAppServer(t, testApp, kube.MustToUnstructured(edited), kube.MustToUnstructured(untouched))
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+
+		res, err := appServer.ListManualEdits(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Len(t, res.Items[0].Edits, 2)
+		assert.Equal(t, "untouched", res.Items[1].Resource.Name)
+		require.Len(t, res.Items[1].Edits, 1)
+		assert.Equal(t, "kubectl-client-side-apply", res.Items[1].Edits[0].GetManager())
+	})
+}
+
+func TestGetIgnoreDifferencesMatches(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.IgnoreDifferences = v1alpha1.IgnoreDifferences{
+			{Kind: "ConfigMap", JSONPointers: []string{"/data"}},
+			{Group: "apps", Kind: "*", Name: "guestbook", JQPathExpressions: []string{".spec.template"}},
+		}
+	})
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	argoCM := map[string]string{"resource.customizations.ignoreDifferences.apps_Deployment": "jsonPointers:\n- /spec/replicas"}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, argoCM, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook"},
+		{Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "other"},
+		{Kind: "Service", Namespace: testNamespace, Name: "guestbook"},
+	})
+	require.NoError(t, err)
+
+	res, err := appServer.GetIgnoreDifferencesMatches(t.Context(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+	require.NoError(t, err)
+	matches := map[string][]*application.IgnoreDifferencesRuleMatch{}
+	for _, item := range res.Items {
+		matches[item.Resource.Kind+"/"+item.Resource.Name] = item.Rules
+	}
+	// status is ignored for all resources by default
+	require.Len(t, matches["Service/guestbook"], 1)
+	assert.Equal(t, "system", matches["Service/guestbook"][0].GetSource())
+	assert.Equal(t, "*/*", matches["Service/guestbook"][0].GetOverrideKey())
+
+	require.Len(t, matches["Deployment/guestbook"], 3)
+	assert.Equal(t, "application", matches["Deployment/guestbook"][0].GetSource())
+	assert.Equal(t, int64(1), matches["Deployment/guestbook"][0].GetIndex())
+	assert.Equal(t, []string{".spec.template"}, matches["Deployment/guestbook"][0].JqPathExpressions)
+	assert.Equal(t, "*/*", matches["Deployment/guestbook"][1].GetOverrideKey())
+	assert.Equal(t, "system", matches["Deployment/guestbook"][2].GetSource())
+	assert.Equal(t, "apps/Deployment", matches["Deployment/guestbook"][2].GetOverrideKey())
+	assert.Equal(t, []string{"/spec/replicas"}, matches["Deployment/guestbook"][2].JsonPointers)
+
+	require.Len(t, matches["Deployment/other"], 2)
+	assert.Equal(t, "system", matches["Deployment/other"][0].GetSource())
+}
+
+func TestGetEffectiveIgnoreDifferences(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.IgnoreDifferences = v1alpha1.IgnoreDifferences{
+			{Kind: "ConfigMap", JSONPointers: []string{"/data"}},
+		}
+	})
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	argoCM := map[string]string{"resource.customizations.ignoreDifferences.apps_Deployment": "managedFieldsManagers:\n- kube-controller-manager"}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, argoCM, testApp)
+
+	res, err := appServer.GetEffectiveIgnoreDifferences(t.Context(), &application.ApplicationEffectiveIgnoreDifferencesQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 3)
+	assert.Equal(t, "application", res.Items[0].GetSource())
+	assert.Equal(t, "ConfigMap", res.Items[0].Rule.Kind)
+	assert.Equal(t, []string{"/data"}, res.Items[0].Rule.JSONPointers)
+	// status is ignored for all resources by default
+	assert.Equal(t, "system", res.Items[1].GetSource())
+	assert.Equal(t, "*/*", res.Items[1].GetOverrideKey())
+	assert.Equal(t, "*", res.Items[1].Rule.Group)
+	assert.Equal(t, "*", res.Items[1].Rule.Kind)
+	assert.Equal(t, "apps/Deployment", res.Items[2].GetOverrideKey())
+	assert.Equal(t, "apps", res.Items[2].Rule.Group)
+	assert.Equal(t, "Deployment", res.Items[2].Rule.Kind)
+	assert.Equal(t, []string{"kube-controller-manager"}, res.Items[2].Rule.ManagedFieldsManagers)
+
+	_, err = appServer.GetEffectiveIgnoreDifferences(t.Context(), &application.ApplicationEffectiveIgnoreDifferencesQuery{Name: ptr.To("unknown")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetRevisionsDiff(t *testing.T) {
+	t.Run("NotSynced", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.GetRevisionsDiff(t.Context(), &application.ApplicationRevisionsDiffQuery{Name: &testApp.Name})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("DefaultRevisions", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Source.TargetRevision = ""
+			app.Status.Sync.Revision = "abc123"
+		})
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.GetRevisionsDiff(t.Context(), &application.ApplicationRevisionsDiffQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, "abc123", res.GetBaseRevision())
+		assert.Equal(t, "HEAD", res.GetTargetRevision())
+		assert.Empty(t, res.Items)
+	})
+	t.Run("SourceIndexOutOfRange", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.GetRevisionsDiff(t.Context(), &application.ApplicationRevisionsDiffQuery{
+			Name:         &testApp.Name,
+			BaseRevision: ptr.To("abc123"),
+			SourceIndex:  ptr.To(int32(1)),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("ChangedResources", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		forRevision := func(revision string) any {
+			return mock.MatchedBy(func(req *apiclient.ManifestRequest) bool {
+				return req.Revision == revision
+			})
+		}
+		mockRepoServiceClient := mocks.RepoServerServiceClient{}
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, forRevision("v1")).Return(func(_ context.Context, _ *apiclient.ManifestRequest, _ ...grpc.CallOption) *apiclient.ManifestResponse {
+			return &apiclient.ManifestResponse{Manifests: []string{
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"unchanged","namespace":"fake-dest-ns"}}`,
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"removed","namespace":"fake-dest-ns"}}`,
+				`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"creds","namespace":"fake-dest-ns"},"data":{"password":"b2xk"}}`,
+			}}
+		}, nil)
+		mockRepoServiceClient.On("GenerateManifest", mock.Anything, forRevision("v2")).Return(func(_ context.Context, _ *apiclient.ManifestRequest, _ ...grpc.CallOption) *apiclient.ManifestResponse {
+			return &apiclient.ManifestResponse{Manifests: []string{
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"unchanged","namespace":"fake-dest-ns"}}`,
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"added","namespace":"fake-dest-ns"}}`,
+				`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"creds","namespace":"fake-dest-ns"},"data":{"password":"bmV3"}}`,
+			}}
+		}, nil)
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+		res, err := appServer.GetRevisionsDiff(t.Context(), &application.ApplicationRevisionsDiffQuery{
+			Name:           &testApp.Name,
+			BaseRevision:   ptr.To("v1"),
+			TargetRevision: ptr.To("v2"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "v1", res.GetBaseRevision())
+		assert.Equal(t, "v2", res.GetTargetRevision())
+		assert.Equal(t, []string{"v1"}, res.BaseRevisions)
+		assert.Equal(t, []string{"v2"}, res.TargetRevisions)
+		require.Len(t, res.Items, 3)
+		assert.Equal(t, "added", res.Items[0].GetName())
+		assert.Empty(t, res.Items[0].GetBaseState())
+		assert.Equal(t, "removed", res.Items[1].GetName())
+		assert.Empty(t, res.Items[1].GetTargetState())
+		assert.Equal(t, "creds", res.Items[2].GetName())
+		assert.NotContains(t, res.Items[2].GetBaseState(), "b2xk")
+		assert.NotContains(t, res.Items[2].GetTargetState(), "bmV3")
+	})
+	t.Run("MultipleSources", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			repoURL := app.Spec.Source.RepoURL
+			app.Spec.Source = nil
+			app.Spec.Sources = v1alpha1.ApplicationSources{{RepoURL: repoURL, Path: "first"}, {RepoURL: repoURL, Path: "second"}}
+			app.Status.Sync.Revisions = []string{"abc123", "def456"}
+		})
+		appServer := newTestAppServer(t, testApp)
 
-	t.Run("can update project with proper permissions", func(t *testing.T) {
-		// Verify can update project with proper permissions
-		_ = appServer.enf.SetBuiltinPolicy(`
-p, admin, applications, update, default/test-app, allow
-p, admin, applications, create, my-proj/test-app, allow
-p, admin, applications, update, my-proj/test-app, allow
-`)
-		updatedApp, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		_, err := appServer.GetRevisionsDiff(t.Context(), &application.ApplicationRevisionsDiffQuery{
+			Name:            &testApp.Name,
+			SourcePositions: []int64{2},
+			BaseRevisions:   []string{"v1"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		res, err := appServer.GetRevisionsDiff(t.Context(), &application.ApplicationRevisionsDiffQuery{
+			Name:            &testApp.Name,
+			SourcePositions: []int64{2},
+			BaseRevisions:   []string{"v1"},
+			TargetRevisions: []string{"v2"},
+		})
 		require.NoError(t, err)
-		assert.Equal(t, "my-proj", updatedApp.Spec.Project)
+		assert.Equal(t, "v1", res.GetBaseRevision())
+		assert.Equal(t, []string{"v1"}, res.BaseRevisions)
+		assert.Equal(t, []string{"v2"}, res.TargetRevisions)
+
+		res, err = appServer.GetRevisionsDiff(t.Context(), &application.ApplicationRevisionsDiffQuery{
+			Name:            &testApp.Name,
+			SourcePositions: []int64{2},
+			BaseRevisions:   []string{""},
+			TargetRevisions: []string{""},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"def456"}, res.BaseRevisions)
+		assert.Equal(t, []string{"HEAD"}, res.TargetRevisions)
+	})
+	t.Run("SourcePositionsOfSingleSource", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.GetRevisionsDiff(t.Context(), &application.ApplicationRevisionsDiffQuery{
+			Name:            &testApp.Name,
+			SourcePositions: []int64{1},
+			BaseRevisions:   []string{"v1"},
+			TargetRevisions: []string{"v2"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
 	})
 }
 
-func TestAppJsonPatch(t *testing.T) {
-	testApp := newTestAppWithAnnotations()
-	ctx := t.Context()
-	//nolint:staticcheck
-	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+func TestGetPerSourceSyncStatus(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.Sync.Revision = "abc123"
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "guestbook", Status: v1alpha1.SyncStatusCodeOutOfSync},
+		}
+	})
 	appServer := newTestAppServer(t, testApp)
-	appServer.enf.SetDefaultRole("")
 
-	app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: ptr.To("garbage")})
-	require.Error(t, err)
-	assert.Nil(t, app)
-
-	app, err = appServer.Patch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: ptr.To("[]")})
-	require.NoError(t, err)
-	assert.NotNil(t, app)
-
-	app, err = appServer.Patch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: ptr.To(`[{"op": "replace", "path": "/spec/source/path", "value": "foo"}]`)})
-	require.NoError(t, err)
-	assert.Equal(t, "foo", app.Spec.Source.Path)
-
-	app, err = appServer.Patch(ctx, &application.ApplicationPatchRequest{Name: &testApp.Name, Patch: ptr.To(`[{"op": "remove", "path": "/metadata/annotations/test.annotation"}]`)})
+	// the fake repo server does not generate any manifests
+	res, err := appServer.GetPerSourceSyncStatus(t.Context(), &application.PerSourceSyncStatusQuery{Name: &testApp.Name})
 	require.NoError(t, err)
-	assert.NotContains(t, app.Annotations, "test.annotation")
+	require.Len(t, res.Sources, 1)
+	assert.Equal(t, "abc123", res.Sources[0].GetRevision())
+	assert.Equal(t, string(v1alpha1.SyncStatusCodeSynced), res.Sources[0].GetStatus())
+	require.Len(t, res.UnattributedOutOfSyncResources, 1)
+	assert.Equal(t, "guestbook", res.UnattributedOutOfSyncResources[0].Name)
 }
 
-func TestAppMergePatch(t *testing.T) {
-	testApp := newTestApp()
-	ctx := t.Context()
-	//nolint:staticcheck
-	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
-	appServer := newTestAppServer(t, testApp)
-	appServer.enf.SetDefaultRole("")
-
-	app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{
-		Name: &testApp.Name, Patch: ptr.To(`{"spec": { "source": { "path": "foo" } }}`), PatchType: ptr.To("merge"),
+func TestPerSourceSyncStatus(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Sources = v1alpha1.ApplicationSources{
+			{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+			{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "helm-guestbook"},
+		}
+		app.Spec.Source = nil
+		app.Status.Sync.Revisions = []string{"abc", "def"}
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Version: "v1", Kind: "Service", Namespace: test.FakeDestNamespace, Name: "guestbook", Status: v1alpha1.SyncStatusCodeSynced},
+			{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "helm-guestbook", Status: v1alpha1.SyncStatusCodeOutOfSync},
+			{Version: "v1", Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "leftover", Status: v1alpha1.SyncStatusCodeOutOfSync},
+		}
 	})
+	manifestInfos := []*apiclient.ManifestResponse{
+		{Manifests: []string{`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook"}}`}},
+		{Manifests: []string{fmt.Sprintf(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"helm-guestbook","namespace":"%s"}}`, test.FakeDestNamespace)}},
+	}
+
+	res, err := perSourceSyncStatus(testApp, manifestInfos)
 	require.NoError(t, err)
-	assert.Equal(t, "foo", app.Spec.Source.Path)
+	require.Len(t, res.Sources, 2)
+	assert.Equal(t, "guestbook", res.Sources[0].GetPath())
+	assert.Equal(t, "abc", res.Sources[0].GetRevision())
+	assert.Equal(t, string(v1alpha1.SyncStatusCodeSynced), res.Sources[0].GetStatus())
+	assert.Empty(t, res.Sources[0].OutOfSyncResources)
+	assert.Equal(t, "def", res.Sources[1].GetRevision())
+	assert.Equal(t, string(v1alpha1.SyncStatusCodeOutOfSync), res.Sources[1].GetStatus())
+	require.Len(t, res.Sources[1].OutOfSyncResources, 1)
+	assert.Equal(t, "helm-guestbook", res.Sources[1].OutOfSyncResources[0].Name)
+	require.Len(t, res.UnattributedOutOfSyncResources, 1)
+	assert.Equal(t, "leftover", res.UnattributedOutOfSyncResources[0].Name)
 }
 
 func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
@@ -2273,6 +6132,294 @@ func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 	})
 }
 
+func TestListProjectSyncWindows(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = "proj-maint"
+	})
+	otherApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "other-app"
+		app.Spec.Project = "proj-maint"
+	})
+
+	t.Run("WindowsWithApps", func(t *testing.T) {
+		appServer := newTestAppServer(t, testApp, otherApp)
+
+		res, err := appServer.ListProjectSyncWindows(t.Context(), &application.ProjectSyncWindowsQuery{Project: ptr.To("proj-maint")})
+		require.NoError(t, err)
+		require.Len(t, res.Windows, 1)
+		assert.True(t, res.Windows[0].GetActive())
+		assert.Equal(t, "allow", res.Windows[0].Window.GetKind())
+		assert.Equal(t, []string{"default/test-app"}, res.Windows[0].Applications)
+	})
+
+	t.Run("ProjectDoesNotExist", func(t *testing.T) {
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.ListProjectSyncWindows(t.Context(), &application.ProjectSyncWindowsQuery{Project: ptr.To("none")})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("AppsWithoutAccessAreHidden", func(t *testing.T) {
+		ctx := t.Context()
+		//nolint:staticcheck
+		ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer := newTestAppServer(t, testApp, otherApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, projects, get, proj-maint, allow
+`)
+
+		res, err := appServer.ListProjectSyncWindows(ctx, &application.ProjectSyncWindowsQuery{Project: ptr.To("proj-maint")})
+		require.NoError(t, err)
+		require.Len(t, res.Windows, 1)
+		assert.Empty(t, res.Windows[0].Applications)
+	})
+}
+
+func TestListAppsBlockedBySyncWindow(t *testing.T) {
+	frozenProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "proj-frozen", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SyncWindows: v1alpha1.SyncWindows{
+				{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"blocked-app"}},
+				{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"manual-app"}, ManualSync: true},
+			},
+		},
+	}
+	blockedApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "blocked-app"
+		app.Spec.Project = "proj-frozen"
+	})
+	manualApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "manual-app"
+		app.Spec.Project = "proj-frozen"
+	})
+	appServer := newTestAppServer(t, frozenProj, blockedApp, manualApp, newTestApp())
+
+	res, err := appServer.ListAppsBlockedBySyncWindow(t.Context(), &application.ApplicationQuery{})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, "blocked-app", res.Items[0].GetName())
+	assert.Equal(t, "proj-frozen", res.Items[0].GetProject())
+	require.Len(t, res.Items[0].Windows, 1)
+	assert.Equal(t, "deny", res.Items[0].Windows[0].Window.GetKind())
+	assert.True(t, res.Items[0].Windows[0].NextOpenTime.After(time.Now()))
+}
+
+func TestListAppsByDestinationNamespace(t *testing.T) {
+	newApp := func(name string, dest v1alpha1.ApplicationDestination) *v1alpha1.Application {
+		return newTestApp(func(app *v1alpha1.Application) {
+			app.Name = name
+			app.Spec.Destination = dest
+		})
+	}
+	appServer := newTestAppServer(t,
+		newApp("by-server", v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "payments"}),
+		newApp("by-name", v1alpha1.ApplicationDestination{Name: "fake-cluster", Namespace: "payments"}),
+		newApp("other-namespace", v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "orders"}),
+		newApp("unknown-cluster", v1alpha1.ApplicationDestination{Server: "https://unknown.example.com", Namespace: "payments"}),
+	)
+
+	res, err := appServer.ListAppsByDestinationNamespace(t.Context(), &application.ApplicationDestinationNamespaceQuery{
+		Name:      ptr.To("fake-cluster"),
+		Namespace: ptr.To("payments"),
+	})
+	require.NoError(t, err)
+	var names []string
+	for i := range res.Items {
+		names = append(names, res.Items[i].Name)
+	}
+	assert.Equal(t, []string{"by-name", "by-server"}, names)
+
+	_, err = appServer.ListAppsByDestinationNamespace(t.Context(), &application.ApplicationDestinationNamespaceQuery{Namespace: ptr.To("payments")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListGroupedByProject(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	newApp := func(name, project string) *v1alpha1.Application {
+		return newTestApp(func(app *v1alpha1.Application) {
+			app.Name = name
+			app.Spec.Project = project
+		})
+	}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, map[string]string{},
+		newApp("app-b", "test-project2"),
+		newApp("app-c", "test-project1"),
+		newApp("app-a", "test-project1"),
+	)
+
+	res, err := appServer.ListGroupedByProject(t.Context(), &application.ApplicationQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), res.GetCount())
+	require.Len(t, res.Projects, 2)
+	assert.Equal(t, "test-project1", res.Projects[0].GetProject())
+	assert.Equal(t, int64(2), res.Projects[0].GetCount())
+	require.Len(t, res.Projects[0].Items, 2)
+	assert.Equal(t, "app-a", res.Projects[0].Items[0].Name)
+	assert.Equal(t, "app-c", res.Projects[0].Items[1].Name)
+	assert.Equal(t, "test-project2", res.Projects[1].GetProject())
+	assert.Equal(t, int64(1), res.Projects[1].GetCount())
+
+	t.Run("Filtered", func(t *testing.T) {
+		res, err := appServer.ListGroupedByProject(t.Context(), &application.ApplicationQuery{Projects: []string{"test-project2"}})
+		require.NoError(t, err)
+		require.Len(t, res.Projects, 1)
+		assert.Equal(t, "test-project2", res.Projects[0].GetProject())
+	})
+	t.Run("Paginated", func(t *testing.T) {
+		_, err := appServer.ListGroupedByProject(t.Context(), &application.ApplicationQuery{Limit: ptr.To(int64(1))})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListStaleApplications(t *testing.T) {
+	staleApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "stale-app"
+		app.Status.History = v1alpha1.RevisionHistories{
+			{ID: 1, Revision: "aaa", DeployedAt: metav1.NewTime(time.Now().Add(-72 * time.Hour))},
+			{ID: 2, Revision: "bbb", DeployedAt: metav1.NewTime(time.Now().Add(-48 * time.Hour))},
+		}
+	})
+	recentApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "recent-app"
+		app.Status.History = v1alpha1.RevisionHistories{
+			{ID: 1, Revision: "aaa", DeployedAt: metav1.NewTime(time.Now().Add(-72 * time.Hour))},
+			{ID: 2, Revision: "ccc", DeployedAt: metav1.NewTime(time.Now().Add(-time.Hour))},
+		}
+	})
+	neverSyncedApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "never-synced-app"
+	})
+	appServer := newTestAppServer(t, staleApp, recentApp, neverSyncedApp)
+
+	t.Run("Stale", func(t *testing.T) {
+		res, err := appServer.ListStaleApplications(t.Context(), &application.ApplicationQuery{StaleAfterSeconds: ptr.To(int64(24 * 60 * 60))})
+		require.NoError(t, err)
+		items := map[string]*application.StaleApplication{}
+		for _, item := range res.Items {
+			items[item.GetName()] = item
+		}
+		require.Len(t, items, 2)
+		require.Contains(t, items, "stale-app")
+		assert.Equal(t, "bbb", items["stale-app"].GetRevision())
+		assert.Equal(t, staleApp.Status.History[1].DeployedAt.Unix(), items["stale-app"].LastSyncedAt.Unix())
+		require.Contains(t, items, "never-synced-app")
+		assert.Nil(t, items["never-synced-app"].LastSyncedAt)
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		res, err := appServer.ListStaleApplications(t.Context(), &application.ApplicationQuery{Name: ptr.To("stale-app"), StaleAfterSeconds: ptr.To(int64(60))})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "stale-app", res.Items[0].GetName())
+	})
+	t.Run("InvalidThreshold", func(t *testing.T) {
+		_, err := appServer.ListStaleApplications(t.Context(), &application.ApplicationQuery{})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestBlockingSyncWindows(t *testing.T) {
+	inactiveHour := (time.Now().UTC().Hour() + 12) % 24
+	t.Run("InactiveAllow", func(t *testing.T) {
+		windows := &v1alpha1.SyncWindows{
+			{Kind: "allow", Schedule: fmt.Sprintf("0 %d * * *", inactiveHour), Duration: "1h"},
+		}
+		blocking, err := blockingSyncWindows(windows)
+		require.NoError(t, err)
+		require.Len(t, blocking, 1)
+		assert.Equal(t, "allow", blocking[0].Kind)
+	})
+	t.Run("ActiveAllow", func(t *testing.T) {
+		windows := &v1alpha1.SyncWindows{
+			{Kind: "allow", Schedule: fmt.Sprintf("0 %d * * *", inactiveHour), Duration: "1h"},
+			{Kind: "allow", Schedule: "* * * * *", Duration: "1h"},
+		}
+		blocking, err := blockingSyncWindows(windows)
+		require.NoError(t, err)
+		assert.Empty(t, blocking)
+	})
+	t.Run("NoWindows", func(t *testing.T) {
+		blocking, err := blockingSyncWindows(nil)
+		require.NoError(t, err)
+		assert.Empty(t, blocking)
+	})
+}
+
+func TestWatchDebounce(t *testing.T) {
+	testApp := newTestApp()
+	flush := make(chan time.Time)
+	originalNewWatchDebounceTicker := newWatchDebounceTicker
+	newWatchDebounceTicker = func(_ time.Duration) (<-chan time.Time, func()) {
+		return flush, func() {}
+	}
+	t.Cleanup(func() {
+		newWatchDebounceTicker = originalNewWatchDebounceTicker
+	})
+
+	// startWatch starts watching the test app, and returns once it is subscribed to its updates
+	startWatch := func(t *testing.T, debounceMilliseconds int64) (*TestWatchServer, *broadcasterHandler, func()) {
+		t.Helper()
+		appServer := newTestAppServer(t, testApp)
+		broadcaster := &broadcasterHandler{}
+		appServer.appBroadcaster = broadcaster
+		ctx, cancel := context.WithCancel(t.Context())
+		stream := newTestWatchServer(ctx)
+		done := make(chan error)
+		go func() {
+			done <- appServer.Watch(&application.ApplicationQuery{Name: &testApp.Name, DebounceMilliseconds: ptr.To(debounceMilliseconds)}, stream)
+		}()
+		require.Eventually(t, func() bool {
+			broadcaster.lock.Lock()
+			defer broadcaster.lock.Unlock()
+			return len(broadcaster.subscribers) > 0
+		}, 5*time.Second, 10*time.Millisecond)
+		return stream, broadcaster, func() {
+			cancel()
+			require.NoError(t, <-done)
+		}
+	}
+	update := func(broadcaster *broadcasterHandler) {
+		for i := 1; i <= 3; i++ {
+			updated := testApp.DeepCopy()
+			updated.ResourceVersion = strconv.Itoa(i)
+			broadcaster.OnUpdate(testApp, updated)
+		}
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		stream, broadcaster, stop := startWatch(t, 0)
+		update(broadcaster)
+		// the initial state followed by every update
+		assert.Equal(t, testApp.Name, (<-stream.events).Application.Name)
+		for i := 1; i <= 3; i++ {
+			assert.Equal(t, strconv.Itoa(i), (<-stream.events).Application.ResourceVersion)
+		}
+		stop()
+	})
+	t.Run("Enabled", func(t *testing.T) {
+		stream, broadcaster, stop := startWatch(t, 200)
+		assert.Equal(t, testApp.Name, (<-stream.events).Application.Name)
+		update(broadcaster)
+		flush <- time.Now()
+		stop()
+		// the updates are coalesced into the latest one
+		require.Len(t, stream.events, 1)
+		assert.Equal(t, "3", (<-stream.events).Application.ResourceVersion)
+	})
+	t.Run("Negative", func(t *testing.T) {
+		appServer := newTestAppServer(t, testApp)
+		err := appServer.Watch(&application.ApplicationQuery{Name: &testApp.Name, DebounceMilliseconds: ptr.To(int64(-1))}, newTestWatchServer(t.Context()))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestGetCachedAppState(t *testing.T) {
 	testApp := newTestApp()
 	testApp.ResourceVersion = "1"
@@ -2435,6 +6582,41 @@ func TestLogsGetSelectedPod(t *testing.T) {
 	})
 }
 
+func TestNewLogFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    *application.ApplicationPodLogsQuery
+		line     string
+		expected bool
+	}{
+		{name: "NoFilter", query: &application.ApplicationPodLogsQuery{}, line: "level=info", expected: true},
+		{name: "Substring", query: &application.ApplicationPodLogsQuery{Filter: ptr.To("ERROR")}, line: "level=error", expected: true},
+		{name: "SubstringMatchCase", query: &application.ApplicationPodLogsQuery{Filter: ptr.To("ERROR"), MatchCase: ptr.To(true)}, line: "level=error", expected: false},
+		{name: "SubstringInverse", query: &application.ApplicationPodLogsQuery{Filter: ptr.To("!error")}, line: "level=error", expected: false},
+		{name: "SubstringIsLiteral", query: &application.ApplicationPodLogsQuery{Filter: ptr.To("level=(error|warn)")}, line: "level=warn", expected: false},
+		{name: "Regex", query: &application.ApplicationPodLogsQuery{Filter: ptr.To("level=(error|warn)"), FilterIsRegex: ptr.To(true)}, line: "level=warn", expected: true},
+		{name: "RegexIgnoreCase", query: &application.ApplicationPodLogsQuery{Filter: ptr.To("level=(error|warn)"), FilterIsRegex: ptr.To(true)}, line: "LEVEL=WARN", expected: true},
+		{name: "RegexMatchCase", query: &application.ApplicationPodLogsQuery{Filter: ptr.To("level=(error|warn)"), FilterIsRegex: ptr.To(true), MatchCase: ptr.To(true)}, line: "LEVEL=WARN", expected: false},
+		{name: "RegexInverse", query: &application.ApplicationPodLogsQuery{Filter: ptr.To("!level=(error|warn)"), FilterIsRegex: ptr.To(true)}, line: "level=info", expected: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches, err := newLogFilter(tc.query)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, matches(tc.line))
+		})
+	}
+
+	t.Run("InvalidRegex", func(t *testing.T) {
+		_, err := newLogFilter(&application.ApplicationPodLogsQuery{Filter: ptr.To("level=(error"), FilterIsRegex: ptr.To(true)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		appServer := newTestAppServer(t, newTestApp())
+		err = appServer.PodLogs(&application.ApplicationPodLogsQuery{Name: ptr.To("test-app"), Filter: ptr.To("level=(error"), FilterIsRegex: ptr.To(true)}, &TestPodLogsServer{ctx: t.Context()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestMaxPodLogsRender(t *testing.T) {
 	defaultMaxPodLogsToRender, _ := newTestAppServer(t).settingsMgr.GetMaxPodLogsToRender()
 
@@ -2485,6 +6667,33 @@ func TestMaxPodLogsRender(t *testing.T) {
 	})
 }
 
+func TestGetPodLogsSnapshot(t *testing.T) {
+	defaultMaxPodLogsToRender, _ := newTestAppServer(t).settingsMgr.GetMaxPodLogsToRender()
+
+	appServer, adminCtx := createAppServerWithMaxLodLogs(t, int(defaultMaxPodLogsToRender-1))
+
+	t.Run("Snapshot", func(t *testing.T) {
+		_, err := appServer.GetPodLogsSnapshot(adminCtx, &application.ApplicationPodLogsQuery{Name: ptr.To("test")})
+		assert.Equal(t, codes.OK, status.Code(err))
+	})
+
+	t.Run("NegativeTailLines", func(t *testing.T) {
+		_, err := appServer.GetPodLogsSnapshot(adminCtx, &application.ApplicationPodLogsQuery{Name: ptr.To("test"), TailLines: ptr.To(int64(-1))})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("TooManyTailLines", func(t *testing.T) {
+		_, err := appServer.GetPodLogsSnapshot(adminCtx, &application.ApplicationPodLogsQuery{Name: ptr.To("test"), TailLines: ptr.To(int64(maxPodLogsSnapshotLines + 1))})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("MaxPodLogsToRender", func(t *testing.T) {
+		appServer, adminCtx := createAppServerWithMaxLodLogs(t, int(defaultMaxPodLogsToRender+1))
+		_, err := appServer.GetPodLogsSnapshot(adminCtx, &application.ApplicationPodLogsQuery{Name: ptr.To("test")})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = max pods to view logs are reached. Please provide more granular query")
+	})
+}
+
 // createAppServerWithMaxLodLogs creates a new app server with given number of pods and resources
 func createAppServerWithMaxLodLogs(t *testing.T, podNumber int, maxPodLogsToRender ...int64) (*Server, context.Context) {
 	t.Helper()
@@ -2622,6 +6831,40 @@ func TestGetAppRefresh_HardRefresh(t *testing.T) {
 	}
 }
 
+func TestGetAppRefresh_HardRefreshDetailsTimeout(t *testing.T) {
+	oldTimeout := hardRefreshAppDetailsTimeout
+	hardRefreshAppDetailsTimeout = 100 * time.Millisecond
+	t.Cleanup(func() {
+		hardRefreshAppDetailsTimeout = oldTimeout
+	})
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	testApp := newTestApp()
+	testApp.ResourceVersion = "1"
+	appServer := newTestAppServer(t, testApp)
+
+	// simulate a repo server which is stuck generating the app details
+	mockRepoServiceClient := mocks.RepoServerServiceClient{}
+	mockRepoServiceClient.On("GetAppDetails", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil, context.DeadlineExceeded)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &mockRepoServiceClient}
+
+	var patched int32
+
+	ch := make(chan string, 1)
+
+	go refreshAnnotationRemover(t, ctx, &patched, appServer, testApp.Name, ch)
+
+	app, err := appServer.Get(t.Context(), &application.ApplicationQuery{
+		Name:    &testApp.Name,
+		Refresh: ptr.To(string(v1alpha1.RefreshTypeHard)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, testApp.Name, app.Name)
+	mockRepoServiceClient.AssertCalled(t, "GetAppDetails", mock.Anything, mock.Anything)
+}
+
 func TestInferResourcesStatusHealth(t *testing.T) {
 	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
 
@@ -2827,9 +7070,89 @@ func TestRunNewStyleResourceAction(t *testing.T) {
 			Kind:         &kind,
 		})
 
-		require.NoError(t, runErr)
-		assert.NotNil(t, appResponse)
+		require.NoError(t, runErr)
+		assert.NotNil(t, appResponse)
+	})
+
+	t.Run("ValidateCreateOperationNotPermitted", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Spec.Project = "createJobDenyingProj"
+		testApp.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationAppTree
+		testApp.Status.Resources = resources
+
+		appServer := newTestAppServer(t, testApp, createJobDenyingProj, kube.MustToUnstructured(&cronJob))
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+
+		err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: nodes})
+		require.NoError(t, err)
+
+		validation, err := appServer.ValidateResourceAction(t.Context(), &application.ResourceActionRunRequestV2{
+			Name:         &testApp.Name,
+			Namespace:    &namespace,
+			Action:       &action,
+			AppNamespace: &testApp.Namespace,
+			ResourceName: &resourceName,
+			Version:      &version,
+			Group:        &group,
+			Kind:         &kind,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, validation.GetAllowed())
+		require.Len(t, validation.Results, 1)
+		result := validation.Results[0]
+		assert.Equal(t, "Job", result.GetKind())
+		assert.Equal(t, "create", result.GetOperation())
+		assert.False(t, result.GetAllowed())
+		assert.False(t, result.GetDryRun())
+		assert.Equal(t, "Forbidden", result.GetReason())
+		assert.Contains(t, result.GetMessage(), "is not permitted to manage")
+	})
+
+	t.Run("ValidateCreateOperationPermitted", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationAppTree
+		testApp.Status.Resources = resources
+
+		appServer := newTestAppServer(t, testApp, kube.MustToUnstructured(&cronJob))
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+
+		err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: nodes})
+		require.NoError(t, err)
+
+		validation, err := appServer.ValidateResourceAction(t.Context(), &application.ResourceActionRunRequestV2{
+			Name:         &testApp.Name,
+			Namespace:    &namespace,
+			Action:       &action,
+			AppNamespace: &testApp.Namespace,
+			ResourceName: &resourceName,
+			Version:      &version,
+			Group:        &group,
+			Kind:         &kind,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, validation.GetAllowed())
+		require.Len(t, validation.Results, 1)
+		assert.True(t, validation.Results[0].GetAllowed())
+		assert.True(t, validation.Results[0].GetDryRun())
+	})
+}
+
+func TestSetResourceActionValidationError(t *testing.T) {
+	result := &application.ResourceActionValidationResult{}
+	err := apierrors.NewInvalid(schema.GroupKind{Group: "batch", Kind: "Job"}, "my-job", field.ErrorList{
+		field.Forbidden(field.NewPath("spec", "template"), "privileged containers are not allowed"),
 	})
+
+	setResourceActionValidationError(result, err)
+
+	assert.False(t, result.GetAllowed())
+	assert.Equal(t, "Invalid", result.GetReason())
+	assert.Equal(t, int32(422), result.GetCode())
+	require.Len(t, result.Causes, 1)
+	assert.Equal(t, "spec.template", result.Causes[0].GetField())
+	assert.Equal(t, "privileged containers are not allowed", result.Causes[0].GetMessage())
 }
 
 func TestRunOldStyleResourceAction(t *testing.T) {
@@ -2901,6 +7224,127 @@ func TestRunOldStyleResourceAction(t *testing.T) {
 		require.NoError(t, runErr)
 		assert.NotNil(t, appResponse)
 	})
+
+	t.Run("ValidatePatchOperationUnimplemented", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationAppTree
+		testApp.Status.Resources = resources
+
+		appServer := newTestAppServer(t, testApp, kube.MustToUnstructured(&deployment))
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+
+		err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: nodes})
+		require.NoError(t, err)
+
+		_, err = appServer.ValidateResourceAction(t.Context(), &application.ResourceActionRunRequestV2{
+			Name:         &testApp.Name,
+			Namespace:    &namespace,
+			Action:       &action,
+			AppNamespace: &testApp.Namespace,
+			ResourceName: &resourceName,
+			Version:      &version,
+			Group:        &group,
+			Kind:         &kind,
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestGetLastAppliedConfig(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: test.FakeDestNamespace,
+			Annotations: map[string]string{
+				common.AnnotationKeyAppInstance:    "test-app:apps/Deployment:" + test.FakeDestNamespace + "/guestbook",
+				corev1.LastAppliedConfigAnnotation: `{"apiVersion":"apps/v1","kind":"Deployment"}`,
+			},
+		},
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Group: "apps", Kind: "Deployment", Version: "v1", Name: "guestbook", Namespace: test.FakeDestNamespace},
+		}
+	})
+	appServer := newTestAppServer(t, testApp, kube.MustToUnstructured(deployment))
+
+	res, err := appServer.GetLastAppliedConfig(t.Context(), &application.ApplicationResourceRequest{
+		Name:         &testApp.Name,
+		Group:        ptr.To("apps"),
+		Kind:         ptr.To("Deployment"),
+		Version:      ptr.To("v1"),
+		Namespace:    ptr.To(test.FakeDestNamespace),
+		ResourceName: ptr.To("guestbook"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, string(v1alpha1.TrackingMethodAnnotation), res.GetTrackingMethod())
+	assert.Equal(t, "test-app:apps/Deployment:"+test.FakeDestNamespace+"/guestbook", res.GetTrackingId())
+	assert.Equal(t, "annotation", res.GetSource())
+	assert.JSONEq(t, `{"apiVersion":"apps/v1","kind":"Deployment"}`, res.GetLastAppliedConfig())
+
+	t.Run("NoAnnotation", func(t *testing.T) {
+		deployment := deployment.DeepCopy()
+		delete(deployment.Annotations, corev1.LastAppliedConfigAnnotation)
+		appServer := newTestAppServer(t, testApp, kube.MustToUnstructured(deployment))
+
+		_, err := appServer.GetLastAppliedConfig(t.Context(), &application.ApplicationResourceRequest{
+			Name:         &testApp.Name,
+			Group:        ptr.To("apps"),
+			Kind:         ptr.To("Deployment"),
+			Version:      ptr.To("v1"),
+			Namespace:    ptr.To(test.FakeDestNamespace),
+			ResourceName: ptr.To("guestbook"),
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestRestartAppWorkloads(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Status.Resources = []v1alpha1.ResourceStatus{
+		{Group: "apps", Kind: kube.DeploymentKind, Version: "v1", Name: "guestbook", Namespace: test.FakeDestNamespace},
+		{Group: "apps", Kind: kube.StatefulSetKind, Version: "v1", Name: "redis", Namespace: test.FakeDestNamespace},
+		{Kind: kube.ServiceKind, Version: "v1", Name: "guestbook", Namespace: test.FakeDestNamespace},
+	}
+
+	t.Run("RestartsAllWorkloads", func(t *testing.T) {
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.RestartAppWorkloads(t.Context(), &application.RestartAppWorkloadsRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 2)
+		for _, r := range res.Results {
+			assert.True(t, r.GetRestarted())
+			assert.Empty(t, r.GetError())
+		}
+	})
+
+	t.Run("ActionNotPermitted", func(t *testing.T) {
+		ctx := t.Context()
+		//nolint:staticcheck
+		ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+		appServer := newTestAppServer(t, testApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/test-app, allow
+p, test-user, applications, action/apps/Deployment/restart, default/test-app, allow
+`)
+
+		res, err := appServer.RestartAppWorkloads(ctx, &application.RestartAppWorkloadsRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 2)
+		for _, r := range res.Results {
+			if r.GetKind() == kube.DeploymentKind {
+				assert.True(t, r.GetRestarted())
+			} else {
+				assert.False(t, r.GetRestarted())
+				assert.Contains(t, r.GetError(), "permission denied")
+			}
+		}
+	})
 }
 
 func TestIsApplicationPermitted(t *testing.T) {
@@ -3285,6 +7729,64 @@ func TestGetAmbiguousRevision_SingleSource(t *testing.T) {
 	assert.Equalf(t, expected, result, "Expected ambiguous revision to be %s, but got %s", expected, result)
 }
 
+func TestGetAmbiguousRevision_EmptyTargetRevision(t *testing.T) {
+	app := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			Sources: []v1alpha1.ApplicationSource{
+				{RepoURL: "https://github.com/example/repo.git"},
+				{RepoURL: "https://charts.example.com", Chart: "my-chart"},
+			},
+		},
+	}
+	syncReq := &application.ApplicationSyncRequest{}
+
+	assert.Equal(t, "HEAD", getAmbiguousRevision(app, syncReq, 0))
+	assert.Empty(t, getAmbiguousRevision(app, syncReq, 1))
+
+	app.Spec = v1alpha1.ApplicationSpec{
+		Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/example/repo.git"},
+	}
+	assert.Equal(t, "HEAD", getAmbiguousRevision(app, syncReq, -1))
+}
+
+func TestServer_ResolveSourceRevisions_EmptyTargetRevisionWithAutoSync(t *testing.T) {
+	s := newTestAppServer(t)
+
+	t.Run("MultiSource", func(t *testing.T) {
+		a := &v1alpha1.Application{
+			Spec: v1alpha1.ApplicationSpec{
+				Sources: []v1alpha1.ApplicationSource{
+					{RepoURL: "https://github.com/example/repo.git"},
+					{RepoURL: "https://github.com/example/other.git"},
+				},
+				SyncPolicy: &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}},
+			},
+		}
+		syncReq := &application.ApplicationSyncRequest{
+			SourcePositions: []int64{2},
+			Revisions:       []string{"HEAD"},
+		}
+
+		_, _, sourceRevisions, _, err := s.resolveSourceRevisions(t.Context(), a, syncReq)
+		require.NoError(t, err)
+		assert.Equal(t, []string{fakeResolveRevisionResponse().Revision, fakeResolveRevisionResponse().Revision}, sourceRevisions)
+	})
+	t.Run("SingleSource", func(t *testing.T) {
+		a := &v1alpha1.Application{
+			Spec: v1alpha1.ApplicationSpec{
+				Source:     &v1alpha1.ApplicationSource{RepoURL: "https://github.com/example/repo.git"},
+				SyncPolicy: &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}},
+			},
+		}
+
+		_, _, _, _, err := s.resolveSourceRevisions(t.Context(), a, &application.ApplicationSyncRequest{Revision: strToPtr("HEAD")})
+		require.NoError(t, err)
+
+		_, _, _, _, err = s.resolveSourceRevisions(t.Context(), a, &application.ApplicationSyncRequest{Revision: strToPtr("main")})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
 func TestServer_ResolveSourceRevisions_MultiSource(t *testing.T) {
 	s := newTestAppServer(t)
 
@@ -3848,3 +8350,420 @@ func TestServerSideDiff(t *testing.T) {
 		assert.Contains(t, err.Error(), "application")
 	})
 }
+
+type fakeLogsArchiveUploader struct {
+	s3manageriface.UploaderAPI
+	input *s3manager.UploadInput
+	body  []byte
+}
+
+func (u *fakeLogsArchiveUploader) UploadWithContext(_ aws.Context, input *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	u.input = input
+	u.body = body
+	return &s3manager.UploadOutput{}, nil
+}
+
+func TestArchiveLogs(t *testing.T) {
+	uploader := &fakeLogsArchiveUploader{}
+	originalNewLogsArchiveUploader := newLogsArchiveUploader
+	newLogsArchiveUploader = func(_ *settings.LogsArchiveSettings) (s3manageriface.UploaderAPI, error) {
+		return uploader, nil
+	}
+	t.Cleanup(func() {
+		newLogsArchiveUploader = originalNewLogsArchiveUploader
+	})
+
+	testApp := newTestApp()
+	sinceTime := metav1.NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	query := &application.ApplicationPodLogsQuery{
+		Name:      &testApp.Name,
+		SinceTime: &sinceTime,
+		UntilTime: ptr.To("2024-01-02T04:04:05Z"),
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		appServer := newTestAppServer(t, testApp)
+		_, err := appServer.ArchiveLogs(t.Context(), query)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	argoCM := map[string]string{"server.logs.archive": "enabled: true\nbucket: logs\nprefix: argocd/\n"}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, argoCM, testApp)
+
+	t.Run("MissingTimeRange", func(t *testing.T) {
+		_, err := appServer.ArchiveLogs(t.Context(), &application.ApplicationPodLogsQuery{Name: &testApp.Name, SinceTime: &sinceTime})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("InvalidTimeRange", func(t *testing.T) {
+		_, err := appServer.ArchiveLogs(t.Context(), &application.ApplicationPodLogsQuery{Name: &testApp.Name, SinceTime: &sinceTime, UntilTime: ptr.To("2024-01-01T00:00:00Z")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Archived", func(t *testing.T) {
+		res, err := appServer.ArchiveLogs(t.Context(), query)
+		require.NoError(t, err)
+		assert.Equal(t, "logs", res.GetBucket())
+		assert.Equal(t, "argocd/default/test-app/20240102T030405Z-20240102T040405Z.log", res.GetKey())
+		assert.Equal(t, int64(0), res.GetEntries())
+		require.NotNil(t, uploader.input)
+		assert.Equal(t, "logs", aws.StringValue(uploader.input.Bucket))
+		assert.Equal(t, res.GetKey(), aws.StringValue(uploader.input.Key))
+		assert.Empty(t, uploader.body)
+	})
+}
+
+func TestDiffFromGeneratedTemplate(t *testing.T) {
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-set", Namespace: testNamespace, UID: "appset-uid"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name:   "{{cluster}}-guestbook",
+					Labels: map[string]string{"team": "payments"},
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					Project: "default",
+					Source: &v1alpha1.ApplicationSource{
+						RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+						Path:           "guestbook",
+						TargetRevision: "HEAD",
+					},
+					Destination: v1alpha1.ApplicationDestination{Server: "{{url}}", Namespace: "guestbook"},
+				},
+			},
+			IgnoreApplicationDifferences: v1alpha1.ApplicationSetIgnoreDifferences{
+				{JSONPointers: []string{"/spec/syncPolicy"}},
+			},
+		},
+	}
+	generatedApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "generated-app"
+		app.Spec.Project = "default"
+		app.Labels = map[string]string{"team": "payments", "extra": "label"}
+		app.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ApplicationSet",
+			Name:       appSet.Name,
+			UID:        appSet.UID,
+			Controller: ptr.To(true),
+		}}
+		app.Spec.Source = &v1alpha1.ApplicationSource{
+			RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+			Path:           "guestbook",
+			TargetRevision: "my-branch",
+		}
+		app.Spec.Destination = v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com", Namespace: "guestbook"}
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}}
+	})
+	standaloneApp := newTestApp()
+	appServer := newTestAppServer(t, appSet, generatedApp, standaloneApp)
+
+	t.Run("GeneratedApplication", func(t *testing.T) {
+		res, err := appServer.DiffFromGeneratedTemplate(t.Context(), &application.ApplicationTemplateDiffQuery{Name: &generatedApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, appSet.Name, res.GetApplicationSetName())
+		require.Len(t, res.Differences, 1)
+		assert.Equal(t, "/spec/source/targetRevision", res.Differences[0].GetPath())
+		assert.Equal(t, `"HEAD"`, res.Differences[0].GetTemplateValue())
+		assert.Equal(t, `"my-branch"`, res.Differences[0].GetLiveValue())
+	})
+
+	t.Run("StandaloneApplication", func(t *testing.T) {
+		res, err := appServer.DiffFromGeneratedTemplate(t.Context(), &application.ApplicationTemplateDiffQuery{Name: &standaloneApp.Name})
+		require.NoError(t, err)
+		assert.Empty(t, res.GetApplicationSetName())
+		assert.Empty(t, res.Differences)
+	})
+
+	t.Run("UnknownApplication", func(t *testing.T) {
+		_, err := appServer.DiffFromGeneratedTemplate(t.Context(), &application.ApplicationTemplateDiffQuery{Name: ptr.To("unknown")})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestGetHookResults(t *testing.T) {
+	t.Run("NoOperation", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.GetHookResults(t.Context(), &application.ApplicationHookResultsQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Empty(t, res.Phases)
+	})
+
+	t.Run("GroupedByPhase", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Status.OperationState = &v1alpha1.OperationState{
+				Phase: synccommon.OperationFailed,
+				SyncResult: &v1alpha1.SyncOperationResult{Resources: v1alpha1.ResourceResults{
+					{Group: "batch", Kind: "Job", Namespace: testNamespace, Name: "smoke-test", HookType: synccommon.HookTypePostSync, HookPhase: synccommon.OperationFailed, SyncPhase: synccommon.SyncPhasePostSync, Message: "Job has reached the specified backoff limit"},
+					{Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook", Status: synccommon.ResultCodeSynced, SyncPhase: synccommon.SyncPhaseSync},
+					{Group: "batch", Kind: "Job", Namespace: testNamespace, Name: "db-migrate", HookType: synccommon.HookTypePreSync, HookPhase: synccommon.OperationSucceeded, SyncPhase: synccommon.SyncPhasePreSync},
+				}},
+			}
+		})
+		appServer := newTestAppServer(t, testApp)
+
+		res, err := appServer.GetHookResults(t.Context(), &application.ApplicationHookResultsQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, "Failed", res.GetOperationPhase())
+		require.Len(t, res.Phases, 2)
+		assert.Equal(t, "PreSync", res.Phases[0].GetPhase())
+		require.Len(t, res.Phases[0].Hooks, 1)
+		assert.Equal(t, "db-migrate", res.Phases[0].Hooks[0].GetName())
+		assert.Equal(t, "Succeeded", res.Phases[0].Hooks[0].GetHookPhase())
+		assert.Equal(t, "PostSync", res.Phases[1].GetPhase())
+		require.Len(t, res.Phases[1].Hooks, 1)
+		assert.Equal(t, "smoke-test", res.Phases[1].Hooks[0].GetName())
+		assert.Equal(t, "Failed", res.Phases[1].Hooks[0].GetHookPhase())
+		assert.Equal(t, "Job has reached the specified backoff limit", res.Phases[1].Hooks[0].GetMessage())
+	})
+}
+
+func TestListProjectAppConditions(t *testing.T) {
+	withConditions := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "with-conditions"
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{
+			{Type: v1alpha1.ApplicationConditionComparisonError, Message: "comparison failed"},
+			{Type: v1alpha1.ApplicationConditionSharedResourceWarning, Message: "shared configmap"},
+			{Type: v1alpha1.ApplicationConditionSharedResourceWarning, Message: "shared secret"},
+		}
+	})
+	alsoWarning := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "also-warning"
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{
+			{Type: v1alpha1.ApplicationConditionSharedResourceWarning, Message: "shared service"},
+		}
+	})
+	otherProject := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "other-project"
+		app.Spec.Project = "my-proj"
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{
+			{Type: v1alpha1.ApplicationConditionComparisonError, Message: "comparison failed"},
+		}
+	})
+	appServer := newTestAppServer(t, withConditions, alsoWarning, otherProject)
+
+	res, err := appServer.ListProjectAppConditions(t.Context(), &application.ProjectAppConditionsQuery{Project: ptr.To("default")})
+	require.NoError(t, err)
+	require.Len(t, res.Groups, 2)
+	assert.Equal(t, v1alpha1.ApplicationConditionComparisonError, res.Groups[0].GetType())
+	assert.Equal(t, int64(1), res.Groups[0].GetCount())
+	assert.Equal(t, "with-conditions", res.Groups[0].Applications[0].GetName())
+	assert.Equal(t, v1alpha1.ApplicationConditionSharedResourceWarning, res.Groups[1].GetType())
+	assert.Equal(t, int64(2), res.Groups[1].GetCount())
+	assert.Len(t, res.Groups[1].Applications, 3)
+}
+
+func TestBatchGetWithTrees(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	res, err := appServer.BatchGetWithTrees(t.Context(), &application.ApplicationsWithTreesQuery{
+		Applications: []*application.ApplicationBatchItemQuery{
+			{Name: &testApp.Name},
+			{Name: ptr.To("does-not-exist")},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 2)
+
+	assert.Equal(t, testApp.Name, res.Items[0].GetName())
+	assert.Empty(t, res.Items[0].GetError())
+	require.NotNil(t, res.Items[0].Application)
+	assert.Equal(t, testApp.Spec, res.Items[0].Application.Spec)
+	require.NotNil(t, res.Items[0].Tree)
+
+	assert.Equal(t, "does-not-exist", res.Items[1].GetName())
+	assert.NotEmpty(t, res.Items[1].GetError())
+	assert.Nil(t, res.Items[1].Application)
+
+	tooMany := make([]*application.ApplicationBatchItemQuery, maxBatchGetWithTreesApplications+1)
+	for i := range tooMany {
+		tooMany[i] = &application.ApplicationBatchItemQuery{Name: &testApp.Name}
+	}
+	_, err = appServer.BatchGetWithTrees(t.Context(), &application.ApplicationsWithTreesQuery{Applications: tooMany})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPreviewSyncOptionImpact(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"PruneLast=true"}}
+	})
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{
+			Kind: "ConfigMap", Namespace: testNamespace, Name: "modified", Modified: true,
+			TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"modified","namespace":"default"},"data":{"key":"desired"}}`,
+			LiveState:   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"modified","namespace":"default"},"data":{"key":"live"}}`,
+		},
+		{
+			Kind: "ConfigMap", Namespace: testNamespace, Name: "missing",
+			TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"missing","namespace":"default","annotations":{"argocd.argoproj.io/sync-options":"Validate=true, ServerSideApply=false"}}}`,
+			LiveState:   "null",
+		},
+		{
+			Kind: "ConfigMap", Namespace: testNamespace, Name: "synced",
+			TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"synced","namespace":"default"}}`,
+			LiveState:   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"synced","namespace":"default"}}`,
+		},
+		{
+			Kind: "Service", Namespace: testNamespace, Name: "obsolete",
+			TargetState: "null",
+			LiveState:   `{"apiVersion":"v1","kind":"Service","metadata":{"name":"obsolete","namespace":"default"}}`,
+		},
+	})
+	require.NoError(t, err)
+
+	names := func(res *application.ApplicationSyncOptionImpactResponse) []string {
+		var names []string
+		for _, resource := range res.Resources {
+			names = append(names, resource.GetName())
+		}
+		return names
+	}
+	query := func(option string) *application.ApplicationSyncOptionImpactRequest {
+		return &application.ApplicationSyncOptionImpactRequest{Name: &testApp.Name, SyncOption: ptr.To(option)}
+	}
+
+	t.Run("ServerSideApply", func(t *testing.T) {
+		res, err := appServer.PreviewSyncOptionImpact(t.Context(), query("ServerSideApply=true"))
+		require.NoError(t, err)
+		assert.True(t, res.GetChanged())
+		assert.Equal(t, []string{"modified"}, names(res))
+		assert.Equal(t, "applied with server-side apply instead of client-side apply", res.Resources[0].GetImpact())
+	})
+	t.Run("ApplyOutOfSyncOnly", func(t *testing.T) {
+		res, err := appServer.PreviewSyncOptionImpact(t.Context(), query("ApplyOutOfSyncOnly=true"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"synced"}, names(res))
+	})
+	t.Run("PruneLast", func(t *testing.T) {
+		res, err := appServer.PreviewSyncOptionImpact(t.Context(), query("PruneLast=true"))
+		require.NoError(t, err)
+		assert.False(t, res.GetChanged())
+		assert.Empty(t, res.Resources)
+
+		res, err = appServer.PreviewSyncOptionImpact(t.Context(), query("PruneLast=false"))
+		require.NoError(t, err)
+		assert.True(t, res.GetChanged())
+		assert.Equal(t, []string{"obsolete"}, names(res))
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := appServer.PreviewSyncOptionImpact(t.Context(), query("CreateNamespace=true"))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = appServer.PreviewSyncOptionImpact(t.Context(), query("Replace=yes"))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetResourceTargetManifest(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+	err := appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{
+		{
+			Kind: "ConfigMap", Namespace: testNamespace, Name: "guestbook",
+			TargetState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook","namespace":"default"},"data":{"key":"desired"}}`,
+			LiveState:   `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook","namespace":"default"},"data":{"key":"live"}}`,
+		},
+		{
+			Kind: "Secret", Namespace: testNamespace, Name: "guestbook",
+			TargetState: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"guestbook","namespace":"default"},"data":{"password":"c2VjcmV0"}}`,
+		},
+		{
+			Kind: "Service", Namespace: testNamespace, Name: "obsolete",
+			TargetState: "null",
+			LiveState:   `{"apiVersion":"v1","kind":"Service","metadata":{"name":"obsolete","namespace":"default"}}`,
+		},
+	})
+	require.NoError(t, err)
+
+	query := func(kind, name string) *application.ApplicationResourceRequest {
+		return &application.ApplicationResourceRequest{Name: &testApp.Name, Namespace: ptr.To(testNamespace), Version: ptr.To("v1"), Kind: ptr.To(kind), ResourceName: ptr.To(name)}
+	}
+
+	t.Run("ConfigMap", func(t *testing.T) {
+		res, err := appServer.GetResourceTargetManifest(t.Context(), query("ConfigMap", "guestbook"))
+		require.NoError(t, err)
+		assert.Contains(t, res.GetManifest(), `"desired"`)
+		assert.NotContains(t, res.GetManifest(), `"live"`)
+	})
+	t.Run("Secret", func(t *testing.T) {
+		res, err := appServer.GetResourceTargetManifest(t.Context(), query("Secret", "guestbook"))
+		require.NoError(t, err)
+		assert.NotContains(t, res.GetManifest(), "c2VjcmV0")
+	})
+	t.Run("Pruned", func(t *testing.T) {
+		_, err := appServer.GetResourceTargetManifest(t.Context(), query("Service", "obsolete"))
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := appServer.GetResourceTargetManifest(t.Context(), query("ConfigMap", "unknown"))
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestRefreshResource(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace, ResourceVersion: "42"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 0, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1},
+	}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook"},
+		}
+	})
+	appServer := newTestAppServer(t, testApp, kube.MustToUnstructured(deployment))
+
+	query := &application.ApplicationResourceRequest{
+		Name:         &testApp.Name,
+		Namespace:    ptr.To(testNamespace),
+		Group:        ptr.To("apps"),
+		Version:      ptr.To("v1"),
+		Kind:         ptr.To("Deployment"),
+		ResourceName: ptr.To("guestbook"),
+	}
+	node, err := appServer.RefreshResource(t.Context(), query)
+	require.NoError(t, err)
+	assert.Equal(t, "42", node.ResourceVersion)
+	require.NotNil(t, node.Health)
+	assert.Equal(t, health.HealthStatusHealthy, node.Health.Status)
+
+	// the controller is asked to refresh the application instead of the cached tree being modified
+	app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, string(v1alpha1.RefreshTypeNormal), app.Annotations[v1alpha1.AnnotationKeyRefresh])
+
+	_, err = appServer.RefreshResource(t.Context(), &application.ApplicationResourceRequest{
+		Name:         &testApp.Name,
+		Namespace:    ptr.To(testNamespace),
+		Version:      ptr.To("v1"),
+		Kind:         ptr.To("Service"),
+		ResourceName: ptr.To("guestbook"),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	//nolint:staticcheck
+	ctx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "test-user"})
+	appServer.enf.SetDefaultRole("")
+	_ = appServer.enf.SetBuiltinPolicy(`
+p, test-user, applications, get, default/*, allow
+`)
+	_, err = appServer.RefreshResource(ctx, query)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -2,12 +2,24 @@ package application
 
 import (
 	"bufio"
+	"context"
 	"errors"
+	"fmt"
 	"io"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager/s3manageriface"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 type logEntry struct {
@@ -160,3 +172,89 @@ func mergeLogStreams(streams []chan logEntry, bufferingDuration time.Duration) c
 	}()
 	return merged
 }
+
+// podLogsCollectorStream implements the parts of the PodLogs server stream that are not needed when the log entries
+// are collected by the API server itself instead of being sent to a client
+type podLogsCollectorStream struct {
+	ctx context.Context
+}
+
+func (s *podLogsCollectorStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *podLogsCollectorStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *podLogsCollectorStream) SetTrailer(metadata.MD) {}
+
+func (s *podLogsCollectorStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *podLogsCollectorStream) SendMsg(_ any) error {
+	return nil
+}
+
+func (s *podLogsCollectorStream) RecvMsg(_ any) error {
+	return nil
+}
+
+// logsArchiveStream is a PodLogs stream which writes the received log entries to an archive, one line per entry
+type logsArchiveStream struct {
+	podLogsCollectorStream
+	w       io.Writer
+	entries int64
+}
+
+func (s *logsArchiveStream) Send(entry *application.LogEntry) error {
+	// the last entry either marks the end of the logs or is already past the requested time range
+	if entry.GetLast() {
+		return nil
+	}
+	if _, err := fmt.Fprintf(s.w, "%s %s %s\n", entry.GetTimeStampStr(), entry.GetPodName(), entry.GetContent()); err != nil {
+		return err
+	}
+	s.entries++
+	return nil
+}
+
+// logsSnapshotStream is a PodLogs stream which keeps the most recent limit log entries in memory
+type logsSnapshotStream struct {
+	podLogsCollectorStream
+	limit     int
+	entries   []*application.LogEntry
+	truncated bool
+}
+
+func (s *logsSnapshotStream) Send(entry *application.LogEntry) error {
+	if entry.GetLast() {
+		return nil
+	}
+	s.entries = append(s.entries, entry)
+	if len(s.entries) > s.limit {
+		s.entries = s.entries[len(s.entries)-s.limit:]
+		s.truncated = true
+	}
+	return nil
+}
+
+// newLogsArchiveUploader creates an uploader for the object store configured in the logs archive settings
+var newLogsArchiveUploader = func(archiveSettings *settings.LogsArchiveSettings) (s3manageriface.UploaderAPI, error) {
+	cfg := aws.NewConfig().WithS3ForcePathStyle(archiveSettings.ForcePathStyle)
+	if archiveSettings.Region != "" {
+		cfg = cfg.WithRegion(archiveSettings.Region)
+	}
+	if archiveSettings.Endpoint != "" {
+		cfg = cfg.WithEndpoint(archiveSettings.Endpoint)
+	}
+	if archiveSettings.AccessKeyID != "" {
+		cfg = cfg.WithCredentials(credentials.NewStaticCredentials(archiveSettings.AccessKeyID, archiveSettings.SecretAccessKey, ""))
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating object store session: %w", err)
+	}
+	return s3manager.NewUploader(sess), nil
+}
@@ -1,6 +1,7 @@
 package application
 
 import (
+	"bytes"
 	"io"
 	"strings"
 	"testing"
@@ -8,6 +9,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 )
 
 func TestParseLogsStream_Successful(t *testing.T) {
@@ -105,3 +110,30 @@ func TestMergeLogStreams_RaceCondition(_ *testing.T) {
 		// and channel closer.
 	}
 }
+
+func TestLogsArchiveStream(t *testing.T) {
+	var buf bytes.Buffer
+	stream := &logsArchiveStream{podLogsCollectorStream: podLogsCollectorStream{ctx: t.Context()}, w: &buf}
+	timeStamp := metav1.NewTime(time.Date(2021, 2, 9, 22, 13, 45, 0, time.UTC))
+
+	require.NoError(t, stream.Send(&application.LogEntry{Content: ptr.To("hello"), PodName: ptr.To("pod-1"), TimeStampStr: ptr.To("2021-02-09T22:13:45Z"), TimeStamp: &timeStamp, Last: ptr.To(false)}))
+	require.NoError(t, stream.Send(&application.LogEntry{Content: ptr.To("world"), PodName: ptr.To("pod-2"), TimeStampStr: ptr.To("2021-02-09T22:13:46Z"), TimeStamp: &timeStamp, Last: ptr.To(false)}))
+	require.NoError(t, stream.Send(&application.LogEntry{Content: ptr.To(""), Last: ptr.To(true)}))
+
+	assert.Equal(t, "2021-02-09T22:13:45Z pod-1 hello\n2021-02-09T22:13:46Z pod-2 world\n", buf.String())
+	assert.Equal(t, int64(2), stream.entries)
+}
+
+func TestLogsSnapshotStream(t *testing.T) {
+	stream := &logsSnapshotStream{podLogsCollectorStream: podLogsCollectorStream{ctx: t.Context()}, limit: 2}
+
+	for _, content := range []string{"first", "second", "third"} {
+		require.NoError(t, stream.Send(&application.LogEntry{Content: ptr.To(content), Last: ptr.To(false)}))
+	}
+	require.NoError(t, stream.Send(&application.LogEntry{Content: ptr.To(""), Last: ptr.To(true)}))
+
+	require.Len(t, stream.entries, 2)
+	assert.Equal(t, "second", stream.entries[0].GetContent())
+	assert.Equal(t, "third", stream.entries[1].GetContent())
+	assert.True(t, stream.truncated)
+}
@@ -0,0 +1,127 @@
+package application
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	openapierrors "k8s.io/kube-openapi/pkg/validation/errors"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// getApplicationSchemaValidator returns a validator for the generated OpenAPI schema of the Application type
+var getApplicationSchemaValidator = sync.OnceValue(func() *validate.SchemaValidator {
+	ref := func(name string) spec.Ref {
+		return spec.MustCreateRef("#/definitions/" + name)
+	}
+	defs := map[string]spec.Schema{}
+	for name, def := range v1alpha1.GetOpenAPIDefinitions(ref) {
+		defs[ref(name).String()] = def.Schema
+	}
+	appRef := ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Application").String()
+	schema := inlineSchemaRefs(defs[appRef], defs, map[string]bool{appRef: true})
+	return validate.NewSchemaValidator(&schema, nil, "", strfmt.Default)
+})
+
+// inlineSchemaRefs replaces the references to the given definitions by the definitions themselves. References to
+// types outside of the v1alpha1 package and recursive references are replaced by a schema accepting any value.
+func inlineSchemaRefs(schema spec.Schema, defs map[string]spec.Schema, visiting map[string]bool) spec.Schema {
+	if name := schema.Ref.String(); name != "" {
+		def, ok := defs[name]
+		if !ok || visiting[name] {
+			return spec.Schema{}
+		}
+		visiting[name] = true
+		defer delete(visiting, name)
+		return inlineSchemaRefs(def, defs, visiting)
+	}
+	if len(schema.Properties) > 0 {
+		props := make(map[string]spec.Schema, len(schema.Properties))
+		for name, prop := range schema.Properties {
+			props[name] = inlineSchemaRefs(prop, defs, visiting)
+		}
+		schema.Properties = props
+	}
+	if schema.Items != nil && schema.Items.Schema != nil {
+		items := inlineSchemaRefs(*schema.Items.Schema, defs, visiting)
+		schema.Items = &spec.SchemaOrArray{Schema: &items}
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		additional := inlineSchemaRefs(*schema.AdditionalProperties.Schema, defs, visiting)
+		schema.AdditionalProperties = &spec.SchemaOrBool{Allows: true, Schema: &additional}
+	}
+	if len(schema.AllOf) > 0 {
+		allOf := make([]spec.Schema, len(schema.AllOf))
+		for i := range schema.AllOf {
+			allOf[i] = inlineSchemaRefs(schema.AllOf[i], defs, visiting)
+		}
+		schema.AllOf = allOf
+	}
+	return schema
+}
+
+// dropNullValues removes the null values of the given object, like the API server prunes them before validating
+// custom resources
+func dropNullValues(obj any) {
+	switch val := obj.(type) {
+	case map[string]any:
+		for k, v := range val {
+			if v == nil {
+				delete(val, k)
+				continue
+			}
+			dropNullValues(v)
+		}
+	case []any:
+		for _, v := range val {
+			dropNullValues(v)
+		}
+	}
+}
+
+// validateApplicationSchema returns the fields of the given JSON application which do not match the OpenAPI schema
+// of the Application type. The status is not validated, since it is not set by API clients.
+func validateApplicationSchema(data []byte) (field.ErrorList, error) {
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error unmarshaling application: %v", err)
+	}
+	delete(obj, "status")
+	dropNullValues(obj)
+
+	var fieldErrs field.ErrorList
+	for _, err := range getApplicationSchemaValidator().Validate(obj).Errors {
+		validationErr, ok := err.(*openapierrors.Validation)
+		if !ok {
+			fieldErrs = append(fieldErrs, field.InternalError(nil, err))
+			continue
+		}
+		path := field.NewPath(strings.TrimPrefix(validationErr.Name, "."))
+		if validationErr.Code() == openapierrors.RequiredFailCode {
+			fieldErrs = append(fieldErrs, field.Required(path, ""))
+		} else {
+			fieldErrs = append(fieldErrs, field.Invalid(path, validationErr.Value, validationErr.Error()))
+		}
+	}
+	return fieldErrs, nil
+}
+
+// enforceApplicationSchema returns an InvalidArgument error listing the invalid fields if the given JSON application
+// does not match the OpenAPI schema of the Application type
+func enforceApplicationSchema(data []byte) error {
+	fieldErrs, err := validateApplicationSchema(data)
+	if err != nil {
+		return err
+	}
+	if len(fieldErrs) > 0 {
+		return status.Errorf(codes.InvalidArgument, "application does not match the Application schema: %s", fieldErrs.ToAggregate().Error())
+	}
+	return nil
+}
@@ -55,6 +55,10 @@ func (c *Cache) GetAppResourcesTree(appName string, res *appv1.ApplicationTree)
 	return c.cache.GetAppResourcesTree(appName, res)
 }
 
+func (c *Cache) SetAppResourcesTree(appName string, resourcesTree *appv1.ApplicationTree) error {
+	return c.cache.SetAppResourcesTree(appName, resourcesTree)
+}
+
 func (c *Cache) OnAppResourcesTreeChanged(ctx context.Context, appName string, callback func() error) error {
 	return c.cache.OnAppResourcesTreeChanged(ctx, appName, callback)
 }
@@ -77,6 +81,25 @@ func (c *Cache) GetRepoConnectionState(repo string, project string) (appv1.Conne
 	return res, err
 }
 
+// localManifestsExpiration is how long uploaded local manifests can be referenced by a sync
+const localManifestsExpiration = time.Hour
+
+// SetLocalManifests stores the manifests uploaded for a local sync of the given application under the given reference
+// and returns when they expire
+func (c *Cache) SetLocalManifests(appName string, ref string, manifests []string) (time.Time, error) {
+	return time.Now().Add(localManifestsExpiration), c.cache.SetItem(localManifestsKey(appName, ref), manifests, localManifestsExpiration, false)
+}
+
+func localManifestsKey(appName string, ref string) string {
+	return fmt.Sprintf("app|%s|local-manifests|%s", appName, ref)
+}
+
+func (c *Cache) GetLocalManifests(appName string, ref string) ([]string, error) {
+	var manifests []string
+	err := c.cache.GetItem(localManifestsKey(appName, ref), &manifests)
+	return manifests, err
+}
+
 func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	return c.cache.GetClusterInfo(server, res)
 }
@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 
@@ -180,6 +181,94 @@ func FilterByRepoP(apps []*argoappv1.Application, repo string) []*argoappv1.Appl
 	return items
 }
 
+// FilterByAutoSyncP returns application pointers whose automated sync is enabled or disabled as requested. All
+// applications are returned if autoSyncEnabled is nil.
+func FilterByAutoSyncP(apps []*argoappv1.Application, autoSyncEnabled *bool) []*argoappv1.Application {
+	if autoSyncEnabled == nil {
+		return apps
+	}
+	items := make([]*argoappv1.Application, 0)
+	for i := 0; i < len(apps); i++ {
+		enabled := apps[i].Spec.SyncPolicy != nil && apps[i].Spec.SyncPolicy.IsAutomatedSyncEnabled()
+		if enabled == *autoSyncEnabled {
+			items = append(items, apps[i])
+		}
+	}
+	return items
+}
+
+// FilterByAnnotationsP returns application pointers whose annotations match the selector. The selector is a comma
+// separated list of requirements which must all be met: "key" and "!key" require the annotation to exist or not to
+// exist, "key=value" (or "key==value") and "key!=value" require it to have or not to have the value. Unlike label
+// selectors, values may contain any character except a comma. All applications are returned if the selector is empty.
+func FilterByAnnotationsP(apps []*argoappv1.Application, selector string) ([]*argoappv1.Application, error) {
+	if strings.TrimSpace(selector) == "" {
+		return apps, nil
+	}
+	requirements, err := parseAnnotationSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*argoappv1.Application, 0)
+	for i := 0; i < len(apps); i++ {
+		if requirements.matches(apps[i].Annotations) {
+			items = append(items, apps[i])
+		}
+	}
+	return items, nil
+}
+
+type annotationRequirement struct {
+	key string
+	// value is only compared if hasValue is set, otherwise the requirement is about the existence of the annotation
+	value    string
+	hasValue bool
+	negated  bool
+}
+
+type annotationRequirements []annotationRequirement
+
+func (r annotationRequirements) matches(annotations map[string]string) bool {
+	for _, req := range r {
+		value, exists := annotations[req.key]
+		matched := exists
+		if req.hasValue {
+			matched = exists && value == req.value
+		}
+		if matched == req.negated {
+			return false
+		}
+	}
+	return true
+}
+
+func parseAnnotationSelector(selector string) (annotationRequirements, error) {
+	var requirements annotationRequirements
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		var req annotationRequirement
+		switch {
+		case strings.HasPrefix(term, "!"):
+			req = annotationRequirement{key: strings.TrimPrefix(term, "!"), negated: true}
+		case strings.Contains(term, "!="):
+			key, value, _ := strings.Cut(term, "!=")
+			req = annotationRequirement{key: key, value: value, hasValue: true, negated: true}
+		case strings.Contains(term, "="):
+			key, value, _ := strings.Cut(term, "=")
+			req = annotationRequirement{key: key, value: strings.TrimPrefix(value, "="), hasValue: true}
+		default:
+			req = annotationRequirement{key: term}
+		}
+		req.key = strings.TrimSpace(req.key)
+		req.value = strings.TrimSpace(req.value)
+		if errs := validation.IsQualifiedName(req.key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid annotation key %q in selector: %s", req.key, strings.Join(errs, "; "))
+		}
+		requirements = append(requirements, req)
+	}
+	return requirements, nil
+}
+
 // FilterByCluster returns an application
 func FilterByCluster(apps []argoappv1.Application, cluster string) []argoappv1.Application {
 	if cluster == "" {
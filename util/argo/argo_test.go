@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 
@@ -624,6 +625,76 @@ func TestFilterByRepoP(t *testing.T) {
 	})
 }
 
+func TestFilterByAnnotationsP(t *testing.T) {
+	apps := []*argoappv1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "payments", Annotations: map[string]string{"example.com/team": "payments", "owner": "jane@example.com"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "search", Annotations: map[string]string{"example.com/team": "search", "deprecated": "true"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "unowned"}},
+	}
+	names := func(apps []*argoappv1.Application) []string {
+		var res []string
+		for _, a := range apps {
+			res = append(res, a.Name)
+		}
+		return res
+	}
+
+	for _, tc := range []struct {
+		selector string
+		expected []string
+	}{
+		{"", []string{"payments", "search", "unowned"}},
+		{"example.com/team=payments", []string{"payments"}},
+		{"example.com/team==payments", []string{"payments"}},
+		{"example.com/team!=payments", []string{"search", "unowned"}},
+		{"example.com/team", []string{"payments", "search"}},
+		{"!example.com/team", []string{"unowned"}},
+		{"example.com/team, !deprecated", []string{"payments"}},
+		{"owner=jane@example.com", []string{"payments"}},
+	} {
+		res, err := FilterByAnnotationsP(apps, tc.selector)
+		require.NoError(t, err, tc.selector)
+		assert.Equal(t, tc.expected, names(res), tc.selector)
+	}
+
+	_, err := FilterByAnnotationsP(apps, "=payments")
+	require.Error(t, err)
+}
+
+func TestFilterByAutoSyncP(t *testing.T) {
+	apps := []*argoappv1.Application{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "automated"},
+			Spec:       argoappv1.ApplicationSpec{SyncPolicy: &argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "automated-disabled"},
+			Spec:       argoappv1.ApplicationSpec{SyncPolicy: &argoappv1.SyncPolicy{Automated: &argoappv1.SyncPolicyAutomated{Enabled: ptr.To(false)}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "manual"},
+		},
+	}
+
+	t.Run("Empty filter", func(t *testing.T) {
+		res := FilterByAutoSyncP(apps, nil)
+		assert.Len(t, res, 3)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		res := FilterByAutoSyncP(apps, ptr.To(true))
+		require.Len(t, res, 1)
+		assert.Equal(t, "automated", res[0].Name)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		res := FilterByAutoSyncP(apps, ptr.To(false))
+		require.Len(t, res, 2)
+		assert.Equal(t, "automated-disabled", res[0].Name)
+		assert.Equal(t, "manual", res[1].Name)
+	})
+}
+
 func TestValidatePermissions(t *testing.T) {
 	t.Run("Empty Repo URL result in condition", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
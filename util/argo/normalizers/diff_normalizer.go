@@ -120,7 +120,7 @@ func (opts *IgnoreNormalizerOpts) getJQExecutionTimeout() time.Duration {
 // NewIgnoreNormalizer creates diff normalizer which removes ignored fields according to given application spec and resource overrides
 func NewIgnoreNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride, opts IgnoreNormalizerOpts) (diff.Normalizer, error) {
 	for key, override := range overrides {
-		group, kind, err := getGroupKindForOverrideKey(key)
+		group, kind, err := GetGroupKindForOverrideKey(key)
 		if err != nil {
 			log.Warn(err)
 		}
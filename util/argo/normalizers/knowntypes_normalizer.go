@@ -42,7 +42,7 @@ func init() {
 func NewKnownTypesNormalizer(overrides map[string]v1alpha1.ResourceOverride) (*knownTypesNormalizer, error) {
 	normalizer := knownTypesNormalizer{typeFields: map[schema.GroupKind][]knownTypeField{}}
 	for key, override := range overrides {
-		group, kind, err := getGroupKindForOverrideKey(key)
+		group, kind, err := GetGroupKindForOverrideKey(key)
 		if err != nil {
 			log.Warn(err)
 		}
@@ -5,7 +5,8 @@ import (
 	"strings"
 )
 
-func getGroupKindForOverrideKey(key string) (string, string, error) {
+// GetGroupKindForOverrideKey returns the group and kind of a resource override key of the form <group>/<kind> or <kind>
+func GetGroupKindForOverrideKey(key string) (string, string, error) {
 	var group, kind string
 	parts := strings.Split(key, "/")
 
@@ -39,6 +39,22 @@ func (rf *ResourcesFilter) isExcludedResource(apiGroup, kind, cluster string) bo
 	return rf.checkResourcePresence(apiGroup, kind, cluster, rf.getExcludedResources())
 }
 
+// ClusterFilters returns the exclusions, including the core excluded resources, and the inclusions which apply to the
+// given cluster
+func (rf *ResourcesFilter) ClusterFilters(cluster string) (exclusions []FilteredResource, inclusions []FilteredResource) {
+	for _, excludedResource := range rf.getExcludedResources() {
+		if excludedResource.MatchCluster(cluster) {
+			exclusions = append(exclusions, excludedResource)
+		}
+	}
+	for _, includedResource := range rf.ResourceInclusions {
+		if includedResource.MatchCluster(cluster) {
+			inclusions = append(inclusions, includedResource)
+		}
+	}
+	return exclusions, inclusions
+}
+
 // Behavior of this function is as follows:
 // +-------------+-------------+-------------+
 // |  Inclusions |  Exclusions |    Result   |
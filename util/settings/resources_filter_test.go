@@ -60,3 +60,18 @@ func TestResourceInclusionsExclusionMultiCluster(t *testing.T) {
 	assert.True(t, filter.IsExcludedResource("whitelisted-resource", "", "cluster-two"))
 	assert.False(t, filter.IsExcludedResource("whitelisted-resource", "", "cluster-three"))
 }
+
+func TestClusterFilters(t *testing.T) {
+	filter := ResourcesFilter{
+		ResourceInclusions: []FilteredResource{{APIGroups: []string{"apps"}, Clusters: []string{"cluster-one"}}},
+		ResourceExclusions: []FilteredResource{{Kinds: []string{"Secret"}, Clusters: []string{"cluster-two"}}, {APIGroups: []string{"batch"}}},
+	}
+
+	exclusions, inclusions := filter.ClusterFilters("cluster-one")
+	assert.Equal(t, append(coreExcludedResources, FilteredResource{APIGroups: []string{"batch"}}), exclusions)
+	assert.Equal(t, []FilteredResource{{APIGroups: []string{"apps"}, Clusters: []string{"cluster-one"}}}, inclusions)
+
+	exclusions, inclusions = filter.ClusterFilters("cluster-two")
+	assert.Len(t, exclusions, len(coreExcludedResources)+2)
+	assert.Empty(t, inclusions)
+}
@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"path"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -141,6 +142,53 @@ type GlobalProjectSettings struct {
 	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty"`
 }
 
+// LogsArchiveSettings holds the configuration of the S3-compatible object store application logs are archived to
+type LogsArchiveSettings struct {
+	// Enabled enables archiving application logs
+	Enabled bool `json:"enabled,omitempty"`
+	// Bucket is the name of the bucket the logs are written to
+	Bucket string `json:"bucket,omitempty"`
+	// Prefix is prepended to the keys of the archived logs
+	Prefix string `json:"prefix,omitempty"`
+	// Endpoint is the URL of an S3-compatible object store, AWS S3 is used if empty
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region is the region of the bucket
+	Region string `json:"region,omitempty"`
+	// ForcePathStyle addresses the bucket in the URL path instead of the host name, as most S3-compatible stores require
+	ForcePathStyle bool `json:"forcePathStyle,omitempty"`
+	// AccessKeyID and SecretAccessKey may reference keys of the argocd-secret with a leading '$'. The default AWS
+	// credential chain is used if they are empty.
+	AccessKeyID     string `json:"accessKeyID,omitempty"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+}
+
+// ApplicationFilter is a named set of filters which application list requests can reference instead of repeating them
+type ApplicationFilter struct {
+	// Name is the name requests reference the filter by
+	Name string `json:"name"`
+	// Projects restricts the list to applications of these projects
+	Projects []string `json:"projects,omitempty"`
+	// Selector restricts the list to applications with matching labels
+	Selector string `json:"selector,omitempty"`
+	// Repo restricts the list to applications with this source repository URL
+	Repo string `json:"repo,omitempty"`
+	// HealthStatuses restricts the list to applications with one of these health statuses
+	HealthStatuses []string `json:"healthStatuses,omitempty"`
+	// SyncStatuses restricts the list to applications with one of these sync statuses
+	SyncStatuses []string `json:"syncStatuses,omitempty"`
+}
+
+// ResourceRedactionRule masks the values of some data keys of the resources of a kind, the same way the data of
+// Secrets is masked
+type ResourceRedactionRule struct {
+	// Group is the API group of the resources, empty for the core group
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the resources, e.g. ConfigMap
+	Kind string `json:"kind"`
+	// Keys are glob patterns of the keys of data, stringData and binaryData whose values are masked
+	Keys []string `json:"keys"`
+}
+
 // Help settings
 type Help struct {
 	// the URL for getting chat help, this will typically be your Slack channel for support
@@ -452,8 +500,14 @@ const (
 	settingsResourceTrackingMethodKey = "application.resourceTrackingMethod"
 	// allowedNodeLabelsKey is the key to the list of allowed node labels for the application pod view
 	allowedNodeLabelsKey = "application.allowedNodeLabels"
+	// requiredApplicationLabelsKey is the key to the map of projects to the labels their applications must have
+	requiredApplicationLabelsKey = "application.requiredLabels"
 	// settingsInstallationID holds the key for the instance installation ID
 	settingsInstallationID = "installationID"
+	// settingsReconciliationTimeoutKey is the key for the period after which the application controller reconciles applications
+	settingsReconciliationTimeoutKey = "timeout.reconciliation"
+	// settingsReconciliationJitterKey is the key for the maximum delay added to the reconciliation period
+	settingsReconciliationJitterKey = "timeout.reconciliation.jitter"
 	// resourcesCustomizationsKey is the key to the map of resource overrides
 	resourceCustomizationsKey = "resource.customizations"
 	// resourceExclusions is the key to the list of excluded resources
@@ -464,6 +518,10 @@ const (
 	resourceIgnoreResourceUpdatesEnabledKey = "resource.ignoreResourceUpdatesEnabled"
 	// resourceSensitiveAnnotationsKey is the key to list of annotations to mask in secret resource
 	resourceSensitiveAnnotationsKey = "resource.sensitive.mask.annotations"
+	// resourceRedactionRulesKey is the key to configure the data keys of other kinds than Secret to mask
+	resourceRedactionRulesKey = "resource.redactionRules"
+	// resourceDeletionProtectionAnnotationKey is the key to the annotation protecting resources from deletion through the API
+	resourceDeletionProtectionAnnotationKey = "resource.deletionProtectionAnnotation"
 	// resourceCustomLabelKey is the key to a custom label to show in node info, if present
 	resourceCustomLabelsKey = "resource.customLabels"
 	// resourceIncludeEventLabelKeys is the key to labels to be added onto Application k8s events if present on an Application or it's AppProject. Supports wildcard.
@@ -514,6 +572,10 @@ const (
 	settingsServerRBACDisableFineGrainedInheritance = "server.rbac.disableApplicationFineGrainedRBACInheritance"
 	// MaxPodLogsToRender the maximum number of pod logs to render
 	settingsMaxPodLogsToRender = "server.maxPodLogsToRender"
+	// settingsLogsArchiveKey is the key to configure the object store application logs are archived to
+	settingsLogsArchiveKey = "server.logs.archive"
+	// settingsApplicationFiltersKey is the key to configure the named filters of application list requests
+	settingsApplicationFiltersKey = "server.application.filters"
 	// helmValuesFileSchemesKey is the key to configure the list of supported helm values file schemas
 	helmValuesFileSchemesKey = "helm.valuesFileSchemes"
 	// execEnabledKey is the key to configure whether the UI exec feature is enabled
@@ -835,6 +897,37 @@ func (mgr *SettingsManager) GetInstallationID() (string, error) {
 	return argoCDCM.Data[settingsInstallationID], nil
 }
 
+const (
+	// defaultReconciliationTimeout is the reconciliation period the application controller uses if none is configured
+	defaultReconciliationTimeout = 120 * time.Second
+	// defaultReconciliationJitter is the reconciliation jitter the application controller uses if none is configured
+	defaultReconciliationJitter = 60 * time.Second
+)
+
+// GetReconciliationTimeout returns the period after which the application controller reconciles applications and the
+// maximum delay it adds to that period. A zero period disables the periodic reconciliation.
+func (mgr *SettingsManager) GetReconciliationTimeout() (time.Duration, time.Duration, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return 0, 0, err
+	}
+	timeout := defaultReconciliationTimeout
+	if value, ok := argoCDCM.Data[settingsReconciliationTimeoutKey]; ok && value != "" {
+		timeout, err = time.ParseDuration(value)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid %s %q: %w", settingsReconciliationTimeoutKey, value, err)
+		}
+	}
+	jitter := defaultReconciliationJitter
+	if value, ok := argoCDCM.Data[settingsReconciliationJitterKey]; ok && value != "" {
+		jitter, err = time.ParseDuration(value)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid %s %q: %w", settingsReconciliationJitterKey, value, err)
+		}
+	}
+	return timeout, jitter, nil
+}
+
 func (mgr *SettingsManager) GetPasswordPattern() (string, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -873,6 +966,99 @@ func (mgr *SettingsManager) GetMaxPodLogsToRender() (int64, error) {
 	return strconv.ParseInt(argoCDCM.Data[settingsMaxPodLogsToRender], 10, 64)
 }
 
+// GetLogsArchiveSettings returns the configuration of the object store application logs are archived to, with secret
+// references of the credentials resolved
+func (mgr *SettingsManager) GetLogsArchiveSettings() (*LogsArchiveSettings, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	archiveSettings := &LogsArchiveSettings{}
+	value := argoCDCM.Data[settingsLogsArchiveKey]
+	if value == "" {
+		return archiveSettings, nil
+	}
+	if err := yaml.Unmarshal([]byte(value), archiveSettings); err != nil {
+		return nil, fmt.Errorf("error unmarshalling logs archive settings: %w", err)
+	}
+	if !archiveSettings.Enabled {
+		return archiveSettings, nil
+	}
+	if archiveSettings.Bucket == "" {
+		return nil, fmt.Errorf("%s: bucket is required", settingsLogsArchiveKey)
+	}
+	if strings.HasPrefix(archiveSettings.AccessKeyID, "$") || strings.HasPrefix(archiveSettings.SecretAccessKey, "$") {
+		argoCDSecret, err := mgr.getSecret()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving argocd-secret: %w", err)
+		}
+		secretValues := make(map[string]string, len(argoCDSecret.Data))
+		for k, v := range argoCDSecret.Data {
+			secretValues[k] = string(v)
+		}
+		archiveSettings.AccessKeyID = ReplaceStringSecret(archiveSettings.AccessKeyID, secretValues)
+		archiveSettings.SecretAccessKey = ReplaceStringSecret(archiveSettings.SecretAccessKey, secretValues)
+	}
+	return archiveSettings, nil
+}
+
+// GetRequiredApplicationLabels returns the sorted keys of the labels the applications of the given project must have.
+// The labels required for the '*' entry apply to the applications of all projects.
+func (mgr *SettingsManager) GetRequiredApplicationLabels(project string) ([]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	value := argoCDCM.Data[requiredApplicationLabelsKey]
+	if value == "" {
+		return nil, nil
+	}
+	requiredLabels := make(map[string][]string)
+	if err := yaml.Unmarshal([]byte(value), &requiredLabels); err != nil {
+		return nil, fmt.Errorf("error unmarshalling required application labels: %w", err)
+	}
+	labelKeys := append(slices.Clone(requiredLabels["*"]), requiredLabels[project]...)
+	slices.Sort(labelKeys)
+	return slices.Compact(labelKeys), nil
+}
+
+// GetResourceDeletionProtectionAnnotation returns the annotation which protects live resources set to "true" from being
+// deleted through the API. An empty string means that no resource is protected.
+func (mgr *SettingsManager) GetResourceDeletionProtectionAnnotation() (string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return "", fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	return strings.TrimSpace(argoCDCM.Data[resourceDeletionProtectionAnnotationKey]), nil
+}
+
+// GetApplicationFilters returns the named filters application list requests can reference
+func (mgr *SettingsManager) GetApplicationFilters() ([]ApplicationFilter, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	filters := make([]ApplicationFilter, 0)
+	value := argoCDCM.Data[settingsApplicationFiltersKey]
+	if value == "" {
+		return filters, nil
+	}
+	if err := yaml.Unmarshal([]byte(value), &filters); err != nil {
+		return nil, fmt.Errorf("error unmarshalling application filters: %w", err)
+	}
+	names := make(map[string]bool, len(filters))
+	for _, filter := range filters {
+		if filter.Name == "" {
+			return nil, fmt.Errorf("%s: filter name is required", settingsApplicationFiltersKey)
+		}
+		if names[filter.Name] {
+			return nil, fmt.Errorf("%s: duplicate filter name '%s'", settingsApplicationFiltersKey, filter.Name)
+		}
+		names[filter.Name] = true
+	}
+	return filters, nil
+}
+
 func (mgr *SettingsManager) GetDeepLinks(deeplinkType string) ([]DeepLink, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -2299,6 +2485,31 @@ func (mgr *SettingsManager) GetSensitiveAnnotations() map[string]bool {
 	return annotationKeys
 }
 
+// GetResourceRedactionRules returns the rules masking data keys of other kinds than Secret, whose data is always masked
+func (mgr *SettingsManager) GetResourceRedactionRules() ([]ResourceRedactionRule, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	rules := make([]ResourceRedactionRule, 0)
+	value := argoCDCM.Data[resourceRedactionRulesKey]
+	if value == "" {
+		return rules, nil
+	}
+	if err := yaml.Unmarshal([]byte(value), &rules); err != nil {
+		return nil, fmt.Errorf("error unmarshalling resource redaction rules: %w", err)
+	}
+	for _, rule := range rules {
+		if rule.Kind == "" {
+			return nil, fmt.Errorf("%s: kind is required", resourceRedactionRulesKey)
+		}
+		if len(rule.Keys) == 0 {
+			return nil, fmt.Errorf("%s: keys are required for kind '%s'", resourceRedactionRulesKey, rule.Kind)
+		}
+	}
+	return rules, nil
+}
+
 func (mgr *SettingsManager) GetMaxWebhookPayloadSize() int64 {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
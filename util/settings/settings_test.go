@@ -65,6 +65,130 @@ func TestDocumentedArgoCDConfigMapIsValid(t *testing.T) {
 	updateSettingsFromConfigMap(&settings, argocdCM)
 }
 
+func TestGetLogsArchiveSettings(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		archiveSettings, err := settingsManager.GetLogsArchiveSettings()
+		require.NoError(t, err)
+		assert.False(t, archiveSettings.Enabled)
+	})
+	t.Run("SecretReferences", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"server.logs.archive": "enabled: true\nbucket: logs\naccessKeyID: $logs.accessKeyID\nsecretAccessKey: $logs.secretAccessKey\n",
+		}, func(secret *corev1.Secret) {
+			secret.Data["logs.accessKeyID"] = []byte("access")
+			secret.Data["logs.secretAccessKey"] = []byte("secret")
+		})
+		archiveSettings, err := settingsManager.GetLogsArchiveSettings()
+		require.NoError(t, err)
+		assert.True(t, archiveSettings.Enabled)
+		assert.Equal(t, "logs", archiveSettings.Bucket)
+		assert.Equal(t, "access", archiveSettings.AccessKeyID)
+		assert.Equal(t, "secret", archiveSettings.SecretAccessKey)
+	})
+	t.Run("MissingBucket", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"server.logs.archive": "enabled: true\n"})
+		_, err := settingsManager.GetLogsArchiveSettings()
+		require.ErrorContains(t, err, "bucket is required")
+	})
+}
+
+func TestGetRequiredApplicationLabels(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		labelKeys, err := settingsManager.GetRequiredApplicationLabels("default")
+		require.NoError(t, err)
+		assert.Empty(t, labelKeys)
+	})
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"application.requiredLabels": "'*': [team]\npayments: [cost-center, team]\n",
+		})
+		labelKeys, err := settingsManager.GetRequiredApplicationLabels("payments")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cost-center", "team"}, labelKeys)
+		labelKeys, err = settingsManager.GetRequiredApplicationLabels("default")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"team"}, labelKeys)
+	})
+}
+
+func TestGetResourceDeletionProtectionAnnotation(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		annotation, err := settingsManager.GetResourceDeletionProtectionAnnotation()
+		require.NoError(t, err)
+		assert.Empty(t, annotation)
+	})
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"resource.deletionProtectionAnnotation": " example.com/protected "})
+		annotation, err := settingsManager.GetResourceDeletionProtectionAnnotation()
+		require.NoError(t, err)
+		assert.Equal(t, "example.com/protected", annotation)
+	})
+}
+
+func TestGetApplicationFilters(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		filters, err := settingsManager.GetApplicationFilters()
+		require.NoError(t, err)
+		assert.Empty(t, filters)
+	})
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"server.application.filters": "- name: payments-degraded\n  projects: [payments]\n  selector: team=payments\n  healthStatuses: [Degraded]\n",
+		})
+		filters, err := settingsManager.GetApplicationFilters()
+		require.NoError(t, err)
+		require.Len(t, filters, 1)
+		assert.Equal(t, "payments-degraded", filters[0].Name)
+		assert.Equal(t, []string{"payments"}, filters[0].Projects)
+		assert.Equal(t, "team=payments", filters[0].Selector)
+		assert.Equal(t, []string{"Degraded"}, filters[0].HealthStatuses)
+	})
+	t.Run("MissingName", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"server.application.filters": "- selector: team=payments\n"})
+		_, err := settingsManager.GetApplicationFilters()
+		require.ErrorContains(t, err, "filter name is required")
+	})
+	t.Run("DuplicateName", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"server.application.filters": "- name: a\n- name: a\n"})
+		_, err := settingsManager.GetApplicationFilters()
+		require.ErrorContains(t, err, "duplicate filter name 'a'")
+	})
+}
+
+func TestGetResourceRedactionRules(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		rules, err := settingsManager.GetResourceRedactionRules()
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+	})
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.redactionRules": "- kind: ConfigMap\n  keys: ['*password*', api-key]\n",
+		})
+		rules, err := settingsManager.GetResourceRedactionRules()
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Empty(t, rules[0].Group)
+		assert.Equal(t, "ConfigMap", rules[0].Kind)
+		assert.Equal(t, []string{"*password*", "api-key"}, rules[0].Keys)
+	})
+	t.Run("MissingKind", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"resource.redactionRules": "- keys: [api-key]\n"})
+		_, err := settingsManager.GetResourceRedactionRules()
+		require.ErrorContains(t, err, "kind is required")
+	})
+	t.Run("MissingKeys", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"resource.redactionRules": "- kind: ConfigMap\n"})
+		_, err := settingsManager.GetResourceRedactionRules()
+		require.ErrorContains(t, err, "keys are required for kind 'ConfigMap'")
+	})
+}
+
 func TestGetConfigMapByName(t *testing.T) {
 	t.Run("data is never nil", func(t *testing.T) {
 		_, settingsManager := fixtures(nil)
@@ -254,6 +378,31 @@ func TestGetInstallationID(t *testing.T) {
 	assert.Equal(t, "123456789", id)
 }
 
+func TestGetReconciliationTimeout(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{})
+		timeout, jitter, err := settingsManager.GetReconciliationTimeout()
+		require.NoError(t, err)
+		assert.Equal(t, 120*time.Second, timeout)
+		assert.Equal(t, 60*time.Second, jitter)
+	})
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"timeout.reconciliation":        "5m",
+			"timeout.reconciliation.jitter": "0s",
+		})
+		timeout, jitter, err := settingsManager.GetReconciliationTimeout()
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Minute, timeout)
+		assert.Equal(t, time.Duration(0), jitter)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"timeout.reconciliation": "five minutes"})
+		_, _, err := settingsManager.GetReconciliationTimeout()
+		require.Error(t, err)
+	})
+}
+
 func TestApplicationFineGrainedRBACInheritanceDisabledDefault(t *testing.T) {
 	_, settingsManager := fixtures(nil)
 	flag, err := settingsManager.ApplicationFineGrainedRBACInheritanceDisabled()